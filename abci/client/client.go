@@ -40,6 +40,13 @@ type Client interface {
 	OfferSnapshotAsync(types.RequestOfferSnapshot) *ReqRes
 	LoadSnapshotChunkAsync(types.RequestLoadSnapshotChunk) *ReqRes
 	ApplySnapshotChunkAsync(types.RequestApplySnapshotChunk) *ReqRes
+	ExtendVoteAsync(types.RequestExtendVote) *ReqRes
+	VerifyVoteExtensionAsync(types.RequestVerifyVoteExtension) *ReqRes
+	PrepareProposalAsync(types.RequestPrepareProposal) *ReqRes
+	ProcessProposalAsync(types.RequestProcessProposal) *ReqRes
+	FinalizeBlockAsync(types.RequestFinalizeBlock) *ReqRes
+	ExportStateAsync(types.RequestExportState) *ReqRes
+	ImportStateAsync(types.RequestImportState) *ReqRes
 
 	FlushSync() error
 	EchoSync(msg string) (*types.ResponseEcho, error)
@@ -56,6 +63,13 @@ type Client interface {
 	OfferSnapshotSync(types.RequestOfferSnapshot) (*types.ResponseOfferSnapshot, error)
 	LoadSnapshotChunkSync(types.RequestLoadSnapshotChunk) (*types.ResponseLoadSnapshotChunk, error)
 	ApplySnapshotChunkSync(types.RequestApplySnapshotChunk) (*types.ResponseApplySnapshotChunk, error)
+	ExtendVoteSync(types.RequestExtendVote) (*types.ResponseExtendVote, error)
+	VerifyVoteExtensionSync(types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error)
+	PrepareProposalSync(types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error)
+	ProcessProposalSync(types.RequestProcessProposal) (*types.ResponseProcessProposal, error)
+	FinalizeBlockSync(types.RequestFinalizeBlock) (*types.ResponseFinalizeBlock, error)
+	ExportStateSync(types.RequestExportState) (*types.ResponseExportState, error)
+	ImportStateSync(types.RequestImportState) (*types.ResponseImportState, error)
 }
 
 //----------------------------------------
@@ -259,6 +259,69 @@ func (cli *grpcClient) ApplySnapshotChunkAsync(params types.RequestApplySnapshot
 	return cli.finishAsyncCall(req, &types.Response{Value: &types.Response_ApplySnapshotChunk{ApplySnapshotChunk: res}})
 }
 
+func (cli *grpcClient) ExtendVoteAsync(params types.RequestExtendVote) *ReqRes {
+	req := types.ToRequestExtendVote(params)
+	res, err := cli.client.ExtendVote(context.Background(), req.GetExtendVote(), grpc.WaitForReady(true))
+	if err != nil {
+		cli.StopForError(err)
+	}
+	return cli.finishAsyncCall(req, &types.Response{Value: &types.Response_ExtendVote{ExtendVote: res}})
+}
+
+func (cli *grpcClient) VerifyVoteExtensionAsync(params types.RequestVerifyVoteExtension) *ReqRes {
+	req := types.ToRequestVerifyVoteExtension(params)
+	res, err := cli.client.VerifyVoteExtension(context.Background(), req.GetVerifyVoteExtension(), grpc.WaitForReady(true))
+	if err != nil {
+		cli.StopForError(err)
+	}
+	return cli.finishAsyncCall(req, &types.Response{Value: &types.Response_VerifyVoteExtension{VerifyVoteExtension: res}})
+}
+
+func (cli *grpcClient) PrepareProposalAsync(params types.RequestPrepareProposal) *ReqRes {
+	req := types.ToRequestPrepareProposal(params)
+	res, err := cli.client.PrepareProposal(context.Background(), req.GetPrepareProposal(), grpc.WaitForReady(true))
+	if err != nil {
+		cli.StopForError(err)
+	}
+	return cli.finishAsyncCall(req, &types.Response{Value: &types.Response_PrepareProposal{PrepareProposal: res}})
+}
+
+func (cli *grpcClient) ProcessProposalAsync(params types.RequestProcessProposal) *ReqRes {
+	req := types.ToRequestProcessProposal(params)
+	res, err := cli.client.ProcessProposal(context.Background(), req.GetProcessProposal(), grpc.WaitForReady(true))
+	if err != nil {
+		cli.StopForError(err)
+	}
+	return cli.finishAsyncCall(req, &types.Response{Value: &types.Response_ProcessProposal{ProcessProposal: res}})
+}
+
+func (cli *grpcClient) FinalizeBlockAsync(params types.RequestFinalizeBlock) *ReqRes {
+	req := types.ToRequestFinalizeBlock(params)
+	res, err := cli.client.FinalizeBlock(context.Background(), req.GetFinalizeBlock(), grpc.WaitForReady(true))
+	if err != nil {
+		cli.StopForError(err)
+	}
+	return cli.finishAsyncCall(req, &types.Response{Value: &types.Response_FinalizeBlock{FinalizeBlock: res}})
+}
+
+func (cli *grpcClient) ExportStateAsync(params types.RequestExportState) *ReqRes {
+	req := types.ToRequestExportState(params)
+	res, err := cli.client.ExportState(context.Background(), req.GetExportState(), grpc.WaitForReady(true))
+	if err != nil {
+		cli.StopForError(err)
+	}
+	return cli.finishAsyncCall(req, &types.Response{Value: &types.Response_ExportState{ExportState: res}})
+}
+
+func (cli *grpcClient) ImportStateAsync(params types.RequestImportState) *ReqRes {
+	req := types.ToRequestImportState(params)
+	res, err := cli.client.ImportState(context.Background(), req.GetImportState(), grpc.WaitForReady(true))
+	if err != nil {
+		cli.StopForError(err)
+	}
+	return cli.finishAsyncCall(req, &types.Response{Value: &types.Response_ImportState{ImportState: res}})
+}
+
 func (cli *grpcClient) finishAsyncCall(req *types.Request, res *types.Response) *ReqRes {
 	reqres := NewReqRes(req)
 	reqres.Response = res // Set response
@@ -362,3 +425,41 @@ func (cli *grpcClient) ApplySnapshotChunkSync(
 	reqres := cli.ApplySnapshotChunkAsync(params)
 	return reqres.Response.GetApplySnapshotChunk(), cli.Error()
 }
+
+func (cli *grpcClient) ExtendVoteSync(params types.RequestExtendVote) (*types.ResponseExtendVote, error) {
+	reqres := cli.ExtendVoteAsync(params)
+	return reqres.Response.GetExtendVote(), cli.Error()
+}
+
+func (cli *grpcClient) VerifyVoteExtensionSync(
+	params types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error) {
+	reqres := cli.VerifyVoteExtensionAsync(params)
+	return reqres.Response.GetVerifyVoteExtension(), cli.Error()
+}
+
+func (cli *grpcClient) PrepareProposalSync(
+	params types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error) {
+	reqres := cli.PrepareProposalAsync(params)
+	return reqres.Response.GetPrepareProposal(), cli.Error()
+}
+
+func (cli *grpcClient) ProcessProposalSync(
+	params types.RequestProcessProposal) (*types.ResponseProcessProposal, error) {
+	reqres := cli.ProcessProposalAsync(params)
+	return reqres.Response.GetProcessProposal(), cli.Error()
+}
+
+func (cli *grpcClient) FinalizeBlockSync(params types.RequestFinalizeBlock) (*types.ResponseFinalizeBlock, error) {
+	reqres := cli.FinalizeBlockAsync(params)
+	return reqres.Response.GetFinalizeBlock(), cli.Error()
+}
+
+func (cli *grpcClient) ExportStateSync(params types.RequestExportState) (*types.ResponseExportState, error) {
+	reqres := cli.ExportStateAsync(params)
+	return reqres.Response.GetExportState(), cli.Error()
+}
+
+func (cli *grpcClient) ImportStateSync(params types.RequestImportState) (*types.ResponseImportState, error) {
+	reqres := cli.ImportStateAsync(params)
+	return reqres.Response.GetImportState(), cli.Error()
+}
@@ -282,6 +282,34 @@ func (cli *socketClient) ApplySnapshotChunkAsync(req types.RequestApplySnapshotC
 	return cli.queueRequest(types.ToRequestApplySnapshotChunk(req))
 }
 
+func (cli *socketClient) ExtendVoteAsync(req types.RequestExtendVote) *ReqRes {
+	return cli.queueRequest(types.ToRequestExtendVote(req))
+}
+
+func (cli *socketClient) VerifyVoteExtensionAsync(req types.RequestVerifyVoteExtension) *ReqRes {
+	return cli.queueRequest(types.ToRequestVerifyVoteExtension(req))
+}
+
+func (cli *socketClient) PrepareProposalAsync(req types.RequestPrepareProposal) *ReqRes {
+	return cli.queueRequest(types.ToRequestPrepareProposal(req))
+}
+
+func (cli *socketClient) ProcessProposalAsync(req types.RequestProcessProposal) *ReqRes {
+	return cli.queueRequest(types.ToRequestProcessProposal(req))
+}
+
+func (cli *socketClient) FinalizeBlockAsync(req types.RequestFinalizeBlock) *ReqRes {
+	return cli.queueRequest(types.ToRequestFinalizeBlock(req))
+}
+
+func (cli *socketClient) ExportStateAsync(req types.RequestExportState) *ReqRes {
+	return cli.queueRequest(types.ToRequestExportState(req))
+}
+
+func (cli *socketClient) ImportStateAsync(req types.RequestImportState) *ReqRes {
+	return cli.queueRequest(types.ToRequestImportState(req))
+}
+
 //----------------------------------------
 
 func (cli *socketClient) FlushSync() error {
@@ -418,6 +446,70 @@ func (cli *socketClient) ApplySnapshotChunkSync(
 	return reqres.Response.GetApplySnapshotChunk(), cli.Error()
 }
 
+func (cli *socketClient) ExtendVoteSync(req types.RequestExtendVote) (*types.ResponseExtendVote, error) {
+	reqres := cli.queueRequest(types.ToRequestExtendVote(req))
+	if err := cli.FlushSync(); err != nil {
+		return nil, err
+	}
+
+	return reqres.Response.GetExtendVote(), cli.Error()
+}
+
+func (cli *socketClient) VerifyVoteExtensionSync(
+	req types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error) {
+	reqres := cli.queueRequest(types.ToRequestVerifyVoteExtension(req))
+	if err := cli.FlushSync(); err != nil {
+		return nil, err
+	}
+
+	return reqres.Response.GetVerifyVoteExtension(), cli.Error()
+}
+
+func (cli *socketClient) PrepareProposalSync(req types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error) {
+	reqres := cli.queueRequest(types.ToRequestPrepareProposal(req))
+	if err := cli.FlushSync(); err != nil {
+		return nil, err
+	}
+
+	return reqres.Response.GetPrepareProposal(), cli.Error()
+}
+
+func (cli *socketClient) ProcessProposalSync(req types.RequestProcessProposal) (*types.ResponseProcessProposal, error) {
+	reqres := cli.queueRequest(types.ToRequestProcessProposal(req))
+	if err := cli.FlushSync(); err != nil {
+		return nil, err
+	}
+
+	return reqres.Response.GetProcessProposal(), cli.Error()
+}
+
+func (cli *socketClient) FinalizeBlockSync(req types.RequestFinalizeBlock) (*types.ResponseFinalizeBlock, error) {
+	reqres := cli.queueRequest(types.ToRequestFinalizeBlock(req))
+	if err := cli.FlushSync(); err != nil {
+		return nil, err
+	}
+
+	return reqres.Response.GetFinalizeBlock(), cli.Error()
+}
+
+func (cli *socketClient) ExportStateSync(req types.RequestExportState) (*types.ResponseExportState, error) {
+	reqres := cli.queueRequest(types.ToRequestExportState(req))
+	if err := cli.FlushSync(); err != nil {
+		return nil, err
+	}
+
+	return reqres.Response.GetExportState(), cli.Error()
+}
+
+func (cli *socketClient) ImportStateSync(req types.RequestImportState) (*types.ResponseImportState, error) {
+	reqres := cli.queueRequest(types.ToRequestImportState(req))
+	if err := cli.FlushSync(); err != nil {
+		return nil, err
+	}
+
+	return reqres.Response.GetImportState(), cli.Error()
+}
+
 //----------------------------------------
 
 func (cli *socketClient) queueRequest(req *types.Request) *ReqRes {
@@ -482,6 +574,20 @@ func resMatchesReq(req *types.Request, res *types.Response) (ok bool) {
 		_, ok = res.Value.(*types.Response_BeginBlock)
 	case *types.Request_EndBlock:
 		_, ok = res.Value.(*types.Response_EndBlock)
+	case *types.Request_ExtendVote:
+		_, ok = res.Value.(*types.Response_ExtendVote)
+	case *types.Request_VerifyVoteExtension:
+		_, ok = res.Value.(*types.Response_VerifyVoteExtension)
+	case *types.Request_PrepareProposal:
+		_, ok = res.Value.(*types.Response_PrepareProposal)
+	case *types.Request_ProcessProposal:
+		_, ok = res.Value.(*types.Response_ProcessProposal)
+	case *types.Request_FinalizeBlock:
+		_, ok = res.Value.(*types.Response_FinalizeBlock)
+	case *types.Request_ExportState:
+		_, ok = res.Value.(*types.Response_ExportState)
+	case *types.Request_ImportState:
+		_, ok = res.Value.(*types.Response_ImportState)
 	}
 	return ok
 }
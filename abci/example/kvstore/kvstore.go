@@ -10,6 +10,7 @@ import (
 
 	"github.com/mydexchain/tendermint0/abci/example/code"
 	"github.com/mydexchain/tendermint0/abci/types"
+	tmtypes "github.com/mydexchain/tendermint0/types"
 	"github.com/mydexchain/tendermint0/version"
 )
 
@@ -79,6 +80,7 @@ func (app *Application) Info(req types.RequestInfo) (resInfo types.ResponseInfo)
 	return types.ResponseInfo{
 		Data:             fmt.Sprintf("{\"size\":%v}", app.state.Size),
 		Version:          version.ABCIVersion,
+		AbciVersion:      version.ABCIVersion,
 		AppVersion:       ProtocolVersion,
 		LastBlockHeight:  app.state.Height,
 		LastBlockAppHash: app.state.AppHash,
@@ -116,10 +118,31 @@ func (app *Application) DeliverTx(req types.RequestDeliverTx) types.ResponseDeli
 	return types.ResponseDeliverTx{Code: code.CodeTypeOK, Events: events}
 }
 
+func (app *Application) FinalizeBlock(req types.RequestFinalizeBlock) types.ResponseFinalizeBlock {
+	return types.LegacyFinalizeBlock(app, req)
+}
+
 func (app *Application) CheckTx(req types.RequestCheckTx) types.ResponseCheckTx {
 	return types.ResponseCheckTx{Code: code.CodeTypeOK, GasWanted: 1}
 }
 
+// PrepareProposal includes the txs of the request, in order, as long as they
+// fit in max_tx_bytes and max_gas, each tx wanting the gas CheckTx reports.
+func (app *Application) PrepareProposal(req types.RequestPrepareProposal) types.ResponsePrepareProposal {
+	txs := make([][]byte, 0, len(req.Txs))
+	var totalBytes, totalGas int64
+	for _, tx := range req.Txs {
+		totalBytes += tmtypes.ComputeProtoSizeForTxs([]tmtypes.Tx{tx})
+		gasWanted := app.CheckTx(types.RequestCheckTx{Tx: tx}).GasWanted
+		if totalBytes > req.MaxTxBytes || (req.MaxGas > -1 && totalGas+gasWanted > req.MaxGas) {
+			break
+		}
+		totalGas += gasWanted
+		txs = append(txs, tx)
+	}
+	return types.ResponsePrepareProposal{Txs: txs, GasWanted: totalGas}
+}
+
 func (app *Application) Commit() types.ResponseCommit {
 	// Using a memdb - just return the big endian size of the db
 	appHash := make([]byte, 8)
@@ -135,6 +158,52 @@ func (app *Application) Commit() types.ResponseCommit {
 	return resp
 }
 
+// ExportState exports a page of the whole database, including the app state,
+// so that nodes can serve snapshots of the app. Only the last committed height
+// can be exported, since the database holds no older versions.
+func (app *Application) ExportState(req types.RequestExportState) types.ResponseExportState {
+	resp := types.ResponseExportState{Height: app.state.Height}
+	if req.Height != app.state.Height {
+		return resp
+	}
+	itr, err := app.state.db.Iterator(req.StartKey, nil)
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+
+	size := int64(0)
+	for ; itr.Valid(); itr.Next() {
+		if len(resp.Items) > 0 && size+int64(len(itr.Key())+len(itr.Value())) > req.MaxBytes {
+			resp.NextKey = itr.Key()
+			break
+		}
+		resp.Items = append(resp.Items, &types.StateItem{Key: itr.Key(), Value: itr.Value()})
+		size += int64(len(itr.Key()) + len(itr.Value()))
+	}
+	if err := itr.Error(); err != nil {
+		panic(err)
+	}
+	return resp
+}
+
+// ImportState writes the items of a restored snapshot to the database, and
+// loads the app state once all items have been imported.
+func (app *Application) ImportState(req types.RequestImportState) types.ResponseImportState {
+	for _, item := range req.Items {
+		if err := app.state.db.Set(item.Key, item.Value); err != nil {
+			panic(err)
+		}
+	}
+	if req.Done {
+		app.state = loadState(app.state.db)
+		if app.state.Height != req.Height {
+			return types.ResponseImportState{Result: types.ResponseImportState_ABORT}
+		}
+	}
+	return types.ResponseImportState{Result: types.ResponseImportState_ACCEPT}
+}
+
 // Returns an associated value or nil if missing.
 func (app *Application) Query(reqQuery types.RequestQuery) (resQuery types.ResponseQuery) {
 	if reqQuery.Prove {
@@ -117,6 +117,81 @@ func TestPersistentKVStoreInfo(t *testing.T) {
 
 }
 
+func TestKVStorePrepareProposal(t *testing.T) {
+	kvstore := NewApplication()
+	txs := [][]byte{[]byte("a=1"), []byte("b=2"), []byte("c=3")}
+
+	res := kvstore.PrepareProposal(types.RequestPrepareProposal{Txs: txs, MaxTxBytes: 100, MaxGas: -1})
+	require.Equal(t, txs, res.Txs)
+	require.Equal(t, int64(3), res.GasWanted)
+
+	// txs which don't fit once encoded are dropped
+	res = kvstore.PrepareProposal(types.RequestPrepareProposal{Txs: txs, MaxTxBytes: 11, MaxGas: -1})
+	require.Equal(t, txs[:2], res.Txs)
+
+	// as well as those exceeding the max gas
+	res = kvstore.PrepareProposal(types.RequestPrepareProposal{Txs: txs, MaxTxBytes: 100, MaxGas: 1})
+	require.Equal(t, txs[:1], res.Txs)
+	require.Equal(t, int64(1), res.GasWanted)
+}
+
+func TestKVStoreExportImportState(t *testing.T) {
+	kvstore := NewApplication()
+	testKVStore(t, kvstore, []byte(testKey+"="+testValue), testKey, testValue)
+	info := kvstore.Info(types.RequestInfo{})
+
+	// Only the last committed height can be exported.
+	export := kvstore.ExportState(types.RequestExportState{Height: info.LastBlockHeight - 1, MaxBytes: 1000})
+	require.Equal(t, info.LastBlockHeight, export.Height)
+	require.Empty(t, export.Items)
+
+	// The state is exported in pages, of a single item since they are larger than max bytes.
+	export = kvstore.ExportState(types.RequestExportState{Height: info.LastBlockHeight, MaxBytes: 1})
+	require.Equal(t, info.LastBlockHeight, export.Height)
+	require.Len(t, export.Items, 1)
+	require.NotEmpty(t, export.NextKey)
+	next := kvstore.ExportState(types.RequestExportState{
+		Height: info.LastBlockHeight, StartKey: export.NextKey, MaxBytes: 1000})
+	require.NotEmpty(t, next.Items)
+	require.Empty(t, next.NextKey)
+
+	restored := NewApplication()
+	res := restored.ImportState(types.RequestImportState{Height: export.Height, Items: export.Items})
+	require.Equal(t, types.ResponseImportState_ACCEPT, res.Result)
+	res = restored.ImportState(types.RequestImportState{Height: next.Height, Items: next.Items, Done: true})
+	require.Equal(t, types.ResponseImportState_ACCEPT, res.Result)
+	require.Equal(t, info, restored.Info(types.RequestInfo{}))
+
+	resQuery := restored.Query(types.RequestQuery{Path: "/store", Data: []byte(testKey)})
+	require.Equal(t, testValue, string(resQuery.Value))
+
+	// A state which doesn't match the snapshot height is rejected.
+	items := append(export.Items, next.Items...)
+	res = NewApplication().ImportState(types.RequestImportState{Height: 7, Items: items, Done: true})
+	require.Equal(t, types.ResponseImportState_ABORT, res.Result)
+}
+
+func TestPersistentKVStoreProposals(t *testing.T) {
+	dir, err := ioutil.TempDir("/tmp", "abci-kvstore-test") // TODO
+	if err != nil {
+		t.Fatal(err)
+	}
+	kvstore := NewPersistentKVStoreApplication(dir)
+
+	goodTx := MakeValSetChangeTx(types.Ed25519ValidatorUpdate([]byte("12345678901234567890123456789012"), 1).PubKey, 1)
+	badTx := []byte("val:notbase64!1")
+	txs := [][]byte{[]byte("a=1"), badTx, goodTx}
+
+	resPrepare := kvstore.PrepareProposal(types.RequestPrepareProposal{Txs: txs, MaxTxBytes: 1000, MaxGas: -1})
+	require.Equal(t, [][]byte{[]byte("a=1"), goodTx}, resPrepare.Txs)
+
+	resProcess := kvstore.ProcessProposal(types.RequestProcessProposal{Txs: resPrepare.Txs})
+	require.Equal(t, types.ResponseProcessProposal_ACCEPT, resProcess.Result)
+
+	resProcess = kvstore.ProcessProposal(types.RequestProcessProposal{Txs: txs})
+	require.Equal(t, types.ResponseProcessProposal_REJECT, resProcess.Result)
+}
+
 // add a validator, remove a validator, update a validator
 func TestValUpdates(t *testing.T) {
 	dir, err := ioutil.TempDir("/tmp", "abci-kvstore-test") // TODO
@@ -184,6 +259,28 @@ func TestValUpdates(t *testing.T) {
 
 }
 
+func TestPersistentKVStoreFinalizeBlock(t *testing.T) {
+	dir, err := ioutil.TempDir("/tmp", "abci-kvstore-test") // TODO
+	if err != nil {
+		t.Fatal(err)
+	}
+	kvstore := NewPersistentKVStoreApplication(dir)
+
+	val := RandVals(1)[0]
+	txs := [][]byte{[]byte("a=1"), []byte("val:notbase64!1"), MakeValSetChangeTx(val.PubKey, val.Power)}
+
+	res := kvstore.FinalizeBlock(types.RequestFinalizeBlock{
+		Hash:   []byte("foo"),
+		Header: tmproto.Header{Height: 1},
+		Txs:    txs,
+	})
+	require.Len(t, res.TxResults, len(txs))
+	require.Equal(t, code.CodeTypeOK, res.TxResults[0].Code)
+	require.Equal(t, code.CodeTypeEncodingError, res.TxResults[1].Code)
+	require.Equal(t, code.CodeTypeOK, res.TxResults[2].Code)
+	valsEqual(t, []types.ValidatorUpdate{val}, res.ValidatorUpdates)
+}
+
 func makeApplyBlock(
 	t *testing.T,
 	kvstore types.Application,
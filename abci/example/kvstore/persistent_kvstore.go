@@ -151,6 +151,10 @@ func (app *PersistentKVStoreApplication) EndBlock(req types.RequestEndBlock) typ
 	return types.ResponseEndBlock{ValidatorUpdates: app.ValUpdates}
 }
 
+func (app *PersistentKVStoreApplication) FinalizeBlock(req types.RequestFinalizeBlock) types.ResponseFinalizeBlock {
+	return types.LegacyFinalizeBlock(app, req)
+}
+
 func (app *PersistentKVStoreApplication) ListSnapshots(
 	req types.RequestListSnapshots) types.ResponseListSnapshots {
 	return types.ResponseListSnapshots{}
@@ -171,6 +175,53 @@ func (app *PersistentKVStoreApplication) ApplySnapshotChunk(
 	return types.ResponseApplySnapshotChunk{Result: types.ResponseApplySnapshotChunk_ABORT}
 }
 
+func (app *PersistentKVStoreApplication) ExportState(req types.RequestExportState) types.ResponseExportState {
+	return app.app.ExportState(req)
+}
+
+func (app *PersistentKVStoreApplication) ImportState(req types.RequestImportState) types.ResponseImportState {
+	return app.app.ImportState(req)
+}
+
+func (app *PersistentKVStoreApplication) ExtendVote(req types.RequestExtendVote) types.ResponseExtendVote {
+	return app.app.ExtendVote(req)
+}
+
+func (app *PersistentKVStoreApplication) VerifyVoteExtension(
+	req types.RequestVerifyVoteExtension) types.ResponseVerifyVoteExtension {
+	return app.app.VerifyVoteExtension(req)
+}
+
+// PrepareProposal drops malformed validator set change txs, which would fail
+// in DeliverTx.
+func (app *PersistentKVStoreApplication) PrepareProposal(
+	req types.RequestPrepareProposal) types.ResponsePrepareProposal {
+	txs := make([][]byte, 0, len(req.Txs))
+	for _, tx := range req.Txs {
+		if isValidatorTx(tx) {
+			if _, err := parseValidatorTx(tx); err != nil {
+				continue
+			}
+		}
+		txs = append(txs, tx)
+	}
+	req.Txs = txs
+	return app.app.PrepareProposal(req)
+}
+
+// ProcessProposal rejects blocks with malformed validator set change txs.
+func (app *PersistentKVStoreApplication) ProcessProposal(
+	req types.RequestProcessProposal) types.ResponseProcessProposal {
+	for _, tx := range req.Txs {
+		if isValidatorTx(tx) {
+			if _, err := parseValidatorTx(tx); err != nil {
+				return types.ResponseProcessProposal{Result: types.ResponseProcessProposal_REJECT}
+			}
+		}
+	}
+	return types.ResponseProcessProposal{Result: types.ResponseProcessProposal_ACCEPT}
+}
+
 //---------------------------------------------
 // update validators
 
@@ -211,35 +262,42 @@ func isValidatorTx(tx []byte) bool {
 // format is "val:pubkey!power"
 // pubkey is a base64-encoded 32-byte ed25519 key
 func (app *PersistentKVStoreApplication) execValidatorTx(tx []byte) types.ResponseDeliverTx {
+	v, err := parseValidatorTx(tx)
+	if err != nil {
+		return types.ResponseDeliverTx{
+			Code: code.CodeTypeEncodingError,
+			Log:  err.Error()}
+	}
+
+	// update
+	return app.updateValidator(v)
+}
+
+// parseValidatorTx decodes a validator set change tx of the form
+// "val:pubkey!power".
+func parseValidatorTx(tx []byte) (types.ValidatorUpdate, error) {
 	tx = tx[len(ValidatorSetChangePrefix):]
 
 	//get the pubkey and power
 	pubKeyAndPower := strings.Split(string(tx), "!")
 	if len(pubKeyAndPower) != 2 {
-		return types.ResponseDeliverTx{
-			Code: code.CodeTypeEncodingError,
-			Log:  fmt.Sprintf("Expected 'pubkey!power'. Got %v", pubKeyAndPower)}
+		return types.ValidatorUpdate{}, fmt.Errorf("expected 'pubkey!power'. Got %v", pubKeyAndPower)
 	}
 	pubkeyS, powerS := pubKeyAndPower[0], pubKeyAndPower[1]
 
 	// decode the pubkey
 	pubkey, err := base64.StdEncoding.DecodeString(pubkeyS)
 	if err != nil {
-		return types.ResponseDeliverTx{
-			Code: code.CodeTypeEncodingError,
-			Log:  fmt.Sprintf("Pubkey (%s) is invalid base64", pubkeyS)}
+		return types.ValidatorUpdate{}, fmt.Errorf("pubkey (%s) is invalid base64", pubkeyS)
 	}
 
 	// decode the power
 	power, err := strconv.ParseInt(powerS, 10, 64)
 	if err != nil {
-		return types.ResponseDeliverTx{
-			Code: code.CodeTypeEncodingError,
-			Log:  fmt.Sprintf("Power (%s) is not an int", powerS)}
+		return types.ValidatorUpdate{}, fmt.Errorf("power (%s) is not an int", powerS)
 	}
 
-	// update
-	return app.updateValidator(types.Ed25519ValidatorUpdate(pubkey, power))
+	return types.Ed25519ValidatorUpdate(pubkey, power), nil
 }
 
 // add, update, or remove a validator
@@ -236,6 +236,27 @@ func (s *SocketServer) handleRequest(req *types.Request, responses chan<- *types
 	case *types.Request_ApplySnapshotChunk:
 		res := s.app.ApplySnapshotChunk(*r.ApplySnapshotChunk)
 		responses <- types.ToResponseApplySnapshotChunk(res)
+	case *types.Request_ExtendVote:
+		res := s.app.ExtendVote(*r.ExtendVote)
+		responses <- types.ToResponseExtendVote(res)
+	case *types.Request_VerifyVoteExtension:
+		res := s.app.VerifyVoteExtension(*r.VerifyVoteExtension)
+		responses <- types.ToResponseVerifyVoteExtension(res)
+	case *types.Request_PrepareProposal:
+		res := s.app.PrepareProposal(*r.PrepareProposal)
+		responses <- types.ToResponsePrepareProposal(res)
+	case *types.Request_ProcessProposal:
+		res := s.app.ProcessProposal(*r.ProcessProposal)
+		responses <- types.ToResponseProcessProposal(res)
+	case *types.Request_FinalizeBlock:
+		res := s.app.FinalizeBlock(*r.FinalizeBlock)
+		responses <- types.ToResponseFinalizeBlock(res)
+	case *types.Request_ExportState:
+		res := s.app.ExportState(*r.ExportState)
+		responses <- types.ToResponseExportState(res)
+	case *types.Request_ImportState:
+		res := s.app.ImportState(*r.ImportState)
+		responses <- types.ToResponseImportState(res)
 	default:
 		responses <- types.ToResponseException("Unknown request")
 	}
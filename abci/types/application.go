@@ -24,11 +24,31 @@ type Application interface {
 	EndBlock(RequestEndBlock) ResponseEndBlock       // Signals the end of a block, returns changes to the validator set
 	Commit() ResponseCommit                          // Commit the state and return the application Merkle root hash
 
+	// FinalizeBlock delivers a whole block at once. Tendermint executes blocks
+	// with FinalizeBlock rather than BeginBlock, DeliverTx and EndBlock if the
+	// application reports, in ResponseInfo.AbciVersion, ABCI 0.18 or later.
+	FinalizeBlock(RequestFinalizeBlock) ResponseFinalizeBlock
+
+	// Vote Extensions
+	ExtendVote(RequestExtendVote) ResponseExtendVote                            // Return the extension of our precommit
+	VerifyVoteExtension(RequestVerifyVoteExtension) ResponseVerifyVoteExtension // Verify a precommit extension
+
+	// Block Proposals
+	PrepareProposal(RequestPrepareProposal) ResponsePrepareProposal // Choose the txs of the block we propose
+	ProcessProposal(RequestProcessProposal) ResponseProcessProposal // Accept or reject a proposed block
+
 	// State Sync Connection
 	ListSnapshots(RequestListSnapshots) ResponseListSnapshots                // List available snapshots
 	OfferSnapshot(RequestOfferSnapshot) ResponseOfferSnapshot                // Offer a snapshot to the application
 	LoadSnapshotChunk(RequestLoadSnapshotChunk) ResponseLoadSnapshotChunk    // Load a snapshot chunk
 	ApplySnapshotChunk(RequestApplySnapshotChunk) ResponseApplySnapshotChunk // Apply a shapshot chunk
+
+	// Node-side snapshots, for applications which don't implement the snapshot
+	// calls above. ExportState is called in pages right after Commit, before the
+	// next block is executed, and must export the state of the requested height,
+	// sorted by key.
+	ExportState(RequestExportState) ResponseExportState // Export the state as key-value pairs
+	ImportState(RequestImportState) ResponseImportState // Import key-value pairs of a restored state
 }
 
 //-------------------------------------------------------
@@ -79,6 +99,16 @@ func (BaseApplication) EndBlock(req RequestEndBlock) ResponseEndBlock {
 	return ResponseEndBlock{}
 }
 
+// FinalizeBlock panics. Tendermint only calls FinalizeBlock on applications
+// reporting, with Info, an ABCI version which has it, and executes blocks on
+// the others with BeginBlock, DeliverTx and EndBlock. Applications reporting
+// it must implement FinalizeBlock, with LegacyFinalizeBlock if they execute
+// blocks with those calls.
+func (BaseApplication) FinalizeBlock(req RequestFinalizeBlock) ResponseFinalizeBlock {
+	panic("FinalizeBlock is not implemented by the application: " +
+		"implement it with LegacyFinalizeBlock or wrap the application with NewLegacyApplication")
+}
+
 func (BaseApplication) ListSnapshots(req RequestListSnapshots) ResponseListSnapshots {
 	return ResponseListSnapshots{}
 }
@@ -95,6 +125,79 @@ func (BaseApplication) ApplySnapshotChunk(req RequestApplySnapshotChunk) Respons
 	return ResponseApplySnapshotChunk{}
 }
 
+func (BaseApplication) ExportState(req RequestExportState) ResponseExportState {
+	return ResponseExportState{}
+}
+
+func (BaseApplication) ImportState(req RequestImportState) ResponseImportState {
+	return ResponseImportState{}
+}
+
+func (BaseApplication) ExtendVote(req RequestExtendVote) ResponseExtendVote {
+	return ResponseExtendVote{}
+}
+
+func (BaseApplication) VerifyVoteExtension(req RequestVerifyVoteExtension) ResponseVerifyVoteExtension {
+	return ResponseVerifyVoteExtension{Result: ResponseVerifyVoteExtension_ACCEPT}
+}
+
+func (BaseApplication) PrepareProposal(req RequestPrepareProposal) ResponsePrepareProposal {
+	return ResponsePrepareProposal{Txs: req.Txs}
+}
+
+func (BaseApplication) ProcessProposal(req RequestProcessProposal) ResponseProcessProposal {
+	return ResponseProcessProposal{Result: ResponseProcessProposal_ACCEPT}
+}
+
+//-------------------------------------------------------
+
+// LegacyFinalizeBlock executes the block of req on app with BeginBlock,
+// DeliverTx for each tx and EndBlock, and combines their responses. It lets
+// applications which execute blocks with those calls implement FinalizeBlock:
+//
+//	func (app *App) FinalizeBlock(req types.RequestFinalizeBlock) types.ResponseFinalizeBlock {
+//		return types.LegacyFinalizeBlock(app, req)
+//	}
+func LegacyFinalizeBlock(app Application, req RequestFinalizeBlock) ResponseFinalizeBlock {
+	resBeginBlock := app.BeginBlock(RequestBeginBlock{
+		Hash:                req.Hash,
+		Header:              req.Header,
+		LastCommitInfo:      req.LastCommitInfo,
+		ByzantineValidators: req.ByzantineValidators,
+	})
+
+	txResults := make([]*ResponseDeliverTx, len(req.Txs))
+	for i, tx := range req.Txs {
+		res := app.DeliverTx(RequestDeliverTx{Tx: tx})
+		txResults[i] = &res
+	}
+
+	resEndBlock := app.EndBlock(RequestEndBlock{Height: req.Header.Height})
+
+	return ResponseFinalizeBlock{
+		BeginBlockEvents:      resBeginBlock.Events,
+		TxResults:             txResults,
+		ValidatorUpdates:      resEndBlock.ValidatorUpdates,
+		ConsensusParamUpdates: resEndBlock.ConsensusParamUpdates,
+		EndBlockEvents:        resEndBlock.Events,
+	}
+}
+
+// NewLegacyApplication wraps app, which executes blocks with BeginBlock,
+// DeliverTx and EndBlock, so that FinalizeBlock is implemented in terms of
+// those calls (see LegacyFinalizeBlock).
+func NewLegacyApplication(app Application) Application {
+	return legacyApplication{app}
+}
+
+type legacyApplication struct {
+	Application
+}
+
+func (app legacyApplication) FinalizeBlock(req RequestFinalizeBlock) ResponseFinalizeBlock {
+	return LegacyFinalizeBlock(app.Application, req)
+}
+
 //-------------------------------------------------------
 
 // GRPCApplication is a GRPC wrapper for Application
@@ -182,3 +285,45 @@ func (app *GRPCApplication) ApplySnapshotChunk(
 	res := app.app.ApplySnapshotChunk(*req)
 	return &res, nil
 }
+
+func (app *GRPCApplication) ExtendVote(
+	ctx context.Context, req *RequestExtendVote) (*ResponseExtendVote, error) {
+	res := app.app.ExtendVote(*req)
+	return &res, nil
+}
+
+func (app *GRPCApplication) VerifyVoteExtension(
+	ctx context.Context, req *RequestVerifyVoteExtension) (*ResponseVerifyVoteExtension, error) {
+	res := app.app.VerifyVoteExtension(*req)
+	return &res, nil
+}
+
+func (app *GRPCApplication) PrepareProposal(
+	ctx context.Context, req *RequestPrepareProposal) (*ResponsePrepareProposal, error) {
+	res := app.app.PrepareProposal(*req)
+	return &res, nil
+}
+
+func (app *GRPCApplication) ProcessProposal(
+	ctx context.Context, req *RequestProcessProposal) (*ResponseProcessProposal, error) {
+	res := app.app.ProcessProposal(*req)
+	return &res, nil
+}
+
+func (app *GRPCApplication) FinalizeBlock(
+	ctx context.Context, req *RequestFinalizeBlock) (*ResponseFinalizeBlock, error) {
+	res := app.app.FinalizeBlock(*req)
+	return &res, nil
+}
+
+func (app *GRPCApplication) ExportState(
+	ctx context.Context, req *RequestExportState) (*ResponseExportState, error) {
+	res := app.app.ExportState(*req)
+	return &res, nil
+}
+
+func (app *GRPCApplication) ImportState(
+	ctx context.Context, req *RequestImportState) (*ResponseImportState, error) {
+	res := app.app.ImportState(*req)
+	return &res, nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type deliverTxApp struct {
+	BaseApplication
+	delivered int
+}
+
+func (app *deliverTxApp) DeliverTx(req RequestDeliverTx) ResponseDeliverTx {
+	app.delivered++
+	return ResponseDeliverTx{Code: 1}
+}
+
+func TestFinalizeBlock(t *testing.T) {
+	app := &deliverTxApp{}
+	req := RequestFinalizeBlock{Txs: [][]byte{[]byte("a"), []byte("b")}}
+
+	// an application embedding BaseApplication must implement FinalizeBlock
+	assert.Panics(t, func() { app.FinalizeBlock(req) })
+
+	res := NewLegacyApplication(app).FinalizeBlock(req)
+	assert.Equal(t, 2, app.delivered)
+	assert.Equal(t, []*ResponseDeliverTx{{Code: 1}, {Code: 1}}, res.TxResults)
+}
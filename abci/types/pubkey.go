@@ -1,12 +1,20 @@
 package types
 
 import (
+	"fmt"
+
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
 	"github.com/mydexchain/tendermint0/crypto/ed25519"
 	cryptoenc "github.com/mydexchain/tendermint0/crypto/encoding"
+	"github.com/mydexchain/tendermint0/crypto/secp256k1"
+	"github.com/mydexchain/tendermint0/crypto/sr25519"
 )
 
 const (
-	PubKeyEd25519 = "ed25519"
+	PubKeyEd25519   = "ed25519"
+	PubKeySecp256k1 = "secp256k1"
+	PubKeySr25519   = "sr25519"
+	PubKeyBls12381  = "bls12381"
 )
 
 func Ed25519ValidatorUpdate(pk []byte, power int64) ValidatorUpdate {
@@ -22,3 +30,48 @@ func Ed25519ValidatorUpdate(pk []byte, power int64) ValidatorUpdate {
 		Power:  power,
 	}
 }
+
+// UpdateValidator returns a ValidatorUpdate for the public key pk of the
+// given type (PubKeyEd25519, PubKeySecp256k1, PubKeySr25519 or PubKeyBls12381). An empty
+// keyType defaults to ed25519. It panics if keyType is not supported.
+func UpdateValidator(pk []byte, power int64, keyType string) ValidatorUpdate {
+	switch keyType {
+	case "", PubKeyEd25519:
+		return Ed25519ValidatorUpdate(pk, power)
+	case PubKeySecp256k1:
+		pke := secp256k1.PubKey(pk)
+		pkp, err := cryptoenc.PubKeyToProto(pke)
+		if err != nil {
+			panic(err)
+		}
+		return ValidatorUpdate{
+			// Address:
+			PubKey: pkp,
+			Power:  power,
+		}
+	case PubKeySr25519:
+		pke := sr25519.PubKey(pk)
+		pkp, err := cryptoenc.PubKeyToProto(pke)
+		if err != nil {
+			panic(err)
+		}
+		return ValidatorUpdate{
+			// Address:
+			PubKey: pkp,
+			Power:  power,
+		}
+	case PubKeyBls12381:
+		pke := bls12381.PubKey(pk)
+		pkp, err := cryptoenc.PubKeyToProto(pke)
+		if err != nil {
+			panic(err)
+		}
+		return ValidatorUpdate{
+			// Address:
+			PubKey: pkp,
+			Power:  power,
+		}
+	default:
+		panic(fmt.Sprintf("key type %s not supported", keyType))
+	}
+}
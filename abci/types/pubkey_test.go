@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
+	"github.com/mydexchain/tendermint0/crypto/ed25519"
+	cryptoenc "github.com/mydexchain/tendermint0/crypto/encoding"
+	"github.com/mydexchain/tendermint0/crypto/secp256k1"
+	"github.com/mydexchain/tendermint0/crypto/sr25519"
+)
+
+func TestUpdateValidator(t *testing.T) {
+	testCases := []struct {
+		keyType string
+		pubKey  crypto.PubKey
+	}{
+		{"", ed25519.GenPrivKey().PubKey()},
+		{PubKeyEd25519, ed25519.GenPrivKey().PubKey()},
+		{PubKeySecp256k1, secp256k1.GenPrivKey().PubKey()},
+		{PubKeySr25519, sr25519.GenPrivKey().PubKey()},
+		{PubKeyBls12381, bls12381.GenPrivKey().PubKey()},
+	}
+
+	for _, tc := range testCases {
+		vu := UpdateValidator(tc.pubKey.Bytes(), 10, tc.keyType)
+		assert.EqualValues(t, 10, vu.Power)
+		pubKey, err := cryptoenc.PubKeyFromProto(vu.PubKey)
+		require.NoError(t, err, tc.keyType)
+		assert.Equal(t, tc.pubKey, pubKey, tc.keyType)
+	}
+
+	assert.Panics(t, func() { UpdateValidator([]byte{1}, 10, "potatoes") })
+}
@@ -83,10 +83,20 @@ func ValidateMsg(pb proto.Message) error {
 			return errors.New("negative Height")
 		}
 	case *bcproto.BlockResponse:
-		_, err := types.BlockFromProto(msg.Block)
+		block, err := types.BlockFromProto(msg.Block)
 		if err != nil {
 			return err
 		}
+		if msg.ExtCommit != nil {
+			extCommit, err := types.ExtendedCommitFromProto(msg.ExtCommit)
+			if err != nil {
+				return fmt.Errorf("invalid extended commit: %w", err)
+			}
+			if extCommit.Height != block.Height {
+				return fmt.Errorf("extended commit height %v does not match block height %v",
+					extCommit.Height, block.Height)
+			}
+		}
 	case *bcproto.NoBlockResponse:
 		if msg.Height < 0 {
 			return errors.New("negative Height")
@@ -108,3 +118,21 @@ func ValidateMsg(pb proto.Message) error {
 	}
 	return nil
 }
+
+// VerifyExtendedCommit verifies that extCommit, received along with the block
+// blockID at height, is signed by +2/3 of vals, along with the vote extensions
+// of its precommits.
+func VerifyExtendedCommit(chainID string, vals *types.ValidatorSet, blockID types.BlockID, height int64,
+	extCommit *types.ExtendedCommit) error {
+	if extCommit.Height != height {
+		return fmt.Errorf("expected extended commit for height %v, got %v", height, extCommit.Height)
+	}
+	voteSet, err := types.ExtendedCommitToVoteSet(chainID, extCommit, vals)
+	if err != nil {
+		return err
+	}
+	if maj23, ok := voteSet.TwoThirdsMajority(); !ok || !maj23.Equals(blockID) {
+		return fmt.Errorf("extended commit is not +2/3 for block %v", blockID)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package v0
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "blockchain"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Time spent verifying the commit of a block.
+	CommitVerificationTime metrics.Histogram
+	// Time spent waiting for the verification of the commit of the block to
+	// apply, which isn't done ahead of time.
+	CommitVerificationWaitTime metrics.Histogram
+	// Time spent applying a block.
+	BlockApplyTime metrics.Histogram
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		CommitVerificationTime: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "commit_verification_time",
+			Help:      "Time spent verifying the commit of a block in ms.",
+			Buckets:   stdprometheus.LinearBuckets(1, 10, 10),
+		}, labels).With(labelsAndValues...),
+		CommitVerificationWaitTime: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "commit_verification_wait_time",
+			Help:      "Time spent waiting for the verification of the commit of the block to apply in ms.",
+			Buckets:   stdprometheus.LinearBuckets(1, 10, 10),
+		}, labels).With(labelsAndValues...),
+		BlockApplyTime: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "block_apply_time",
+			Help:      "Time spent applying a block in ms.",
+			Buckets:   stdprometheus.LinearBuckets(1, 10, 10),
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		CommitVerificationTime:     discard.NewHistogram(),
+		CommitVerificationWaitTime: discard.NewHistogram(),
+		BlockApplyTime:             discard.NewHistogram(),
+	}
+}
@@ -42,7 +42,7 @@ func (p testPeer) runInputRoutine() {
 // Request desired, pretend like we got the block immediately.
 func (p testPeer) simulateInput(input inputData) {
 	block := &types.Block{Header: types.Header{Height: input.request.Height}}
-	input.pool.AddBlock(input.request.PeerID, block, 123)
+	input.pool.AddBlock(input.request.PeerID, block, nil, 123)
 	// TODO: uncommenting this creates a race which is detected by:
 	// https://github.com/golang/go/blob/2bd767b1022dd3254bcec469f0ee164024726486/src/testing/testing.go#L854-L856
 	// see: https://github.com/mydexchain/tendermint0/issues/3390#issue-418379890
@@ -137,6 +137,24 @@ func TestBlockPoolBasic(t *testing.T) {
 	}
 }
 
+func TestBlockPoolPeekBlocks(t *testing.T) {
+	start := int64(42)
+	pool := NewBlockPool(start, make(chan BlockRequest), make(chan peerError))
+	for height := start; height < start+5; height++ {
+		pool.requesters[height] = newBPRequester(pool, height)
+	}
+	for _, height := range []int64{start, start + 1, start + 3} {
+		pool.requesters[height].block = &types.Block{Header: types.Header{Height: height}}
+	}
+
+	// the blocks are returned up to the first missing one
+	blocks := pool.PeekBlocks(5)
+	require.Len(t, blocks, 2)
+	assert.Equal(t, start, blocks[0].Height)
+	assert.Equal(t, start+1, blocks[1].Height)
+	assert.Len(t, pool.PeekBlocks(1), 1)
+}
+
 func TestBlockPoolTimeout(t *testing.T) {
 	start := int64(42)
 	peers := makePeers(10, start+1, 1000)
@@ -59,11 +59,22 @@ type BlockchainReactor struct {
 
 	requestsCh <-chan BlockRequest
 	errorsCh   <-chan peerError
+
+	verifier *commitVerifier
+	metrics  *Metrics
+}
+
+// ReactorOption sets an optional parameter on the reactor.
+type ReactorOption func(*BlockchainReactor)
+
+// ReactorMetrics sets the metrics.
+func ReactorMetrics(metrics *Metrics) ReactorOption {
+	return func(bcR *BlockchainReactor) { bcR.metrics = metrics }
 }
 
 // NewBlockchainReactor returns new reactor instance.
 func NewBlockchainReactor(state sm.State, blockExec *sm.BlockExecutor, store *store.BlockStore,
-	fastSync bool) *BlockchainReactor {
+	fastSync bool, options ...ReactorOption) *BlockchainReactor {
 
 	if state.LastBlockHeight != store.Height() {
 		panic(fmt.Sprintf("state (%v) and store (%v) height mismatch", state.LastBlockHeight,
@@ -89,8 +100,13 @@ func NewBlockchainReactor(state sm.State, blockExec *sm.BlockExecutor, store *st
 		fastSync:     fastSync,
 		requestsCh:   requestsCh,
 		errorsCh:     errorsCh,
+		metrics:      NopMetrics(),
 	}
 	bcR.BaseReactor = *p2p.NewBaseReactor("BlockchainReactor", bcR)
+	for _, option := range options {
+		option(bcR)
+	}
+	bcR.verifier = newCommitVerifier(state.ChainID, bcR.metrics)
 	return bcR
 }
 
@@ -181,7 +197,10 @@ func (bcR *BlockchainReactor) respondToPeer(msg *bcproto.BlockRequest,
 			return false
 		}
 
-		msgBytes, err := bc.EncodeMsg(&bcproto.BlockResponse{Block: bl})
+		msgBytes, err := bc.EncodeMsg(&bcproto.BlockResponse{
+			Block:     bl,
+			ExtCommit: bcR.store.LoadBlockExtendedCommit(msg.Height).ToProto(),
+		})
 		if err != nil {
 			bcR.Logger.Error("could not marshal msg", "err", err)
 			return false
@@ -227,7 +246,15 @@ func (bcR *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte)
 			bcR.Logger.Error("Block content is invalid", "err", err)
 			return
 		}
-		bcR.pool.AddBlock(src.ID(), bi, len(msgBytes))
+		var extCommit *types.ExtendedCommit
+		if msg.ExtCommit != nil {
+			extCommit, err = types.ExtendedCommitFromProto(msg.ExtCommit)
+			if err != nil {
+				bcR.Logger.Error("Extended commit is invalid", "err", err)
+				return
+			}
+		}
+		bcR.pool.AddBlock(src.ID(), bi, extCommit, len(msgBytes))
 	case *bcproto.StatusRequest:
 		// Send peer our state.
 		msgBytes, err := bc.EncodeMsg(&bcproto.StatusResponse{
@@ -259,7 +286,6 @@ func (bcR *BlockchainReactor) poolRoutine(stateSynced bool) {
 
 	blocksSynced := uint64(0)
 
-	chainID := bcR.initialState.ChainID
 	state := bcR.initialState
 
 	lastHundred := time.Now()
@@ -351,15 +377,12 @@ FOR_LOOP:
 				didProcessCh <- struct{}{}
 			}
 
-			firstParts := first.MakePartSet(types.BlockPartSizeBytes)
-			firstPartSetHeader := firstParts.Header()
-			firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: firstPartSetHeader}
+			// Start verifying the commits of the next blocks, to be done with
+			// them while the first block is applied.
+			bcR.verifier.verifyAhead(state, bcR.pool.PeekBlocks(verifyAheadBlocks+1))
+
 			// Finally, verify the first block using the second's commit
-			// NOTE: we can probably make this more efficient, but note that calling
-			// first.Hash() doesn't verify the tx contents, so MakePartSet() is
-			// currently necessary.
-			err := state.Validators.VerifyCommit(
-				chainID, firstID, first.Height, second.LastCommit)
+			firstParts, firstID, err := bcR.verifier.verify(state, first, second)
 			if err != nil {
 				bcR.Logger.Error("Error in validation", "err", err)
 				peerID := bcR.pool.RedoRequest(first.Height)
@@ -378,19 +401,38 @@ FOR_LOOP:
 				}
 				continue FOR_LOOP
 			} else {
+				extCommit := bcR.pool.PeekExtendedCommit()
 				bcR.pool.PopRequest()
 
+				// the second's commit needn't be verified again when
+				// validating the second block
+				bcR.blockExec.VerifiedCommits().Add(state.Validators, firstID, first.Height, second.LastCommit)
+
 				// TODO: batch saves so we dont persist to disk every block
-				bcR.store.SaveBlock(first, firstParts, second.LastCommit)
+				if extCommit != nil {
+					err = bc.VerifyExtendedCommit(state.ChainID, state.Validators, firstID, first.Height, extCommit)
+					if err != nil {
+						bcR.Logger.Error("Ignoring invalid extended commit", "height", first.Height, "err", err)
+						extCommit = nil
+					}
+				}
+				if extCommit != nil {
+					bcR.store.SaveBlockWithExtendedCommit(first, firstParts, extCommit)
+				} else {
+					bcR.store.SaveBlock(first, firstParts, second.LastCommit)
+				}
 
 				// TODO: same thing for app - but we would need a way to
 				// get the hash without persisting the state
 				var err error
+				startTime := time.Now().UnixNano()
 				state, _, err = bcR.blockExec.ApplyBlock(state, firstID, first)
 				if err != nil {
 					// TODO This is bad, are we zombie?
 					panic(fmt.Sprintf("Failed to process committed block (%d:%X): %v", first.Height, first.Hash(), err))
 				}
+				endTime := time.Now().UnixNano()
+				bcR.metrics.BlockApplyTime.Observe(float64(endTime-startTime) / 1000000)
 				blocksSynced++
 
 				if blocksSynced%100 == 0 {
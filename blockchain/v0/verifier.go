@@ -0,0 +1,121 @@
+package v0
+
+import (
+	"bytes"
+	"time"
+
+	sm "github.com/mydexchain/tendermint0/state"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+// verifyAheadBlocks is the number of blocks following the block being applied
+// whose commit is verified in the meantime.
+const verifyAheadBlocks = 10
+
+// commitVerification is the verification of the commit of a block, which is
+// the LastCommit of the next block.
+type commitVerification struct {
+	block    *types.Block
+	parts    *types.PartSet
+	blockID  types.BlockID
+	commit   *types.Commit
+	valsHash []byte
+
+	done chan struct{}
+	err  error // set before done is closed
+}
+
+// commitVerifier verifies the commits of the blocks following the one being
+// applied in the background, so that the verification of the commit of the
+// next block is usually done by the time it is applied.
+//
+// The validators of a block are only known once the blocks before it are
+// applied: the commits of the blocks ahead are verified with the validators
+// of the state, assuming they don't change, and a verification is only used
+// if the validators turn out to be the same. It must only be used by a single
+// goroutine.
+type commitVerifier struct {
+	chainID       string
+	metrics       *Metrics
+	verifications map[int64]*commitVerification
+}
+
+func newCommitVerifier(chainID string, metrics *Metrics) *commitVerifier {
+	return &commitVerifier{
+		chainID:       chainID,
+		metrics:       metrics,
+		verifications: make(map[int64]*commitVerification),
+	}
+}
+
+// verifyAhead starts verifying the commits of the consecutive blocks, but the
+// last one whose commit is in a block we don't have yet.
+func (cv *commitVerifier) verifyAhead(state sm.State, blocks []*types.Block) {
+	for i := 0; i+1 < len(blocks); i++ {
+		block, commit := blocks[i], blocks[i+1].LastCommit
+		if v, ok := cv.verifications[block.Height]; ok && v.block == block && v.commit == commit {
+			continue
+		}
+		vals := state.NextValidators
+		if block.Height == state.LastBlockHeight+1 {
+			vals = state.Validators
+		}
+		// skip the blocks signed by other validators, their commit will be
+		// verified when they are applied
+		if !bytes.Equal(block.ValidatorsHash, vals.Hash()) {
+			continue
+		}
+		cv.verifications[block.Height] = cv.start(vals, block, commit)
+	}
+}
+
+// verify returns the part set and ID of first, after verifying its commit with
+// the validators of the state, waiting for the verification to complete if it
+// was started ahead.
+func (cv *commitVerifier) verify(state sm.State, first, second *types.Block) (*types.PartSet, types.BlockID, error) {
+	v, ok := cv.verifications[first.Height]
+	for height := range cv.verifications {
+		if height <= first.Height {
+			delete(cv.verifications, height)
+		}
+	}
+	if !ok || v.block != first || v.commit != second.LastCommit || !bytes.Equal(v.valsHash, state.Validators.Hash()) {
+		v = cv.start(state.Validators, first, second.LastCommit)
+	}
+
+	startTime := time.Now().UnixNano()
+	<-v.done
+	endTime := time.Now().UnixNano()
+	cv.metrics.CommitVerificationWaitTime.Observe(float64(endTime-startTime) / 1000000)
+	return v.parts, v.blockID, v.err
+}
+
+// start starts verifying the commit of the block in a new goroutine.
+func (cv *commitVerifier) start(vals *types.ValidatorSet, block *types.Block,
+	commit *types.Commit) *commitVerification {
+
+	// NOTE: we can probably make this more efficient, but note that calling
+	// block.Hash() doesn't verify the tx contents, so MakePartSet() is
+	// currently necessary.
+	parts := block.MakePartSet(types.BlockPartSizeBytes)
+	v := &commitVerification{
+		block:    block,
+		parts:    parts,
+		blockID:  types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()},
+		commit:   commit,
+		valsHash: vals.Hash(),
+		done:     make(chan struct{}),
+	}
+
+	// the goroutine gets its own copy of the validators, computing some
+	// fields lazily
+	vals, height := vals.Copy(), block.Height
+	go func() {
+		startTime := time.Now().UnixNano()
+		v.err = vals.VerifyCommit(cv.chainID, v.blockID, height, commit)
+		endTime := time.Now().UnixNano()
+		cv.metrics.CommitVerificationTime.Observe(float64(endTime-startTime) / 1000000)
+		close(v.done)
+	}()
+	return v
+}
@@ -0,0 +1,93 @@
+package v0
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/mydexchain/tendermint0/config"
+	sm "github.com/mydexchain/tendermint0/state"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+// makeChain returns n consecutive blocks, each committing the previous one
+// with a vote of privVal.
+func makeChain(t *testing.T, state sm.State, privVal types.PrivValidator, n int) []*types.Block {
+	blocks := make([]*types.Block, n)
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+	for i := range blocks {
+		if i > 0 {
+			lastCommit = makeCommit(t, state, privVal, blocks[i-1])
+		}
+		blocks[i] = makeBlock(int64(i+1), state, lastCommit)
+	}
+	return blocks
+}
+
+func makeCommit(t *testing.T, state sm.State, privVal types.PrivValidator, block *types.Block) *types.Commit {
+	parts := block.MakePartSet(types.BlockPartSizeBytes)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+	vote, err := types.MakeVote(block.Height, blockID, state.Validators, privVal, state.ChainID, time.Now())
+	require.NoError(t, err)
+	return types.NewCommit(vote.Height, vote.Round, blockID, []types.CommitSig{vote.CommitSig()})
+}
+
+func TestCommitVerifier(t *testing.T) {
+	config = cfg.ResetTestRoot("blockchain_verifier_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc(1, false, 30)
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+
+	blocks := makeChain(t, state, privVals[0], 4)
+	cv := newCommitVerifier(state.ChainID, NopMetrics())
+
+	// the commits of all the blocks but the last are verified ahead
+	cv.verifyAhead(state, blocks)
+	assert.Len(t, cv.verifications, 3)
+
+	parts, blockID, err := cv.verify(state, blocks[0], blocks[1])
+	require.NoError(t, err)
+	assert.Equal(t, blocks[0].Hash().Bytes(), blockID.Hash.Bytes())
+	assert.Equal(t, parts.Header(), blockID.PartSetHeader)
+	assert.Len(t, cv.verifications, 2)
+
+	// a block received again, from another peer, is verified again
+	_, otherPrivVals := randGenesisDoc(1, false, 30)
+	badSecond := makeBlock(3, state, makeCommit(t, state, otherPrivVals[0], blocks[1]))
+	_, _, err = cv.verify(state, blocks[1], badSecond)
+	assert.Error(t, err)
+	assert.Len(t, cv.verifications, 1)
+
+	_, _, err = cv.verify(state, blocks[2], blocks[3])
+	assert.NoError(t, err)
+	assert.Empty(t, cv.verifications)
+}
+
+func TestCommitVerifierValidatorsChange(t *testing.T) {
+	config = cfg.ResetTestRoot("blockchain_verifier_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc(1, false, 30)
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+
+	blocks := makeChain(t, state, privVals[0], 4)
+	cv := newCommitVerifier(state.ChainID, NopMetrics())
+
+	// the blocks signed by other validators than the next ones are skipped
+	otherGenDoc, _ := randGenesisDoc(1, false, 30)
+	otherState, err := sm.MakeGenesisState(otherGenDoc)
+	require.NoError(t, err)
+	state.NextValidators = otherState.Validators
+	cv.verifyAhead(state, blocks)
+	require.Len(t, cv.verifications, 1)
+	assert.Contains(t, cv.verifications, int64(1))
+
+	// and a verification with other validators isn't used
+	state.Validators = otherState.Validators
+	_, _, err = cv.verify(state, blocks[0], blocks[1])
+	assert.Error(t, err)
+}
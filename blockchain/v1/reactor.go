@@ -203,7 +203,10 @@ func (bcR *BlockchainReactor) sendBlockToPeer(msg *bcproto.BlockRequest,
 			bcR.Logger.Error("Could not send block message to peer", "err", err)
 			return false
 		}
-		msgBytes, err := bc.EncodeMsg(&bcproto.BlockResponse{Block: pbbi})
+		msgBytes, err := bc.EncodeMsg(&bcproto.BlockResponse{
+			Block:     pbbi,
+			ExtCommit: bcR.store.LoadBlockExtendedCommit(msg.Height).ToProto(),
+		})
 		if err != nil {
 			bcR.Logger.Error("unable to marshal msg", "err", err)
 			return false
@@ -284,13 +287,22 @@ func (bcR *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte)
 			bcR.Logger.Error("error transition block from protobuf", "err", err)
 			return
 		}
+		var extCommit *types.ExtendedCommit
+		if msg.ExtCommit != nil {
+			extCommit, err = types.ExtendedCommitFromProto(msg.ExtCommit)
+			if err != nil {
+				bcR.Logger.Error("error transition extended commit from protobuf", "err", err)
+				return
+			}
+		}
 		msgForFSM := bcReactorMessage{
 			event: blockResponseEv,
 			data: bReactorEventData{
-				peerID: src.ID(),
-				height: bi.Height,
-				block:  bi,
-				length: len(msgBytes),
+				peerID:    src.ID(),
+				height:    bi.Height,
+				block:     bi,
+				extCommit: extCommit,
+				length:    len(msgBytes),
 			},
 		}
 		bcR.Logger.Info("Received", "src", src, "height", bi.Height)
@@ -445,7 +457,7 @@ func (bcR *BlockchainReactor) reportPeerErrorToSwitch(err error, peerID p2p.ID)
 
 func (bcR *BlockchainReactor) processBlock() error {
 
-	first, second, err := bcR.fsm.FirstTwoBlocks()
+	first, second, extCommit, err := bcR.fsm.FirstTwoBlocks()
 	if err != nil {
 		// We need both to sync the first block.
 		return err
@@ -467,7 +479,18 @@ func (bcR *BlockchainReactor) processBlock() error {
 		return errBlockVerificationFailure
 	}
 
-	bcR.store.SaveBlock(first, firstParts, second.LastCommit)
+	if extCommit != nil {
+		err = bc.VerifyExtendedCommit(chainID, bcR.state.Validators, firstID, first.Height, extCommit)
+		if err != nil {
+			bcR.Logger.Error("ignoring invalid extended commit", "height", first.Height, "err", err)
+			extCommit = nil
+		}
+	}
+	if extCommit != nil {
+		bcR.store.SaveBlockWithExtendedCommit(first, firstParts, extCommit)
+	} else {
+		bcR.store.SaveBlock(first, firstParts, second.LastCommit)
+	}
 
 	bcR.state, _, err = bcR.blockExec.ApplyBlock(bcR.state, firstID, first)
 	if err != nil {
@@ -522,8 +545,8 @@ func (bcR *BlockchainReactor) switchToConsensus() {
 // Called by FSM and pool:
 // - pool calls when it detects slow peer or when peer times out
 // - FSM calls when:
-//    - adding a block (addBlock) fails
-//    - reactor processing of a block reports failure and FSM sends back the peers of first and second blocks
+//   - adding a block (addBlock) fails
+//   - reactor processing of a block reports failure and FSM sends back the peers of first and second blocks
 func (bcR *BlockchainReactor) sendPeerError(err error, peerID p2p.ID) {
 	bcR.Logger.Info("sendPeerError:", "peer", peerID, "error", err)
 	msgData := bcFsmMessage{
@@ -12,7 +12,7 @@ import (
 
 type iIO interface {
 	sendBlockRequest(peerID p2p.ID, height int64) error
-	sendBlockToPeer(block *types.Block, peerID p2p.ID) error
+	sendBlockToPeer(block *types.Block, extCommit *types.ExtendedCommit, peerID p2p.ID) error
 	sendBlockNotFound(height int64, peerID p2p.ID) error
 	sendStatusResponse(base, height int64, peerID p2p.ID) error
 
@@ -77,7 +77,7 @@ func (sio *switchIO) sendStatusResponse(base int64, height int64, peerID p2p.ID)
 	return nil
 }
 
-func (sio *switchIO) sendBlockToPeer(block *types.Block, peerID p2p.ID) error {
+func (sio *switchIO) sendBlockToPeer(block *types.Block, extCommit *types.ExtendedCommit, peerID p2p.ID) error {
 	peer := sio.sw.Peers().Get(peerID)
 	if peer == nil {
 		return fmt.Errorf("peer not found")
@@ -91,7 +91,7 @@ func (sio *switchIO) sendBlockToPeer(block *types.Block, peerID p2p.ID) error {
 		return err
 	}
 
-	msgBytes, err := bc.EncodeMsg(&bcproto.BlockResponse{Block: bpb})
+	msgBytes, err := bc.EncodeMsg(&bcproto.BlockResponse{Block: bpb, ExtCommit: extCommit.ToProto()})
 	if err != nil {
 		return err
 	}
@@ -36,8 +36,9 @@ func (p pcFinished) Error() string {
 }
 
 type queueItem struct {
-	block  *types.Block
-	peerID p2p.ID
+	block     *types.Block
+	extCommit *types.ExtendedCommit
+	peerID    p2p.ID
 }
 
 type blockQueue map[int64]queueItem
@@ -86,11 +87,11 @@ func (state *pcState) synced() bool {
 	return len(state.queue) <= 1
 }
 
-func (state *pcState) enqueue(peerID p2p.ID, block *types.Block, height int64) {
+func (state *pcState) enqueue(peerID p2p.ID, block *types.Block, extCommit *types.ExtendedCommit, height int64) {
 	if _, ok := state.queue[height]; ok {
 		panic("duplicate block enqueued by processor")
 	}
-	state.queue[height] = queueItem{block: block, peerID: peerID}
+	state.queue[height] = queueItem{block: block, extCommit: extCommit, peerID: peerID}
 }
 
 func (state *pcState) height() int64 {
@@ -132,7 +133,7 @@ func (state *pcState) handle(event Event) (Event, error) {
 
 		// enqueue block if height is higher than state height, else ignore it
 		if event.block.Height > state.height() {
-			state.enqueue(event.peerID, event.block, event.block.Height)
+			state.enqueue(event.peerID, event.block, event.extCommit, event.block.Height)
 		}
 		return noOp, nil
 
@@ -160,7 +161,19 @@ func (state *pcState) handle(event Event) (Event, error) {
 				nil
 		}
 
-		state.context.saveBlock(first, firstParts, second.LastCommit)
+		// the extended commit is only kept if it is valid, otherwise we fall back
+		// to the block's last commit
+		extCommit := firstItem.extCommit
+		if extCommit != nil {
+			if err := state.context.verifyExtendedCommit(tmState.ChainID, firstID, first.Height, extCommit); err != nil {
+				extCommit = nil
+			}
+		}
+		if extCommit != nil {
+			state.context.saveBlockWithExtendedCommit(first, firstParts, extCommit)
+		} else {
+			state.context.saveBlock(first, firstParts, second.LastCommit)
+		}
 
 		if err := state.context.applyBlock(firstID, first); err != nil {
 			panic(fmt.Sprintf("failed to process committed block (%d:%X): %v", first.Height, first.Hash(), err))
@@ -3,6 +3,7 @@ package v2
 import (
 	"fmt"
 
+	bc "github.com/mydexchain/tendermint0/blockchain"
 	"github.com/mydexchain/tendermint0/state"
 	"github.com/mydexchain/tendermint0/types"
 )
@@ -11,6 +12,8 @@ type processorContext interface {
 	applyBlock(blockID types.BlockID, block *types.Block) error
 	verifyCommit(chainID string, blockID types.BlockID, height int64, commit *types.Commit) error
 	saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
+	verifyExtendedCommit(chainID string, blockID types.BlockID, height int64, extCommit *types.ExtendedCommit) error
+	saveBlockWithExtendedCommit(block *types.Block, blockParts *types.PartSet, extCommit *types.ExtendedCommit)
 	tmState() state.State
 	setState(state.State)
 }
@@ -51,6 +54,16 @@ func (pc *pContext) saveBlock(block *types.Block, blockParts *types.PartSet, see
 	pc.store.SaveBlock(block, blockParts, seenCommit)
 }
 
+func (pc pContext) verifyExtendedCommit(
+	chainID string, blockID types.BlockID, height int64, extCommit *types.ExtendedCommit) error {
+	return bc.VerifyExtendedCommit(chainID, pc.state.Validators, blockID, height, extCommit)
+}
+
+func (pc *pContext) saveBlockWithExtendedCommit(
+	block *types.Block, blockParts *types.PartSet, extCommit *types.ExtendedCommit) {
+	pc.store.SaveBlockWithExtendedCommit(block, blockParts, extCommit)
+}
+
 type mockPContext struct {
 	applicationBL  []int64
 	verificationBL []int64
@@ -91,6 +104,16 @@ func (mpc *mockPContext) saveBlock(block *types.Block, blockParts *types.PartSet
 
 }
 
+func (mpc *mockPContext) verifyExtendedCommit(
+	chainID string, blockID types.BlockID, height int64, extCommit *types.ExtendedCommit) error {
+	return nil
+}
+
+func (mpc *mockPContext) saveBlockWithExtendedCommit(
+	block *types.Block, blockParts *types.PartSet, extCommit *types.ExtendedCommit) {
+
+}
+
 func (mpc *mockPContext) setState(state state.State) {
 	mpc.state = state
 }
@@ -23,6 +23,8 @@ const (
 type blockStore interface {
 	LoadBlock(height int64) *types.Block
 	SaveBlock(*types.Block, *types.PartSet, *types.Commit)
+	LoadBlockExtendedCommit(height int64) *types.ExtendedCommit
+	SaveBlockWithExtendedCommit(*types.Block, *types.PartSet, *types.ExtendedCommit)
 	Base() int64
 	Height() int64
 }
@@ -205,10 +207,11 @@ type rProcessBlock struct {
 // blockResponse message received from a peer
 type bcBlockResponse struct {
 	priorityNormal
-	time   time.Time
-	peerID p2p.ID
-	size   int64
-	block  *types.Block
+	time      time.Time
+	peerID    p2p.ID
+	size      int64
+	block     *types.Block
+	extCommit *types.ExtendedCommit
 }
 
 // blockNoResponse message received from a peer
@@ -436,7 +439,8 @@ func (r *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	case *bcproto.BlockRequest:
 		block := r.store.LoadBlock(msg.Height)
 		if block != nil {
-			if err = r.io.sendBlockToPeer(block, src.ID()); err != nil {
+			extCommit := r.store.LoadBlockExtendedCommit(msg.Height)
+			if err = r.io.sendBlockToPeer(block, extCommit, src.ID()); err != nil {
 				r.logger.Error("Could not send block message to peer: ", err)
 			}
 		} else {
@@ -459,14 +463,25 @@ func (r *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 		bi, err := types.BlockFromProto(msg.Block)
 		if err != nil {
 			r.logger.Error("error transitioning block from protobuf", "err", err)
+			r.mtx.RUnlock()
 			return
 		}
+		var extCommit *types.ExtendedCommit
+		if msg.ExtCommit != nil {
+			extCommit, err = types.ExtendedCommitFromProto(msg.ExtCommit)
+			if err != nil {
+				r.logger.Error("error transitioning extended commit from protobuf", "err", err)
+				r.mtx.RUnlock()
+				return
+			}
+		}
 		if r.events != nil {
 			r.events <- bcBlockResponse{
-				peerID: src.ID(),
-				block:  bi,
-				size:   int64(len(msgBytes)),
-				time:   time.Now(),
+				peerID:    src.ID(),
+				block:     bi,
+				extCommit: extCommit,
+				size:      int64(len(msgBytes)),
+				time:      time.Now(),
 			}
 		}
 		r.mtx.RUnlock()
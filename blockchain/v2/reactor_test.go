@@ -76,6 +76,15 @@ func (ml *mockBlockStore) SaveBlock(block *types.Block, part *types.PartSet, com
 	ml.blocks[block.Height] = block
 }
 
+func (ml *mockBlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommit {
+	return nil
+}
+
+func (ml *mockBlockStore) SaveBlockWithExtendedCommit(
+	block *types.Block, part *types.PartSet, extCommit *types.ExtendedCommit) {
+	ml.blocks[block.Height] = block
+}
+
 type mockBlockApplier struct {
 }
 
@@ -106,7 +115,7 @@ func (sio *mockSwitchIo) sendStatusResponse(base, height int64, peerID p2p.ID) e
 	return nil
 }
 
-func (sio *mockSwitchIo) sendBlockToPeer(block *types.Block, peerID p2p.ID) error {
+func (sio *mockSwitchIo) sendBlockToPeer(block *types.Block, extCommit *types.ExtendedCommit, peerID p2p.ID) error {
 	sio.mtx.Lock()
 	defer sio.mtx.Unlock()
 	sio.numBlockResponse++
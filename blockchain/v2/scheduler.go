@@ -28,8 +28,9 @@ type scBlockRequest struct {
 // a block has been received and validated by the scheduler
 type scBlockReceived struct {
 	priorityNormal
-	peerID p2p.ID
-	block  *types.Block
+	peerID    p2p.ID
+	block     *types.Block
+	extCommit *types.ExtendedCommit
 }
 
 // scheduler detected a peer error
@@ -526,7 +527,7 @@ func (sc *scheduler) handleBlockResponse(event bcBlockResponse) (Event, error) {
 		return scPeerError{peerID: event.peerID, reason: err}, nil
 	}
 
-	return scBlockReceived{peerID: event.peerID, block: event.block}, nil
+	return scBlockReceived{peerID: event.peerID, block: event.block, extCommit: event.extCommit}, nil
 }
 
 func (sc *scheduler) handleNoBlockResponse(event bcNoBlockResponse) (Event, error) {
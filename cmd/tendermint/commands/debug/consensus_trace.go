@@ -0,0 +1,229 @@
+package debug
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	cfg "github.com/mydexchain/tendermint0/config"
+	cs "github.com/mydexchain/tendermint0/consensus"
+	"github.com/mydexchain/tendermint0/libs/cli"
+	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
+)
+
+var (
+	traceHeight        int64
+	traceLateThreshold time.Duration
+
+	flagTraceHeight        = "height"
+	flagTraceLateThreshold = "late-threshold"
+)
+
+var consensusTraceCmd = &cobra.Command{
+	Use:   "consensus-trace [trace-file]",
+	Short: "Render the consensus trace of a node as per-height timelines",
+	Long: `Render the consensus trace recorded by a node (see trace_file in the
+[consensus] section of config.toml) as per-height timelines of state
+transitions, timeouts and votes.
+
+A vote is marked as late if it arrived after the node had already moved on
+from the step it was cast for (e.g. a prevote received after entering
+precommit), or if its latency exceeds --late-threshold.
+
+If no trace file is given, the one configured in the node's home directory is
+used.
+
+Example:
+$ tendermint debug consensus-trace --height 1234`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: consensusTraceCmdHandler,
+}
+
+func init() {
+	consensusTraceCmd.Flags().Int64Var(
+		&traceHeight,
+		flagTraceHeight,
+		0,
+		"Only render the given height (0 renders all heights in the trace)",
+	)
+	consensusTraceCmd.Flags().DurationVar(
+		&traceLateThreshold,
+		flagTraceLateThreshold,
+		time.Second,
+		"Latency above which a vote is marked as late",
+	)
+}
+
+func consensusTraceCmdHandler(cmd *cobra.Command, args []string) error {
+	var traceFile string
+	if len(args) > 0 {
+		traceFile = args[0]
+	} else {
+		conf := cfg.DefaultConfig()
+		if err := viper.Unmarshal(conf); err != nil {
+			return err
+		}
+		conf = conf.SetRoot(viper.GetString(cli.HomeFlag))
+		if conf.Consensus.TraceFile == "" {
+			return errors.New("no trace file given and none configured (see trace_file in config.toml)")
+		}
+		traceFile = conf.Consensus.TraceFilePath()
+	}
+	if _, err := os.Stat(traceFile); err != nil {
+		return fmt.Errorf("failed to open consensus trace: %w", err)
+	}
+
+	events, err := cs.ReadTraceFile(traceFile)
+	if err != nil {
+		return fmt.Errorf("failed to read consensus trace: %w", err)
+	}
+
+	renderConsensusTrace(os.Stdout, events, traceHeight, traceLateThreshold)
+	return nil
+}
+
+// heightTrace holds the trace events of a single height.
+type heightTrace struct {
+	height int64
+	events []cs.TraceEvent
+
+	// time at which the node entered a step for a round, used to spot votes
+	// which arrived after the node had moved on
+	entered map[int32]map[cs.TraceEventType]time.Time
+}
+
+func (ht *heightTrace) enteredAt(round int32, typ cs.TraceEventType) (time.Time, bool) {
+	t, ok := ht.entered[round][typ]
+	return t, ok
+}
+
+// isLate reports whether vote arrived after the node moved past the step the
+// vote was cast for, or with a latency above threshold.
+func (ht *heightTrace) isLate(vote cs.TraceEvent, threshold time.Duration) bool {
+	if vote.Latency > threshold {
+		return true
+	}
+	var next []cs.TraceEventType
+	switch vote.VoteType {
+	case tmproto.PrevoteType.String():
+		next = []cs.TraceEventType{cs.TraceEnterPrecommit}
+	case tmproto.PrecommitType.String():
+		next = []cs.TraceEventType{cs.TraceEnterCommit}
+	}
+	for _, typ := range next {
+		if t, ok := ht.enteredAt(vote.Round, typ); ok && vote.Time.After(t) {
+			return true
+		}
+	}
+	// votes for a round the node already left
+	if t, ok := ht.enteredAt(vote.Round+1, cs.TraceEnterNewRound); ok && vote.Time.After(t) {
+		return true
+	}
+	return false
+}
+
+func groupTraceByHeight(events []cs.TraceEvent) []*heightTrace {
+	byHeight := make(map[int64]*heightTrace)
+	for _, ev := range events {
+		ht, ok := byHeight[ev.Height]
+		if !ok {
+			ht = &heightTrace{
+				height:  ev.Height,
+				entered: make(map[int32]map[cs.TraceEventType]time.Time),
+			}
+			byHeight[ev.Height] = ht
+		}
+		ht.events = append(ht.events, ev)
+		if ev.Type != cs.TraceVote && ev.Type != cs.TraceProposal && ev.Type != cs.TraceTimeout {
+			if ht.entered[ev.Round] == nil {
+				ht.entered[ev.Round] = make(map[cs.TraceEventType]time.Time)
+			}
+			if _, ok := ht.entered[ev.Round][ev.Type]; !ok {
+				ht.entered[ev.Round][ev.Type] = ev.Time
+			}
+		}
+	}
+
+	heights := make([]*heightTrace, 0, len(byHeight))
+	for _, ht := range byHeight {
+		heights = append(heights, ht)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i].height < heights[j].height })
+	return heights
+}
+
+func renderConsensusTrace(w io.Writer, events []cs.TraceEvent, height int64, lateThreshold time.Duration) {
+	lateByValidator := make(map[string]int)
+
+	for _, ht := range groupTraceByHeight(events) {
+		if height != 0 && ht.height != height {
+			continue
+		}
+
+		start := ht.events[0].Time
+		fmt.Fprintf(w, "Height %d\n", ht.height)
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		var late []string
+		for _, ev := range ht.events {
+			offset := ev.Time.Sub(start).Round(time.Millisecond)
+			switch ev.Type {
+			case cs.TraceVote:
+				mark := ""
+				if ht.isLate(ev, lateThreshold) {
+					mark = "LATE"
+					late = append(late, fmt.Sprintf("%s (round %d %s)", ev.ValidatorAddress, ev.Round, ev.VoteType))
+					lateByValidator[ev.ValidatorAddress]++
+				}
+				peer := string(ev.PeerID)
+				if peer == "" {
+					peer = "self"
+				}
+				fmt.Fprintf(tw, "  +%v\tround %d\t%s %s\t%s\tlatency %v\tpeer %s\t%s\n",
+					offset, ev.Round, ev.Type, ev.VoteType, ev.ValidatorAddress,
+					ev.Latency.Round(time.Millisecond), peer, mark)
+			case cs.TraceProposal:
+				fmt.Fprintf(tw, "  +%v\tround %d\t%s\t%s\tlatency %v\t\t\n",
+					offset, ev.Round, ev.Type, ev.ValidatorAddress, ev.Latency.Round(time.Millisecond))
+			case cs.TraceTimeout:
+				fmt.Fprintf(tw, "  +%v\tround %d\t%s %s\tafter %v\t\t\t\n",
+					offset, ev.Round, ev.Type, ev.Step, ev.Duration)
+			default:
+				fmt.Fprintf(tw, "  +%v\tround %d\t%s\t%s\t\t\t\n", offset, ev.Round, ev.Type, ev.BlockID)
+			}
+		}
+		tw.Flush()
+
+		if len(late) > 0 {
+			fmt.Fprintf(w, "  Late votes:\n")
+			for _, l := range late {
+				fmt.Fprintf(w, "    %s\n", l)
+			}
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(lateByValidator) == 0 {
+		return
+	}
+	validators := make([]string, 0, len(lateByValidator))
+	for addr := range lateByValidator {
+		validators = append(validators, addr)
+	}
+	sort.Slice(validators, func(i, j int) bool {
+		if lateByValidator[validators[i]] != lateByValidator[validators[j]] {
+			return lateByValidator[validators[i]] > lateByValidator[validators[j]]
+		}
+		return validators[i] < validators[j]
+	})
+	fmt.Fprintf(w, "Validators with late votes:\n")
+	for _, addr := range validators {
+		fmt.Fprintf(w, "  %s\t%d\n", addr, lateByValidator[addr])
+	}
+}
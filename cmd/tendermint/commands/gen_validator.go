@@ -7,6 +7,7 @@ import (
 
 	tmjson "github.com/mydexchain/tendermint0/libs/json"
 	"github.com/mydexchain/tendermint0/privval"
+	"github.com/mydexchain/tendermint0/types"
 )
 
 // GenValidatorCmd allows the generation of a keypair for a
@@ -14,15 +15,24 @@ import (
 var GenValidatorCmd = &cobra.Command{
 	Use:   "gen_validator",
 	Short: "Generate new validator keypair",
-	Run:   genValidator,
+	RunE:  genValidator,
 }
 
-func genValidator(cmd *cobra.Command, args []string) {
-	pv := privval.GenFilePV("", "")
+func init() {
+	GenValidatorCmd.Flags().StringVar(&keyType, "key-type", types.ABCIPubKeyTypeEd25519,
+		"Key type of the generated keypair (ed25519, secp256k1, sr25519 or bls12381)")
+}
+
+func genValidator(cmd *cobra.Command, args []string) error {
+	pv, err := privval.GenFilePVWithKeyType("", "", keyType)
+	if err != nil {
+		return err
+	}
 	jsbz, err := tmjson.Marshal(pv)
 	if err != nil {
 		panic(err)
 	}
 	fmt.Printf(`%v
 `, string(jsbz))
+	return nil
 }
@@ -21,6 +21,13 @@ var InitFilesCmd = &cobra.Command{
 	RunE:  initFiles,
 }
 
+var keyType string
+
+func init() {
+	InitFilesCmd.Flags().StringVar(&keyType, "key-type", types.ABCIPubKeyTypeEd25519,
+		"Key type of the generated private validator (ed25519, secp256k1, sr25519 or bls12381)")
+}
+
 func initFiles(cmd *cobra.Command, args []string) error {
 	return initFilesWithConfig(config)
 }
@@ -35,7 +42,11 @@ func initFilesWithConfig(config *cfg.Config) error {
 		logger.Info("Found private validator", "keyFile", privValKeyFile,
 			"stateFile", privValStateFile)
 	} else {
-		pv = privval.GenFilePV(privValKeyFile, privValStateFile)
+		var err error
+		pv, err = privval.GenFilePVWithKeyType(privValKeyFile, privValStateFile, keyType)
+		if err != nil {
+			return err
+		}
 		pv.Save()
 		logger.Info("Generated private validator", "keyFile", privValKeyFile,
 			"stateFile", privValStateFile)
@@ -65,6 +76,8 @@ func initFilesWithConfig(config *cfg.Config) error {
 		if err != nil {
 			return fmt.Errorf("can't get pubkey: %w", err)
 		}
+		// the validator's key type must be allowed by the consensus params
+		genDoc.ConsensusParams.Validator.PubKeyTypes = []string{pubKey.Type()}
 		genDoc.Validators = []types.GenesisValidator{{
 			Address: pubKey.Address(),
 			PubKey:  pubKey,
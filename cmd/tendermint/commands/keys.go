@@ -0,0 +1,415 @@
+package commands
+
+import (
+	"bufio"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/cosmos/go-bip39"
+	"github.com/spf13/cobra"
+
+	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/ed25519"
+	"github.com/mydexchain/tendermint0/crypto/hd"
+	tmjson "github.com/mydexchain/tendermint0/libs/json"
+	tmos "github.com/mydexchain/tendermint0/libs/os"
+	"github.com/mydexchain/tendermint0/libs/tempfile"
+	"github.com/mydexchain/tendermint0/p2p"
+	"github.com/mydexchain/tendermint0/privval"
+	"github.com/mydexchain/tendermint0/types"
+	tmtime "github.com/mydexchain/tendermint0/types/time"
+)
+
+// KeysCmd groups the commands managing the validator and node keys.
+var KeysCmd = &cobra.Command{
+	Use:   "keys",
+	Short: "Manage the validator and node keys",
+}
+
+var keysMnemonicCmd = &cobra.Command{
+	Use:   "mnemonic",
+	Short: "Generate a new BIP39 mnemonic of 24 words",
+	RunE:  keysMnemonic,
+}
+
+var keysDeriveCmd = &cobra.Command{
+	Use:   "derive",
+	Short: "Derive the validator or node key from a BIP39 mnemonic",
+	Long: `Derive the validator key, or the node key with --node-key, from a BIP39
+mnemonic read from the first line of the standard input. An optional BIP39
+passphrase is read from the second line.
+
+Ed25519 keys are derived with SLIP-10, which only supports hardened indexes,
+and secp256k1 keys with BIP32. The key file must not exist: the last sign
+state of the validator, if any, is kept.`,
+	RunE: keysDerive,
+}
+
+var keysListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the key files with their types and addresses",
+	Long: `List the private validator key file, the node key file and the other key files
+next to them, with their types and addresses (IDs for node keys).`,
+	RunE: keysList,
+}
+
+var keysShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the address and public key of the validator or node key",
+	Long: `Show the type, address and public key of the validator key, or of the node
+key with --node-key. The public key is encoded without its type.`,
+	RunE: keysShow,
+}
+
+var keysRotateNodeKeyCmd = &cobra.Command{
+	Use:   "rotate-node-key",
+	Short: "Replace the node key with a new one and print its ID",
+	Long: `Replace the node key with a newly generated one and print the new node ID.
+The old node ID and public key are appended to the node_key_history.json
+record, next to the node key, and the old private key is discarded.`,
+	RunE: keysRotateNodeKey,
+}
+
+var keysNextValidatorKeyCmd = &cobra.Command{
+	Use:   "next-validator-key",
+	Short: "Generate the key the validator rotates to and show it",
+	Long: `Generate the next key of the validator, saved in the private validator key
+file, and show it like the show command. Once the application rotates the
+validator to this key, with a validator update carrying the operator address of
+the validator, the node switches to it at the height the rotation takes effect.`,
+	RunE: keysNextValidatorKey,
+}
+
+const (
+	keyEncodingHex    = "hex"
+	keyEncodingBase64 = "base64"
+	keyEncodingBech32 = "bech32"
+
+	// nodeKeyHistoryName is the name of the record of the rotated node keys.
+	nodeKeyHistoryName = "node_key_history.json"
+)
+
+var (
+	keysKeyType      string
+	keysHDPath       string
+	keysNodeKey      bool
+	keysEncoding     string
+	keysBech32Prefix string
+	keysNextKeyType  string
+)
+
+func init() {
+	keysDeriveCmd.Flags().StringVar(&keysKeyType, "key-type", types.ABCIPubKeyTypeEd25519,
+		"Key type of the derived keypair (ed25519 or secp256k1)")
+	keysDeriveCmd.Flags().StringVar(&keysHDPath, "hd-path", "",
+		fmt.Sprintf("Derivation path (default %s for ed25519 and %s for secp256k1)",
+			hd.DefaultPathEd25519, hd.DefaultPathSecp256k1))
+	keysDeriveCmd.Flags().BoolVar(&keysNodeKey, "node-key", false,
+		"Derive the node key instead of the validator key (ed25519 only)")
+
+	keysShowCmd.Flags().BoolVar(&keysNodeKey, "node-key", false,
+		"Show the node key instead of the validator key")
+	for _, cmd := range []*cobra.Command{keysShowCmd, keysNextValidatorKeyCmd} {
+		cmd.Flags().StringVar(&keysEncoding, "encoding", keyEncodingHex,
+			"Encoding of the address and public key (hex, base64 or bech32)")
+		cmd.Flags().StringVar(&keysBech32Prefix, "bech32-prefix", "tendermint",
+			"Human-readable part of the bech32 address, followed by \"pub\" for the public key")
+	}
+
+	keysNextValidatorKeyCmd.Flags().StringVar(&keysNextKeyType, "key-type", "",
+		"Key type of the next key (default the type of the current key)")
+
+	KeysCmd.AddCommand(keysMnemonicCmd)
+	KeysCmd.AddCommand(keysDeriveCmd)
+	KeysCmd.AddCommand(keysListCmd)
+	KeysCmd.AddCommand(keysShowCmd)
+	KeysCmd.AddCommand(keysRotateNodeKeyCmd)
+	KeysCmd.AddCommand(keysNextValidatorKeyCmd)
+}
+
+func keysMnemonic(cmd *cobra.Command, args []string) error {
+	entropy, err := bip39.NewEntropy(256)
+	if err != nil {
+		return err
+	}
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), mnemonic)
+	return nil
+}
+
+func keysDerive(cmd *cobra.Command, args []string) error {
+	if keysNodeKey && keysKeyType != types.ABCIPubKeyTypeEd25519 {
+		return fmt.Errorf("node keys must be %s keys", types.ABCIPubKeyTypeEd25519)
+	}
+
+	in := bufio.NewReader(cmd.InOrStdin())
+	mnemonic, err := readLine(in)
+	if err != nil {
+		return fmt.Errorf("can't read the mnemonic: %w", err)
+	}
+	passphrase, err := readLine(in)
+	if err != nil {
+		return fmt.Errorf("can't read the passphrase: %w", err)
+	}
+	seed, err := bip39.NewSeedWithErrorChecking(strings.Join(strings.Fields(mnemonic), " "), passphrase)
+	if err != nil {
+		return fmt.Errorf("invalid mnemonic: %w", err)
+	}
+	privKey, err := derivePrivKey(seed, keysKeyType, keysHDPath)
+	if err != nil {
+		return err
+	}
+
+	if keysNodeKey {
+		nodeKeyFile := config.NodeKeyFile()
+		if tmos.FileExists(nodeKeyFile) {
+			return fmt.Errorf("node key at %s already exists", nodeKeyFile)
+		}
+		nodeKey := &p2p.NodeKey{PrivKey: privKey}
+		if err := nodeKey.SaveAs(nodeKeyFile); err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), nodeKey.ID())
+		return nil
+	}
+
+	keyFilePath, stateFilePath := config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()
+	if tmos.FileExists(keyFilePath) {
+		return fmt.Errorf("private validator key at %s already exists", keyFilePath)
+	}
+	pv := privval.NewFilePV(privKey, keyFilePath, stateFilePath)
+	// keep the last sign state to prevent double signing
+	if tmos.FileExists(stateFilePath) {
+		pv.Key.Save()
+	} else {
+		pv.Save()
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), pv.GetAddress())
+	return nil
+}
+
+// readLine reads a line without its line ending. It returns an empty line at
+// the end of the input.
+func readLine(in *bufio.Reader) (string, error) {
+	line, err := in.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// derivePrivKey derives the private key of the given type from the seed, at
+// the given path or at the default one of the type if empty.
+func derivePrivKey(seed []byte, keyType, hdPath string) (crypto.PrivKey, error) {
+	if hdPath == "" {
+		switch keyType {
+		case types.ABCIPubKeyTypeEd25519:
+			hdPath = hd.DefaultPathEd25519
+		case types.ABCIPubKeyTypeSecp256k1:
+			hdPath = hd.DefaultPathSecp256k1
+		}
+	}
+	path, err := hd.ParsePath(hdPath)
+	if err != nil {
+		return nil, err
+	}
+
+	switch keyType {
+	case types.ABCIPubKeyTypeEd25519:
+		privKey, err := hd.DerivePrivKeyEd25519(seed, path)
+		if err != nil {
+			return nil, err
+		}
+		return privKey, nil
+	case types.ABCIPubKeyTypeSecp256k1:
+		privKey, err := hd.DerivePrivKeySecp256k1(seed, path)
+		if err != nil {
+			return nil, err
+		}
+		return privKey, nil
+	default:
+		return nil, fmt.Errorf("key type %q can't be derived", keyType)
+	}
+}
+
+// keyFile holds the fields of the private validator and node key files, the
+// latter having only a private key.
+type keyFile struct {
+	Address types.Address  `json:"address"`
+	PubKey  crypto.PubKey  `json:"pub_key"`
+	PrivKey crypto.PrivKey `json:"priv_key"`
+}
+
+func keysList(cmd *cobra.Command, args []string) error {
+	paths := []string{config.PrivValidatorKeyFile(), config.NodeKeyFile()}
+	for _, dir := range []string{filepath.Dir(paths[0]), filepath.Dir(paths[1])} {
+		others, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		if err != nil {
+			return err
+		}
+		paths = append(paths, others...)
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "FILE\tKIND\tTYPE\tADDRESS")
+	listed := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		if listed[path] {
+			continue
+		}
+		listed[path] = true
+
+		bz, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		var key keyFile
+		if err := tmjson.Unmarshal(bz, &key); err != nil || key.PrivKey == nil {
+			continue
+		}
+		if len(key.Address) == 0 {
+			fmt.Fprintf(w, "%s\tnode\t%s\t%s\n", path, key.PrivKey.Type(), p2p.PubKeyToID(key.PrivKey.PubKey()))
+		} else {
+			fmt.Fprintf(w, "%s\tvalidator\t%s\t%s\n", path, key.PrivKey.Type(), key.Address)
+		}
+	}
+	return w.Flush()
+}
+
+func keysShow(cmd *cobra.Command, args []string) error {
+	var pubKey crypto.PubKey
+	if keysNodeKey {
+		nodeKey, err := p2p.LoadNodeKey(config.NodeKeyFile())
+		if err != nil {
+			return err
+		}
+		pubKey = nodeKey.PubKey()
+	} else {
+		keyFilePath := config.PrivValidatorKeyFile()
+		if !tmos.FileExists(keyFilePath) {
+			return fmt.Errorf("private validator file %s does not exist", keyFilePath)
+		}
+		pubKey = privval.LoadFilePVEmptyState(keyFilePath, config.PrivValidatorStateFile()).Key.PubKey
+	}
+	return showKey(cmd.OutOrStdout(), pubKey)
+}
+
+// showKey writes the type, address and public key of a key, encoded with
+// the --encoding and --bech32-prefix flags.
+func showKey(w io.Writer, pubKey crypto.PubKey) error {
+	address, err := encodeKey(pubKey.Address(), keysEncoding, keysBech32Prefix)
+	if err != nil {
+		return err
+	}
+	encodedPubKey, err := encodeKey(pubKey.Bytes(), keysEncoding, keysBech32Prefix+"pub")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "type: %s\naddress: %s\npub_key: %s\n", pubKey.Type(), address, encodedPubKey)
+	return nil
+}
+
+// encodeKey encodes an address or a key, prefix being the human-readable part
+// of bech32.
+func encodeKey(bz []byte, encoding, prefix string) (string, error) {
+	switch encoding {
+	case keyEncodingHex:
+		return fmt.Sprintf("%X", bz), nil
+	case keyEncodingBase64:
+		return base64.StdEncoding.EncodeToString(bz), nil
+	case keyEncodingBech32:
+		if prefix == "" {
+			return "", errors.New("bech32 prefix can't be empty")
+		}
+		data, err := bech32.ConvertBits(bz, 8, 5, true)
+		if err != nil {
+			return "", err
+		}
+		return bech32.Encode(prefix, data)
+	default:
+		return "", fmt.Errorf("unknown encoding %q (expected hex, base64 or bech32)", encoding)
+	}
+}
+
+// nodeKeyRecord records a rotated node key.
+type nodeKeyRecord struct {
+	ID        p2p.ID        `json:"id"`
+	PubKey    crypto.PubKey `json:"pub_key"`
+	RotatedAt time.Time     `json:"rotated_at"`
+}
+
+func keysRotateNodeKey(cmd *cobra.Command, args []string) error {
+	nodeKeyFile := config.NodeKeyFile()
+	oldNodeKey, err := p2p.LoadNodeKey(nodeKeyFile)
+	if err != nil {
+		return fmt.Errorf("can't load the node key: %w", err)
+	}
+
+	// record the old node key before replacing it
+	historyFile := filepath.Join(filepath.Dir(nodeKeyFile), nodeKeyHistoryName)
+	var history []nodeKeyRecord
+	if tmos.FileExists(historyFile) {
+		bz, err := ioutil.ReadFile(historyFile)
+		if err != nil {
+			return err
+		}
+		if err := tmjson.Unmarshal(bz, &history); err != nil {
+			return fmt.Errorf("can't read the node key history %s: %w", historyFile, err)
+		}
+	}
+	history = append(history, nodeKeyRecord{
+		ID:        oldNodeKey.ID(),
+		PubKey:    oldNodeKey.PubKey(),
+		RotatedAt: tmtime.Now(),
+	})
+	bz, err := tmjson.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := tempfile.WriteFileAtomic(historyFile, bz, 0600); err != nil {
+		return err
+	}
+
+	nodeKey := &p2p.NodeKey{PrivKey: ed25519.GenPrivKey()}
+	if err := nodeKey.SaveAs(nodeKeyFile); err != nil {
+		return err
+	}
+	logger.Info("Rotated node key", "old", oldNodeKey.ID(), "new", nodeKey.ID(), "history", historyFile)
+	fmt.Fprintln(cmd.OutOrStdout(), nodeKey.ID())
+	return nil
+}
+
+func keysNextValidatorKey(cmd *cobra.Command, args []string) error {
+	keyFilePath, stateFilePath := config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()
+	if !tmos.FileExists(keyFilePath) {
+		return fmt.Errorf("private validator file %s does not exist", keyFilePath)
+	}
+	pv := privval.LoadFilePVEmptyState(keyFilePath, stateFilePath)
+	if pv.Key.NextPubKey != nil {
+		return fmt.Errorf("private validator at %s already has a next key %v", keyFilePath, pv.Key.NextPubKey.Address())
+	}
+
+	keyType := keysNextKeyType
+	if keyType == "" {
+		keyType = pv.Key.PubKey.Type()
+	}
+	next, err := privval.GenFilePVWithKeyType("", "", keyType)
+	if err != nil {
+		return err
+	}
+	pv.SetNextKey(next.Key.PrivKey)
+	pv.Key.Save()
+	logger.Info("Generated next private validator key", "address", pv.Key.Address, "next", pv.Key.NextPubKey.Address())
+	return showKey(cmd.OutOrStdout(), pv.Key.NextPubKey)
+}
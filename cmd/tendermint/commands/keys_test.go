@@ -0,0 +1,219 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/mydexchain/tendermint0/config"
+	tmjson "github.com/mydexchain/tendermint0/libs/json"
+	"github.com/mydexchain/tendermint0/p2p"
+	"github.com/mydexchain/tendermint0/privval"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// setupKeysTest sets the config to a new root directory.
+func setupKeysTest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keys_test")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	config = cfg.TestConfig()
+	config.SetRoot(dir)
+	cfg.EnsureRoot(dir)
+}
+
+// runKeysCmd runs a keys command with the given input and returns its output.
+func runKeysCmd(t *testing.T, cmd *cobra.Command, input string) (string, error) {
+	var out bytes.Buffer
+	cmd.SetIn(strings.NewReader(input))
+	cmd.SetOut(&out)
+	err := cmd.RunE(cmd, nil)
+	return out.String(), err
+}
+
+func TestKeysDerive(t *testing.T) {
+	defer func() { keysKeyType, keysHDPath, keysNodeKey = "ed25519", "", false }()
+
+	for _, keyType := range []string{"ed25519", "secp256k1"} {
+		setupKeysTest(t)
+		keysKeyType = keyType
+
+		out, err := runKeysCmd(t, keysDeriveCmd, testMnemonic+"\n")
+		require.NoError(t, err)
+		pv := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+		assert.Equal(t, keyType, pv.Key.PrivKey.Type())
+		assert.Equal(t, pv.GetAddress().String()+"\n", out)
+
+		// the key can't be overwritten
+		_, err = runKeysCmd(t, keysDeriveCmd, testMnemonic+"\n")
+		assert.Error(t, err)
+
+		// the same mnemonic gives the same key, unless the passphrase or the
+		// path differs
+		setupKeysTest(t)
+		out2, err := runKeysCmd(t, keysDeriveCmd, testMnemonic)
+		require.NoError(t, err)
+		assert.Equal(t, out, out2)
+
+		setupKeysTest(t)
+		out2, err = runKeysCmd(t, keysDeriveCmd, testMnemonic+"\npassphrase\n")
+		require.NoError(t, err)
+		assert.NotEqual(t, out, out2)
+
+		setupKeysTest(t)
+		keysHDPath = "m/44'/118'/1'/0'/0'"
+		out2, err = runKeysCmd(t, keysDeriveCmd, testMnemonic)
+		require.NoError(t, err)
+		assert.NotEqual(t, out, out2)
+		keysHDPath = ""
+	}
+
+	// invalid mnemonic
+	setupKeysTest(t)
+	_, err := runKeysCmd(t, keysDeriveCmd, strings.Replace(testMnemonic, "about", "abandon", 1))
+	assert.Error(t, err)
+
+	// node key
+	keysKeyType, keysNodeKey = "ed25519", true
+	out, err := runKeysCmd(t, keysDeriveCmd, testMnemonic)
+	require.NoError(t, err)
+	nodeKey, err := p2p.LoadNodeKey(config.NodeKeyFile())
+	require.NoError(t, err)
+	assert.Equal(t, string(nodeKey.ID())+"\n", out)
+
+	keysKeyType = "secp256k1"
+	_, err = runKeysCmd(t, keysDeriveCmd, testMnemonic)
+	assert.Error(t, err, "node keys are ed25519 keys")
+}
+
+func TestKeysShow(t *testing.T) {
+	defer func() { keysEncoding, keysBech32Prefix = "hex", "tendermint" }()
+	setupKeysTest(t)
+	_, err := runKeysCmd(t, keysDeriveCmd, testMnemonic)
+	require.NoError(t, err)
+	pubKey := privval.LoadFilePVEmptyState(config.PrivValidatorKeyFile(), "").Key.PubKey
+
+	decodeBech32 := func(s string) []byte {
+		hrp, data, err := bech32.Decode(s)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(hrp, "tmvalcons"))
+		bz, err := bech32.ConvertBits(data, 5, 8, false)
+		require.NoError(t, err)
+		return bz
+	}
+	testCases := []struct {
+		encoding, prefix string
+		decode           func(string) []byte
+	}{
+		{"hex", "", func(s string) []byte { bz, _ := hex.DecodeString(s); return bz }},
+		{"base64", "", func(s string) []byte { bz, _ := base64.StdEncoding.DecodeString(s); return bz }},
+		{"bech32", "tmvalcons", decodeBech32},
+	}
+	for _, tc := range testCases {
+		keysEncoding, keysBech32Prefix = tc.encoding, tc.prefix
+		out, err := runKeysCmd(t, keysShowCmd, "")
+		require.NoError(t, err, tc.encoding)
+
+		var keyType, address, encodedPubKey string
+		_, err = fmt.Sscanf(out, "type: %s\naddress: %s\npub_key: %s\n", &keyType, &address, &encodedPubKey)
+		require.NoError(t, err, out)
+		assert.Equal(t, "ed25519", keyType)
+		assert.Equal(t, []byte(pubKey.Address()), tc.decode(address), tc.encoding)
+		assert.Equal(t, pubKey.Bytes(), tc.decode(encodedPubKey), tc.encoding)
+	}
+	keysEncoding = "base58"
+	_, err = runKeysCmd(t, keysShowCmd, "")
+	assert.Error(t, err)
+}
+
+func TestKeysRotateNodeKey(t *testing.T) {
+	setupKeysTest(t)
+	_, err := runKeysCmd(t, keysRotateNodeKeyCmd, "")
+	assert.Error(t, err, "no node key to rotate")
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	require.NoError(t, err)
+	ids := []p2p.ID{nodeKey.ID()}
+	for i := 0; i < 2; i++ {
+		out, err := runKeysCmd(t, keysRotateNodeKeyCmd, "")
+		require.NoError(t, err)
+		nodeKey, err = p2p.LoadNodeKey(config.NodeKeyFile())
+		require.NoError(t, err)
+		assert.Equal(t, string(nodeKey.ID())+"\n", out)
+		assert.NotEqual(t, ids[len(ids)-1], nodeKey.ID())
+		ids = append(ids, nodeKey.ID())
+	}
+
+	bz, err := ioutil.ReadFile(filepath.Join(filepath.Dir(config.NodeKeyFile()), nodeKeyHistoryName))
+	require.NoError(t, err)
+	var history []nodeKeyRecord
+	require.NoError(t, tmjson.Unmarshal(bz, &history))
+	require.Len(t, history, 2)
+	for i, record := range history {
+		assert.Equal(t, ids[i], record.ID)
+		assert.Equal(t, record.ID, p2p.PubKeyToID(record.PubKey))
+	}
+}
+
+func TestKeysList(t *testing.T) {
+	setupKeysTest(t)
+	_, err := runKeysCmd(t, keysDeriveCmd, testMnemonic)
+	require.NoError(t, err)
+	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	require.NoError(t, err)
+	address := privval.LoadFilePVEmptyState(config.PrivValidatorKeyFile(), "").GetAddress()
+
+	out, err := runKeysCmd(t, keysListCmd, "")
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 3, out)
+	assert.Equal(t, []string{config.PrivValidatorKeyFile(), "validator", "ed25519", address.String()},
+		strings.Fields(lines[1]))
+	assert.Equal(t, []string{config.NodeKeyFile(), "node", "ed25519", string(nodeKey.ID())},
+		strings.Fields(lines[2]))
+}
+
+func TestKeysNextValidatorKey(t *testing.T) {
+	defer func() { keysNextKeyType = "" }()
+	setupKeysTest(t)
+	_, err := runKeysCmd(t, keysNextValidatorKeyCmd, "")
+	assert.Error(t, err, "no private validator")
+
+	pv := privval.GenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	pv.Save()
+	out, err := runKeysCmd(t, keysNextValidatorKeyCmd, "")
+	require.NoError(t, err)
+
+	pv = privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	nextPubKey, err := pv.GetNextPubKey()
+	require.NoError(t, err)
+	require.NotNil(t, nextPubKey)
+	assert.Equal(t, pv.Key.PubKey.Type(), nextPubKey.Type())
+	assert.Equal(t, fmt.Sprintf("type: ed25519\naddress: %X\npub_key: %X\n", nextPubKey.Address(), nextPubKey.Bytes()),
+		out)
+
+	// the next key is kept until the validator rotates to it
+	_, err = runKeysCmd(t, keysNextValidatorKeyCmd, "")
+	assert.Error(t, err)
+
+	require.NoError(t, pv.RotateKey(1))
+	keysNextKeyType = "secp256k1"
+	_, err = runKeysCmd(t, keysNextValidatorKeyCmd, "")
+	require.NoError(t, err)
+	pv = privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	assert.Equal(t, nextPubKey, pv.Key.PubKey)
+	assert.Equal(t, "secp256k1", pv.Key.NextPubKey.Type())
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 
 	dbm "github.com/mydexchain/tm-db"
@@ -16,6 +17,7 @@ import (
 	tmmath "github.com/mydexchain/tendermint0/libs/math"
 	tmos "github.com/mydexchain/tendermint0/libs/os"
 	"github.com/mydexchain/tendermint0/light"
+	"github.com/mydexchain/tendermint0/light/daemon"
 	lproxy "github.com/mydexchain/tendermint0/light/proxy"
 	lrpc "github.com/mydexchain/tendermint0/light/rpc"
 	dbs "github.com/mydexchain/tendermint0/light/store/db"
@@ -38,6 +40,13 @@ need a primary RPC address, a trusted hash and height and witness RPC addresses
 (if not using sequential verification). To restart the node, thereafter
 only the chainID is required. 
 
+With --daemon, instead of the proxy, a light daemon is started. It keeps
+following the primary in the background and serves a small REST API:
+
+  GET /verified_header/{height}
+  GET /verified_validators/{height}
+  GET /trusted_status
+
 `,
 	RunE: runProxy,
 	Args: cobra.ExactArgs(1),
@@ -61,6 +70,11 @@ var (
 
 	verbose bool
 
+	daemonMode           bool
+	updatePeriod         time.Duration
+	pruningSize          uint16
+	prometheusListenAddr string
+
 	primaryKey   = []byte("primary")
 	witnessesKey = []byte("witnesses")
 )
@@ -89,6 +103,14 @@ func init() {
 	LightCmd.Flags().BoolVar(&sequential, "sequential", false,
 		"Sequential Verification. Verify all headers sequentially as opposed to using skipping verification",
 	)
+	LightCmd.Flags().BoolVar(&daemonMode, "daemon", false,
+		"Serve the REST API of a light daemon instead of the RPC proxy")
+	LightCmd.Flags().DurationVar(&updatePeriod, "update-period", daemon.DefaultUpdatePeriod,
+		"How often the light daemon fetches and verifies the latest header")
+	LightCmd.Flags().Uint16Var(&pruningSize, "pruning-size", 1000,
+		"Maximum number of light blocks to store. 0 disables pruning")
+	LightCmd.Flags().StringVar(&prometheusListenAddr, "prometheus-laddr", "",
+		"Serve Prometheus metrics on the given address (disabled if empty)")
 }
 
 func runProxy(cmd *cobra.Command, args []string) error {
@@ -137,7 +159,21 @@ func runProxy(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("can't parse trust level: %w", err)
 	}
 
-	options := []light.Option{light.Logger(logger)}
+	options := []light.Option{
+		light.Logger(logger),
+		light.PruningSize(pruningSize),
+	}
+
+	if prometheusListenAddr != "" {
+		options = append(options,
+			light.WithMetrics(light.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", chainID)))
+		go func() {
+			logger.Info("Starting Prometheus server...", "laddr", prometheusListenAddr)
+			if err := http.ListenAndServe(prometheusListenAddr, promhttp.Handler()); err != nil {
+				logger.Error("Prometheus ListenAndServe", "err", err)
+			}
+		}()
+	}
 
 	if sequential {
 		options = append(options, light.SequentialVerification())
@@ -173,6 +209,10 @@ func runProxy(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if daemonMode {
+		return runDaemon(c, logger)
+	}
+
 	rpcClient, err := rpchttp.New(primaryAddr, "/websocket")
 	if err != nil {
 		return fmt.Errorf("http client for %s: %w", primaryAddr, err)
@@ -189,10 +229,13 @@ func runProxy(cmd *cobra.Command, args []string) error {
 		cfg.WriteTimeout = config.RPC.TimeoutBroadcastTxCommit + 1*time.Second
 	}
 
+	lrpcClient := lrpc.NewClient(rpcClient, c)
+	lrpcClient.SetLogger(logger.With("module", "rpc"))
+
 	p := lproxy.Proxy{
 		Addr:   listenAddr,
 		Config: cfg,
-		Client: lrpc.NewClient(rpcClient, c),
+		Client: lrpcClient,
 		Logger: logger,
 	}
 	// Stop upon receiving SIGTERM or CTRL-C.
@@ -209,6 +252,40 @@ func runProxy(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runDaemon(c *light.Client, logger log.Logger) error {
+	d := daemon.NewDaemon(c, updatePeriod)
+	d.SetLogger(logger.With("module", "light-daemon"))
+	if err := d.Start(); err != nil {
+		return fmt.Errorf("can't start light daemon: %w", err)
+	}
+
+	cfg := rpcserver.DefaultConfig()
+	cfg.MaxBodyBytes = config.RPC.MaxBodyBytes
+	cfg.MaxHeaderBytes = config.RPC.MaxHeaderBytes
+	cfg.MaxOpenConnections = maxOpenConnections
+
+	listener, err := rpcserver.Listen(listenAddr, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Stop upon receiving SIGTERM or CTRL-C.
+	tmos.TrapSignal(logger, func() {
+		if err := d.Stop(); err != nil {
+			logger.Error("Failed to stop light daemon", "err", err)
+		}
+		listener.Close()
+	})
+
+	logger.Info("Starting light daemon...", "laddr", listenAddr)
+	if err := rpcserver.Serve(listener, d.Handler(), logger, cfg); err != http.ErrServerClosed {
+		// Error starting or closing listener:
+		logger.Error("light daemon Serve", "err", err)
+	}
+
+	return nil
+}
+
 func checkForExistingProviders(db dbm.DB) (string, []string, error) {
 	primaryBytes, err := db.Get(primaryKey)
 	if err != nil {
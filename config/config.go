@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"time"
@@ -366,6 +367,14 @@ type RPCConfig struct {
 	// Maximum size of request header, in bytes
 	MaxHeaderBytes int `mapstructure:"max_header_bytes"`
 
+	// Maximum number of requests of a JSON-RPC batch evaluated concurrently.
+	// 0 or 1 - requests of a batch are evaluated one after another.
+	MaxBatchConcurrency int `mapstructure:"max_batch_concurrency"`
+
+	// Compress responses with gzip for clients which accept it
+	// (see the Accept-Encoding request header).
+	CompressResponses bool `mapstructure:"compress_responses"`
+
 	// The path to a file containing certificate that is used to create the HTTPS server.
 	// Migth be either absolute path or path related to tendermint's config directory.
 	//
@@ -405,6 +414,9 @@ func DefaultRPCConfig() *RPCConfig {
 		MaxBodyBytes:   int64(1000000), // 1MB
 		MaxHeaderBytes: 1 << 20,        // same as the net/http default
 
+		MaxBatchConcurrency: 4,
+		CompressResponses:   true,
+
 		TLSCertFile: "",
 		TLSKeyFile:  "",
 	}
@@ -443,6 +455,9 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.MaxHeaderBytes < 0 {
 		return errors.New("max_header_bytes can't be negative")
 	}
+	if cfg.MaxBatchConcurrency < 0 {
+		return errors.New("max_batch_concurrency can't be negative")
+	}
 	return nil
 }
 
@@ -716,6 +731,11 @@ type StateSyncConfig struct {
 	TrustPeriod time.Duration `mapstructure:"trust_period"`
 	TrustHeight int64         `mapstructure:"trust_height"`
 	TrustHash   string        `mapstructure:"trust_hash"`
+
+	// Node-side snapshots of the application state, taken every SnapshotInterval blocks if
+	// non-zero, keeping the SnapshotKeepRecent most recent ones (0 keeps all).
+	SnapshotInterval   int64 `mapstructure:"snapshot_interval"`
+	SnapshotKeepRecent int   `mapstructure:"snapshot_keep_recent"`
 }
 
 func (cfg *StateSyncConfig) TrustHashBytes() []byte {
@@ -729,7 +749,9 @@ func (cfg *StateSyncConfig) TrustHashBytes() []byte {
 
 // DefaultStateSyncConfig returns a default configuration for the state sync service
 func DefaultStateSyncConfig() *StateSyncConfig {
-	return &StateSyncConfig{}
+	return &StateSyncConfig{
+		SnapshotKeepRecent: 2,
+	}
 }
 
 // TestFastSyncConfig returns a default configuration for the state sync service
@@ -739,11 +761,15 @@ func TestStateSyncConfig() *StateSyncConfig {
 
 // ValidateBasic performs basic validation.
 func (cfg *StateSyncConfig) ValidateBasic() error {
+	if cfg.SnapshotInterval < 0 {
+		return errors.New("snapshot_interval can't be negative")
+	}
+	if cfg.SnapshotKeepRecent < 0 {
+		return errors.New("snapshot_keep_recent can't be negative")
+	}
 	if cfg.Enable {
-		if len(cfg.RPCServers) == 0 {
-			return errors.New("rpc_servers is required")
-		}
-		if len(cfg.RPCServers) < 2 {
+		// Without rpc_servers, light blocks are fetched from peers.
+		if len(cfg.RPCServers) == 1 {
 			return errors.New("at least two rpc_servers entries is required")
 		}
 		for _, server := range cfg.RPCServers {
@@ -812,6 +838,11 @@ type ConsensusConfig struct {
 	WalPath string `mapstructure:"wal_file"`
 	walFile string // overrides WalPath if set
 
+	// Path to a file recording the consensus state transitions, timeouts and
+	// votes (with their latency) for debugging. The file is rotated once it
+	// gets too big. An empty path disables the trace.
+	TraceFile string `mapstructure:"trace_file"`
+
 	TimeoutPropose        time.Duration `mapstructure:"timeout_propose"`
 	TimeoutProposeDelta   time.Duration `mapstructure:"timeout_propose_delta"`
 	TimeoutPrevote        time.Duration `mapstructure:"timeout_prevote"`
@@ -830,6 +861,15 @@ type ConsensusConfig struct {
 	// Reactor sleep duration parameters
 	PeerGossipSleepDuration     time.Duration `mapstructure:"peer_gossip_sleep_duration"`
 	PeerQueryMaj23SleepDuration time.Duration `mapstructure:"peer_query_maj23_sleep_duration"`
+
+	// Detect the validators signing conflicting votes among the votes received
+	// from peers, for the given number of heights
+	ObserveEquivocation        bool  `mapstructure:"observe_equivocation"`
+	EquivocationObserverWindow int64 `mapstructure:"equivocation_observer_window"`
+
+	// Allow BLS12-381 validator keys. They are experimental, and require a
+	// build with cgo.
+	ExperimentalBLS bool `mapstructure:"experimental_bls"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service
@@ -848,6 +888,9 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		CreateEmptyBlocksInterval:   0 * time.Second,
 		PeerGossipSleepDuration:     100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration: 2000 * time.Millisecond,
+		ObserveEquivocation:         true,
+		EquivocationObserverWindow:  10,
+		ExperimentalBLS:             false,
 	}
 }
 
@@ -912,6 +955,11 @@ func (cfg *ConsensusConfig) SetWalFile(walFile string) {
 	cfg.walFile = walFile
 }
 
+// TraceFilePath returns the full path to the consensus trace file
+func (cfg *ConsensusConfig) TraceFilePath() string {
+	return rootify(cfg.TraceFile, cfg.RootDir)
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *ConsensusConfig) ValidateBasic() error {
@@ -945,6 +993,9 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.PeerQueryMaj23SleepDuration < 0 {
 		return errors.New("peer_query_maj23_sleep_duration can't be negative")
 	}
+	if cfg.EquivocationObserverWindow <= 0 {
+		return errors.New("equivocation_observer_window must be positive")
+	}
 	return nil
 }
 
@@ -1002,6 +1053,13 @@ type InstrumentationConfig struct {
 
 	// Instrumentation namespace.
 	Namespace string `mapstructure:"namespace"`
+
+	// URL to post the evidence added to the evidence pool and committed to,
+	// as JSON. Notifications which can't be delivered are kept in the
+	// evidence_webhook database, up to 10000, and retried, unless the webhook
+	// rejects them with a 4xx status code.
+	// "" - disabled.
+	EvidenceWebhookURL string `mapstructure:"evidence_webhook_url"`
 }
 
 // DefaultInstrumentationConfig returns a default configuration for metrics
@@ -1027,6 +1085,15 @@ func (cfg *InstrumentationConfig) ValidateBasic() error {
 	if cfg.MaxOpenConnections < 0 {
 		return errors.New("max_open_connections can't be negative")
 	}
+	if cfg.EvidenceWebhookURL != "" {
+		u, err := url.Parse(cfg.EvidenceWebhookURL)
+		if err != nil {
+			return fmt.Errorf("invalid evidence_webhook_url: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("evidence_webhook_url must be an http or https URL, got %q", cfg.EvidenceWebhookURL)
+		}
+	}
 	return nil
 }
 
@@ -76,6 +76,7 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 		"TimeoutBroadcastTxCommit",
 		"MaxBodyBytes",
 		"MaxHeaderBytes",
+		"MaxBatchConcurrency",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -126,6 +127,9 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 func TestStateSyncConfigValidateBasic(t *testing.T) {
 	cfg := TestStateSyncConfig()
 	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.SnapshotInterval = -1
+	require.Error(t, cfg.ValidateBasic())
 }
 
 func TestFastSyncConfigValidateBasic(t *testing.T) {
@@ -164,6 +168,8 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"PeerGossipSleepDuration negative":     {func(c *ConsensusConfig) { c.PeerGossipSleepDuration = -1 }, true},
 		"PeerQueryMaj23SleepDuration":          {func(c *ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
 		"PeerQueryMaj23SleepDuration negative": {func(c *ConsensusConfig) { c.PeerQueryMaj23SleepDuration = -1 }, true},
+		"EquivocationObserverWindow":           {func(c *ConsensusConfig) { c.EquivocationObserverWindow = 100 }, false},
+		"EquivocationObserverWindow zero":      {func(c *ConsensusConfig) { c.EquivocationObserverWindow = 0 }, true},
 	}
 	for desc, tc := range testcases {
 		tc := tc // appease linter
@@ -188,4 +194,10 @@ func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	// tamper with maximum open connections
 	cfg.MaxOpenConnections = -1
 	assert.Error(t, cfg.ValidateBasic())
+	cfg.MaxOpenConnections = 3
+
+	cfg.EvidenceWebhookURL = "https://example.com/evidence"
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.EvidenceWebhookURL = "tcp://127.0.0.1:8080"
+	assert.Error(t, cfg.ValidateBasic())
 }
@@ -217,6 +217,14 @@ max_body_bytes = {{ .RPC.MaxBodyBytes }}
 # Maximum size of request header, in bytes
 max_header_bytes = {{ .RPC.MaxHeaderBytes }}
 
+# Maximum number of requests of a JSON-RPC batch evaluated concurrently.
+# 0 or 1 - requests of a batch are evaluated one after another.
+max_batch_concurrency = {{ .RPC.MaxBatchConcurrency }}
+
+# Compress responses with gzip for clients which accept it
+# (see the Accept-Encoding request header).
+compress_responses = {{ .RPC.CompressResponses }}
+
 # The path to a file containing certificate that is used to create the HTTPS server.
 # Migth be either absolute path or path related to tendermint's config directory.
 # If the certificate is signed by a certificate authority,
@@ -341,8 +349,10 @@ max_tx_bytes = {{ .Mempool.MaxTxBytes }}
 enable = {{ .StateSync.Enable }}
 
 # RPC servers (comma-separated) for light client verification of the synced state machine and
-# retrieval of state data for node bootstrapping. Also needs a trusted height and corresponding
-# header hash obtained from a trusted source, and a period during which validators can be trusted.
+# retrieval of state data for node bootstrapping. If empty, the data is fetched from peers over
+# p2p instead, which requires at least two connected peers. Also needs a trusted height and
+# corresponding header hash obtained from a trusted source, and a period during which validators
+# can be trusted.
 #
 # For Chain SDK-based chains, trust_period should usually be about 2/3 of the unbonding time (~2
 # weeks) during which they can be financially punished (slashed) for misbehavior.
@@ -352,9 +362,21 @@ trust_hash = "{{ .StateSync.TrustHash }}"
 trust_period = "{{ .StateSync.TrustPeriod }}"
 
 # Temporary directory for state sync snapshot chunks, defaults to the OS tempdir (typically /tmp).
-# Will create a new, randomly named directory within, and remove it when done.
+# Will create a new, randomly named directory within, and remove it when done. If set, chunks are
+# instead kept in a directory named after the snapshot, so that restoring the snapshot can resume
+# after a restart without refetching them.
 temp_dir = "{{ .StateSync.TempDir }}"
 
+# Node-side snapshots, for applications which don't implement state sync snapshots themselves.
+# If snapshot_interval is non-zero, the node exports the application state via the ExportState
+# ABCI call every snapshot_interval blocks, stores it as a snapshot under the data directory and
+# serves it to peers. Snapshots are taken right after committing a block, delaying the next one
+# until done. Nodes restoring such snapshots must also set snapshot_interval, and import
+# the state via the ImportState ABCI call. snapshot_keep_recent is the number of recent snapshots
+# to keep (0 keeps all).
+snapshot_interval = {{ .StateSync.SnapshotInterval }}
+snapshot_keep_recent = {{ .StateSync.SnapshotKeepRecent }}
+
 #######################################################
 ###       Fast Sync Configuration Connections       ###
 #######################################################
@@ -373,6 +395,11 @@ version = "{{ .FastSync.Version }}"
 
 wal_file = "{{ js .Consensus.WalPath }}"
 
+# Path to a file recording the consensus state transitions, timeouts and votes
+# (with their latency) for debugging, see "tendermint debug consensus-trace".
+# The file is rotated once it gets too big. Leave empty to disable the trace.
+trace_file = "{{ js .Consensus.TraceFile }}"
+
 timeout_propose = "{{ .Consensus.TimeoutPropose }}"
 timeout_propose_delta = "{{ .Consensus.TimeoutProposeDelta }}"
 timeout_prevote = "{{ .Consensus.TimeoutPrevote }}"
@@ -392,6 +419,17 @@ create_empty_blocks_interval = "{{ .Consensus.CreateEmptyBlocksInterval }}"
 peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+# Detect the validators signing conflicting votes among the votes received
+# from peers, for the last equivocation_observer_window heights, and submit
+# the evidence to the evidence pool
+observe_equivocation = {{ .Consensus.ObserveEquivocation }}
+equivocation_observer_window = {{ .Consensus.EquivocationObserverWindow }}
+
+# Allow BLS12-381 validator keys. They are experimental, and require a build
+# with cgo. A node refuses to start, or to apply blocks, with consensus params
+# accepting them unless this is set.
+experimental_bls = {{ .Consensus.ExperimentalBLS }}
+
 #######################################################
 ###   Transaction Indexer Configuration Options     ###
 #######################################################
@@ -429,6 +467,13 @@ max_open_connections = {{ .Instrumentation.MaxOpenConnections }}
 
 # Instrumentation namespace
 namespace = "{{ .Instrumentation.Namespace }}"
+
+# URL to post the evidence added to the evidence pool and committed to, as
+# JSON. Notifications which can't be delivered are kept in the
+# evidence_webhook database, up to 10000, and retried, unless the webhook
+# rejects them with a 4xx status code.
+# "" - disabled.
+evidence_webhook_url = "{{ .Instrumentation.EvidenceWebhookURL }}"
 `
 
 /****** these are for test settings ***********/
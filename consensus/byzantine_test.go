@@ -23,6 +23,7 @@ import (
 	mempl "github.com/mydexchain/tendermint0/mempool"
 	"github.com/mydexchain/tendermint0/p2p"
 	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
+	"github.com/mydexchain/tendermint0/proxy"
 	sm "github.com/mydexchain/tendermint0/state"
 	"github.com/mydexchain/tendermint0/store"
 	"github.com/mydexchain/tendermint0/types"
@@ -75,7 +76,8 @@ func TestByzantinePrevoteEquivocation(t *testing.T) {
 		evpool.SetLogger(logger.With("module", "evidence"))
 
 		// Make State
-		blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyAppConnCon, mempool, evpool)
+		blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxy.NewAppConnConsensus(proxyAppConnCon),
+			mempool, evpool)
 		cs := NewState(thisConfig.Consensus, state, blockExec, blockStore, mempool, evpool)
 		cs.SetLogger(cs.Logger)
 		// set private validator
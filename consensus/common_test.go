@@ -35,6 +35,7 @@ import (
 	"github.com/mydexchain/tendermint0/p2p"
 	"github.com/mydexchain/tendermint0/privval"
 	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
+	"github.com/mydexchain/tendermint0/proxy"
 	sm "github.com/mydexchain/tendermint0/state"
 	"github.com/mydexchain/tendermint0/store"
 	"github.com/mydexchain/tendermint0/types"
@@ -392,7 +393,8 @@ func newStateWithConfigAndBlockStore(
 	// Make State
 	stateDB := blockDB
 	sm.SaveState(stateDB, state) //for save height 1's validators info
-	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyAppConnCon, mempool, evpool)
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxy.NewAppConnConsensus(proxyAppConnCon),
+		mempool, evpool)
 	cs := NewState(thisConfig.Consensus, state, blockExec, blockStore, mempool, evpool)
 	cs.SetLogger(log.TestingLogger().With("module", "consensus"))
 	cs.SetPrivValidator(pv)
@@ -454,7 +456,8 @@ func randStateWithEvpool(t *testing.T, nValidators int) (*State, []*validatorStu
 	sm.SaveState(stateDB, state)
 	evpool, err := evidence.NewPool(stateDB, evidenceDB, blockStore)
 	require.NoError(t, err)
-	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyAppConnCon, mempool, evpool)
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxy.NewAppConnConsensus(proxyAppConnCon),
+		mempool, evpool)
 	cs := NewState(config.Consensus, state, blockExec, blockStore, mempool, evpool)
 	cs.SetLogger(log.TestingLogger().With("module", "consensus"))
 	cs.SetPrivValidator(privVals[0])
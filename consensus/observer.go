@@ -0,0 +1,277 @@
+package consensus
+
+import (
+	"bytes"
+	"time"
+
+	tmsync "github.com/mydexchain/tendermint0/libs/sync"
+	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
+	sm "github.com/mydexchain/tendermint0/state"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+const (
+	// conflictQueueSize is the number of conflicting votes waiting to be
+	// verified by the equivocation observer. Further conflicts are dropped.
+	conflictQueueSize = 100
+
+	// maxObservedVotes is the number of votes the equivocation observer
+	// records. Further votes are dropped until the window moves on.
+	maxObservedVotes = 10 * types.MaxVotesCount
+)
+
+// voteKey identifies the vote a validator may cast in a given step.
+type voteKey struct {
+	height  int64
+	round   int32
+	typ     tmproto.SignedMsgType
+	address string
+}
+
+// voteConflict is a vote conflicting with the vote first received for the
+// same step.
+type voteConflict struct {
+	key            voteKey
+	existing, vote *types.Vote
+}
+
+// equivocationObserver records the votes received from peers for a sliding
+// window of heights and submits DuplicateVoteEvidence to the evidence pool
+// when a validator signs two votes for different blocks in the same step.
+//
+// The consensus state only detects conflicting votes for the height it is at
+// and only if it is a validator, so that nodes such as sentries would
+// otherwise drop the votes of misbehaving validators.
+//
+// The votes are recorded without being verified. Only the votes of a conflict
+// are, by verifyRoutine, so that receiving votes costs no signature
+// verification. The votes of the addresses out of the validator set of their
+// height, or for rounds after the one we reached at that height plus one, are
+// ignored, and at most maxObservedVotes votes are recorded.
+type equivocationObserver struct {
+	conS      *State
+	window    int64
+	conflicts chan voteConflict
+
+	mtx      tmsync.Mutex
+	height   int64           // the consensus height the window ends at
+	rounds   map[int64]int32 // the last consensus round reached at each height
+	votes    map[voteKey]*types.Vote
+	checking map[voteKey]struct{}
+	reported map[voteKey]struct{}
+	valSets  map[int64]*types.ValidatorSet
+}
+
+func newEquivocationObserver(conS *State, window int64) *equivocationObserver {
+	return &equivocationObserver{
+		conS:      conS,
+		window:    window,
+		conflicts: make(chan voteConflict, conflictQueueSize),
+		rounds:    make(map[int64]int32),
+		votes:     make(map[voteKey]*types.Vote),
+		checking:  make(map[voteKey]struct{}),
+		reported:  make(map[voteKey]struct{}),
+		valSets:   make(map[int64]*types.ValidatorSet),
+	}
+}
+
+// observeVote records the vote if it is the first one received from its
+// validator for its step, or queues it for verification if it conflicts with
+// that one. Votes out of the window are ignored.
+func (obs *equivocationObserver) observeVote(vote *types.Vote) {
+	cs := obs.conS
+	cs.mtx.RLock()
+	height, round, ourPubKey := cs.Height, cs.Round, cs.privValidatorPubKey
+	valSets := [3]*types.ValidatorSet{cs.LastValidators, cs.Validators, cs.state.NextValidators}
+	cs.mtx.RUnlock()
+	// conflicting votes from ourselves are reported by the consensus state
+	if ourPubKey != nil && bytes.Equal(vote.ValidatorAddress, ourPubKey.Address()) {
+		return
+	}
+
+	obs.mtx.Lock()
+	defer obs.mtx.Unlock()
+
+	if height > obs.height {
+		obs.height = height
+		obs.prune()
+		for i, valSet := range valSets {
+			if h := height - 1 + int64(i); valSet != nil && h > height-obs.window {
+				obs.valSets[h] = valSet
+			}
+		}
+	}
+	if height == obs.height && round > obs.rounds[height] {
+		obs.rounds[height] = round
+	}
+	// votes for the next height can be received before we enter it
+	if vote.Height > obs.height+1 || vote.Height <= obs.height-obs.window {
+		return
+	}
+	// votes for the next round can be received before we enter it
+	if vote.Round > obs.rounds[vote.Height]+1 {
+		return
+	}
+	key := voteKey{vote.Height, vote.Round, vote.Type, string(vote.ValidatorAddress)}
+	if _, ok := obs.reported[key]; ok {
+		return
+	}
+	existing, ok := obs.votes[key]
+	if !ok {
+		valSet := obs.validatorSet(vote.Height)
+		if valSet == nil || !valSet.HasAddress(vote.ValidatorAddress) {
+			return
+		}
+		if len(obs.votes) >= maxObservedVotes {
+			cs.Logger.Debug("Dropping vote, too many observed votes", "vote", vote)
+			return
+		}
+		obs.votes[key] = vote
+		return
+	}
+	if existing.BlockID.Equals(vote.BlockID) {
+		return
+	}
+	if _, ok := obs.checking[key]; ok {
+		return
+	}
+
+	select {
+	case obs.conflicts <- voteConflict{key, existing, vote}:
+		obs.checking[key] = struct{}{}
+	default:
+		cs.Logger.Debug("Dropping conflicting votes, too many to verify", "vote", vote)
+	}
+}
+
+// verifyRoutine verifies the conflicting votes until quit is closed.
+func (obs *equivocationObserver) verifyRoutine(quit <-chan struct{}) {
+	for {
+		select {
+		case conflict := <-obs.conflicts:
+			obs.verifyConflict(conflict)
+		case <-quit:
+			return
+		}
+	}
+}
+
+// verifyConflict submits DuplicateVoteEvidence to the evidence pool if both
+// votes are signed by a validator of their height. Otherwise, a first vote
+// which can't be verified is replaced by the other one, so that peers can't
+// forge votes hiding the conflicts.
+func (obs *equivocationObserver) verifyConflict(conflict voteConflict) {
+	cs := obs.conS
+	key, existing, vote := conflict.key, conflict.existing, conflict.vote
+
+	obs.mtx.Lock()
+	valSet := obs.validatorSet(key.height)
+	obs.mtx.Unlock()
+
+	existingValid, voteValid := false, false
+	if valSet != nil {
+		if _, val := valSet.GetByAddress(vote.ValidatorAddress); val != nil {
+			cs.mtx.RLock()
+			chainID := cs.state.ChainID
+			cs.mtx.RUnlock()
+			existingValid = existing.Verify(chainID, val.PubKey) == nil
+			voteValid = vote.Verify(chainID, val.PubKey) == nil
+		}
+	}
+
+	var (
+		timestamp time.Time
+		ok        bool
+	)
+	if existingValid && voteValid {
+		timestamp, ok = obs.evidenceTime(key.height)
+	}
+
+	obs.mtx.Lock()
+	delete(obs.checking, key)
+	switch {
+	case existingValid && voteValid && ok:
+		obs.reported[key] = struct{}{}
+	case !existingValid && obs.votes[key] == existing:
+		if voteValid {
+			obs.votes[key] = vote
+		} else {
+			delete(obs.votes, key)
+		}
+	}
+	obs.mtx.Unlock()
+	if !existingValid || !voteValid || !ok {
+		return
+	}
+
+	ev := types.NewDuplicateVoteEvidence(existing, vote, timestamp)
+	cs.Logger.Info("Observed conflicting votes", "evidence", ev)
+	if err := cs.evpool.AddEvidence(ev); err != nil {
+		cs.Logger.Error("Failed to add evidence to the evidence pool", "err", err)
+	}
+}
+
+// validatorSet returns the validator set at the given height, or nil if it
+// can't be loaded. The sets of the heights around the consensus height are
+// taken from the consensus state, the others are loaded once.
+// CONTRACT: obs.mtx is held.
+func (obs *equivocationObserver) validatorSet(height int64) *types.ValidatorSet {
+	if valSet, ok := obs.valSets[height]; ok {
+		return valSet
+	}
+	valSet, err := sm.LoadValidators(obs.conS.blockExec.DB(), height)
+	if err != nil {
+		valSet = nil
+	}
+	obs.valSets[height] = valSet
+	return valSet
+}
+
+// evidenceTime returns the time of evidence at the given height, as expected by
+// the evidence pool: the time of the block at that height, which for the
+// height being decided is the median time of the last commit.
+func (obs *equivocationObserver) evidenceTime(height int64) (time.Time, bool) {
+	cs := obs.conS
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+
+	if height == cs.Height {
+		if height == cs.state.InitialHeight {
+			return cs.state.LastBlockTime, true // genesis time
+		}
+		if cs.LastCommit == nil || !cs.LastCommit.HasTwoThirdsMajority() {
+			return time.Time{}, false
+		}
+		return sm.MedianTime(cs.LastCommit.MakeCommit(), cs.LastValidators), true
+	}
+	blockMeta := cs.blockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return time.Time{}, false
+	}
+	return blockMeta.Header.Time, true
+}
+
+// prune removes the votes, rounds and validator sets out of the window.
+func (obs *equivocationObserver) prune() {
+	minHeight := obs.height - obs.window
+	for height := range obs.rounds {
+		if height <= minHeight {
+			delete(obs.rounds, height)
+		}
+	}
+	for height := range obs.valSets {
+		if height <= minHeight {
+			delete(obs.valSets, height)
+		}
+	}
+	for key := range obs.votes {
+		if key.height <= minHeight {
+			delete(obs.votes, key)
+		}
+	}
+	for key := range obs.reported {
+		if key.height <= minHeight {
+			delete(obs.reported, key)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package consensus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mydexchain/tendermint0/crypto/tmhash"
+	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+// verifyConflicts verifies the queued conflicts as verifyRoutine would.
+func verifyConflicts(observer *equivocationObserver) {
+	for len(observer.conflicts) > 0 {
+		observer.verifyConflict(<-observer.conflicts)
+	}
+}
+
+func TestEquivocationObserver(t *testing.T) {
+	cs1, vss, evpool := randStateWithEvpool(t, 4)
+	observer := newEquivocationObserver(cs1, 10)
+
+	headerA := types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("A"))}
+	headerB := types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("B"))}
+	hashA, hashB := tmhash.Sum([]byte("blockA")), tmhash.Sum([]byte("blockB"))
+
+	// conflicting votes of ours are left to the consensus state
+	observer.observeVote(signVote(vss[0], tmproto.PrevoteType, hashA, headerA))
+	observer.observeVote(signVote(vss[0], tmproto.PrevoteType, hashB, headerB))
+	assert.Empty(t, observer.votes)
+
+	// a forged vote is recorded without being verified, and replaced by the
+	// vote it conflicts with once both are verified
+	forged := signVote(vss[1], tmproto.PrevoteType, hashB, headerB)
+	forged.Signature = []byte("forged")
+	observer.observeVote(forged)
+	assert.Len(t, observer.votes, 1)
+	assert.Empty(t, observer.conflicts)
+	voteA := signVote(vss[1], tmproto.PrevoteType, hashA, headerA)
+	observer.observeVote(voteA)
+	assert.Len(t, observer.conflicts, 1)
+	verifyConflicts(observer)
+	assert.Empty(t, evpool.AllPendingEvidence())
+	require.Len(t, observer.votes, 1)
+	for _, vote := range observer.votes {
+		assert.Equal(t, voteA, vote)
+	}
+
+	// the same vote received twice isn't a conflict
+	observer.observeVote(voteA)
+	assert.Empty(t, observer.conflicts)
+
+	// nor a vote for another block in another round
+	vss[1].Round++
+	observer.observeVote(signVote(vss[1], tmproto.PrevoteType, hashB, headerB))
+	vss[1].Round--
+	assert.Len(t, observer.votes, 2)
+	assert.Empty(t, observer.conflicts)
+
+	// conflicting votes are verified once and turned into evidence
+	voteB := signVote(vss[1], tmproto.PrevoteType, hashB, headerB)
+	observer.observeVote(voteB)
+	observer.observeVote(voteB)
+	assert.Len(t, observer.conflicts, 1)
+	verifyConflicts(observer)
+	observer.observeVote(voteB)
+	assert.Empty(t, observer.conflicts)
+	pending := evpool.AllPendingEvidence()
+	require.Len(t, pending, 1)
+	ev, ok := pending[0].(*types.DuplicateVoteEvidence)
+	require.True(t, ok)
+	assert.Equal(t, types.NewDuplicateVoteEvidence(voteA, voteB, cs1.state.LastBlockTime), ev)
+
+	// votes after the next height are ignored
+	vss[2].Height = 3
+	observer.observeVote(signVote(vss[2], tmproto.PrevoteType, hashA, headerA))
+	assert.Len(t, observer.votes, 2)
+}
+
+func TestEquivocationObserverWindow(t *testing.T) {
+	cs1, vss, _ := randStateWithEvpool(t, 4)
+	observer := newEquivocationObserver(cs1, 2)
+
+	hash := tmhash.Sum([]byte("block"))
+	header := types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("header"))}
+	observer.observeVote(signVote(vss[1], tmproto.PrecommitType, hash, header))
+	require.Len(t, observer.votes, 1)
+	require.NotNil(t, observer.validatorSet(1))
+	assert.Contains(t, observer.valSets, int64(1))
+
+	// the votes and validator sets out of the window are pruned
+	vss[2].Height = 2
+	observer.observeVote(signVote(vss[2], tmproto.PrecommitType, hash, header))
+	assert.Len(t, observer.votes, 2)
+	cs1.mtx.Lock()
+	cs1.Height = 3
+	cs1.mtx.Unlock()
+	vss[3].Height = 3
+	observer.observeVote(signVote(vss[3], tmproto.PrecommitType, hash, header))
+	assert.Len(t, observer.votes, 2)
+	require.NotNil(t, observer.validatorSet(2))
+	assert.NotContains(t, observer.valSets, int64(1))
+
+	// and older votes ignored
+	vss[1].Height = 1
+	observer.observeVote(signVote(vss[1], tmproto.PrevoteType, hash, header))
+	assert.Len(t, observer.votes, 2)
+}
+
+func TestEquivocationObserverIgnoredVotes(t *testing.T) {
+	cs1, vss, _ := randStateWithEvpool(t, 4)
+	observer := newEquivocationObserver(cs1, 10)
+
+	hash := tmhash.Sum([]byte("block"))
+	header := types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("header"))}
+
+	// votes of non validators
+	observer.observeVote(signVote(newValidatorStub(types.NewMockPV(), 4), tmproto.PrevoteType, hash, header))
+	assert.Empty(t, observer.votes)
+
+	// votes for rounds after the next one
+	vss[1].Round = 2
+	observer.observeVote(signVote(vss[1], tmproto.PrevoteType, hash, header))
+	assert.Empty(t, observer.votes)
+	cs1.mtx.Lock()
+	cs1.Round = 1
+	cs1.mtx.Unlock()
+	observer.observeVote(signVote(vss[1], tmproto.PrevoteType, hash, header))
+	assert.Len(t, observer.votes, 1)
+
+	// and for the rounds after the first ones of the next height
+	vss[2].Height, vss[2].Round = 2, 2
+	observer.observeVote(signVote(vss[2], tmproto.PrevoteType, hash, header))
+	assert.Len(t, observer.votes, 1)
+	vss[2].Round = 1
+	observer.observeVote(signVote(vss[2], tmproto.PrevoteType, hash, header))
+	assert.Len(t, observer.votes, 2)
+
+	// votes beyond the ones the observer records
+	for i := len(observer.votes); i < maxObservedVotes; i++ {
+		observer.votes[voteKey{height: 1, round: 1, address: fmt.Sprint(i)}] = &types.Vote{}
+	}
+	observer.observeVote(signVote(vss[3], tmproto.PrevoteType, hash, header))
+	assert.Len(t, observer.votes, maxObservedVotes)
+}
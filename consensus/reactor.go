@@ -46,6 +46,7 @@ type Reactor struct {
 	mtx      tmsync.RWMutex
 	waitSync bool
 	eventBus *types.EventBus
+	observer *equivocationObserver
 
 	Metrics *Metrics
 }
@@ -60,6 +61,9 @@ func NewReactor(consensusState *State, waitSync bool, options ...ReactorOption)
 		waitSync: waitSync,
 		Metrics:  NopMetrics(),
 	}
+	if consensusState.config.ObserveEquivocation {
+		conR.observer = newEquivocationObserver(consensusState, consensusState.config.EquivocationObserverWindow)
+	}
 	conR.BaseReactor = *p2p.NewBaseReactor("Consensus", conR)
 
 	for _, option := range options {
@@ -77,6 +81,11 @@ func (conR *Reactor) OnStart() error {
 	// start routine that computes peer statistics for evaluating peer quality
 	go conR.peerStatsRoutine()
 
+	// start routine that verifies the conflicting votes received from peers
+	if conR.observer != nil {
+		go conR.observer.verifyRoutine(conR.Quit())
+	}
+
 	conR.subscribeToBroadcastEvents()
 
 	if !conR.WaitSync() {
@@ -331,6 +340,9 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 			ps.EnsureVoteBitArrays(height, valSize)
 			ps.EnsureVoteBitArrays(height-1, lastCommitSize)
 			ps.SetHasVote(msg.Vote)
+			if conR.observer != nil {
+				conR.observer.observeVote(msg.Vote)
+			}
 
 			cs.peerMsgQueue <- msgInfo{msg, src.ID()}
 
@@ -667,6 +679,13 @@ OUTER_LOOP:
 			// Load the block commit for prs.Height,
 			// which contains precommit signatures for prs.Height.
 			commit := conR.conS.blockStore.LoadBlockCommit(prs.Height)
+			if commit != nil && commit.Aggregated() {
+				// The precommits of an aggregated commit have no signatures, so
+				// send the ones seen by this node instead, if any.
+				if seenCommit := conR.conS.blockStore.LoadSeenCommit(prs.Height); seenCommit != nil {
+					commit = seenCommit
+				}
+			}
 			if ps.PickSendVote(commit) {
 				logger.Debug("Picked Catchup commit to send", "height", prs.Height)
 				continue OUTER_LOOP
@@ -1075,10 +1094,18 @@ func (ps *PeerState) PickVoteToSend(votes types.VoteSetReader) (vote *types.Vote
 	if psVotes == nil {
 		return nil, false // Not something worth sending
 	}
-	if index, ok := votes.BitArray().Sub(psVotes).PickRandom(); ok {
-		return votes.GetByIndex(int32(index)), true
+	candidates := votes.BitArray().Sub(psVotes)
+	for {
+		index, ok := candidates.PickRandom()
+		if !ok {
+			return nil, false
+		}
+		// the votes of an aggregated commit have no signatures and can't be sent
+		if vote := votes.GetByIndex(int32(index)); len(vote.Signature) > 0 {
+			return vote, true
+		}
+		candidates.SetIndex(index, false)
 	}
-	return nil, false
 }
 
 func (ps *PeerState) getVoteBitArray(height int64, round int32, votesType tmproto.SignedMsgType) *bits.BitArray {
@@ -31,6 +31,7 @@ import (
 	"github.com/mydexchain/tendermint0/p2p"
 	"github.com/mydexchain/tendermint0/p2p/mock"
 	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
+	"github.com/mydexchain/tendermint0/proxy"
 	sm "github.com/mydexchain/tendermint0/state"
 	"github.com/mydexchain/tendermint0/store"
 	"github.com/mydexchain/tendermint0/types"
@@ -160,7 +161,8 @@ func TestReactorWithEvidence(t *testing.T) {
 		evpool := newMockEvidencePool(privVals[vIdx])
 
 		// Make State
-		blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyAppConnCon, mempool, evpool)
+		blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxy.NewAppConnConsensus(proxyAppConnCon),
+			mempool, evpool)
 		cs := NewState(thisConfig.Consensus, state, blockExec, blockStore, mempool, evpool)
 		cs.SetLogger(log.TestingLogger().With("module", "consensus"))
 		cs.SetPrivValidator(pv)
@@ -208,6 +208,8 @@ type Handshaker struct {
 	logger       log.Logger
 
 	nBlocks int // number of blocks applied to the state
+
+	blockExecOptions []sm.BlockExecutorOption
 }
 
 func NewHandshaker(stateDB dbm.DB, state sm.State,
@@ -234,6 +236,11 @@ func (h *Handshaker) SetEventBus(eventBus types.BlockEventPublisher) {
 	h.eventBus = eventBus
 }
 
+// SetBlockExecutorOptions sets the options of the executor replaying blocks.
+func (h *Handshaker) SetBlockExecutorOptions(options ...sm.BlockExecutorOption) {
+	h.blockExecOptions = options
+}
+
 // NBlocks returns the number of blocks applied to the state.
 func (h *Handshaker) NBlocks() int {
 	return h.nBlocks
@@ -496,7 +503,8 @@ func (h *Handshaker) replayBlock(state sm.State, height int64, proxyApp proxy.Ap
 
 	// Use stubs for both mempool and evidence pool since no transactions nor
 	// evidence are needed here - block already exists.
-	blockExec := sm.NewBlockExecutor(h.stateDB, h.logger, proxyApp, emptyMempool{}, emptyEvidencePool{})
+	blockExec := sm.NewBlockExecutor(h.stateDB, h.logger, proxyApp, emptyMempool{}, emptyEvidencePool{},
+		h.blockExecOptions...)
 	blockExec.SetEventBus(h.eventBus)
 
 	var err error
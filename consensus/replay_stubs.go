@@ -8,6 +8,7 @@ import (
 	"github.com/mydexchain/tendermint0/proxy"
 	sm "github.com/mydexchain/tendermint0/state"
 	"github.com/mydexchain/tendermint0/types"
+	"github.com/mydexchain/tendermint0/version"
 )
 
 //-----------------------------------------------------------------------------
@@ -82,22 +83,15 @@ type mockProxyApp struct {
 	abci.BaseApplication
 
 	appHash       []byte
-	txCount       int
 	abciResponses *tmstate.ABCIResponses
 }
 
-func (mock *mockProxyApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
-	r := mock.abciResponses.DeliverTxs[mock.txCount]
-	mock.txCount++
-	if r == nil {
-		return abci.ResponseDeliverTx{}
-	}
-	return *r
+func (mock *mockProxyApp) Info(req abci.RequestInfo) abci.ResponseInfo {
+	return abci.ResponseInfo{AbciVersion: version.ABCIVersion}
 }
 
-func (mock *mockProxyApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
-	mock.txCount = 0
-	return *mock.abciResponses.EndBlock
+func (mock *mockProxyApp) FinalizeBlock(req abci.RequestFinalizeBlock) abci.ResponseFinalizeBlock {
+	return *mock.abciResponses.FinalizeBlock
 }
 
 func (mock *mockProxyApp) Commit() abci.ResponseCommit {
@@ -128,6 +128,9 @@ type State struct {
 	replayMode   bool // so we don't log signing errors during replay
 	doWALCatchup bool // determines if we even try to do the catchup
 
+	// records State transitions for debugging, see config.TraceFile
+	tracer Tracer
+
 	// for tests where we want to limit the number of transitions the state makes
 	nSteps int
 
@@ -172,6 +175,7 @@ func NewState(
 		done:             make(chan struct{}),
 		doWALCatchup:     true,
 		wal:              nilWAL{},
+		tracer:           nopTracer{},
 		evpool:           evpool,
 		evsw:             tmevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
@@ -279,6 +283,7 @@ func (cs *State) SetPrivValidator(priv types.PrivValidator) {
 	if err := cs.updatePrivValidatorPubKey(); err != nil {
 		cs.Logger.Error("Can't get private validator pubkey", "err", err)
 	}
+	cs.rotatePrivValidatorKey()
 }
 
 // SetTimeoutTicker sets the local timer. It may be useful to overwrite for testing.
@@ -353,6 +358,21 @@ func (cs *State) OnStart() error {
 		}
 	}
 
+	// We may set the tracer in testing before calling Start, so only open the
+	// trace file if it's still the nopTracer.
+	if _, ok := cs.tracer.(nopTracer); ok && cs.config.TraceFile != "" {
+		tracer, err := NewFileTracer(cs.config.TraceFilePath())
+		if err != nil {
+			cs.Logger.Error("Failed to open consensus trace", "file", cs.config.TraceFilePath(), "err", err)
+			return err
+		}
+		tracer.SetLogger(cs.Logger.With("trace", cs.config.TraceFilePath()))
+		cs.tracer = tracer
+	}
+	if err := cs.tracer.Start(); err != nil {
+		return err
+	}
+
 	if err := cs.evsw.Start(); err != nil {
 		return err
 	}
@@ -402,6 +422,9 @@ func (cs *State) loadWalFile() error {
 func (cs *State) OnStop() {
 	cs.evsw.Stop()
 	cs.timeoutTicker.Stop()
+	if err := cs.tracer.Stop(); err != nil {
+		cs.Logger.Error("Error stopping consensus trace", "err", err)
+	}
 	// WAL is stopped in receiveRoutine.
 }
 
@@ -500,6 +523,29 @@ func (cs *State) updateHeight(height int64) {
 	cs.Height = height
 }
 
+// trace records ev in the consensus trace, unless we're replaying the WAL.
+func (cs *State) trace(ev TraceEvent) {
+	if cs.replayMode {
+		return
+	}
+	ev.Time = tmtime.Now()
+	cs.tracer.Trace(ev)
+}
+
+func (cs *State) traceVote(vote *types.Vote, peerID p2p.ID) {
+	cs.trace(TraceEvent{
+		Type:             TraceVote,
+		Height:           vote.Height,
+		Round:            vote.Round,
+		VoteType:         vote.Type.String(),
+		ValidatorAddress: vote.ValidatorAddress.String(),
+		ValidatorIndex:   vote.ValidatorIndex,
+		BlockID:          vote.BlockID.String(),
+		PeerID:           peerID,
+		Latency:          tmtime.Now().Sub(vote.Timestamp),
+	})
+}
+
 func (cs *State) updateRoundStep(round int32, step cstypes.RoundStepType) {
 	cs.Round = round
 	cs.Step = step
@@ -531,19 +577,29 @@ func (cs *State) sendInternalMessage(mi msgInfo) {
 	}
 }
 
-// Reconstruct LastCommit from SeenCommit, which we saved along with the block,
-// (which happens even before saving the state)
+// Reconstruct LastCommit from the extended SeenCommit, which we saved along
+// with the block, (which happens even before saving the state). The vote
+// extensions are missing if the block was saved without them, e.g. by state
+// sync, in which case the SeenCommit is used.
 func (cs *State) reconstructLastCommit(state sm.State) {
-	seenCommit := cs.blockStore.LoadSeenCommit(state.LastBlockHeight)
-	if seenCommit == nil {
-		panic(fmt.Sprintf("Failed to reconstruct LastCommit: seen commit for height %v not found",
-			state.LastBlockHeight))
+	extCommit := cs.blockStore.LoadBlockExtendedCommit(state.LastBlockHeight)
+	if extCommit == nil {
+		seenCommit := cs.blockStore.LoadSeenCommit(state.LastBlockHeight)
+		if seenCommit == nil {
+			panic(fmt.Sprintf("Failed to reconstruct LastCommit: seen commit for height %v not found",
+				state.LastBlockHeight))
+		}
+		extCommit = seenCommit.WrappedExtendedCommit()
 	}
 
-	lastPrecommits := types.CommitToVoteSet(state.ChainID, seenCommit, state.LastValidators)
+	lastPrecommits, err := types.ExtendedCommitToVoteSet(state.ChainID, extCommit, state.LastValidators)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to reconstruct LastCommit: %v", err))
+	}
 	if !lastPrecommits.HasTwoThirdsMajority() {
 		panic("Failed to reconstruct LastCommit: Does not have +2/3 maj")
 	}
+	lastPrecommits.SetExtensionVerifier(cs.verifyVoteExtension)
 
 	cs.LastCommit = lastPrecommits
 }
@@ -628,6 +684,7 @@ func (cs *State) updateToState(state sm.State) {
 
 	cs.Validators = validators
 	cs.Proposal = nil
+	cs.ProposalReceiveTime = time.Time{}
 	cs.ProposalBlock = nil
 	cs.ProposalBlockParts = nil
 	cs.LockedRound = -1
@@ -637,12 +694,17 @@ func (cs *State) updateToState(state sm.State) {
 	cs.ValidBlock = nil
 	cs.ValidBlockParts = nil
 	cs.Votes = cstypes.NewHeightVoteSet(state.ChainID, height, validators)
+	cs.Votes.SetExtensionVerifier(cs.verifyVoteExtension)
 	cs.CommitRound = -1
 	cs.LastValidators = state.LastValidators
 	cs.TriggeredTimeoutPrecommit = false
 
 	cs.state = state
 
+	// Switch to the next key of the private validator if it was rotated at
+	// this height.
+	cs.rotatePrivValidatorKey()
+
 	// Finally, broadcast RoundState
 	cs.newStep()
 }
@@ -820,6 +882,14 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
 
+	cs.trace(TraceEvent{
+		Type:     TraceTimeout,
+		Height:   ti.Height,
+		Round:    ti.Round,
+		Step:     ti.Step.String(),
+		Duration: ti.Duration,
+	})
+
 	switch ti.Step {
 	case cstypes.RoundStepNewHeight:
 		// NewRound event fired from enterNewRound.
@@ -896,6 +966,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	}
 
 	logger.Info(fmt.Sprintf("enterNewRound(%v/%v). Current: %v/%v/%v", height, round, cs.Height, cs.Round, cs.Step))
+	cs.trace(TraceEvent{Type: TraceEnterNewRound, Height: height, Round: round})
 
 	// Increment validators if necessary
 	validators := cs.Validators
@@ -916,6 +987,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	} else {
 		logger.Info("Resetting Proposal info")
 		cs.Proposal = nil
+		cs.ProposalReceiveTime = time.Time{}
 		cs.ProposalBlock = nil
 		cs.ProposalBlockParts = nil
 	}
@@ -971,6 +1043,7 @@ func (cs *State) enterPropose(height int64, round int32) {
 		return
 	}
 	logger.Info(fmt.Sprintf("enterPropose(%v/%v). Current: %v/%v/%v", height, round, cs.Height, cs.Round, cs.Step))
+	cs.trace(TraceEvent{Type: TraceEnterPropose, Height: height, Round: round})
 
 	defer func() {
 		// Done enterPropose:
@@ -1097,15 +1170,15 @@ func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.Pa
 		panic("entered createProposalBlock with privValidator being nil")
 	}
 
-	var commit *types.Commit
+	var commit *types.ExtendedCommit
 	switch {
 	case cs.Height == cs.state.InitialHeight:
 		// We're creating a proposal for the first block.
 		// The commit is empty, but not nil.
-		commit = types.NewCommit(0, 0, types.BlockID{}, nil)
+		commit = types.NewExtendedCommit(0, 0, types.BlockID{}, nil)
 	case cs.LastCommit.HasTwoThirdsMajority():
-		// Make the commit from LastCommit
-		commit = cs.LastCommit.MakeCommit()
+		// Make the commit from LastCommit, along with the vote extensions
+		commit = cs.LastCommit.MakeExtendedCommit()
 	default: // This shouldn't happen.
 		cs.Logger.Error("enterPropose: Cannot propose anything: No commit for the previous block")
 		return
@@ -1119,7 +1192,12 @@ func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.Pa
 	}
 	proposerAddr := cs.privValidatorPubKey.Address()
 
-	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr)
+	block, blockParts, err := cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr)
+	if err != nil {
+		cs.Logger.Error("enterPropose: Cannot create the proposal block", "err", err)
+		return nil, nil
+	}
+	return block, blockParts
 }
 
 // Enter: `timeoutPropose` after entering Propose.
@@ -1145,6 +1223,7 @@ func (cs *State) enterPrevote(height int64, round int32) {
 	}()
 
 	cs.Logger.Info(fmt.Sprintf("enterPrevote(%v/%v). Current: %v/%v/%v", height, round, cs.Height, cs.Round, cs.Step))
+	cs.trace(TraceEvent{Type: TraceEnterPrevote, Height: height, Round: round})
 
 	// Sign and broadcast vote as necessary
 	cs.doPrevote(height, round)
@@ -1179,6 +1258,33 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		return
 	}
 
+	// With proposer based timestamps, the time of a new block (i.e. one which
+	// wasn't already prevoted by +2/3 in an earlier round) must be timely. The
+	// block at the initial height has the genesis time, which is exempt.
+	tsParams := cs.state.ConsensusParams.Timestamp
+	if tsParams.ProposerBased && cs.Height != cs.state.InitialHeight &&
+		cs.Proposal != nil && cs.Proposal.POLRound == -1 &&
+		!isTimely(cs.ProposalBlock.Time, cs.ProposalReceiveTime, tsParams) {
+		logger.Error("enterPrevote: ProposalBlock is not timely",
+			"time", cs.ProposalBlock.Time, "receiveTime", cs.ProposalReceiveTime,
+			"precision", tsParams.Precision, "messageDelay", tsParams.MessageDelay)
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
+	// The application may reject the block.
+	accepted, err := cs.blockExec.ProcessProposal(cs.ProposalBlock, cs.state)
+	if err != nil {
+		logger.Error("enterPrevote: Failed to process ProposalBlock", "err", err)
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+	if !accepted {
+		logger.Error("enterPrevote: ProposalBlock was rejected by the application")
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
@@ -1186,6 +1292,15 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 	cs.signAddVote(tmproto.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header())
 }
 
+// isTimely reports whether a proposal for a block with time blockTime, which
+// was received at receiveTime, is timely, i.e. whether
+// blockTime - precision <= receiveTime <= blockTime + messageDelay + precision.
+func isTimely(blockTime, receiveTime time.Time, params tmproto.TimestampParams) bool {
+	lower := blockTime.Add(-params.Precision)
+	upper := blockTime.Add(params.MessageDelay + params.Precision)
+	return !receiveTime.Before(lower) && !receiveTime.After(upper)
+}
+
 // Enter: any +2/3 prevotes at next round.
 func (cs *State) enterPrevoteWait(height int64, round int32) {
 	logger := cs.Logger.With("height", height, "round", round)
@@ -1204,6 +1319,7 @@ func (cs *State) enterPrevoteWait(height int64, round int32) {
 		panic(fmt.Sprintf("enterPrevoteWait(%v/%v), but Prevotes does not have any +2/3 votes", height, round))
 	}
 	logger.Info(fmt.Sprintf("enterPrevoteWait(%v/%v). Current: %v/%v/%v", height, round, cs.Height, cs.Round, cs.Step))
+	cs.trace(TraceEvent{Type: TraceEnterPrevoteWait, Height: height, Round: round})
 
 	defer func() {
 		// Done enterPrevoteWait:
@@ -1236,6 +1352,7 @@ func (cs *State) enterPrecommit(height int64, round int32) {
 	}
 
 	logger.Info(fmt.Sprintf("enterPrecommit(%v/%v). Current: %v/%v/%v", height, round, cs.Height, cs.Round, cs.Step))
+	cs.trace(TraceEvent{Type: TraceEnterPrecommit, Height: height, Round: round})
 
 	defer func() {
 		// Done enterPrecommit:
@@ -1362,6 +1479,7 @@ func (cs *State) enterPrecommitWait(height int64, round int32) {
 		panic(fmt.Sprintf("enterPrecommitWait(%v/%v), but Precommits does not have any +2/3 votes", height, round))
 	}
 	logger.Info(fmt.Sprintf("enterPrecommitWait(%v/%v). Current: %v/%v/%v", height, round, cs.Height, cs.Round, cs.Step))
+	cs.trace(TraceEvent{Type: TraceEnterPrecommitWait, Height: height, Round: round})
 
 	defer func() {
 		// Done enterPrecommitWait:
@@ -1388,6 +1506,7 @@ func (cs *State) enterCommit(height int64, commitRound int32) {
 		return
 	}
 	logger.Info(fmt.Sprintf("enterCommit(%v/%v). Current: %v/%v/%v", height, commitRound, cs.Height, cs.Round, cs.Step))
+	cs.trace(TraceEvent{Type: TraceEnterCommit, Height: height, Round: commitRound})
 
 	defer func() {
 		// Done enterCommit:
@@ -1494,6 +1613,8 @@ func (cs *State) finalizeCommit(height int64) {
 		panic(fmt.Errorf("+2/3 committed an invalid block: %w", err))
 	}
 
+	cs.trace(TraceEvent{Type: TraceFinalizeCommit, Height: height, Round: cs.CommitRound, BlockID: blockID.String()})
+
 	cs.Logger.Info("Finalizing commit of block with N txs",
 		"height", block.Height,
 		"hash", block.Hash(),
@@ -1508,8 +1629,8 @@ func (cs *State) finalizeCommit(height int64) {
 		// NOTE: the seenCommit is local justification to commit this block,
 		// but may differ from the LastCommit included in the next block
 		precommits := cs.Votes.Precommits(cs.CommitRound)
-		seenCommit := precommits.MakeCommit()
-		cs.blockStore.SaveBlock(block, blockParts, seenCommit)
+		seenExtCommit := precommits.MakeExtendedCommit()
+		cs.blockStore.SaveBlockWithExtendedCommit(block, blockParts, seenExtCommit)
 	} else {
 		// Happens during replay if we already saved the block but didn't commit
 		cs.Logger.Info("Calling finalizeCommit on already stored block", "height", block.Height)
@@ -1716,6 +1837,7 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 
 	proposal.Signature = p.Signature
 	cs.Proposal = proposal
+	cs.ProposalReceiveTime = tmtime.Now()
 	// We don't update cs.ProposalBlockParts if it is already set.
 	// This happens if we're already in cstypes.RoundStepCommit or if there is a valid block in the current round.
 	// TODO: We can check if Proposal is for a different block as this is a sign of misbehavior!
@@ -1723,6 +1845,14 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 		cs.ProposalBlockParts = types.NewPartSetFromHeader(proposal.BlockID.PartSetHeader)
 	}
 	cs.Logger.Info("Received proposal", "proposal", proposal)
+	cs.trace(TraceEvent{
+		Type:             TraceProposal,
+		Height:           proposal.Height,
+		Round:            proposal.Round,
+		ValidatorAddress: cs.Validators.GetProposer().Address.String(),
+		BlockID:          proposal.BlockID.String(),
+		Latency:          tmtime.Now().Sub(proposal.Timestamp),
+	})
 	return nil
 }
 
@@ -1885,6 +2015,7 @@ func (cs *State) addVote(
 		}
 
 		cs.Logger.Info(fmt.Sprintf("Added to lastPrecommits: %v", cs.LastCommit.StringShort()))
+		cs.traceVote(vote, peerID)
 		cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote})
 		cs.evsw.FireEvent(types.EventVote, vote)
 
@@ -1911,6 +2042,7 @@ func (cs *State) addVote(
 		// Either duplicate, or error upon cs.Votes.AddByIndex()
 		return
 	}
+	cs.traceVote(vote, peerID)
 
 	cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote})
 	cs.evsw.FireEvent(types.EventVote, vote)
@@ -2017,6 +2149,18 @@ func (cs *State) addVote(
 	return added, err
 }
 
+// verifyVoteExtension asks the application to verify the extension of a
+// precommit for a block received from another validator. It is called by the
+// VoteSet the vote is added to, once the signatures of the vote and of its
+// extension are verified, so that the application only ever sees extensions
+// of votes actually cast by their validator.
+func (cs *State) verifyVoteExtension(vote *types.Vote) error {
+	if cs.privValidatorPubKey != nil && bytes.Equal(vote.ValidatorAddress, cs.privValidatorPubKey.Address()) {
+		return nil
+	}
+	return cs.blockExec.VerifyVoteExtension(vote)
+}
+
 // CONTRACT: cs.privValidator is not nil.
 func (cs *State) signVote(
 	msgType tmproto.SignedMsgType,
@@ -2042,9 +2186,17 @@ func (cs *State) signVote(
 		Type:             msgType,
 		BlockID:          types.BlockID{Hash: hash, PartSetHeader: header},
 	}
+	if types.IsVoteExtendable(vote.ToProto()) {
+		ext, err := cs.blockExec.ExtendVote(vote)
+		if err != nil {
+			return nil, err
+		}
+		vote.Extension = ext
+	}
 	v := vote.ToProto()
 	err := cs.privValidator.SignVote(cs.state.ChainID, v)
 	vote.Signature = v.Signature
+	vote.ExtensionSignature = v.ExtensionSignature
 
 	return vote, err
 }
@@ -2114,6 +2266,34 @@ func (cs *State) updatePrivValidatorPubKey() error {
 	return nil
 }
 
+// rotatePrivValidatorKey switches the private validator to its next key if the
+// validators of the current height contain it, i.e. if the key rotation of the
+// validator takes effect at this height.
+func (cs *State) rotatePrivValidatorKey() {
+	pv, ok := cs.privValidator.(types.RotatingPrivValidator)
+	if !ok || cs.Validators == nil {
+		return
+	}
+
+	nextPubKey, err := pv.GetNextPubKey()
+	if err != nil {
+		cs.Logger.Error("Can't get private validator next pubkey", "err", err)
+		return
+	}
+	if nextPubKey == nil || !cs.Validators.HasAddress(nextPubKey.Address()) {
+		return
+	}
+
+	if err := pv.RotateKey(cs.Height); err != nil {
+		cs.Logger.Error("Can't rotate private validator key", "height", cs.Height, "err", err)
+		return
+	}
+	cs.Logger.Info("Rotated private validator key", "height", cs.Height, "address", nextPubKey.Address())
+	if err := cs.updatePrivValidatorPubKey(); err != nil {
+		cs.Logger.Error("Can't get private validator pubkey", "err", err)
+	}
+}
+
 //---------------------------------------------------------
 
 func CompareHRS(h1 int64, r1 int32, s1 cstypes.RoundStepType, h2 int64, r2 int32, s2 cstypes.RoundStepType) int {
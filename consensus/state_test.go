@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -11,14 +12,20 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/mydexchain/tendermint0/abci/example/counter"
+	abci "github.com/mydexchain/tendermint0/abci/types"
+	cfg "github.com/mydexchain/tendermint0/config"
 	cstypes "github.com/mydexchain/tendermint0/consensus/types"
+	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/ed25519"
 	"github.com/mydexchain/tendermint0/crypto/tmhash"
 	"github.com/mydexchain/tendermint0/libs/log"
 	tmpubsub "github.com/mydexchain/tendermint0/libs/pubsub"
 	tmrand "github.com/mydexchain/tendermint0/libs/rand"
 	p2pmock "github.com/mydexchain/tendermint0/p2p/mock"
+	"github.com/mydexchain/tendermint0/privval"
 	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
 	"github.com/mydexchain/tendermint0/types"
+	tmtime "github.com/mydexchain/tendermint0/types/time"
 )
 
 /*
@@ -238,6 +245,117 @@ func TestStateBadProposal(t *testing.T) {
 	signAddVotes(cs1, tmproto.PrecommitType, propBlock.Hash(), propBlock.MakePartSet(partSize).Header(), vs2)
 }
 
+func TestStateUntimelyProposal(t *testing.T) {
+	cs1, vss := randState(2)
+	height, round := cs1.Height, cs1.Round
+	vs2 := vss[1]
+
+	// make the genesis time older than the bounds allow
+	cs1.state.ConsensusParams.Timestamp = tmproto.TimestampParams{
+		ProposerBased: true,
+		Precision:     time.Second,
+		MessageDelay:  time.Second,
+	}
+	cs1.state.LastBlockTime = tmtime.Now().Add(-time.Hour)
+	// leave the time to set the proposal at the next height
+	consensusConfig := *cs1.config
+	consensusConfig.TimeoutPropose = 10 * time.Second
+	cs1.config = &consensusConfig
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+	// the block at the initial height has the genesis time, so it is
+	// prevoted although not timely
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	rs := cs1.GetRoundState()
+	propBlockHash, propPartSetHeader := rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header()
+	validatePrevote(t, cs1, round, vss[0], propBlockHash)
+
+	signAddVotes(cs1, tmproto.PrevoteType, propBlockHash, propPartSetHeader, vs2)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	signAddVotes(cs1, tmproto.PrecommitType, propBlockHash, propPartSetHeader, vs2)
+	ensurePrecommit(voteCh, height, round)
+	ensureNewBlock(newBlockCh, height)
+
+	// at the next height, the second validator proposes a block with a time
+	// after the last block's, but not timely
+	height++
+	incrementHeight(vs2)
+	ensureNewRound(newRoundCh, height, round)
+	pubKey, err := vs2.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, pubKey.Address(), cs1.GetRoundState().Validators.GetProposer().Address)
+
+	cs1.mtx.Lock()
+	propBlock, _ := cs1.createProposalBlock()
+	propBlock.Time = cs1.state.LastBlockTime.Add(time.Millisecond)
+	cs1.mtx.Unlock()
+	propBlockParts := propBlock.MakePartSet(types.BlockPartSizeBytes)
+
+	blockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
+	proposal := types.NewProposal(vs2.Height, round, -1, blockID)
+	p := proposal.ToProto()
+	if err := vs2.SignProposal(config.ChainID(), p); err != nil {
+		t.Fatal("failed to sign proposal", err)
+	}
+	proposal.Signature = p.Signature
+
+	if err := cs1.SetProposalAndBlock(proposal, propBlock, propBlockParts, "some peer"); err != nil {
+		t.Fatal(err)
+	}
+	ensureProposal(proposalCh, height, round, blockID)
+
+	// the block is valid, but not timely, so we prevote nil
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vss[0], nil)
+}
+
+// 1 val, the application rejects the proposal, so we prevote nil
+func TestStateRejectedProposal(t *testing.T) {
+	state, privVals := randGenesisState(1, false, 10)
+	cs1 := newState(state, privVals[0], &rejectProposalApp{Application: counter.NewApplication(true)})
+	height, round := cs1.Height, cs1.Round
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+	startTestRound(cs1, height, round)
+	ensureNewProposal(proposalCh, height, round)
+
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, newValidatorStub(privVals[0], 0), nil)
+}
+
+func TestIsTimely(t *testing.T) {
+	params := tmproto.TimestampParams{
+		ProposerBased: true,
+		Precision:     time.Second,
+		MessageDelay:  2 * time.Second,
+	}
+	blockTime := tmtime.Now()
+
+	testCases := []struct {
+		receiveTime time.Time
+		timely      bool
+	}{
+		{blockTime, true},
+		{blockTime.Add(-time.Second), true},
+		{blockTime.Add(-time.Second - time.Millisecond), false},
+		{blockTime.Add(3 * time.Second), true},
+		{blockTime.Add(3*time.Second + time.Millisecond), false},
+	}
+	for i, tc := range testCases {
+		assert.Equal(t, tc.timely, isTimely(blockTime, tc.receiveTime, params), "#%d", i)
+	}
+}
+
 //----------------------------------------------------------------------------------------------------
 // FullRoundSuite
 
@@ -331,6 +449,82 @@ func TestStateFullRound2(t *testing.T) {
 	ensureNewBlock(newBlockCh, height)
 }
 
+// 1 val, its precommit is extended by the application and the extension is
+// handed back to the application when proposing the next block.
+func TestStateVoteExtensions(t *testing.T) {
+	state, privVals := randGenesisState(1, false, 10)
+	app := newExtensionApp()
+	cs1 := newState(state, privVals[0], app)
+	height, round := cs1.Height, cs1.Round
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+
+	startTestRound(cs1, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+
+	precommit := cs1.Votes.Precommits(round).GetByIndex(0)
+	require.NotNil(t, precommit)
+	assert.Equal(t, extensionFor(height), precommit.Extension)
+	pubKey, err := privVals[0].GetPubKey()
+	require.NoError(t, err)
+	require.NoError(t, precommit.VerifyExtension(config.ChainID(), pubKey))
+
+	ensureNewBlock(newBlockCh, height)
+
+	// the proposal of the next height carries the extensions to the application
+	ensureNewProposal(proposalCh, height+1, 0)
+	req := app.lastPrepareProposal()
+	require.NotNil(t, req)
+	assert.Equal(t, height+1, req.Height)
+	require.Len(t, req.LocalLastCommit.Votes, 1)
+	assert.True(t, req.LocalLastCommit.Votes[0].SignedLastBlock)
+	assert.Equal(t, extensionFor(height), req.LocalLastCommit.Votes[0].VoteExtension)
+}
+
+func TestStateRejectedVoteExtension(t *testing.T) {
+	state, privVals := randGenesisState(2, false, 10)
+	app := newExtensionApp()
+	cs1 := newState(state, privVals[0], app)
+	vs2 := newValidatorStub(privVals[1], 1)
+	incrementHeight(vs2)
+
+	blockID := types.BlockID{
+		Hash:          tmrand.Bytes(tmhash.Size),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmrand.Bytes(tmhash.Size)},
+	}
+
+	// the application rejects the extension
+	vote := signExtendedPrecommit(vs2, blockID.Hash, blockID.PartSetHeader, []byte("bad"))
+	added, err := cs1.tryAddVote(vote, "peer")
+	assert.False(t, added)
+	assert.Error(t, err)
+
+	// the extension doesn't match its signature
+	vote = signExtendedPrecommit(vs2, blockID.Hash, blockID.PartSetHeader, extensionFor(cs1.Height))
+	vote.Extension = []byte("tampered")
+	added, err = cs1.tryAddVote(vote, "peer")
+	assert.False(t, added)
+	assert.Error(t, err)
+
+	// the vote isn't signed by its validator: the application doesn't see it
+	verified := app.verifiedExtensions()
+	vote = signExtendedPrecommit(vs2, blockID.Hash, blockID.PartSetHeader, extensionFor(cs1.Height))
+	vote.Signature = tmrand.Bytes(len(vote.Signature))
+	added, err = cs1.tryAddVote(vote, "peer")
+	assert.False(t, added)
+	assert.Error(t, err)
+	assert.Equal(t, verified, app.verifiedExtensions())
+
+	vote = signExtendedPrecommit(vs2, blockID.Hash, blockID.PartSetHeader, extensionFor(cs1.Height))
+	added, err = cs1.tryAddVote(vote, "peer")
+	assert.True(t, added)
+	assert.NoError(t, err)
+}
+
 //------------------------------------------------------------------------------------------
 // LockSuite
 
@@ -1848,3 +2042,154 @@ func subscribeUnBuffered(eventBus *types.EventBus, q tmpubsub.Query) <-chan tmpu
 	}
 	return sub.Out()
 }
+
+func extensionFor(height int64) []byte {
+	return []byte(fmt.Sprintf("extension-%d", height))
+}
+
+func signExtendedPrecommit(
+	vs *validatorStub,
+	hash []byte,
+	header types.PartSetHeader,
+	extension []byte,
+) *types.Vote {
+	pubKey, err := vs.PrivValidator.GetPubKey()
+	if err != nil {
+		panic(err)
+	}
+	vote := &types.Vote{
+		ValidatorIndex:   vs.Index,
+		ValidatorAddress: pubKey.Address(),
+		Height:           vs.Height,
+		Round:            vs.Round,
+		Timestamp:        tmtime.Now(),
+		Type:             tmproto.PrecommitType,
+		BlockID:          types.BlockID{Hash: hash, PartSetHeader: header},
+		Extension:        extension,
+	}
+	v := vote.ToProto()
+	if err := vs.PrivValidator.SignVote(config.ChainID(), v); err != nil {
+		panic(err)
+	}
+	vote.Signature = v.Signature
+	vote.ExtensionSignature = v.ExtensionSignature
+	return vote
+}
+
+// extensionApp extends precommits with their height, accepts only such
+// extensions, and records the PrepareProposal requests.
+type extensionApp struct {
+	abci.Application
+
+	mtx      sync.Mutex
+	prepare  *abci.RequestPrepareProposal
+	verified int
+}
+
+func newExtensionApp() *extensionApp {
+	return &extensionApp{Application: counter.NewApplication(true)}
+}
+
+func (app *extensionApp) ExtendVote(req abci.RequestExtendVote) abci.ResponseExtendVote {
+	return abci.ResponseExtendVote{VoteExtension: extensionFor(req.Height)}
+}
+
+func (app *extensionApp) VerifyVoteExtension(req abci.RequestVerifyVoteExtension) abci.ResponseVerifyVoteExtension {
+	app.mtx.Lock()
+	app.verified++
+	app.mtx.Unlock()
+	if !bytes.Equal(req.VoteExtension, extensionFor(req.Height)) {
+		return abci.ResponseVerifyVoteExtension{Result: abci.ResponseVerifyVoteExtension_REJECT}
+	}
+	return abci.ResponseVerifyVoteExtension{Result: abci.ResponseVerifyVoteExtension_ACCEPT}
+}
+
+func (app *extensionApp) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.prepare = &req
+	return abci.ResponsePrepareProposal{}
+}
+
+// verifiedExtensions returns the number of VerifyVoteExtension requests.
+func (app *extensionApp) verifiedExtensions() int {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	return app.verified
+}
+
+func (app *extensionApp) lastPrepareProposal() *abci.RequestPrepareProposal {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	return app.prepare
+}
+
+// rejectProposalApp rejects all proposals.
+type rejectProposalApp struct {
+	abci.Application
+}
+
+func (app *rejectProposalApp) ProcessProposal(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	return abci.ResponseProcessProposal{Result: abci.ResponseProcessProposal_REJECT}
+}
+
+// TestStateRotatePrivValidatorKey checks that a validator switches to its next
+// key at the height its key rotation takes effect.
+func TestStateRotatePrivValidatorKey(t *testing.T) {
+	config := cfg.ResetTestRoot("consensus_state_test")
+	state, privVals := randGenesisState(1, false, 10)
+	pubKey, err := privVals[0].GetPubKey()
+	require.NoError(t, err)
+
+	pv := privval.NewFilePV(privVals[0].(types.MockPV).PrivKey,
+		config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	nextPrivKey := ed25519.GenPrivKey()
+	pv.SetNextKey(nextPrivKey)
+	pv.Save()
+
+	app := &rotateKeyApp{Application: counter.NewApplication(true), pubKey: pubKey, nextPubKey: nextPrivKey.PubKey()}
+	cs1 := newStateWithConfig(config, state, pv, app)
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+
+	startTestRound(cs1, cs1.Height, cs1.Round)
+
+	// the key rotated at height 2 takes effect at height 4: the chain only
+	// makes progress if the validator signs with its next key from then on
+	for height := int64(1); height <= 5; height++ {
+		ensureNewBlock(newBlockCh, height)
+	}
+	for height := int64(1); height <= 4; height++ {
+		block := cs1.blockStore.LoadBlock(height)
+		if height < 4 {
+			assert.Equal(t, pubKey.Address(), block.ProposerAddress, height)
+		} else {
+			assert.Equal(t, nextPrivKey.PubKey().Address(), block.ProposerAddress, height)
+		}
+	}
+	pvPubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, nextPrivKey.PubKey(), pvPubKey)
+}
+
+// rotateKeyApp sets the operator address of its validator at height 1 and
+// rotates its key at height 2.
+type rotateKeyApp struct {
+	abci.Application
+	pubKey, nextPubKey crypto.PubKey
+}
+
+func (app *rotateKeyApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	res := app.Application.EndBlock(req)
+	var update abci.ValidatorUpdate
+	switch req.Height {
+	case 1:
+		update = types.TM2PB.NewValidatorUpdate(app.pubKey, 10)
+	case 2:
+		update = types.TM2PB.NewValidatorUpdate(app.nextPubKey, 10)
+	default:
+		return res
+	}
+	update.OperatorAddress = []byte("operator")
+	res.ValidatorUpdates = append(res.ValidatorUpdates, update)
+	return res
+}
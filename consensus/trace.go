@@ -0,0 +1,174 @@
+package consensus
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	auto "github.com/mydexchain/tendermint0/libs/autofile"
+	"github.com/mydexchain/tendermint0/libs/log"
+	tmos "github.com/mydexchain/tendermint0/libs/os"
+	"github.com/mydexchain/tendermint0/libs/service"
+	"github.com/mydexchain/tendermint0/p2p"
+)
+
+//--------------------------------------------------------
+// Consensus trace
+
+// TraceEventType is the kind of a TraceEvent.
+type TraceEventType string
+
+const (
+	TraceEnterNewRound      TraceEventType = "enter_new_round"
+	TraceEnterPropose       TraceEventType = "enter_propose"
+	TraceEnterPrevote       TraceEventType = "enter_prevote"
+	TraceEnterPrevoteWait   TraceEventType = "enter_prevote_wait"
+	TraceEnterPrecommit     TraceEventType = "enter_precommit"
+	TraceEnterPrecommitWait TraceEventType = "enter_precommit_wait"
+	TraceEnterCommit        TraceEventType = "enter_commit"
+	TraceFinalizeCommit     TraceEventType = "finalize_commit"
+	TraceTimeout            TraceEventType = "timeout"
+	TraceProposal           TraceEventType = "proposal"
+	TraceVote               TraceEventType = "vote"
+)
+
+// TraceEvent is a single State transition or input recorded in the consensus
+// trace. Unlike the WAL, which records messages for crash recovery, the trace
+// records what the State did with them and when, for debugging stalls.
+type TraceEvent struct {
+	Time   time.Time      `json:"time"`
+	Type   TraceEventType `json:"type"`
+	Height int64          `json:"height"`
+	Round  int32          `json:"round"`
+
+	// Timeouts: the step the timeout was scheduled for and its duration.
+	Step     string        `json:"step,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+
+	// Votes and proposals. PeerID is empty for our own messages. Latency is
+	// the difference between the time we received the vote and its timestamp.
+	VoteType         string        `json:"vote_type,omitempty"`
+	ValidatorAddress string        `json:"validator_address,omitempty"`
+	ValidatorIndex   int32         `json:"validator_index,omitempty"`
+	BlockID          string        `json:"block_id,omitempty"`
+	PeerID           p2p.ID        `json:"peer_id,omitempty"`
+	Latency          time.Duration `json:"latency,omitempty"`
+}
+
+// Tracer records TraceEvents.
+type Tracer interface {
+	Trace(TraceEvent)
+
+	// service methods
+	Start() error
+	Stop() error
+}
+
+// FileTracer writes TraceEvents as JSON lines to an autofile group, which is
+// rotated once its head grows too big.
+type FileTracer struct {
+	service.BaseService
+
+	group *auto.Group
+}
+
+var _ Tracer = &FileTracer{}
+
+// NewFileTracer returns a new tracer writing to traceFile.
+func NewFileTracer(traceFile string, groupOptions ...func(*auto.Group)) (*FileTracer, error) {
+	err := tmos.EnsureDir(filepath.Dir(traceFile), 0700)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ensure trace directory is in place: %w", err)
+	}
+
+	group, err := auto.OpenGroup(traceFile, groupOptions...)
+	if err != nil {
+		return nil, err
+	}
+	tracer := &FileTracer{group: group}
+	tracer.BaseService = *service.NewBaseService(nil, "FileTracer", tracer)
+	return tracer, nil
+}
+
+func (t *FileTracer) SetLogger(l log.Logger) {
+	t.BaseService.Logger = l
+	t.group.SetLogger(l)
+}
+
+func (t *FileTracer) OnStart() error {
+	return t.group.Start()
+}
+
+// OnStop flushes the trace and stops the underlying autofile group.
+func (t *FileTracer) OnStop() {
+	if err := t.group.FlushAndSync(); err != nil {
+		t.Logger.Error("Failed to flush consensus trace", "err", err)
+	}
+	t.group.Stop()
+	t.group.Close()
+}
+
+// Trace writes ev to the trace. The trace is flushed once a block is
+// committed.
+func (t *FileTracer) Trace(ev TraceEvent) {
+	bz, err := json.Marshal(ev)
+	if err != nil {
+		t.Logger.Error("Failed to encode consensus trace event", "err", err)
+		return
+	}
+	if err := t.group.WriteLine(string(bz)); err != nil {
+		t.Logger.Error("Failed to write consensus trace event", "err", err)
+		return
+	}
+	if ev.Type == TraceFinalizeCommit {
+		if err := t.group.FlushAndSync(); err != nil {
+			t.Logger.Error("Failed to flush consensus trace", "err", err)
+		}
+	}
+}
+
+// ReadTraceFile reads all the events recorded in the trace traceFile,
+// including the rotated files, oldest first.
+func ReadTraceFile(traceFile string) ([]TraceEvent, error) {
+	group, err := auto.OpenGroup(traceFile)
+	if err != nil {
+		return nil, err
+	}
+	defer group.Close()
+
+	gr, err := group.NewReader(group.MinIndex())
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+
+	return ReadTrace(gr)
+}
+
+// ReadTrace reads the events of a trace from r.
+func ReadTrace(r io.Reader) ([]TraceEvent, error) {
+	var events []TraceEvent
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var ev TraceEvent
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			return nil, fmt.Errorf("failed to decode trace event on line %d: %w", line, err)
+		}
+		events = append(events, ev)
+	}
+	return events, scanner.Err()
+}
+
+type nopTracer struct{}
+
+var _ Tracer = nopTracer{}
+
+func (nopTracer) Trace(TraceEvent) {}
+func (nopTracer) Start() error     { return nil }
+func (nopTracer) Stop() error      { return nil }
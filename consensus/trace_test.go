@@ -0,0 +1,109 @@
+package consensus
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mydexchain/tendermint0/libs/log"
+	tmsync "github.com/mydexchain/tendermint0/libs/sync"
+	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+func TestFileTracer(t *testing.T) {
+	traceFile := filepath.Join(t.TempDir(), "trace")
+	tracer, err := NewFileTracer(traceFile)
+	require.NoError(t, err)
+	tracer.SetLogger(log.TestingLogger())
+	require.NoError(t, tracer.Start())
+
+	now := time.Now().UTC().Round(0)
+	events := []TraceEvent{
+		{Time: now, Type: TraceEnterNewRound, Height: 1, Round: 0},
+		{Time: now, Type: TraceTimeout, Height: 1, Round: 0, Step: "RoundStepPropose", Duration: 3 * time.Second},
+		{
+			Time: now, Type: TraceVote, Height: 1, Round: 0, VoteType: tmproto.PrevoteType.String(),
+			ValidatorAddress: "AB", ValidatorIndex: 2, PeerID: "peer", Latency: 15 * time.Millisecond,
+		},
+		{Time: now, Type: TraceFinalizeCommit, Height: 1, Round: 0},
+	}
+	for _, ev := range events {
+		tracer.Trace(ev)
+	}
+	require.NoError(t, tracer.Stop())
+
+	read, err := ReadTraceFile(traceFile)
+	require.NoError(t, err)
+	assert.Equal(t, events, read)
+}
+
+type memTracer struct {
+	mtx    tmsync.Mutex
+	events []TraceEvent
+}
+
+func (t *memTracer) Trace(ev TraceEvent) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.events = append(t.events, ev)
+}
+
+func (t *memTracer) Start() error { return nil }
+func (t *memTracer) Stop() error  { return nil }
+
+func (t *memTracer) types(height int64) []TraceEventType {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	var typs []TraceEventType
+	for _, ev := range t.events {
+		if ev.Height == height {
+			typs = append(typs, ev.Type)
+		}
+	}
+	return typs
+}
+
+func TestStateTrace(t *testing.T) {
+	cs1, vss := randState(4)
+	tracer := &memTracer{}
+	cs1.tracer = tracer
+	height, round := cs1.Height, cs1.Round
+
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewProposal(proposalCh, height, round)
+
+	rs := cs1.GetRoundState()
+	signAddVotes(cs1, tmproto.PrecommitType, rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header(), vss[1:]...)
+	ensureNewRound(newRoundCh, height+1, 0)
+
+	typs := tracer.types(height)
+	for _, typ := range []TraceEventType{
+		TraceEnterNewRound,
+		TraceEnterPropose,
+		TraceEnterPrevote,
+		TraceVote,
+		TraceEnterCommit,
+		TraceFinalizeCommit,
+	} {
+		assert.Contains(t, typs, typ)
+	}
+
+	var precommits int
+	tracer.mtx.Lock()
+	for _, ev := range tracer.events {
+		if ev.Type == TraceVote && ev.Height == height && ev.VoteType == tmproto.PrecommitType.String() {
+			precommits++
+			assert.NotEmpty(t, ev.ValidatorAddress)
+		}
+	}
+	tracer.mtx.Unlock()
+	assert.GreaterOrEqual(t, precommits, 3)
+}
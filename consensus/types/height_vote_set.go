@@ -47,6 +47,7 @@ type HeightVoteSet struct {
 	round             int32                  // max tracked round
 	roundVoteSets     map[int32]RoundVoteSet // keys: [0...round]
 	peerCatchupRounds map[p2p.ID][]int32     // keys: peer.ID; values: at most 2 rounds
+	verifyExtension   func(*types.Vote) error
 }
 
 func NewHeightVoteSet(chainID string, height int64, valSet *types.ValidatorSet) *HeightVoteSet {
@@ -70,6 +71,17 @@ func (hvs *HeightVoteSet) Reset(height int64, valSet *types.ValidatorSet) {
 	hvs.round = 0
 }
 
+// SetExtensionVerifier sets the function verifying the vote extensions of the
+// precommits of every round. See VoteSet.SetExtensionVerifier.
+func (hvs *HeightVoteSet) SetExtensionVerifier(verify func(*types.Vote) error) {
+	hvs.mtx.Lock()
+	defer hvs.mtx.Unlock()
+	hvs.verifyExtension = verify
+	for _, rvs := range hvs.roundVoteSets {
+		rvs.Precommits.SetExtensionVerifier(verify)
+	}
+}
+
 func (hvs *HeightVoteSet) Height() int64 {
 	hvs.mtx.Lock()
 	defer hvs.mtx.Unlock()
@@ -106,6 +118,9 @@ func (hvs *HeightVoteSet) addRound(round int32) {
 	// log.Debug("addRound(round)", "round", round)
 	prevotes := types.NewVoteSet(hvs.chainID, hvs.height, round, tmproto.PrevoteType, hvs.valSet)
 	precommits := types.NewVoteSet(hvs.chainID, hvs.height, round, tmproto.PrecommitType, hvs.valSet)
+	if hvs.verifyExtension != nil {
+		precommits.SetExtensionVerifier(hvs.verifyExtension)
+	}
 	hvs.roundVoteSets[round] = RoundVoteSet{
 		Prevotes:   prevotes,
 		Precommits: precommits,
@@ -0,0 +1,34 @@
+package batch
+
+import (
+	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/ed25519"
+	"github.com/mydexchain/tendermint0/crypto/sr25519"
+)
+
+// CreateBatchVerifier checks if a key type implements the batch verifier
+// interface. It returns the batch verifier and true if it does, nil and false
+// otherwise. Ed25519 signatures are not batched, but verified in parallel (see
+// ed25519.ParallelVerifier).
+func CreateBatchVerifier(pk crypto.PubKey) (crypto.BatchVerifier, bool) {
+	switch pk.(type) {
+	case ed25519.PubKey:
+		return ed25519.NewParallelVerifier(), true
+	case sr25519.PubKey:
+		return sr25519.NewBatchVerifier(), true
+	}
+
+	// case where the key does not support batch verification
+	return nil, false
+}
+
+// SupportsBatchVerifier checks if a key type implements the batch verifier
+// interface.
+func SupportsBatchVerifier(pk crypto.PubKey) bool {
+	switch pk.(type) {
+	case ed25519.PubKey, sr25519.PubKey:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,55 @@
+// +build cgo
+
+package bls12381
+
+import (
+	"io"
+	"testing"
+
+	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/internal/benchmarking"
+)
+
+func BenchmarkKeyGeneration(b *testing.B) {
+	benchmarkKeygenWrapper := func(reader io.Reader) crypto.PrivKey {
+		return genPrivKey(reader)
+	}
+	benchmarking.BenchmarkKeyGeneration(b, benchmarkKeygenWrapper)
+}
+
+func BenchmarkSigning(b *testing.B) {
+	priv := GenPrivKey()
+	benchmarking.BenchmarkSigning(b, priv)
+}
+
+func BenchmarkVerification(b *testing.B) {
+	priv := GenPrivKey()
+	benchmarking.BenchmarkVerification(b, priv)
+}
+
+func BenchmarkAggregateVerification(b *testing.B) {
+	const n = 8
+	var (
+		pubKeys = make([]PubKey, n)
+		msgs    = make([][]byte, n)
+		sigs    = make([][]byte, n)
+	)
+	for i := range pubKeys {
+		priv := GenPrivKey()
+		pubKeys[i] = priv.PubKey().(PubKey)
+		msgs[i] = []byte{byte(i)}
+		sig, err := priv.Sign(msgs[i])
+		if err != nil {
+			b.Fatal(err)
+		}
+		sigs[i] = sig
+	}
+	agg, err := AggregateSignatures(sigs)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VerifyAggregateSignature(pubKeys, msgs, agg)
+	}
+}
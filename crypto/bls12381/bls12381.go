@@ -0,0 +1,141 @@
+// Package bls12381 implements BLS signatures on the BLS12-381 curve, with the
+// blst library. The signatures can be aggregated: the aggregate of n
+// signatures is as large as a single one and is verified with n+1 pairings.
+//
+// Signatures are in G1 (48 bytes) and public keys in G2 (96 bytes), both in
+// the usual compressed encoding. Messages are signed together with the public
+// key, following the message augmentation scheme of the IETF BLS signature
+// draft (ciphersuite BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_AUG_), which
+// protects the aggregates against rogue key attacks without proofs of
+// possession.
+//
+// NOTE: this package is experimental, and requires cgo. Nodes only accept BLS
+// validator keys when consensus.experimental_bls is set.
+package bls12381
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/tmhash"
+	tmjson "github.com/mydexchain/tendermint0/libs/json"
+)
+
+var (
+	_ crypto.PrivKey = PrivKey{}
+	_ crypto.PubKey  = PubKey{}
+)
+
+const (
+	PrivKeyName = "tendermint/PrivKeyBLS12381"
+	PubKeyName  = "tendermint/PubKeyBLS12381"
+
+	// PrivKeySize is the size of a private key: a big-endian scalar.
+	PrivKeySize = 32
+	// PubKeySize is the size of a compressed point of G2.
+	PubKeySize = 96
+	// SignatureSize is the size of a compressed point of G1.
+	SignatureSize = 48
+
+	keyType = "bls12381"
+)
+
+// ErrDisabled is returned when using BLS12-381 keys in a build without cgo.
+var ErrDisabled = errors.New("BLS12-381 keys require a build with cgo")
+
+func init() {
+	tmjson.RegisterType(PubKey{}, PubKeyName)
+	tmjson.RegisterType(PrivKey{}, PrivKeyName)
+}
+
+//-------------------------------------
+// PrivKey
+
+// PrivKey implements crypto.PrivKey.
+type PrivKey []byte
+
+// Bytes returns the byte representation of the PrivKey.
+func (privKey PrivKey) Bytes() []byte {
+	return []byte(privKey)
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKey) Equals(other crypto.PrivKey) bool {
+	if otherBLS, ok := other.(PrivKey); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherBLS[:]) == 1
+	}
+	return false
+}
+
+func (privKey PrivKey) Type() string {
+	return keyType
+}
+
+// GenPrivKey generates a new BLS12-381 private key.
+// It uses OS randomness in conjunction with the current global random seed
+// in tendermint/libs/common to generate the private key.
+func GenPrivKey() PrivKey {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new BLS12-381 private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKey {
+	seed := make([]byte, 64)
+	if _, err := io.ReadFull(rand, seed); err != nil {
+		panic(err)
+	}
+	return privKeyFromSeed(seed)
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA-512, and uses that 64 byte
+// output to create the private key.
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) PrivKey {
+	seed := sha512.Sum512(secret)
+	return privKeyFromSeed(seed[:])
+}
+
+//-------------------------------------
+// PubKey
+
+// PubKey implements crypto.PubKey for BLS signatures on BLS12-381.
+type PubKey []byte
+
+// Address is the SHA256-20 of the raw pubkey bytes.
+func (pubKey PubKey) Address() crypto.Address {
+	return crypto.Address(tmhash.SumTruncated(pubKey))
+}
+
+// Bytes returns the byte representation of the PubKey.
+func (pubKey PubKey) Bytes() []byte {
+	return []byte(pubKey)
+}
+
+// VerifySignature checks that sig is a signature of the public key and msg.
+func (pubKey PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	return VerifyAggregateSignature([]PubKey{pubKey}, [][]byte{msg}, sig)
+}
+
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeyBLS12381{%X}", []byte(pubKey))
+}
+
+// Equals - checks that two public keys are the same time
+// Runs in constant time based on length of the keys.
+func (pubKey PubKey) Equals(other crypto.PubKey) bool {
+	if otherBLS, ok := other.(PubKey); ok {
+		return bytes.Equal(pubKey[:], otherBLS[:])
+	}
+	return false
+}
+
+func (pubKey PubKey) Type() string {
+	return keyType
+}
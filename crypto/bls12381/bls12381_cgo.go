@@ -0,0 +1,137 @@
+// +build cgo
+
+package bls12381
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	blst "github.com/supranational/blst/bindings/go"
+
+	"github.com/mydexchain/tendermint0/crypto"
+)
+
+// Enabled is whether BLS12-381 keys can be used, which requires cgo.
+const Enabled = true
+
+// dst is the domain separation tag of the IETF ciphersuite with signatures in
+// G1 and message augmentation.
+var dst = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_AUG_")
+
+// Sign produces a signature of the public key and msg.
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	sk, err := privKey.secretKey()
+	if err != nil {
+		return nil, err
+	}
+	pubKey := new(blst.P2Affine).From(sk).Compress()
+	return new(blst.P1Affine).Sign(sk, msg, dst, pubKey).Compress(), nil
+}
+
+// PubKey gets the corresponding public key from the private key.
+func (privKey PrivKey) PubKey() crypto.PubKey {
+	sk, err := privKey.secretKey()
+	if err != nil {
+		panic(fmt.Sprintf("Invalid private key: %v", err))
+	}
+	return PubKey(new(blst.P2Affine).From(sk).Compress())
+}
+
+func (privKey PrivKey) secretKey() (*blst.SecretKey, error) {
+	if len(privKey) != PrivKeySize {
+		return nil, errors.New("private key size is incorrect")
+	}
+	sk := new(blst.SecretKey).Deserialize(privKey)
+	if sk == nil || !sk.Valid() {
+		return nil, errors.New("private key is out of range")
+	}
+	return sk, nil
+}
+
+// privKeyFromSeed derives a private key from the seed, of at least 32 bytes,
+// with the KeyGen procedure of the IETF BLS signature draft.
+func privKeyFromSeed(seed []byte) PrivKey {
+	return PrivKey(blst.KeyGen(seed).Serialize())
+}
+
+//-------------------------------------
+// Aggregation
+
+// AggregateSignatures returns the aggregate of the given signatures, which
+// can be signatures or aggregates themselves. It returns an error if any of
+// them is invalid.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+	agg := new(blst.P1Aggregate)
+	for i, sig := range sigs {
+		s := new(blst.P1Affine).Uncompress(sig)
+		if s == nil || !agg.Add(s, true) {
+			return nil, fmt.Errorf("invalid signature #%d", i)
+		}
+	}
+	return agg.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature checks that sig is the aggregate of the signatures
+// of msgs[i] by pubKeys[i]. The same key may sign several messages and
+// several keys the same message.
+func VerifyAggregateSignature(pubKeys []PubKey, msgs [][]byte, sig []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) {
+		return false
+	}
+	s := new(blst.P1Affine).Uncompress(sig)
+	if s == nil {
+		return false
+	}
+
+	pks := make([]*blst.P2Affine, len(pubKeys))
+	augs := make([][]byte, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		pk, err := decodePubKey(pubKey)
+		if err != nil {
+			return false
+		}
+		pks[i], augs[i] = pk, pubKey
+	}
+	return s.AggregateVerify(true, pks, false, msgs, dst, true, augs)
+}
+
+// pubKeyCacheSize is the number of decoded public keys kept by decodePubKey.
+const pubKeyCacheSize = 1024
+
+// pubKeyCache holds decoded public keys, since checking that a point is in G2
+// costs about as much as a pairing. It is emptied once full.
+var pubKeyCache = struct {
+	sync.Mutex
+	points map[string]*blst.P2Affine
+}{points: make(map[string]*blst.P2Affine)}
+
+// decodePubKey decodes pubKey, which must be in G2 and not the point at
+// infinity.
+func decodePubKey(pubKey PubKey) (*blst.P2Affine, error) {
+	pubKeyCache.Lock()
+	pk, ok := pubKeyCache.points[string(pubKey)]
+	pubKeyCache.Unlock()
+	if ok {
+		return pk, nil
+	}
+
+	pk = new(blst.P2Affine).Uncompress(pubKey)
+	if pk == nil {
+		return nil, errors.New("invalid public key encoding")
+	}
+	if !pk.KeyValidate() {
+		return nil, errors.New("public key is not in G2 or is the point at infinity")
+	}
+
+	pubKeyCache.Lock()
+	if len(pubKeyCache.points) >= pubKeyCacheSize {
+		pubKeyCache.points = make(map[string]*blst.P2Affine)
+	}
+	pubKeyCache.points[string(pubKey)] = pk
+	pubKeyCache.Unlock()
+	return pk, nil
+}
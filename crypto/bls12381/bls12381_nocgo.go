@@ -0,0 +1,34 @@
+// +build !cgo
+
+package bls12381
+
+import (
+	"github.com/mydexchain/tendermint0/crypto"
+)
+
+// Enabled is whether BLS12-381 keys can be used, which requires cgo.
+const Enabled = false
+
+// Sign returns ErrDisabled.
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	return nil, ErrDisabled
+}
+
+// PubKey panics with ErrDisabled.
+func (privKey PrivKey) PubKey() crypto.PubKey {
+	panic(ErrDisabled)
+}
+
+func privKeyFromSeed(seed []byte) PrivKey {
+	panic(ErrDisabled)
+}
+
+// AggregateSignatures returns ErrDisabled.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	return nil, ErrDisabled
+}
+
+// VerifyAggregateSignature rejects every signature.
+func VerifyAggregateSignature(pubKeys []PubKey, msgs [][]byte, sig []byte) bool {
+	return false
+}
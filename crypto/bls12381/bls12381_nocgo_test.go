@@ -0,0 +1,25 @@
+// +build !cgo
+
+package bls12381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
+)
+
+// Note: run with CGO_ENABLED=0.
+func TestDisabled(t *testing.T) {
+	assert.False(t, bls12381.Enabled)
+
+	_, err := bls12381.PrivKey(make([]byte, bls12381.PrivKeySize)).Sign([]byte("msg"))
+	assert.Equal(t, bls12381.ErrDisabled, err)
+	assert.Panics(t, func() { bls12381.GenPrivKey() })
+
+	pubKey := bls12381.PubKey(make([]byte, bls12381.PubKeySize))
+	assert.False(t, pubKey.VerifySignature([]byte("msg"), make([]byte, bls12381.SignatureSize)))
+	_, err = bls12381.AggregateSignatures([][]byte{make([]byte, bls12381.SignatureSize)})
+	assert.Equal(t, bls12381.ErrDisabled, err)
+}
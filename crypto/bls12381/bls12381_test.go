@@ -0,0 +1,106 @@
+// +build cgo
+
+package bls12381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
+	tmjson "github.com/mydexchain/tendermint0/libs/json"
+)
+
+func TestSignAndValidateBLS12381(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), bls12381.PubKeySize)
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.Nil(t, err)
+	require.Len(t, sig, bls12381.SignatureSize)
+
+	// Test the signature
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+
+	// Another key or message
+	assert.False(t, bls12381.GenPrivKey().PubKey().VerifySignature(msg, sig))
+	assert.False(t, pubKey.VerifySignature(msg[1:], sig))
+
+	// Mutate the signature, just one bit.
+	sig[7] ^= byte(0x01)
+	assert.False(t, pubKey.VerifySignature(msg, sig))
+	assert.False(t, pubKey.VerifySignature(msg, sig[:10]))
+
+	// The point at infinity is not a valid public key, nor a valid signature of it.
+	infinity := make([]byte, bls12381.PubKeySize)
+	infinity[0] = 0xc0
+	infinitySig := make([]byte, bls12381.SignatureSize)
+	infinitySig[0] = 0xc0
+	assert.False(t, bls12381.PubKey(infinity).VerifySignature(msg, infinitySig))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	a := bls12381.GenPrivKeyFromSecret([]byte("secret"))
+	assert.True(t, a.Equals(bls12381.GenPrivKeyFromSecret([]byte("secret"))))
+	assert.False(t, a.Equals(bls12381.GenPrivKeyFromSecret([]byte("other secret"))))
+	assert.True(t, a.PubKey().Equals(bls12381.GenPrivKeyFromSecret([]byte("secret")).PubKey()))
+
+	_, err := bls12381.PrivKey(make([]byte, bls12381.PrivKeySize)).Sign([]byte("msg"))
+	assert.Error(t, err, "zero private key")
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	var (
+		pubKeys = make([]bls12381.PubKey, 4)
+		msgs    = make([][]byte, 4)
+		sigs    = make([][]byte, 4)
+	)
+	for i := range pubKeys {
+		privKey := bls12381.GenPrivKey()
+		pubKeys[i] = privKey.PubKey().(bls12381.PubKey)
+		// two keys sign the same message
+		msgs[i] = []byte{byte(i / 2)}
+		sig, err := privKey.Sign(msgs[i])
+		require.NoError(t, err)
+		sigs[i] = sig
+	}
+
+	agg, err := bls12381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	assert.True(t, bls12381.VerifyAggregateSignature(pubKeys, msgs, agg))
+
+	// aggregates can be aggregated further
+	agg01, err := bls12381.AggregateSignatures(sigs[:2])
+	require.NoError(t, err)
+	agg23, err := bls12381.AggregateSignatures(sigs[2:])
+	require.NoError(t, err)
+	agg2, err := bls12381.AggregateSignatures([][]byte{agg01, agg23})
+	require.NoError(t, err)
+	assert.Equal(t, agg, agg2)
+
+	// a missing or mismatched signer
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys[1:], msgs[1:], agg))
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys, [][]byte{msgs[2], msgs[1], msgs[0], msgs[3]}, agg))
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys, msgs[1:], agg))
+	assert.False(t, bls12381.VerifyAggregateSignature(nil, nil, agg))
+
+	_, err = bls12381.AggregateSignatures(nil)
+	assert.Error(t, err)
+	_, err = bls12381.AggregateSignatures([][]byte{sigs[0], sigs[1][1:]})
+	assert.Error(t, err)
+}
+
+func TestJSONEncoding(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	bz, err := tmjson.Marshal(privKey.PubKey())
+	require.NoError(t, err)
+	assert.Contains(t, string(bz), bls12381.PubKeyName)
+
+	var pubKey crypto.PubKey
+	require.NoError(t, tmjson.Unmarshal(bz, &pubKey))
+	assert.True(t, privKey.PubKey().Equals(pubKey))
+}
@@ -35,6 +35,18 @@ type PrivKey interface {
 	Type() string
 }
 
+// BatchVerifier verifies a batch of signatures at once. It is implemented by
+// the key types, which support batch verification (see crypto/batch).
+type BatchVerifier interface {
+	// Add appends an entry to the batch. It returns an error if the key is
+	// of a different type or the signature is malformed.
+	Add(key PubKey, message, signature []byte) error
+	// Verify verifies all the entries in the batch. It returns true if every
+	// signature is valid, along with the validity of each signature in the
+	// order they were added.
+	Verify() (bool, []bool)
+}
+
 type Symmetric interface {
 	Keygen() []byte
 	Encrypt(plaintext []byte, secret []byte) (ciphertext []byte)
@@ -3,8 +3,11 @@ package ed25519
 import (
 	"bytes"
 	"crypto/subtle"
+	"errors"
 	"fmt"
 	"io"
+	"runtime"
+	"sync"
 
 	"golang.org/x/crypto/ed25519"
 
@@ -169,3 +172,94 @@ func (pubKey PubKey) Equals(other crypto.PubKey) bool {
 
 	return false
 }
+
+//-------------------------------------
+
+var _ crypto.BatchVerifier = &ParallelVerifier{}
+
+// minParallelBatchSize is the minimum number of entries for which a batch is
+// verified by several goroutines.
+const minParallelBatchSize = 16
+
+type batchEntry struct {
+	pubKey    PubKey
+	message   []byte
+	signature []byte
+}
+
+// ParallelVerifier implements crypto.BatchVerifier for Ed25519 signatures,
+// without batch verification: each signature is verified on its own, and the
+// entries are spread across GOMAXPROCS goroutines. It is hence only faster than
+// verifying the signatures in turn on several cores.
+//
+// The signatures are verified with the same (cofactorless) equation as
+// PubKey.VerifySignature, which can't be checked for several signatures at
+// once without accepting some signatures rejected individually. Real batch
+// verification requires both to use the cofactored equation (ZIP-215), which
+// changes the set of valid signatures, and hence the consensus rules.
+type ParallelVerifier struct {
+	entries []batchEntry
+}
+
+// NewParallelVerifier returns an empty Ed25519 parallel verifier.
+func NewParallelVerifier() *ParallelVerifier {
+	return &ParallelVerifier{}
+}
+
+// Add implements crypto.BatchVerifier.
+func (b *ParallelVerifier) Add(key crypto.PubKey, msg, signature []byte) error {
+	pubKey, ok := key.(PubKey)
+	if !ok {
+		return fmt.Errorf("pubkey is not Ed25519: %T", key)
+	}
+	if len(pubKey) != PubKeySize {
+		return errors.New("pubkey size is incorrect")
+	}
+	if len(signature) != SignatureSize {
+		return errors.New("invalid signature")
+	}
+
+	b.entries = append(b.entries, batchEntry{pubKey: pubKey, message: msg, signature: signature})
+	return nil
+}
+
+// Verify implements crypto.BatchVerifier.
+func (b *ParallelVerifier) Verify() (bool, []bool) {
+	valid := make([]bool, len(b.entries))
+
+	workers := runtime.GOMAXPROCS(0)
+	if len(b.entries) < minParallelBatchSize || workers == 1 {
+		b.verifyRange(valid, 0, len(valid))
+	} else {
+		var (
+			wg        sync.WaitGroup
+			chunkSize = (len(valid) + workers - 1) / workers
+		)
+		for start := 0; start < len(valid); start += chunkSize {
+			end := start + chunkSize
+			if end > len(valid) {
+				end = len(valid)
+			}
+			wg.Add(1)
+			go func(start, end int) {
+				defer wg.Done()
+				b.verifyRange(valid, start, end)
+			}(start, end)
+		}
+		wg.Wait()
+	}
+
+	for _, ok := range valid {
+		if !ok {
+			return false, valid
+		}
+	}
+	return true, valid
+}
+
+func (b *ParallelVerifier) verifyRange(valid []bool, start, end int) {
+	for i := start; i < end; i++ {
+		e := b.entries[i]
+		valid[i] = ed25519.Verify(ed25519.PublicKey(e.pubKey), e.message, e.signature)
+	}
+}
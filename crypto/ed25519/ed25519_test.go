@@ -8,6 +8,7 @@ import (
 
 	"github.com/mydexchain/tendermint0/crypto"
 	"github.com/mydexchain/tendermint0/crypto/ed25519"
+	"github.com/mydexchain/tendermint0/crypto/sr25519"
 )
 
 func TestSignAndValidateEd25519(t *testing.T) {
@@ -28,3 +29,44 @@ func TestSignAndValidateEd25519(t *testing.T) {
 
 	assert.False(t, pubKey.VerifySignature(msg, sig))
 }
+
+func TestBatchVerifyEd25519(t *testing.T) {
+	var (
+		bv   = ed25519.NewParallelVerifier()
+		msgs = make([][]byte, 20)
+		sigs = make([][]byte, 20)
+		keys = make([]crypto.PubKey, 20)
+		want = make([]bool, 20)
+	)
+	for i := range msgs {
+		privKey := ed25519.GenPrivKey()
+		msgs[i] = crypto.CRandBytes(128)
+		sig, err := privKey.Sign(msgs[i])
+		require.NoError(t, err)
+		sigs[i], keys[i] = sig, privKey.PubKey()
+		require.NoError(t, bv.Add(keys[i], msgs[i], sigs[i]))
+		want[i] = true
+	}
+
+	ok, valid := bv.Verify()
+	assert.True(t, ok)
+	assert.Equal(t, want, valid)
+
+	// one of the signatures is for another message
+	bv = ed25519.NewParallelVerifier()
+	for i := range msgs {
+		msg := msgs[i]
+		if i == 3 {
+			msg = msgs[4]
+		}
+		require.NoError(t, bv.Add(keys[i], msg, sigs[i]))
+	}
+	want[3] = false
+	ok, valid = bv.Verify()
+	assert.False(t, ok)
+	assert.Equal(t, want, valid)
+
+	// malformed entries
+	assert.Error(t, bv.Add(sr25519.GenPrivKey().PubKey(), msgs[0], sigs[0]))
+	assert.Error(t, bv.Add(keys[0], msgs[0], sigs[0][:10]))
+}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 
 	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
 	"github.com/mydexchain/tendermint0/crypto/ed25519"
+	"github.com/mydexchain/tendermint0/crypto/secp256k1"
+	"github.com/mydexchain/tendermint0/crypto/sr25519"
 	pc "github.com/mydexchain/tendermint0/proto/tendermint/crypto"
 )
 
@@ -19,6 +22,24 @@ func PubKeyToProto(k crypto.PubKey) (pc.PublicKey, error) {
 				Ed25519: k,
 			},
 		}
+	case secp256k1.PubKey:
+		kp = pc.PublicKey{
+			Sum: &pc.PublicKey_Secp256K1{
+				Secp256K1: k,
+			},
+		}
+	case sr25519.PubKey:
+		kp = pc.PublicKey{
+			Sum: &pc.PublicKey_Sr25519{
+				Sr25519: k,
+			},
+		}
+	case bls12381.PubKey:
+		kp = pc.PublicKey{
+			Sum: &pc.PublicKey_Bls12381{
+				Bls12381: k,
+			},
+		}
 	default:
 		return kp, fmt.Errorf("toproto: key type %v is not supported", k)
 	}
@@ -36,6 +57,30 @@ func PubKeyFromProto(k pc.PublicKey) (crypto.PubKey, error) {
 		pk := make(ed25519.PubKey, ed25519.PubKeySize)
 		copy(pk, k.Ed25519)
 		return pk, nil
+	case *pc.PublicKey_Secp256K1:
+		if len(k.Secp256K1) != secp256k1.PubKeySize {
+			return nil, fmt.Errorf("invalid size for PubKeySecp256k1. Got %d, expected %d",
+				len(k.Secp256K1), secp256k1.PubKeySize)
+		}
+		pk := make(secp256k1.PubKey, secp256k1.PubKeySize)
+		copy(pk, k.Secp256K1)
+		return pk, nil
+	case *pc.PublicKey_Sr25519:
+		if len(k.Sr25519) != sr25519.PubKeySize {
+			return nil, fmt.Errorf("invalid size for PubKeySr25519. Got %d, expected %d",
+				len(k.Sr25519), sr25519.PubKeySize)
+		}
+		pk := make(sr25519.PubKey, sr25519.PubKeySize)
+		copy(pk, k.Sr25519)
+		return pk, nil
+	case *pc.PublicKey_Bls12381:
+		if len(k.Bls12381) != bls12381.PubKeySize {
+			return nil, fmt.Errorf("invalid size for PubKeyBLS12381. Got %d, expected %d",
+				len(k.Bls12381), bls12381.PubKeySize)
+		}
+		pk := make(bls12381.PubKey, bls12381.PubKeySize)
+		copy(pk, k.Bls12381)
+		return pk, nil
 	default:
 		return nil, fmt.Errorf("fromproto: key type %v is not supported", k)
 	}
@@ -51,6 +96,24 @@ func PrivKeyToProto(k crypto.PrivKey) (pc.PrivateKey, error) {
 				Ed25519: k,
 			},
 		}
+	case secp256k1.PrivKey:
+		kp = pc.PrivateKey{
+			Sum: &pc.PrivateKey_Secp256K1{
+				Secp256K1: k,
+			},
+		}
+	case sr25519.PrivKey:
+		kp = pc.PrivateKey{
+			Sum: &pc.PrivateKey_Sr25519{
+				Sr25519: k,
+			},
+		}
+	case bls12381.PrivKey:
+		kp = pc.PrivateKey{
+			Sum: &pc.PrivateKey_Bls12381{
+				Bls12381: k,
+			},
+		}
 	default:
 		return kp, errors.New("toproto: key type is not supported")
 	}
@@ -61,14 +124,37 @@ func PrivKeyToProto(k crypto.PrivKey) (pc.PrivateKey, error) {
 func PrivKeyFromProto(k pc.PrivateKey) (crypto.PrivKey, error) {
 	switch k := k.Sum.(type) {
 	case *pc.PrivateKey_Ed25519:
-
-		if len(k.Ed25519) != ed25519.PubKeySize {
-			return nil, fmt.Errorf("invalid size for PubKeyEd25519. Got %d, expected %d",
-				len(k.Ed25519), ed25519.PubKeySize)
+		if len(k.Ed25519) != ed25519.PrivateKeySize {
+			return nil, fmt.Errorf("invalid size for PrivKeyEd25519. Got %d, expected %d",
+				len(k.Ed25519), ed25519.PrivateKeySize)
 		}
 		pk := make(ed25519.PrivKey, ed25519.PrivateKeySize)
 		copy(pk, k.Ed25519)
 		return pk, nil
+	case *pc.PrivateKey_Secp256K1:
+		if len(k.Secp256K1) != secp256k1.PrivKeySize {
+			return nil, fmt.Errorf("invalid size for PrivKeySecp256k1. Got %d, expected %d",
+				len(k.Secp256K1), secp256k1.PrivKeySize)
+		}
+		pk := make(secp256k1.PrivKey, secp256k1.PrivKeySize)
+		copy(pk, k.Secp256K1)
+		return pk, nil
+	case *pc.PrivateKey_Sr25519:
+		if len(k.Sr25519) != sr25519.PrivKeySize {
+			return nil, fmt.Errorf("invalid size for PrivKeySr25519. Got %d, expected %d",
+				len(k.Sr25519), sr25519.PrivKeySize)
+		}
+		pk := make(sr25519.PrivKey, sr25519.PrivKeySize)
+		copy(pk, k.Sr25519)
+		return pk, nil
+	case *pc.PrivateKey_Bls12381:
+		if len(k.Bls12381) != bls12381.PrivKeySize {
+			return nil, fmt.Errorf("invalid size for PrivKeyBLS12381. Got %d, expected %d",
+				len(k.Bls12381), bls12381.PrivKeySize)
+		}
+		pk := make(bls12381.PrivKey, bls12381.PrivKeySize)
+		copy(pk, k.Bls12381)
+		return pk, nil
 	default:
 		return nil, errors.New("fromproto: key type not supported")
 	}
@@ -0,0 +1,55 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
+	"github.com/mydexchain/tendermint0/crypto/ed25519"
+	"github.com/mydexchain/tendermint0/crypto/secp256k1"
+	"github.com/mydexchain/tendermint0/crypto/sr25519"
+	pc "github.com/mydexchain/tendermint0/proto/tendermint/crypto"
+)
+
+func TestKeysProtoRoundTrip(t *testing.T) {
+	privKeys := []crypto.PrivKey{
+		ed25519.GenPrivKey(),
+		secp256k1.GenPrivKey(),
+		sr25519.GenPrivKey(),
+		bls12381.GenPrivKey(),
+	}
+
+	for _, privKey := range privKeys {
+		pubKey := privKey.PubKey()
+
+		pbPubKey, err := PubKeyToProto(pubKey)
+		require.NoError(t, err, pubKey.Type())
+		pubKey2, err := PubKeyFromProto(pbPubKey)
+		require.NoError(t, err, pubKey.Type())
+		assert.Equal(t, pubKey, pubKey2)
+
+		pbPrivKey, err := PrivKeyToProto(privKey)
+		require.NoError(t, err, privKey.Type())
+		privKey2, err := PrivKeyFromProto(pbPrivKey)
+		require.NoError(t, err, privKey.Type())
+		assert.Equal(t, privKey, privKey2)
+	}
+}
+
+func TestPubKeyFromProtoInvalidSize(t *testing.T) {
+	testCases := []pc.PublicKey{
+		{Sum: &pc.PublicKey_Ed25519{Ed25519: []byte{1, 2, 3}}},
+		{Sum: &pc.PublicKey_Secp256K1{Secp256K1: []byte{1, 2, 3}}},
+		{Sum: &pc.PublicKey_Sr25519{Sr25519: []byte{1, 2, 3}}},
+		{Sum: &pc.PublicKey_Bls12381{Bls12381: []byte{1, 2, 3}}},
+		{},
+	}
+
+	for _, tc := range testCases {
+		_, err := PubKeyFromProto(tc)
+		assert.Error(t, err)
+	}
+}
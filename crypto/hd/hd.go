@@ -0,0 +1,193 @@
+// Package hd derives keys from a seed, such as the seed of a BIP39 mnemonic,
+// along a hierarchical deterministic path: with SLIP-10 for ed25519 keys and
+// BIP32 for secp256k1 keys. SLIP-10 being a generalization of BIP32, both
+// share the same implementation.
+//
+// See https://github.com/satoshilabs/slips/blob/master/slip-0010.md and
+// https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki.
+package hd
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+	xed25519 "golang.org/x/crypto/ed25519"
+
+	"github.com/mydexchain/tendermint0/crypto/ed25519"
+	tmsecp256k1 "github.com/mydexchain/tendermint0/crypto/secp256k1"
+)
+
+const (
+	// HardenedOffset is added to the index of a hardened child.
+	HardenedOffset uint32 = 0x80000000
+
+	// DefaultPathEd25519 is the default path of ed25519 keys. SLIP-10 only
+	// supports hardened derivation for ed25519.
+	DefaultPathEd25519 = "m/44'/118'/0'/0'/0'"
+	// DefaultPathSecp256k1 is the default path of secp256k1 keys, which is
+	// the one of the Cosmos Hub accounts.
+	DefaultPathSecp256k1 = "m/44'/118'/0'/0/0"
+)
+
+// Path is a derivation path: the indexes of the children from the master key,
+// hardened ones being offset by HardenedOffset.
+type Path []uint32
+
+// ParsePath parses a path such as m/44'/118'/0'/0/0, where hardened indexes
+// end with ' or h.
+func ParsePath(s string) (Path, error) {
+	parts := strings.Split(s, "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("path %q must start with m", s)
+	}
+	path := make(Path, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		offset := uint32(0)
+		if strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") {
+			offset = HardenedOffset
+			part = part[:len(part)-1]
+		}
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || uint32(index) >= HardenedOffset {
+			return nil, fmt.Errorf("invalid index %q in path %q", part, s)
+		}
+		path = append(path, uint32(index)+offset)
+	}
+	return path, nil
+}
+
+// String returns the path in the format parsed by ParsePath.
+func (path Path) String() string {
+	var sb strings.Builder
+	sb.WriteString("m")
+	for _, index := range path {
+		if index >= HardenedOffset {
+			fmt.Fprintf(&sb, "/%d'", index-HardenedOffset)
+		} else {
+			fmt.Fprintf(&sb, "/%d", index)
+		}
+	}
+	return sb.String()
+}
+
+// DerivePrivKeyEd25519 derives the ed25519 private key of the seed at the
+// given path, with SLIP-10. All the indexes of the path must be hardened.
+func DerivePrivKeyEd25519(seed []byte, path Path) (ed25519.PrivKey, error) {
+	key, _, err := derive(ed25519Curve, seed, path)
+	if err != nil {
+		return nil, err
+	}
+	return ed25519.PrivKey(xed25519.NewKeyFromSeed(key)), nil
+}
+
+// DerivePrivKeySecp256k1 derives the secp256k1 private key of the seed at the
+// given path, with BIP32.
+func DerivePrivKeySecp256k1(seed []byte, path Path) (tmsecp256k1.PrivKey, error) {
+	key, _, err := derive(secp256k1Curve, seed, path)
+	if err != nil {
+		return nil, err
+	}
+	return tmsecp256k1.PrivKey(key), nil
+}
+
+// curve holds the parameters of the derivation for a curve.
+type curve struct {
+	// seedKey is the HMAC key of the master key derivation.
+	seedKey []byte
+	// order is the order of the curve, or nil if the keys are used as is
+	// (ed25519).
+	order *big.Int
+}
+
+var (
+	ed25519Curve   = curve{seedKey: []byte("ed25519 seed")}
+	secp256k1Curve = curve{seedKey: []byte("Bitcoin seed"), order: secp256k1.S256().N}
+)
+
+// derive returns the private key and chain code at the given path.
+func derive(c curve, seed []byte, path Path) (key, chainCode []byte, err error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, nil, errors.New("seed must be between 16 and 64 bytes")
+	}
+
+	// master key
+	data := seed
+	for {
+		i := hmacSHA512(c.seedKey, data)
+		key, chainCode = i[:32], i[32:]
+		if c.isValid(key) {
+			break
+		}
+		data = i
+	}
+
+	for _, index := range path {
+		key, chainCode, err = c.child(key, chainCode, index)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return key, chainCode, nil
+}
+
+// child returns the private key and chain code of the child at index.
+func (c curve) child(key, chainCode []byte, index uint32) ([]byte, []byte, error) {
+	var data []byte
+	switch {
+	case index >= HardenedOffset:
+		data = append([]byte{0}, key...)
+	case c.order == nil:
+		return nil, nil, fmt.Errorf("ed25519 only supports hardened derivation, got index %d", index)
+	default:
+		_, pubKey := secp256k1.PrivKeyFromBytes(secp256k1.S256(), key)
+		data = pubKey.SerializeCompressed()
+	}
+	var ser32 [4]byte
+	binary.BigEndian.PutUint32(ser32[:], index)
+	data = append(data, ser32[:]...)
+
+	for {
+		i := hmacSHA512(chainCode, data)
+		childKey, childChainCode := i[:32], i[32:]
+		if c.order == nil {
+			return childKey, childChainCode, nil
+		}
+		if c.isValid(childKey) {
+			// childKey = parse256(IL) + key (mod n)
+			k := new(big.Int).SetBytes(childKey)
+			k.Add(k, new(big.Int).SetBytes(key)).Mod(k, c.order)
+			if k.Sign() != 0 {
+				childKey = make([]byte, 32)
+				b := k.Bytes()
+				copy(childKey[32-len(b):], b)
+				return childKey, childChainCode, nil
+			}
+		}
+		// invalid key (probability lower than 1 in 2^127): retry with
+		// 0x01 || IR || ser32(index)
+		data = append(append([]byte{1}, childChainCode...), ser32[:]...)
+	}
+}
+
+// isValid returns true if key is a valid private key: in [1, n-1] (any key
+// is valid for ed25519).
+func (c curve) isValid(key []byte) bool {
+	if c.order == nil {
+		return true
+	}
+	k := new(big.Int).SetBytes(key)
+	return k.Sign() != 0 && k.Cmp(c.order) < 0
+}
+
+func hmacSHA512(key, data []byte) []byte {
+	h := hmac.New(sha512.New, key)
+	h.Write(data) // does not error
+	return h.Sum(nil)
+}
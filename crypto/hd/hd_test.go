@@ -0,0 +1,115 @@
+package hd
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePath(t *testing.T) {
+	path, err := ParsePath("m/44'/118'/0'/0/1")
+	require.NoError(t, err)
+	assert.Equal(t, Path{44 + HardenedOffset, 118 + HardenedOffset, HardenedOffset, 0, 1}, path)
+	assert.Equal(t, "m/44'/118'/0'/0/1", path.String())
+
+	path, err = ParsePath("m/0h/2147483647")
+	require.NoError(t, err)
+	assert.Equal(t, Path{HardenedOffset, HardenedOffset - 1}, path)
+
+	path, err = ParsePath("m")
+	require.NoError(t, err)
+	assert.Empty(t, path)
+
+	for _, s := range []string{"", "44'/0'", "m/", "m/a", "m/-1", "m/2147483648", "m/0''"} {
+		_, err := ParsePath(s)
+		assert.Error(t, err, s)
+	}
+}
+
+// Test vector 1 of SLIP-10 for ed25519.
+func TestDeriveEd25519(t *testing.T) {
+	seed := fromHex(t, "000102030405060708090a0b0c0d0e0f")
+	testCases := []struct {
+		path, chainCode, key string
+	}{
+		{"m",
+			"90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb",
+			"2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7"},
+		{"m/0'",
+			"8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69",
+			"68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3"},
+		{"m/0'/1'",
+			"a320425f77d1b5c2505a6b1b27382b37368ee640e3557c315416801243552f14",
+			"b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"},
+		{"m/0'/1'/2'/2'/1000000000'",
+			"68789923a0cac2cd5a29172a475fe9e0fb14cd6adb5ad98a3fa70333e7afa230",
+			"8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793"},
+	}
+	for _, tc := range testCases {
+		path, err := ParsePath(tc.path)
+		require.NoError(t, err)
+		key, chainCode, err := derive(ed25519Curve, seed, path)
+		require.NoError(t, err)
+		assert.Equal(t, tc.chainCode, hex.EncodeToString(chainCode), tc.path)
+		assert.Equal(t, tc.key, hex.EncodeToString(key), tc.path)
+
+		privKey, err := DerivePrivKeyEd25519(seed, path)
+		require.NoError(t, err)
+		assert.Equal(t, tc.key, hex.EncodeToString(privKey[:32]), tc.path)
+	}
+
+	// only hardened derivation
+	_, err := DerivePrivKeyEd25519(seed, Path{HardenedOffset, 1})
+	assert.Error(t, err)
+}
+
+// Test vector 1 of BIP32.
+func TestDeriveSecp256k1(t *testing.T) {
+	seed := fromHex(t, "000102030405060708090a0b0c0d0e0f")
+	testCases := []struct {
+		path, chainCode, key string
+	}{
+		{"m",
+			"873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508",
+			"e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+		{"m/0'",
+			"47fdacbd0f1097043b78c63c20c34ef4ed9a111d980047ad16282c7ae6236141",
+			"edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{"m/0'/1",
+			"2a7857631386ba23dacac34180dd1983734e444fdbf774041578e9b6adb37c19",
+			"3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"},
+		{"m/0'/1/2'",
+			"04466b9cc8e161e966409ca52986c584f07e9dc81f735db683c3ff6ec7b1503f",
+			"cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca"},
+		{"m/0'/1/2'/2/1000000000",
+			"c783e67b921d2beb8f6b389cc646d7263b4145701dadd2161548a8b078e65e9e",
+			"471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"},
+	}
+	for _, tc := range testCases {
+		path, err := ParsePath(tc.path)
+		require.NoError(t, err)
+		key, chainCode, err := derive(secp256k1Curve, seed, path)
+		require.NoError(t, err)
+		assert.Equal(t, tc.chainCode, hex.EncodeToString(chainCode), tc.path)
+		assert.Equal(t, tc.key, hex.EncodeToString(key), tc.path)
+
+		privKey, err := DerivePrivKeySecp256k1(seed, path)
+		require.NoError(t, err)
+		assert.Equal(t, tc.key, hex.EncodeToString(privKey), tc.path)
+	}
+}
+
+func TestDeriveInvalidSeed(t *testing.T) {
+	_, err := DerivePrivKeyEd25519(make([]byte, 15), nil)
+	assert.Error(t, err)
+	_, err = DerivePrivKeySecp256k1(make([]byte, 65), nil)
+	assert.Error(t, err)
+}
+
+func fromHex(t *testing.T, s string) []byte {
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
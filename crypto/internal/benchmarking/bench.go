@@ -1,6 +1,7 @@
 package benchmarking
 
 import (
+	"fmt"
 	"io"
 	"testing"
 
@@ -61,6 +62,54 @@ func BenchmarkVerification(b *testing.B, priv crypto.PrivKey) {
 	}
 }
 
+// BenchmarkBatchVerification benchmarks verifying batches of signatures by
+// distinct keys generated with genPrivKey, both with the batch verifier
+// returned by newBatchVerifier and one by one.
+func BenchmarkBatchVerification(b *testing.B, genPrivKey func() crypto.PrivKey,
+	newBatchVerifier func() crypto.BatchVerifier) {
+	for _, n := range []int{1, 8, 64, 1024} {
+		var (
+			pubKeys    = make([]crypto.PubKey, n)
+			messages   = make([][]byte, n)
+			signatures = make([][]byte, n)
+		)
+		for i := 0; i < n; i++ {
+			priv := genPrivKey()
+			pubKeys[i] = priv.PubKey()
+			messages[i] = []byte(fmt.Sprintf("Hello, world! #%d", i))
+			sig, err := priv.Sign(messages[i])
+			if err != nil {
+				b.Fatal(err)
+			}
+			signatures[i] = sig
+		}
+
+		b.Run(fmt.Sprintf("batch-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				bv := newBatchVerifier()
+				for j := 0; j < n; j++ {
+					if err := bv.Add(pubKeys[j], messages[j], signatures[j]); err != nil {
+						b.Fatal(err)
+					}
+				}
+				if ok, _ := bv.Verify(); !ok {
+					b.Fatal("batch verification failed")
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("single-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < n; j++ {
+					if !pubKeys[j].VerifySignature(messages[j], signatures[j]) {
+						b.Fatal("verification failed")
+					}
+				}
+			}
+		})
+	}
+}
+
 // Below is the aforementioned license.
 
 // Copyright (c) 2012 The Go Authors. All rights reserved.
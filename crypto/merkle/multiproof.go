@@ -0,0 +1,233 @@
+package merkle
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/mydexchain/tendermint0/crypto/tmhash"
+	tmcrypto "github.com/mydexchain/tendermint0/proto/tendermint/crypto"
+)
+
+// MultiProof represents a Merkle proof of several items of a tree computed by
+// HashFromByteSlices. Unlike separate Proofs, it includes the hash of each
+// subtree without any of the items only once, so the inner nodes shared by
+// the items are neither repeated nor sent at all.
+// NOTE: like Proof, it includes the leaf hashes but not the root hash.
+type MultiProof struct {
+	Total      int64    `json:"total"`       // Total number of items.
+	Indices    []int64  `json:"indices"`     // Indexes of the items to prove, in increasing order.
+	LeafHashes [][]byte `json:"leaf_hashes"` // Hashes of the item values.
+	Aunts      [][]byte `json:"aunts"`       // Hashes of the subtrees without items, from left to right.
+}
+
+// MultiProofFromByteSlices computes an inclusion proof of the items at the
+// given indexes, which must be in increasing order.
+func MultiProofFromByteSlices(items [][]byte, indices []int64) (rootHash []byte, proof *MultiProof, err error) {
+	if err := validateIndices(int64(len(items)), indices); err != nil {
+		return nil, nil, err
+	}
+	proof = &MultiProof{
+		Total:      int64(len(items)),
+		Indices:    append([]int64(nil), indices...),
+		LeafHashes: make([][]byte, 0, len(indices)),
+	}
+	rootHash = proof.build(items, 0, indices)
+	return rootHash, proof, nil
+}
+
+// build returns the hash of the items, starting at offset, adding the leaf
+// hashes of the items at the given indexes and the hashes of the subtrees
+// without any of them to the proof.
+func (mp *MultiProof) build(items [][]byte, offset int64, indices []int64) []byte {
+	if len(indices) == 0 {
+		hash := HashFromByteSlices(items)
+		mp.Aunts = append(mp.Aunts, hash)
+		return hash
+	}
+	if len(items) == 1 {
+		hash := leafHash(items[0])
+		mp.LeafHashes = append(mp.LeafHashes, hash)
+		return hash
+	}
+	k := getSplitPoint(int64(len(items)))
+	i := splitIndices(indices, offset+k)
+	left := mp.build(items[:k], offset, indices[:i])
+	right := mp.build(items[k:], offset+k, indices[i:])
+	return innerHash(left, right)
+}
+
+// Verify that the MultiProof proves the root hash, leaves being the values of
+// the items in the order of mp.Indices.
+// Check mp.Indices/mp.Total manually if needed
+func (mp *MultiProof) Verify(rootHash []byte, leaves [][]byte) error {
+	if err := mp.verifyLeaves(leaves); err != nil {
+		return err
+	}
+	computedHash := mp.ComputeRootHash()
+	if computedHash == nil {
+		return errors.New("proof is not internally consistent")
+	}
+	if !bytes.Equal(computedHash, rootHash) {
+		return fmt.Errorf("invalid root hash: wanted %X got %X", rootHash, computedHash)
+	}
+	return nil
+}
+
+func (mp *MultiProof) verifyLeaves(leaves [][]byte) error {
+	if len(leaves) != len(mp.LeafHashes) {
+		return fmt.Errorf("expected %d leaves, got %d", len(mp.LeafHashes), len(leaves))
+	}
+	for i, leaf := range leaves {
+		if hash := leafHash(leaf); !bytes.Equal(mp.LeafHashes[i], hash) {
+			return fmt.Errorf("invalid leaf hash #%d: wanted %X got %X", i, hash, mp.LeafHashes[i])
+		}
+	}
+	return nil
+}
+
+// ComputeRootHash computes the root hash from the leaf hashes. Does not verify
+// the result. It returns nil if the proof is malformed.
+func (mp *MultiProof) ComputeRootHash() []byte {
+	if validateIndices(mp.Total, mp.Indices) != nil || len(mp.LeafHashes) != len(mp.Indices) {
+		return nil
+	}
+	leafHashes, aunts := mp.LeafHashes, mp.Aunts
+	rootHash := computeHashFromMultiProof(0, mp.Total, mp.Indices, &leafHashes, &aunts)
+	// all the hashes must be used
+	if len(leafHashes) != 0 || len(aunts) != 0 {
+		return nil
+	}
+	return rootHash
+}
+
+// String implements the stringer interface for MultiProof.
+// It is a wrapper around StringIndented.
+func (mp *MultiProof) String() string {
+	return mp.StringIndented("")
+}
+
+// StringIndented generates a canonical string representation of a MultiProof.
+func (mp *MultiProof) StringIndented(indent string) string {
+	return fmt.Sprintf(`MultiProof{
+%s  Total:   %v
+%s  Indices: %v
+%s  Aunts:   %X
+%s}`,
+		indent, mp.Total,
+		indent, mp.Indices,
+		indent, mp.Aunts,
+		indent)
+}
+
+// ValidateBasic performs basic validation.
+// NOTE: it expects the leaf hashes and the aunts to be of size tmhash.Size,
+// and it expects at most MaxAunts aunts per index.
+func (mp *MultiProof) ValidateBasic() error {
+	if err := validateIndices(mp.Total, mp.Indices); err != nil {
+		return err
+	}
+	if len(mp.LeafHashes) != len(mp.Indices) {
+		return fmt.Errorf("expected %d leaf hashes, got %d", len(mp.Indices), len(mp.LeafHashes))
+	}
+	for i, hash := range mp.LeafHashes {
+		if len(hash) != tmhash.Size {
+			return fmt.Errorf("expected LeafHashes#%d size to be %d, got %d", i, tmhash.Size, len(hash))
+		}
+	}
+	if max := MaxAunts * len(mp.Indices); len(mp.Aunts) > max {
+		return fmt.Errorf("expected no more than %d aunts, got %d", max, len(mp.Aunts))
+	}
+	for i, auntHash := range mp.Aunts {
+		if len(auntHash) != tmhash.Size {
+			return fmt.Errorf("expected Aunts#%d size to be %d, got %d", i, tmhash.Size, len(auntHash))
+		}
+	}
+	return nil
+}
+
+func (mp *MultiProof) ToProto() *tmcrypto.MultiProof {
+	if mp == nil {
+		return nil
+	}
+	pb := new(tmcrypto.MultiProof)
+
+	pb.Total = mp.Total
+	pb.Indices = mp.Indices
+	pb.LeafHashes = mp.LeafHashes
+	pb.Aunts = mp.Aunts
+
+	return pb
+}
+
+func MultiProofFromProto(pb *tmcrypto.MultiProof) (*MultiProof, error) {
+	if pb == nil {
+		return nil, errors.New("nil multi proof")
+	}
+
+	mp := new(MultiProof)
+
+	mp.Total = pb.Total
+	mp.Indices = pb.Indices
+	mp.LeafHashes = pb.LeafHashes
+	mp.Aunts = pb.Aunts
+
+	return mp, mp.ValidateBasic()
+}
+
+// validateIndices checks that there's at least one index and that the
+// indexes are in increasing order, in [0, total).
+func validateIndices(total int64, indices []int64) error {
+	if len(indices) == 0 {
+		return errors.New("no indices to prove")
+	}
+	for i, index := range indices {
+		if index < 0 || index >= total {
+			return fmt.Errorf("index %d out of range [0, %d)", index, total)
+		}
+		if i > 0 && index <= indices[i-1] {
+			return errors.New("indices must be in increasing order")
+		}
+	}
+	return nil
+}
+
+// splitIndices returns the number of indexes below k.
+func splitIndices(indices []int64, k int64) int {
+	return sort.Search(len(indices), func(i int) bool { return indices[i] >= k })
+}
+
+// Use the leaf hashes and aunts, consumed from left to right, to get the
+// hash of the total items starting at offset. The result is nil if there are
+// too few hashes.
+// Recursive impl.
+func computeHashFromMultiProof(offset, total int64, indices []int64, leafHashes, aunts *[][]byte) []byte {
+	if len(indices) == 0 {
+		if len(*aunts) == 0 {
+			return nil
+		}
+		hash := (*aunts)[0]
+		*aunts = (*aunts)[1:]
+		return hash
+	}
+	if total == 1 {
+		if len(*leafHashes) == 0 {
+			return nil
+		}
+		hash := (*leafHashes)[0]
+		*leafHashes = (*leafHashes)[1:]
+		return hash
+	}
+	k := getSplitPoint(total)
+	i := splitIndices(indices, offset+k)
+	left := computeHashFromMultiProof(offset, k, indices[:i], leafHashes, aunts)
+	if left == nil {
+		return nil
+	}
+	right := computeHashFromMultiProof(offset+k, total-k, indices[i:], leafHashes, aunts)
+	if right == nil {
+		return nil
+	}
+	return innerHash(left, right)
+}
@@ -0,0 +1,283 @@
+package merkle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mydexchain/tendermint0/crypto/tmhash"
+	tmrand "github.com/mydexchain/tendermint0/libs/rand"
+	tmcrypto "github.com/mydexchain/tendermint0/proto/tendermint/crypto"
+)
+
+func TestMultiProof(t *testing.T) {
+	for total := 1; total <= 20; total++ {
+		items := make([][]byte, total)
+		for i := range items {
+			items[i] = tmrand.Bytes(tmrand.Intn(64))
+		}
+		rootHash := HashFromByteSlices(items)
+
+		// every subset of up to 6 items, or random ones beyond
+		subsets := make([][]int64, 0)
+		if total <= 6 {
+			for mask := 1; mask < 1<<total; mask++ {
+				subsets = append(subsets, indicesFromMask(mask))
+			}
+		} else {
+			for i := 0; i < 20; i++ {
+				var indices []int64
+				for j := 0; j < total; j++ {
+					if tmrand.Bool() {
+						indices = append(indices, int64(j))
+					}
+				}
+				if len(indices) > 0 {
+					subsets = append(subsets, indices)
+				}
+			}
+		}
+
+		for _, indices := range subsets {
+			indices := indices
+			t.Run(fmt.Sprintf("%d/%v", total, indices), func(t *testing.T) {
+				root, proof, err := MultiProofFromByteSlices(items, indices)
+				require.NoError(t, err)
+				assert.Equal(t, rootHash, root)
+				require.NoError(t, proof.ValidateBasic())
+
+				leaves := make([][]byte, len(indices))
+				for i, index := range indices {
+					leaves[i] = items[index]
+				}
+				require.NoError(t, proof.Verify(rootHash, leaves))
+
+				// no more hashes than the separate proofs
+				_, proofs := ProofsFromByteSlices(items)
+				separate := 0
+				for _, index := range indices {
+					separate += len(proofs[index].Aunts) + 1
+				}
+				assert.LessOrEqual(t, len(proof.Aunts)+len(proof.LeafHashes), separate)
+
+				// wrong leaves
+				leaves[0] = append(leaves[0], 'x')
+				assert.Error(t, proof.Verify(rootHash, leaves))
+				assert.Error(t, proof.Verify(rootHash, leaves[1:]))
+				leaves[0] = items[indices[0]]
+
+				// wrong root
+				assert.Error(t, proof.Verify(tmrand.Bytes(32), leaves))
+
+				// an extra aunt
+				proof.Aunts = append(proof.Aunts, rootHash)
+				assert.Error(t, proof.Verify(rootHash, leaves))
+			})
+		}
+	}
+}
+
+func TestMultiProofSharesAunts(t *testing.T) {
+	items := make([][]byte, 64)
+	for i := range items {
+		items[i] = []byte{byte(i)}
+	}
+	indices := make([]int64, 32)
+	for i := range indices {
+		indices[i] = int64(i)
+	}
+
+	// the first half of the tree only needs the root of the second one
+	_, proof, err := MultiProofFromByteSlices(items, indices)
+	require.NoError(t, err)
+	assert.Len(t, proof.Aunts, 1)
+	assert.Equal(t, HashFromByteSlices(items[32:]), proof.Aunts[0])
+}
+
+func TestMultiProofFromByteSlicesInvalidIndices(t *testing.T) {
+	items := [][]byte{[]byte("apple"), []byte("watermelon"), []byte("kiwi")}
+	testCases := [][]int64{
+		nil,
+		{3},
+		{-1, 0},
+		{1, 0},
+		{1, 1},
+	}
+	for _, indices := range testCases {
+		_, _, err := MultiProofFromByteSlices(items, indices)
+		assert.Error(t, err, "%v", indices)
+	}
+}
+
+func TestMultiProofValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		malleateProof func(*MultiProof)
+		errStr        string
+	}{
+		{"Good", func(mp *MultiProof) {}, ""},
+		{"No Indices", func(mp *MultiProof) { mp.Indices = nil }, "no indices to prove"},
+		{"Index out of range", func(mp *MultiProof) { mp.Indices[1] = 3 }, "index 3 out of range [0, 3)"},
+		{"Unordered Indices", func(mp *MultiProof) { mp.Indices[0], mp.Indices[1] = 2, 0 },
+			"indices must be in increasing order"},
+		{"Missing LeafHash", func(mp *MultiProof) { mp.LeafHashes = mp.LeafHashes[1:] },
+			"expected 2 leaf hashes, got 1"},
+		{"Invalid LeafHash", func(mp *MultiProof) { mp.LeafHashes[0] = make([]byte, 10) },
+			"expected LeafHashes#0 size to be 32, got 10"},
+		{"Too many Aunts", func(mp *MultiProof) { mp.Aunts = make([][]byte, 2*MaxAunts+1) },
+			"expected no more than 200 aunts, got 201"},
+		{"Invalid Aunt", func(mp *MultiProof) { mp.Aunts[0] = make([]byte, 10) },
+			"expected Aunts#0 size to be 32, got 10"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			_, proof, err := MultiProofFromByteSlices([][]byte{
+				[]byte("apple"),
+				[]byte("watermelon"),
+				[]byte("kiwi"),
+			}, []int64{0, 2})
+			require.NoError(t, err)
+			tc.malleateProof(proof)
+			err = proof.ValidateBasic()
+			if tc.errStr != "" {
+				assert.Contains(t, err.Error(), tc.errStr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMultiProofProtobuf(t *testing.T) {
+	_, proof, err := MultiProofFromByteSlices([][]byte{
+		[]byte("apple"),
+		[]byte("watermelon"),
+		[]byte("kiwi"),
+	}, []int64{0, 2})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		testName string
+		mp       *MultiProof
+		expPass  bool
+	}{
+		{"empty proof", &MultiProof{}, false},
+		{"failure nil", nil, false},
+		{"success", proof, true},
+	}
+	for _, tc := range testCases {
+		pb := tc.mp.ToProto()
+
+		mp, err := MultiProofFromProto(pb)
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, tc.mp, mp, tc.testName)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestLeavesOp(t *testing.T) {
+	items := [][]byte{[]byte("apple"), []byte("watermelon"), []byte("kiwi"), []byte("banana")}
+	rootHash, proof, err := MultiProofFromByteSlices(items, []int64{1, 3})
+	require.NoError(t, err)
+
+	// round trip through the proof runtime
+	prt := DefaultProofRuntime()
+	pop := NewLeavesOp([]byte("fruits"), proof).ProofOp()
+	op, err := prt.Decode(pop)
+	require.NoError(t, err)
+	assert.Equal(t, NewLeavesOp([]byte("fruits"), proof), op)
+
+	poz := ProofOperators{op}
+	leaves := [][]byte{items[1], items[3]}
+	assert.NoError(t, poz.Verify(rootHash, "/fruits", leaves))
+	assert.Error(t, poz.Verify(rootHash, "/vegetables", leaves))
+	assert.Error(t, poz.Verify(rootHash, "/fruits", [][]byte{items[3], items[1]}))
+	assert.Error(t, poz.Verify(rootHash, "/fruits", leaves[:1]))
+
+	_, err = LeavesOpDecoder(NewValueOp([]byte("fruits"), &Proof{}).ProofOp())
+	assert.Error(t, err)
+}
+
+func TestAbsenceOp(t *testing.T) {
+	m := map[string][]byte{"b": []byte("banana"), "d": []byte("date"), "f": []byte("fig")}
+	keys := []string{"b", "d", "f"}
+	items := make([][]byte, len(keys))
+	for i, k := range keys {
+		items[i] = kvPairLeaf([]byte(k), tmhash.Sum(m[k]))
+	}
+	rootHash, proofs := ProofsFromByteSlices(items)
+
+	// the present keys are proven by ValueOp in the same tree
+	prt := DefaultProofRuntime()
+	for i, k := range keys {
+		pops := &tmcrypto.ProofOps{Ops: []tmcrypto.ProofOp{NewValueOp([]byte(k), proofs[i]).ProofOp()}}
+		require.NoError(t, prt.VerifyValue(pops, rootHash, "/"+k, m[k]))
+	}
+
+	ops := make(map[string]AbsenceOp)
+	for _, k := range []string{"a", "c", "e", "g"} {
+		root, op, err := AbsenceOpFromMap(m, []byte(k))
+		require.NoError(t, err, k)
+		require.Equal(t, rootHash, root, k)
+		ops[k] = op
+
+		// round trip through the proof runtime
+		pops := &tmcrypto.ProofOps{Ops: []tmcrypto.ProofOp{op.ProofOp()}}
+		assert.NoError(t, prt.VerifyAbsence(pops, rootHash, "/"+k), k)
+		assert.Error(t, prt.VerifyAbsence(pops, rootHash, "/"+k+k), k)
+		assert.Error(t, prt.Verify(pops, rootHash, "/"+k, [][]byte{m["b"]}), k)
+	}
+	assert.Len(t, ops["a"].Keys, 1)
+	assert.Len(t, ops["c"].Keys, 2)
+	assert.Len(t, ops["g"].Keys, 1)
+
+	_, _, err := AbsenceOpFromMap(m, []byte("d"))
+	assert.Error(t, err)
+
+	// the pairs must be next to the key
+	for _, tc := range []struct {
+		testName string
+		op       AbsenceOp
+	}{
+		{"present key", NewAbsenceOp([]byte("d"), ops["c"].Proof, ops["c"].Keys, ops["c"].ValueHashes)},
+		{"key after the pairs", NewAbsenceOp([]byte("e"), ops["c"].Proof, ops["c"].Keys, ops["c"].ValueHashes)},
+		{"key after the first pair", NewAbsenceOp([]byte("c"), ops["a"].Proof, ops["a"].Keys, ops["a"].ValueHashes)},
+		{"key before the last pair", NewAbsenceOp([]byte("e"), ops["g"].Proof, ops["g"].Keys, ops["g"].ValueHashes)},
+		{"wrong value hash", NewAbsenceOp([]byte("c"), ops["c"].Proof, ops["c"].Keys, ops["e"].ValueHashes)},
+		{"no pairs", NewAbsenceOp([]byte("c"), ops["c"].Proof, nil, nil)},
+	} {
+		_, err := tc.op.Run(nil)
+		assert.Error(t, err, tc.testName)
+	}
+	_, proof, err := MultiProofFromByteSlices(items, []int64{0, 2})
+	require.NoError(t, err)
+	op := NewAbsenceOp([]byte("c"), proof, [][]byte{[]byte("b"), []byte("f")},
+		[][]byte{tmhash.Sum(m["b"]), tmhash.Sum(m["f"])})
+	_, err = op.Run(nil)
+	assert.Error(t, err, "pairs not next to each other")
+
+	// empty tree
+	root, op, err := AbsenceOpFromMap(nil, []byte("c"))
+	require.NoError(t, err)
+	assert.Equal(t, HashFromByteSlices(nil), root)
+	pops := &tmcrypto.ProofOps{Ops: []tmcrypto.ProofOp{op.ProofOp()}}
+	assert.NoError(t, prt.VerifyAbsence(pops, root, "/c"))
+	assert.Error(t, prt.VerifyAbsence(pops, rootHash, "/c"))
+}
+
+func indicesFromMask(mask int) []int64 {
+	var indices []int64
+	for i := 0; mask>>i > 0; i++ {
+		if mask>>i&1 == 1 {
+			indices = append(indices, int64(i))
+		}
+	}
+	return indices
+}
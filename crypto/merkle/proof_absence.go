@@ -0,0 +1,188 @@
+package merkle
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/mydexchain/tendermint0/crypto/tmhash"
+	tmcrypto "github.com/mydexchain/tendermint0/proto/tendermint/crypto"
+)
+
+const ProofOpAbsence = "simple:absence"
+
+// AbsenceOp takes no argument and produces the root hash of a tree of
+// key-value pairs sorted by key, like the one of ValueOp, that doesn't have
+// the key. The proof includes the pairs next to the key: the pairs right
+// before and right after it, or only the first or the last pair if the key
+// comes before or after all of them. The proof of an empty tree is nil.
+//
+// If the produced root hash matches the expected hash, the
+// proof is good.
+type AbsenceOp struct {
+	// Encoded in ProofOp.Key.
+	key []byte
+
+	// To encode in ProofOp.Data
+	Proof       *MultiProof `json:"proof"`
+	Keys        [][]byte    `json:"keys"`         // Keys of the pairs next to the key.
+	ValueHashes [][]byte    `json:"value_hashes"` // Hashes of their values.
+}
+
+var _ ProofOperator = AbsenceOp{}
+
+func NewAbsenceOp(key []byte, proof *MultiProof, keys, valueHashes [][]byte) AbsenceOp {
+	return AbsenceOp{
+		key:         key,
+		Proof:       proof,
+		Keys:        keys,
+		ValueHashes: valueHashes,
+	}
+}
+
+// AbsenceOpFromMap returns the root hash of the tree of the key-value pairs of
+// m sorted by key, and a proof that key isn't in it.
+func AbsenceOpFromMap(m map[string][]byte, key []byte) (rootHash []byte, op AbsenceOp, err error) {
+	if _, ok := m[string(key)]; ok {
+		return nil, AbsenceOp{}, fmt.Errorf("key %X is in the map", key)
+	}
+	if len(m) == 0 {
+		return emptyHash(), NewAbsenceOp(key, nil, nil, nil), nil
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	items := make([][]byte, len(keys))
+	valueHashes := make([][]byte, len(keys))
+	for i, k := range keys {
+		valueHashes[i] = tmhash.Sum(m[k])
+		items[i] = kvPairLeaf([]byte(k), valueHashes[i])
+	}
+
+	// i is the index of the first key after key
+	var indices []int64
+	switch i := sort.SearchStrings(keys, string(key)); i {
+	case 0:
+		indices = []int64{0}
+	case len(keys):
+		indices = []int64{int64(i - 1)}
+	default:
+		indices = []int64{int64(i - 1), int64(i)}
+	}
+	rootHash, proof, err := MultiProofFromByteSlices(items, indices)
+	if err != nil {
+		return nil, AbsenceOp{}, err
+	}
+	pairKeys := make([][]byte, len(indices))
+	pairValueHashes := make([][]byte, len(indices))
+	for i, index := range indices {
+		pairKeys[i] = []byte(keys[index])
+		pairValueHashes[i] = valueHashes[index]
+	}
+	return rootHash, NewAbsenceOp(key, proof, pairKeys, pairValueHashes), nil
+}
+
+func AbsenceOpDecoder(pop tmcrypto.ProofOp) (ProofOperator, error) {
+	if pop.Type != ProofOpAbsence {
+		return nil, fmt.Errorf("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpAbsence)
+	}
+	var pbop tmcrypto.AbsenceOp
+	err := pbop.Unmarshal(pop.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into AbsenceOp: %w", err)
+	}
+
+	var mp *MultiProof
+	if pbop.Proof != nil {
+		mp, err = MultiProofFromProto(pbop.Proof)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return NewAbsenceOp(pop.Key, mp, pbop.Keys, pbop.ValueHashes), nil
+}
+
+func (op AbsenceOp) ProofOp() tmcrypto.ProofOp {
+	pbval := tmcrypto.AbsenceOp{
+		Key:         op.key,
+		Proof:       op.Proof.ToProto(),
+		Keys:        op.Keys,
+		ValueHashes: op.ValueHashes,
+	}
+	bz, err := pbval.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmcrypto.ProofOp{
+		Type: ProofOpAbsence,
+		Key:  op.key,
+		Data: bz,
+	}
+}
+
+func (op AbsenceOp) String() string {
+	return fmt.Sprintf("AbsenceOp{%v}", op.GetKey())
+}
+
+func (op AbsenceOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf("expected no args, got %v", len(args))
+	}
+	if op.Proof == nil {
+		if len(op.Keys) != 0 || len(op.ValueHashes) != 0 {
+			return nil, errors.New("expected no key-value pairs without a proof")
+		}
+		return [][]byte{emptyHash()}, nil
+	}
+	if err := op.verifyPairs(); err != nil {
+		return nil, err
+	}
+
+	leaves := make([][]byte, len(op.Keys))
+	for i, key := range op.Keys {
+		leaves[i] = kvPairLeaf(key, op.ValueHashes[i])
+	}
+	if err := op.Proof.verifyLeaves(leaves); err != nil {
+		return nil, err
+	}
+	rootHash := op.Proof.ComputeRootHash()
+	if rootHash == nil {
+		return nil, errors.New("proof is not internally consistent")
+	}
+	return [][]byte{rootHash}, nil
+}
+
+// verifyPairs checks that the proven key-value pairs are next to the key.
+func (op AbsenceOp) verifyPairs() error {
+	indices := op.Proof.Indices
+	if len(op.Keys) != len(indices) || len(op.ValueHashes) != len(indices) {
+		return fmt.Errorf("expected %d keys and value hashes, got %d and %d",
+			len(indices), len(op.Keys), len(op.ValueHashes))
+	}
+	switch len(indices) {
+	case 1:
+		cmp := bytes.Compare(op.key, op.Keys[0])
+		if (indices[0] == 0 && cmp < 0) || (indices[0] == op.Proof.Total-1 && cmp > 0) {
+			return nil
+		}
+		return fmt.Errorf("key-value pair #%d isn't next to the key", indices[0])
+	case 2:
+		if indices[1] != indices[0]+1 {
+			return fmt.Errorf("key-value pairs #%d and #%d aren't next to each other", indices[0], indices[1])
+		}
+		if bytes.Compare(op.Keys[0], op.key) >= 0 || bytes.Compare(op.key, op.Keys[1]) >= 0 {
+			return errors.New("key isn't between the keys of the key-value pairs")
+		}
+		return nil
+	default:
+		return fmt.Errorf("expected 1 or 2 key-value pairs, got %d", len(indices))
+	}
+}
+
+func (op AbsenceOp) GetKey() []byte {
+	return op.key
+}
@@ -0,0 +1,86 @@
+package merkle
+
+import (
+	"errors"
+	"fmt"
+
+	tmcrypto "github.com/mydexchain/tendermint0/proto/tendermint/crypto"
+)
+
+const ProofOpLeaves = "simple:leaves"
+
+// LeavesOp takes the values of several items of a tree computed by
+// HashFromByteSlices, in the order of their indexes, and produces the root
+// hash. Unlike ValueOp, the values are the leaves themselves rather than
+// key-value pairs.
+//
+// If the produced root hash matches the expected hash, the
+// proof is good.
+type LeavesOp struct {
+	// Encoded in ProofOp.Key.
+	key []byte
+
+	// To encode in ProofOp.Data
+	Proof *MultiProof `json:"proof"`
+}
+
+var _ ProofOperator = LeavesOp{}
+
+func NewLeavesOp(key []byte, proof *MultiProof) LeavesOp {
+	return LeavesOp{
+		key:   key,
+		Proof: proof,
+	}
+}
+
+func LeavesOpDecoder(pop tmcrypto.ProofOp) (ProofOperator, error) {
+	if pop.Type != ProofOpLeaves {
+		return nil, fmt.Errorf("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpLeaves)
+	}
+	var pbop tmcrypto.LeavesOp
+	err := pbop.Unmarshal(pop.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into LeavesOp: %w", err)
+	}
+
+	mp, err := MultiProofFromProto(pbop.Proof)
+	if err != nil {
+		return nil, err
+	}
+	return NewLeavesOp(pop.Key, mp), nil
+}
+
+func (op LeavesOp) ProofOp() tmcrypto.ProofOp {
+	pbval := tmcrypto.LeavesOp{
+		Key:   op.key,
+		Proof: op.Proof.ToProto(),
+	}
+	bz, err := pbval.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmcrypto.ProofOp{
+		Type: ProofOpLeaves,
+		Key:  op.key,
+		Data: bz,
+	}
+}
+
+func (op LeavesOp) String() string {
+	return fmt.Sprintf("LeavesOp{%v}", op.GetKey())
+}
+
+func (op LeavesOp) Run(args [][]byte) ([][]byte, error) {
+	if err := op.Proof.verifyLeaves(args); err != nil {
+		return nil, err
+	}
+	rootHash := op.Proof.ComputeRootHash()
+	if rootHash == nil {
+		return nil, errors.New("proof is not internally consistent")
+	}
+	return [][]byte{rootHash}, nil
+}
+
+func (op LeavesOp) GetKey() []byte {
+	return op.key
+}
@@ -129,11 +129,13 @@ func (prt *ProofRuntime) Verify(proof *tmcrypto.ProofOps, root []byte, keypath s
 	return poz.Verify(root, keypath, args)
 }
 
-// DefaultProofRuntime only knows about value proofs.
+// DefaultProofRuntime only knows about value, leaves and absence proofs.
 // To use e.g. IAVL proofs, register op-decoders as
 // defined in the IAVL package.
 func DefaultProofRuntime() (prt *ProofRuntime) {
 	prt = NewProofRuntime()
 	prt.RegisterOpDecoder(ProofOpValue, ValueOpDecoder)
+	prt.RegisterOpDecoder(ProofOpLeaves, LeavesOpDecoder)
+	prt.RegisterOpDecoder(ProofOpAbsence, AbsenceOpDecoder)
 	return
 }
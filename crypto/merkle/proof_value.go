@@ -83,11 +83,8 @@ func (op ValueOp) Run(args [][]byte) ([][]byte, error) {
 	hasher.Write(value) // does not error
 	vhash := hasher.Sum(nil)
 
-	bz := new(bytes.Buffer)
 	// Wrap <op.Key, vhash> to hash the KVPair.
-	encodeByteSlice(bz, op.key) // does not error
-	encodeByteSlice(bz, vhash)  // does not error
-	kvhash := leafHash(bz.Bytes())
+	kvhash := leafHash(kvPairLeaf(op.key, vhash))
 
 	if !bytes.Equal(kvhash, op.Proof.LeafHash) {
 		return nil, fmt.Errorf("leaf hash mismatch: want %X got %X", op.Proof.LeafHash, kvhash)
@@ -101,3 +98,11 @@ func (op ValueOp) Run(args [][]byte) ([][]byte, error) {
 func (op ValueOp) GetKey() []byte {
 	return op.key
 }
+
+// kvPairLeaf returns the leaf of a key-value pair, given the hash of the value.
+func kvPairLeaf(key, valueHash []byte) []byte {
+	bz := new(bytes.Buffer)
+	encodeByteSlice(bz, key)       // does not error
+	encodeByteSlice(bz, valueHash) // does not error
+	return bz.Bytes()
+}
@@ -0,0 +1,131 @@
+package sr25519
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	schnorrkel "github.com/ChainSafe/go-schnorrkel"
+	r255 "github.com/gtank/ristretto255"
+
+	"github.com/mydexchain/tendermint0/crypto"
+)
+
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+type batchEntry struct {
+	pubKey    PubKey
+	message   []byte
+	signature []byte
+
+	a *r255.Element // public key
+	r *r255.Element // R of the signature
+	s *r255.Scalar  // s of the signature
+	k *r255.Scalar  // challenge
+}
+
+// BatchVerifier implements crypto.BatchVerifier for Sr25519 signatures.
+//
+// Given the signatures (R_i, s_i) by the keys A_i with the challenges k_i, it
+// checks that
+//
+//	sum(z_i * (R_i + k_i * A_i - s_i * B)) == 0
+//
+// for random z_i with a single multiscalar multiplication. Since ristretto255
+// is a prime order group, this holds iff every signature is valid (except
+// with a negligible probability). If the batch is invalid, the signatures are
+// verified one by one to find the invalid ones.
+type BatchVerifier struct {
+	entries []batchEntry
+}
+
+// NewBatchVerifier returns an empty Sr25519 batch verifier.
+func NewBatchVerifier() *BatchVerifier {
+	return &BatchVerifier{}
+}
+
+// Add implements crypto.BatchVerifier.
+func (b *BatchVerifier) Add(key crypto.PubKey, msg, signature []byte) error {
+	pubKey, ok := key.(PubKey)
+	if !ok {
+		return fmt.Errorf("pubkey is not Sr25519: %T", key)
+	}
+	if len(pubKey) != PubKeySize {
+		return errors.New("pubkey size is incorrect")
+	}
+	if len(signature) != SignatureSize {
+		return errors.New("invalid signature")
+	}
+
+	a := r255.NewElement()
+	if err := a.Decode(pubKey); err != nil {
+		return fmt.Errorf("invalid pubkey: %w", err)
+	}
+	var sig64 [SignatureSize]byte
+	copy(sig64[:], signature)
+	sig := &schnorrkel.Signature{}
+	if err := sig.Decode(sig64); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	// same transcript as schnorrkel.PublicKey#Verify
+	t := schnorrkel.NewSigningContext([]byte{}, msg)
+	t.AppendMessage([]byte("proto-name"), []byte("Schnorr-sig"))
+	t.AppendMessage([]byte("sign:pk"), pubKey)
+	t.AppendMessage([]byte("sign:R"), sig.R.Encode([]byte{}))
+	k := r255.NewScalar().FromUniformBytes(t.ExtractBytes([]byte("sign:c"), 64))
+
+	b.entries = append(b.entries, batchEntry{
+		pubKey:    pubKey,
+		message:   msg,
+		signature: signature,
+		a:         a,
+		r:         sig.R,
+		s:         sig.S,
+		k:         k,
+	})
+	return nil
+}
+
+// Verify implements crypto.BatchVerifier.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	valid := make([]bool, len(b.entries))
+
+	if b.verifyBatch() {
+		for i := range valid {
+			valid[i] = true
+		}
+		return true, valid
+	}
+
+	for i, e := range b.entries {
+		valid[i] = e.pubKey.VerifySignature(e.message, e.signature)
+	}
+	return false, valid
+}
+
+// verifyBatch checks the batch equation (see BatchVerifier).
+func (b *BatchVerifier) verifyBatch() bool {
+	var (
+		n       = len(b.entries)
+		scalars = make([]*r255.Scalar, 0, 2*n+1)
+		points  = make([]*r255.Element, 0, 2*n+1)
+		sumZS   = r255.NewScalar()
+		zb      [64]byte
+	)
+	for _, e := range b.entries {
+		if _, err := rand.Read(zb[:]); err != nil {
+			return false
+		}
+		z := r255.NewScalar().FromUniformBytes(zb[:])
+
+		sumZS.Add(sumZS, r255.NewScalar().Multiply(z, e.s))
+		scalars = append(scalars, z, r255.NewScalar().Multiply(z, e.k))
+		points = append(points, e.r, e.a)
+	}
+	scalars = append(scalars, r255.NewScalar().Negate(sumZS))
+	points = append(points, r255.NewElement().Base())
+
+	sum := r255.NewElement().VarTimeMultiScalarMult(scalars, points)
+	return sum.Equal(r255.NewElement().Zero()) == 1
+}
@@ -24,3 +24,9 @@ func BenchmarkVerification(b *testing.B) {
 	priv := GenPrivKey()
 	benchmarking.BenchmarkVerification(b, priv)
 }
+
+func BenchmarkBatchVerification(b *testing.B) {
+	benchmarking.BenchmarkBatchVerification(b,
+		func() crypto.PrivKey { return GenPrivKey() },
+		func() crypto.BatchVerifier { return NewBatchVerifier() })
+}
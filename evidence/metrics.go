@@ -0,0 +1,85 @@
+package evidence
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/mydexchain/tendermint0/types"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "evidence"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of pending evidence.
+	PendingEvidence metrics.Gauge
+	// Number of evidence which failed verification, by evidence type.
+	VerificationFailures metrics.Counter
+	// Number of pending evidence removed because they expired.
+	ExpiredEvidence metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		PendingEvidence: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pending_evidence",
+			Help:      "Number of pending evidence.",
+		}, labels).With(labelsAndValues...),
+		VerificationFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "verification_failures",
+			Help:      "Number of evidence which failed verification, by evidence type.",
+		}, append(labels, "type")).With(labelsAndValues...),
+		ExpiredEvidence: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "expired_evidence",
+			Help:      "Number of pending evidence removed because they expired.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		PendingEvidence:      discard.NewGauge(),
+		VerificationFailures: discard.NewCounter(),
+		ExpiredEvidence:      discard.NewCounter(),
+	}
+}
+
+// evidenceType returns the type of the evidence used as label of the metrics.
+func evidenceType(ev types.Evidence) string {
+	switch ev.(type) {
+	case *types.DuplicateVoteEvidence:
+		return "duplicate_vote"
+	case *types.ConflictingHeadersEvidence:
+		return "conflicting_headers"
+	case *types.LightClientAttackEvidence:
+		return "light_client_attack"
+	case *types.LunaticValidatorEvidence:
+		return "lunatic_validator"
+	case *types.PotentialAmnesiaEvidence:
+		return "potential_amnesia"
+	case *types.AmnesiaEvidence:
+		return "amnesia"
+	default:
+		return "unknown"
+	}
+}
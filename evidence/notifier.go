@@ -0,0 +1,359 @@
+package evidence
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	dbm "github.com/mydexchain/tm-db"
+
+	tmbytes "github.com/mydexchain/tendermint0/libs/bytes"
+	"github.com/mydexchain/tendermint0/libs/service"
+	tmsync "github.com/mydexchain/tendermint0/libs/sync"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+const (
+	webhookTimeout    = 10 * time.Second
+	webhookMinBackoff = 1 * time.Second
+	webhookMaxBackoff = 1 * time.Minute
+	webhookMaxOutbox  = 10000
+	webhookQueueSize  = 1000
+)
+
+// Notifier is notified of the evidence the pool adds and commits. Its methods
+// are called synchronously by the pool and thus must not block.
+type Notifier interface {
+	// NotifyPending is called when verified evidence is added to the pending
+	// evidence.
+	NotifyPending(ev types.Evidence)
+	// NotifyCommitted is called when evidence is committed in the block at the
+	// given height.
+	NotifyCommitted(ev types.Evidence, height int64)
+}
+
+// nopNotifier is the notifier of a pool without notifier.
+type nopNotifier struct{}
+
+func (nopNotifier) NotifyPending(types.Evidence)          {}
+func (nopNotifier) NotifyCommitted(types.Evidence, int64) {}
+
+// WithNotifier sets the notifier.
+func WithNotifier(notifier Notifier) PoolOption {
+	return func(evpool *Pool) { evpool.notifier = notifier }
+}
+
+// WebhookPayload is the JSON body of the requests of the WebhookNotifier.
+type WebhookPayload struct {
+	// Status of the evidence: pending or committed.
+	Status           Status           `json:"status"`
+	Type             string           `json:"type"`
+	Hash             tmbytes.HexBytes `json:"hash"`
+	ValidatorAddress tmbytes.HexBytes `json:"validator_address"`
+	Height           int64            `json:"height"`
+	Time             time.Time        `json:"time"`
+	// Height of the block the evidence was committed in, if it is committed.
+	CommitHeight int64 `json:"commit_height,omitempty"`
+	// Protobuf encoding of the evidence.
+	Evidence []byte `json:"evidence"`
+}
+
+func newWebhookPayload(status Status, ev types.Evidence, commitHeight int64) (WebhookPayload, error) {
+	evpb, err := types.EvidenceToProto(ev)
+	if err != nil {
+		return WebhookPayload{}, fmt.Errorf("unable to convert to proto, err: %w", err)
+	}
+	bz, err := proto.Marshal(evpb)
+	if err != nil {
+		return WebhookPayload{}, fmt.Errorf("unable to marshal evidence: %w", err)
+	}
+	return WebhookPayload{
+		Status:           status,
+		Type:             evidenceType(ev),
+		Hash:             ev.Hash(),
+		ValidatorAddress: ev.Address(),
+		Height:           ev.Height(),
+		Time:             ev.Time(),
+		CommitHeight:     commitHeight,
+		Evidence:         bz,
+	}, nil
+}
+
+// webhookNotification is a notification waiting to be saved to the outbox.
+type webhookNotification struct {
+	status       Status
+	ev           types.Evidence
+	commitHeight int64
+}
+
+// WebhookNotifier is a Notifier posting a WebhookPayload to a URL for each
+// notification.
+//
+// Notifications are queued without blocking the pool, then saved to an
+// outbox by the delivery routine, so that they survive restarts, and
+// delivered in order once the service is started. The notifications still
+// queued are saved when the service stops, but lost on a crash. A failed
+// delivery is retried with exponential backoff until the webhook responds
+// with a 2xx status code, unless it responds with a 4xx status code other
+// than 408 and 429, which drops the notification. The notifications are
+// dropped as well while the queue or the outbox is full.
+type WebhookNotifier struct {
+	service.BaseService
+
+	url    string
+	client *http.Client
+	outbox dbm.DB
+
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	maxOutbox  int
+
+	queue chan webhookNotification
+
+	mtx        tmsync.Mutex
+	nextSeq    uint64
+	outboxSize int
+}
+
+var _ Notifier = (*WebhookNotifier)(nil)
+
+// NewWebhookNotifier returns a WebhookNotifier posting to url, with the
+// notifications yet to be delivered stored in outbox.
+func NewWebhookNotifier(url string, outbox dbm.DB) (*WebhookNotifier, error) {
+	wn := &WebhookNotifier{
+		url:        url,
+		client:     &http.Client{Timeout: webhookTimeout},
+		outbox:     outbox,
+		minBackoff: webhookMinBackoff,
+		maxBackoff: webhookMaxBackoff,
+		maxOutbox:  webhookMaxOutbox,
+		queue:      make(chan webhookNotification, webhookQueueSize),
+	}
+	wn.BaseService = *service.NewBaseService(nil, "WebhookNotifier", wn)
+
+	// count the notifications left in the outbox and continue their sequence
+	iter, err := outbox.Iterator(nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		wn.nextSeq = binary.BigEndian.Uint64(iter.Key()) + 1
+		wn.outboxSize++
+	}
+	return wn, iter.Error()
+}
+
+// NotifyPending implements Notifier.
+func (wn *WebhookNotifier) NotifyPending(ev types.Evidence) {
+	wn.enqueue(StatusPending, ev, 0)
+}
+
+// NotifyCommitted implements Notifier.
+func (wn *WebhookNotifier) NotifyCommitted(ev types.Evidence, height int64) {
+	wn.enqueue(StatusCommitted, ev, height)
+}
+
+// OnStart implements service.Service by starting the delivery of the
+// notifications.
+func (wn *WebhookNotifier) OnStart() error {
+	go wn.deliverRoutine()
+	return nil
+}
+
+// OnStop implements service.Service by saving the queued notifications.
+func (wn *WebhookNotifier) OnStop() {
+	wn.persist()
+}
+
+// enqueue queues the notification to be saved to the outbox.
+func (wn *WebhookNotifier) enqueue(status Status, ev types.Evidence, commitHeight int64) {
+	select {
+	case wn.queue <- webhookNotification{status, ev, commitHeight}:
+	default:
+		wn.Logger.Error("Webhook queue is full, dropping notification", "evidence", ev, "status", status)
+	}
+}
+
+// persist saves the given and the queued notifications to the outbox, in a
+// single batch.
+func (wn *WebhookNotifier) persist(notifications ...webhookNotification) {
+	wn.mtx.Lock()
+	defer wn.mtx.Unlock()
+
+drain:
+	for len(notifications) < webhookQueueSize {
+		select {
+		case n := <-wn.queue:
+			notifications = append(notifications, n)
+		default:
+			break drain
+		}
+	}
+	if len(notifications) == 0 {
+		return
+	}
+
+	batch := wn.outbox.NewBatch()
+	defer batch.Close()
+	nextSeq, outboxSize := wn.nextSeq, wn.outboxSize
+	for _, n := range notifications {
+		if outboxSize >= wn.maxOutbox {
+			wn.Logger.Error("Webhook outbox is full, dropping notification", "evidence", n.ev, "status", n.status)
+			continue
+		}
+		payload, err := newWebhookPayload(n.status, n.ev, n.commitHeight)
+		if err != nil {
+			wn.Logger.Error("Failed to create webhook payload", "evidence", n.ev, "err", err)
+			continue
+		}
+		bz, err := json.Marshal(payload)
+		if err != nil {
+			wn.Logger.Error("Failed to marshal webhook payload", "evidence", n.ev, "err", err)
+			continue
+		}
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, nextSeq)
+		if err := batch.Set(key, bz); err != nil {
+			wn.Logger.Error("Failed to save webhook notifications", "err", err)
+			return
+		}
+		nextSeq++
+		outboxSize++
+	}
+	if err := batch.WriteSync(); err != nil {
+		wn.Logger.Error("Failed to save webhook notifications", "err", err)
+		return
+	}
+	wn.nextSeq, wn.outboxSize = nextSeq, outboxSize
+}
+
+// deliverRoutine saves the queued notifications to the outbox and posts the
+// notifications of the outbox in order, removing them once delivered or
+// rejected.
+func (wn *WebhookNotifier) deliverRoutine() {
+	backoff := wn.minBackoff
+	for {
+		wn.persist()
+		key, payload, err := wn.first()
+		switch {
+		case err != nil:
+			wn.Logger.Error("Failed to read webhook outbox", "err", err)
+		case key == nil:
+			select {
+			case n := <-wn.queue:
+				wn.persist(n)
+				continue
+			case <-wn.Quit():
+				return
+			}
+		default:
+			err = wn.post(payload)
+			if statusErr, ok := err.(webhookStatusError); ok && !statusErr.retryable() {
+				wn.Logger.Error("Webhook rejected notification, dropping it", "err", err, "payload", string(payload))
+				err = nil
+			}
+			if err == nil {
+				wn.remove(key)
+				backoff = wn.minBackoff
+				continue
+			}
+			wn.Logger.Error("Failed to deliver webhook notification", "err", err, "retryIn", backoff)
+		}
+
+		if !wn.waitPersisting(backoff) {
+			return
+		}
+		backoff *= 2
+		if backoff > wn.maxBackoff {
+			backoff = wn.maxBackoff
+		}
+	}
+}
+
+// waitPersisting waits for the given duration, saving the notifications queued
+// meanwhile. It returns false if the service is stopped.
+func (wn *WebhookNotifier) waitPersisting(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	for {
+		select {
+		case n := <-wn.queue:
+			wn.persist(n)
+		case <-timer.C:
+			return true
+		case <-wn.Quit():
+			return false
+		}
+	}
+}
+
+// first returns the oldest notification of the outbox, or a nil key if it's
+// empty.
+func (wn *WebhookNotifier) first() (key, payload []byte, err error) {
+	iter, err := wn.outbox.Iterator(nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+	if iter.Valid() {
+		return iter.Key(), iter.Value(), nil
+	}
+	return nil, nil, iter.Error()
+}
+
+// remove removes the notification with the given key from the outbox.
+func (wn *WebhookNotifier) remove(key []byte) {
+	wn.mtx.Lock()
+	defer wn.mtx.Unlock()
+	if err := wn.outbox.Delete(key); err != nil {
+		wn.Logger.Error("Failed to remove webhook notification from outbox", "err", err)
+		return
+	}
+	wn.outboxSize--
+}
+
+// webhookStatusError is the error of a webhook responding with a status code
+// other than 2xx.
+type webhookStatusError struct {
+	code   int
+	status string
+}
+
+func (e webhookStatusError) Error() string {
+	return fmt.Sprintf("webhook responded with status %s", e.status)
+}
+
+// retryable returns whether the request may succeed if retried: client errors
+// won't, except timeouts and rate limiting.
+func (e webhookStatusError) retryable() bool {
+	switch {
+	case e.code == http.StatusRequestTimeout, e.code == http.StatusTooManyRequests:
+		return true
+	case e.code >= 400 && e.code <= 499:
+		return false
+	default:
+		return true
+	}
+}
+
+func (wn *WebhookNotifier) post(payload []byte) error {
+	resp, err := wn.client.Post(wn.url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body) // to reuse the connection
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return webhookStatusError{code: resp.StatusCode, status: resp.Status}
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package evidence
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/mydexchain/tm-db"
+
+	"github.com/mydexchain/tendermint0/evidence/mocks"
+	"github.com/mydexchain/tendermint0/libs/log"
+	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+// webhookStub is a webhook failing the first failures requests with the given
+// status code.
+type webhookStub struct {
+	*httptest.Server
+	failures int32
+	payloads chan WebhookPayload
+}
+
+func newWebhookStub(t *testing.T, failures int32, status int) *webhookStub {
+	stub := &webhookStub{failures: failures, payloads: make(chan WebhookPayload, 10)}
+	stub.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&stub.failures, -1) >= 0 {
+			w.WriteHeader(status)
+			return
+		}
+		var payload WebhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		stub.payloads <- payload
+	}))
+	t.Cleanup(stub.Close)
+	return stub
+}
+
+func (stub *webhookStub) receive(t *testing.T) WebhookPayload {
+	select {
+	case payload := <-stub.payloads:
+		return payload
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not called after 5s")
+		return WebhookPayload{}
+	}
+}
+
+func newTestWebhookNotifier(t *testing.T, url string, outbox dbm.DB) *WebhookNotifier {
+	notifier, err := NewWebhookNotifier(url, outbox)
+	require.NoError(t, err)
+	notifier.SetLogger(log.TestingLogger())
+	notifier.minBackoff, notifier.maxBackoff = 10*time.Millisecond, 20*time.Millisecond
+	return notifier
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var (
+		val          = types.NewMockPV()
+		height       = int64(1)
+		stateDB      = initializeValidatorState(val, height)
+		blockStore   = &mocks.BlockStore{}
+		evidenceTime = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+		stub         = newWebhookStub(t, 0, http.StatusInternalServerError)
+		notifier     = newTestWebhookNotifier(t, stub.URL, dbm.NewMemDB())
+	)
+	blockStore.On("LoadBlockMeta", mock.AnythingOfType("int64")).Return(
+		&types.BlockMeta{Header: types.Header{Time: evidenceTime}},
+	)
+	require.NoError(t, notifier.Start())
+	t.Cleanup(func() { notifier.Stop() })
+
+	pool, err := NewPool(stateDB, dbm.NewMemDB(), blockStore, WithNotifier(notifier))
+	require.NoError(t, err)
+
+	evidence := types.NewMockDuplicateVoteEvidenceWithValidator(height, evidenceTime, val, evidenceChainID)
+	require.NoError(t, pool.AddEvidence(evidence))
+	payload := stub.receive(t)
+	assert.Equal(t, StatusPending, payload.Status)
+	assert.Equal(t, "duplicate_vote", payload.Type)
+	assert.EqualValues(t, evidence.Hash(), payload.Hash)
+	assert.EqualValues(t, evidence.Address(), payload.ValidatorAddress)
+	assert.Equal(t, height, payload.Height)
+	assert.True(t, evidenceTime.Equal(payload.Time))
+	assert.Zero(t, payload.CommitHeight)
+
+	var evpb tmproto.Evidence
+	require.NoError(t, proto.Unmarshal(payload.Evidence, &evpb))
+	ev, err := types.EvidenceFromProto(&evpb)
+	require.NoError(t, err)
+	assert.Equal(t, evidence.Hash(), ev.Hash())
+
+	pool.MarkEvidenceAsCommitted(height+1, []types.Evidence{evidence})
+	payload = stub.receive(t)
+	assert.Equal(t, StatusCommitted, payload.Status)
+	assert.EqualValues(t, evidence.Hash(), payload.Hash)
+	assert.Equal(t, height+1, payload.CommitHeight)
+}
+
+func TestWebhookNotifierOutbox(t *testing.T) {
+	var (
+		val          = types.NewMockPV()
+		evidenceTime = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+		stub         = newWebhookStub(t, 3, http.StatusInternalServerError)
+		outbox       = dbm.NewMemDB()
+	)
+
+	// the notifications are queued, then saved to the outbox by the delivery
+	// routine or when the notifier stops, and kept while it is stopped
+	notifier := newTestWebhookNotifier(t, stub.URL, outbox)
+	evList := make([]types.Evidence, 3)
+	for i := range evList {
+		evList[i] = types.NewMockDuplicateVoteEvidenceWithValidator(int64(i+1), evidenceTime, val, evidenceChainID)
+		notifier.NotifyPending(evList[i])
+	}
+	key, _, err := notifier.first()
+	require.NoError(t, err)
+	assert.Nil(t, key)
+	notifier.persist()
+
+	// and delivered in order after a restart, despite failures
+	notifier = newTestWebhookNotifier(t, stub.URL, outbox)
+	assert.EqualValues(t, 3, notifier.nextSeq)
+	require.NoError(t, notifier.Start())
+	t.Cleanup(func() { notifier.Stop() })
+	for _, ev := range evList {
+		assert.EqualValues(t, ev.Hash(), stub.receive(t).Hash)
+	}
+
+	notifier.NotifyCommitted(evList[0], 4)
+	payload := stub.receive(t)
+	assert.EqualValues(t, evList[0].Hash(), payload.Hash)
+	assert.Equal(t, StatusCommitted, payload.Status)
+	assert.Equal(t, int64(4), payload.CommitHeight)
+
+	// delivered notifications are removed from the outbox
+	assert.Eventually(t, func() bool {
+		key, _, err := notifier.first()
+		return err == nil && key == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWebhookNotifierRejected(t *testing.T) {
+	var (
+		val          = types.NewMockPV()
+		evidenceTime = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+		stub         = newWebhookStub(t, 1, http.StatusBadRequest)
+		outbox       = dbm.NewMemDB()
+	)
+
+	// the notifications beyond the size of the outbox are dropped
+	notifier := newTestWebhookNotifier(t, stub.URL, outbox)
+	notifier.maxOutbox = 2
+	evList := make([]types.Evidence, 3)
+	for i := range evList {
+		evList[i] = types.NewMockDuplicateVoteEvidenceWithValidator(int64(i+1), evidenceTime, val, evidenceChainID)
+		notifier.NotifyPending(evList[i])
+	}
+	notifier.persist()
+	assert.Equal(t, 2, notifier.outboxSize)
+
+	// and a notification rejected by the webhook isn't retried
+	notifier = newTestWebhookNotifier(t, stub.URL, outbox)
+	assert.Equal(t, 2, notifier.outboxSize)
+	require.NoError(t, notifier.Start())
+	t.Cleanup(func() { notifier.Stop() })
+	assert.EqualValues(t, evList[1].Hash(), stub.receive(t).Hash)
+	assert.Eventually(t, func() bool {
+		key, _, err := notifier.first()
+		return err == nil && key == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	select {
+	case payload := <-stub.payloads:
+		t.Fatalf("unexpected notification %v", payload)
+	default:
+	}
+}
+
+func TestWebhookNotifierQueueFull(t *testing.T) {
+	val := types.NewMockPV()
+	evidenceTime := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	notifier := newTestWebhookNotifier(t, "http://localhost", dbm.NewMemDB())
+	notifier.queue = make(chan webhookNotification, 2)
+
+	// the notifications beyond the size of the queue are dropped
+	for i := 0; i < 3; i++ {
+		ev := types.NewMockDuplicateVoteEvidenceWithValidator(int64(i+1), evidenceTime, val, evidenceChainID)
+		notifier.NotifyPending(ev)
+	}
+	assert.Len(t, notifier.queue, 2)
+
+	// and the queued ones saved in a single batch
+	notifier.persist()
+	assert.Empty(t, notifier.queue)
+	assert.Equal(t, 2, notifier.outboxSize)
+	assert.EqualValues(t, 2, notifier.nextSeq)
+}
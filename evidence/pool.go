@@ -1,6 +1,7 @@
 package evidence
 
 import (
+	"bytes"
 	"fmt"
 	"sync"
 	"time"
@@ -21,6 +22,20 @@ const (
 	baseKeyPending       = byte(0x01)
 	baseKeyPOLC          = byte(0x02)
 	baseKeyAwaitingTrial = byte(0x03)
+	baseKeyHash          = byte(0x04)
+)
+
+// Status is the status of a piece of evidence in the pool.
+type Status string
+
+const (
+	// StatusPending is the status of evidence ready to be proposed.
+	StatusPending Status = "pending"
+	// StatusAwaitingTrial is the status of potential amnesia evidence during
+	// its trial period.
+	StatusAwaitingTrial Status = "awaiting_trial"
+	// StatusCommitted is the status of evidence committed in a block.
+	StatusCommitted Status = "committed"
 )
 
 // Pool maintains a pool of valid evidence to be broadcasted and committed
@@ -43,11 +58,22 @@ type Pool struct {
 	// will have ended and we will need to then upgrade the evidence to amnesia evidence.
 	// It is set to -1 when we don't have any evidence on trial.
 	nextEvidenceTrialEndedHeight int64
+
+	metrics  *Metrics
+	notifier Notifier
+}
+
+// PoolOption sets an optional parameter on the pool.
+type PoolOption func(*Pool)
+
+// WithMetrics sets the metrics.
+func WithMetrics(metrics *Metrics) PoolOption {
+	return func(evpool *Pool) { evpool.metrics = metrics }
 }
 
 // Creates a new pool. If using an existing evidence store, it will add all pending evidence
 // to the concurrent list.
-func NewPool(stateDB, evidenceDB dbm.DB, blockStore BlockStore) (*Pool, error) {
+func NewPool(stateDB, evidenceDB dbm.DB, blockStore BlockStore, options ...PoolOption) (*Pool, error) {
 	var (
 		state = sm.LoadState(stateDB)
 	)
@@ -60,6 +86,11 @@ func NewPool(stateDB, evidenceDB dbm.DB, blockStore BlockStore) (*Pool, error) {
 		evidenceStore:                evidenceDB,
 		evidenceList:                 clist.New(),
 		nextEvidenceTrialEndedHeight: -1,
+		metrics:                      NopMetrics(),
+		notifier:                     nopNotifier{},
+	}
+	for _, option := range options {
+		option(pool)
 	}
 
 	// if pending evidence already in db, in event of prior failure, then load it back to the evidenceList
@@ -67,6 +98,7 @@ func NewPool(stateDB, evidenceDB dbm.DB, blockStore BlockStore) (*Pool, error) {
 	for _, ev := range evList {
 		pool.evidenceList.PushBack(ev)
 	}
+	pool.metrics.PendingEvidence.Set(float64(len(evList)))
 
 	return pool, nil
 }
@@ -142,7 +174,8 @@ func (evpool *Pool) AddPOLC(polc *types.ProofOfLockChange) error {
 }
 
 // AddEvidence checks the evidence is valid and adds it to the pool. If
-// evidence is composite (ConflictingHeadersEvidence), it will be broken up
+// evidence is composite (ConflictingHeadersEvidence or
+// LightClientAttackEvidence), it will be broken up
 // into smaller pieces.
 func (evpool *Pool) AddEvidence(evidence types.Evidence) error {
 	var (
@@ -167,6 +200,7 @@ func (evpool *Pool) AddEvidence(evidence types.Evidence) error {
 		}
 
 		if err := ce.VerifyComposite(&blockMeta.Header, valSet); err != nil {
+			evpool.metrics.VerificationFailures.With("type", evidenceType(evidence)).Add(1)
 			return err
 		}
 
@@ -207,6 +241,7 @@ func (evpool *Pool) AddEvidence(evidence types.Evidence) error {
 		// 1) Verify against state.
 		if err := sm.VerifyEvidence(evpool.stateDB, state, ev, header); err != nil {
 			evpool.logger.Debug("Inbound evidence is invalid", "evidence", ev, "err", err)
+			evpool.metrics.VerificationFailures.With("type", evidenceType(ev)).Add(1)
 			return types.NewErrEvidenceInvalid(ev, err)
 		}
 
@@ -233,11 +268,13 @@ func (evpool *Pool) AddEvidence(evidence types.Evidence) error {
 				aeWithoutPolc := types.NewAmnesiaEvidence(ae.PotentialAmnesiaEvidence, types.NewEmptyPOLC())
 				if evpool.IsPending(aeWithoutPolc) {
 					evpool.removePendingEvidence(aeWithoutPolc)
+					evpool.unindexEvidence(aeWithoutPolc)
 				} else if evpool.IsOnTrial(ae.PotentialAmnesiaEvidence) {
 					key := keyAwaitingTrial(ae.PotentialAmnesiaEvidence)
 					if err := evpool.evidenceStore.Delete(key); err != nil {
 						evpool.logger.Error("Failed to remove potential amnesia evidence from database", "err", err)
 					}
+					evpool.unindexEvidence(ae.PotentialAmnesiaEvidence)
 				}
 			}
 		}
@@ -251,6 +288,7 @@ func (evpool *Pool) AddEvidence(evidence types.Evidence) error {
 		evpool.evidenceList.PushBack(ev)
 
 		evpool.logger.Info("Verified new evidence of byzantine behavior", "evidence", ev)
+		evpool.notifier.NotifyPending(ev)
 	}
 
 	return nil
@@ -276,6 +314,10 @@ func (evpool *Pool) MarkEvidenceAsCommitted(height int64, evidence []types.Evide
 			// if we can't move evidence to committed then don't remove the evidence from pending
 			continue
 		}
+		if err := evpool.indexEvidence(ev); err != nil {
+			evpool.logger.Error("Unable to index committed evidence", "err", err)
+		}
+		evpool.notifier.NotifyCommitted(ev, height)
 		// if pending, remove from that bucket, remember not all evidence has been seen before
 		if evpool.IsPending(ev) {
 			evpool.removePendingEvidence(ev)
@@ -348,6 +390,67 @@ func (evpool *Pool) IsOnTrial(evidence types.Evidence) bool {
 	return ok
 }
 
+// EvidenceByHash returns the evidence with the given hash along with its status
+// and, if it is committed, the height of the block it was committed in. It
+// returns a nil evidence if the pool doesn't have it.
+func (evpool *Pool) EvidenceByHash(hash []byte) (ev types.Evidence, status Status, height int64, err error) {
+	// the keys are found from the height of the evidence, which is indexed by
+	// its hash
+	value, err := evpool.evidenceStore.Get(keyHash(hash))
+	if err != nil || value == nil {
+		return nil, "", 0, err
+	}
+	var evHeight gogotypes.Int64Value
+	if err := proto.Unmarshal(value, &evHeight); err != nil {
+		return nil, "", 0, err
+	}
+	suffix := keySuffixFromHeightAndHash(evHeight.Value, hash)
+
+	buckets := []struct {
+		prefixKey byte
+		status    Status
+	}{
+		{baseKeyPending, StatusPending},
+		{baseKeyAwaitingTrial, StatusAwaitingTrial},
+		{baseKeyCommitted, StatusCommitted},
+	}
+	for _, bucket := range buckets {
+		value, err := evpool.evidenceStore.Get(append([]byte{bucket.prefixKey}, suffix...))
+		if err != nil {
+			return nil, "", 0, err
+		}
+		if value == nil {
+			continue
+		}
+		if bucket.status != StatusCommitted {
+			var evpb tmproto.Evidence
+			if err := proto.Unmarshal(value, &evpb); err != nil {
+				return nil, "", 0, err
+			}
+			ev, err := types.EvidenceFromProto(&evpb)
+			return ev, bucket.status, 0, err
+		}
+
+		// only the height of committed evidence is stored, the evidence
+		// itself being in the block
+		var h gogotypes.Int64Value
+		if err := proto.Unmarshal(value, &h); err != nil {
+			return nil, "", 0, err
+		}
+		block := evpool.blockStore.LoadBlock(h.Value)
+		if block == nil {
+			return nil, "", 0, fmt.Errorf("don't have block #%d of committed evidence %X", h.Value, hash)
+		}
+		for _, ev := range block.Evidence.Evidence {
+			if bytes.Equal(ev.Hash(), hash) {
+				return ev, StatusCommitted, h.Value, nil
+			}
+		}
+		return nil, "", 0, fmt.Errorf("committed evidence %X is not in block #%d", hash, h.Value)
+	}
+	return nil, "", 0, nil
+}
+
 // RetrievePOLC attempts to find a polc at the given height and round, if not there than exist returns false, all
 // database errors are automatically logged
 func (evpool *Pool) RetrievePOLC(height int64, round int32) (*types.ProofOfLockChange, error) {
@@ -420,8 +523,38 @@ func (evpool *Pool) addPendingEvidence(evidence types.Evidence) error {
 	}
 
 	key := keyPending(evidence)
+	pending, err := evpool.evidenceStore.Has(key)
+	if err != nil {
+		return err
+	}
 
-	return evpool.evidenceStore.Set(key, evBytes)
+	if err := evpool.evidenceStore.Set(key, evBytes); err != nil {
+		return err
+	}
+	if err := evpool.indexEvidence(evidence); err != nil {
+		return err
+	}
+	if !pending {
+		evpool.metrics.PendingEvidence.Add(1)
+	}
+	return nil
+}
+
+// indexEvidence indexes the height of the evidence by its hash, so that
+// EvidenceByHash finds its key in the buckets.
+func (evpool *Pool) indexEvidence(evidence types.Evidence) error {
+	bz, err := proto.Marshal(&gogotypes.Int64Value{Value: evidence.Height()})
+	if err != nil {
+		return err
+	}
+	return evpool.evidenceStore.Set(keyHash(evidence.Hash()), bz)
+}
+
+// unindexEvidence removes the index of evidence no longer in any bucket.
+func (evpool *Pool) unindexEvidence(evidence types.Evidence) {
+	if err := evpool.evidenceStore.Delete(keyHash(evidence.Hash())); err != nil {
+		evpool.logger.Error("Unable to delete evidence index", "err", err)
+	}
 }
 
 func (evpool *Pool) removePendingEvidence(evidence types.Evidence) {
@@ -429,6 +562,7 @@ func (evpool *Pool) removePendingEvidence(evidence types.Evidence) {
 	if err := evpool.evidenceStore.Delete(key); err != nil {
 		evpool.logger.Error("Unable to delete pending evidence", "err", err)
 	} else {
+		evpool.metrics.PendingEvidence.Add(-1)
 		evpool.logger.Info("Deleted pending evidence", "evidence", evidence)
 	}
 }
@@ -503,6 +637,8 @@ func (evpool *Pool) removeExpiredPendingEvidence() {
 			return
 		}
 		evpool.removePendingEvidence(ev)
+		evpool.unindexEvidence(ev)
+		evpool.metrics.ExpiredEvidence.Add(1)
 		blockEvidenceMap[evMapKey(ev)] = struct{}{}
 	}
 }
@@ -602,6 +738,7 @@ func (evpool *Pool) upgradePotentialAmnesiaEvidence() int64 {
 					evpool.logger.Error("Unable to delete potential amnesia evidence", "err", err)
 					continue
 				}
+				evpool.unindexEvidence(pe)
 			} else {
 				evpool.logger.Debug("Potential amnesia evidence is not ready to be upgraded. Ready at", "height",
 					pe.HeightStamp+trialPeriod, "currentHeight", currentHeight)
@@ -675,6 +812,9 @@ func (evpool *Pool) handleInboundPotentialAmnesiaEvidence(pe *types.PotentialAmn
 		if err != nil {
 			return err
 		}
+		if err := evpool.indexEvidence(pe); err != nil {
+			return err
+		}
 		evpool.logger.Debug("Valid potential amnesia evidence has been added. Starting trial period",
 			"ev", pe)
 		// keep track of when the next pe has finished the trial period
@@ -718,6 +858,14 @@ func keyPOLCFromHeightAndRound(height int64, round int32) []byte {
 	return append([]byte{baseKeyPOLC}, []byte(fmt.Sprintf("%s/%s", bE(height), bE(int64(round))))...)
 }
 
+func keyHash(hash []byte) []byte {
+	return append([]byte{baseKeyHash}, hash...)
+}
+
 func keySuffix(evidence types.Evidence) []byte {
-	return []byte(fmt.Sprintf("%s/%X", bE(evidence.Height()), evidence.Hash()))
+	return keySuffixFromHeightAndHash(evidence.Height(), evidence.Hash())
+}
+
+func keySuffixFromHeightAndHash(height int64, hash []byte) []byte {
+	return []byte(fmt.Sprintf("%s/%X", bE(height), hash))
 }
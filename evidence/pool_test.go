@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-kit/kit/metrics/generic"
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -122,6 +123,58 @@ func TestProposingAndCommittingEvidence(t *testing.T) {
 	// evidence should
 }
 
+func TestEvidenceByHash(t *testing.T) {
+	var (
+		val          = types.NewMockPV()
+		height       = int64(1)
+		stateDB      = initializeValidatorState(val, height)
+		evidenceDB   = dbm.NewMemDB()
+		blockStore   = &mocks.BlockStore{}
+		evidenceTime = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+		pending      = generic.NewGauge("pending_evidence")
+	)
+
+	blockStore.On("LoadBlockMeta", mock.AnythingOfType("int64")).Return(
+		&types.BlockMeta{Header: types.Header{Time: evidenceTime}},
+	)
+
+	metrics := NopMetrics()
+	metrics.PendingEvidence = pending
+	pool, err := NewPool(stateDB, evidenceDB, blockStore, WithMetrics(metrics))
+	require.NoError(t, err)
+
+	evidence := types.NewMockDuplicateVoteEvidenceWithValidator(height, evidenceTime, val, evidenceChainID)
+	ev, _, _, err := pool.EvidenceByHash(evidence.Hash())
+	require.NoError(t, err)
+	assert.Nil(t, ev)
+
+	// pending
+	require.NoError(t, pool.AddEvidence(evidence))
+	assert.Equal(t, float64(1), pending.Value())
+	ev, status, evHeight, err := pool.EvidenceByHash(evidence.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, evidence, ev)
+	assert.Equal(t, StatusPending, status)
+	assert.EqualValues(t, 0, evHeight)
+
+	// a hash without index isn't searched in the buckets
+	require.NoError(t, evidenceDB.Delete(keyHash(evidence.Hash())))
+	ev, _, _, err = pool.EvidenceByHash(evidence.Hash())
+	require.NoError(t, err)
+	assert.Nil(t, ev)
+
+	// committed, the evidence being loaded from its block
+	block := types.MakeBlock(height+1, nil, nil, []types.Evidence{evidence})
+	blockStore.On("LoadBlock", height+1).Return(block)
+	pool.MarkEvidenceAsCommitted(height+1, []types.Evidence{evidence})
+	assert.Equal(t, float64(0), pending.Value())
+	ev, status, evHeight, err = pool.EvidenceByHash(evidence.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, evidence.Hash(), ev.Hash())
+	assert.Equal(t, StatusCommitted, status)
+	assert.Equal(t, height+1, evHeight)
+}
+
 func TestAddEvidence(t *testing.T) {
 	var (
 		val          = types.NewMockPV()
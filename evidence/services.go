@@ -8,4 +8,5 @@ import (
 
 type BlockStore interface {
 	LoadBlockMeta(height int64) *types.BlockMeta
+	LoadBlock(height int64) *types.Block
 }
@@ -42,6 +42,13 @@ func MarshalIndent(v interface{}, prefix, indent string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Encode writes the JSON encoding of v to w, using the same encoding as Marshal.
+// The output is written piecewise as it is produced rather than being buffered
+// in memory, so w should usually be buffered.
+func Encode(w io.Writer, v interface{}) error {
+	return encode(w, v)
+}
+
 func encode(w io.Writer, v interface{}) error {
 	// Bare nil values can't be reflected, so we must handle them here.
 	if v == nil {
@@ -2,6 +2,7 @@ package light
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -107,6 +108,14 @@ func MaxClockDrift(d time.Duration) Option {
 	}
 }
 
+// WithMetrics option sets the metrics reported by the client. Default:
+// NopMetrics().
+func WithMetrics(metrics *Metrics) Option {
+	return func(c *Client) {
+		c.metrics = metrics
+	}
+}
+
 // Client represents a light client, connected to a single chain, which gets
 // headers from a primary provider, verifies them either sequentially or by
 // skipping some and stores them in a trusted store (usually, a local FS).
@@ -127,12 +136,10 @@ type Client struct {
 	// See Witnesses option
 	witnesses []provider.Provider
 
-	// Where trusted headers are stored.
+	// Where trusted light blocks are stored.
 	trustedStore store.Store
-	// Highest trusted header from the store (height=H).
-	latestTrustedHeader *types.SignedHeader
-	// Highest validator set from the store (height=H).
-	latestTrustedVals *types.ValidatorSet
+	// Highest trusted light block from the store (height=H).
+	latestTrustedBlock *types.LightBlock
 
 	// See RemoveNoLongerTrustedHeadersPeriod option
 	pruningSize uint16
@@ -141,12 +148,13 @@ type Client struct {
 
 	quit chan struct{}
 
-	logger log.Logger
+	logger  log.Logger
+	metrics *Metrics
 }
 
 // NewClient returns a new light client. It returns an error if it fails to
-// obtain the header & vals from the primary or they are invalid (e.g. trust
-// hash does not match with the one from the header).
+// obtain the light block from the primary or it is invalid (e.g. trust hash
+// does not match with the one from the header).
 //
 // Witnesses are providers, which will be used for cross-checking the primary
 // provider. At least one witness must be given when skipping verification is
@@ -171,15 +179,15 @@ func NewClient(
 		return nil, err
 	}
 
-	if c.latestTrustedHeader != nil {
-		c.logger.Info("Checking trusted header using options")
+	if c.latestTrustedBlock != nil {
+		c.logger.Info("Checking trusted light block using options")
 		if err := c.checkTrustedHeaderUsingOptions(trustOptions); err != nil {
 			return nil, err
 		}
 	}
 
-	if c.latestTrustedHeader == nil || c.latestTrustedHeader.Height < trustOptions.Height {
-		c.logger.Info("Downloading trusted header using options")
+	if c.latestTrustedBlock == nil || c.latestTrustedBlock.Height < trustOptions.Height {
+		c.logger.Info("Downloading trusted light block using options")
 		if err := c.initializeWithTrustOptions(trustOptions); err != nil {
 			return nil, err
 		}
@@ -213,6 +221,7 @@ func NewClientFromTrustedStore(
 		confirmationFn:   func(action string) bool { return true },
 		quit:             make(chan struct{}),
 		logger:           log.NewNopLogger(),
+		metrics:          NopMetrics(),
 	}
 
 	for _, o := range options {
@@ -237,36 +246,29 @@ func NewClientFromTrustedStore(
 		return nil, err
 	}
 
-	if err := c.restoreTrustedHeaderAndVals(); err != nil {
+	if err := c.restoreTrustedLightBlock(); err != nil {
 		return nil, err
 	}
 
 	return c, nil
 }
 
-// restoreTrustedHeaderAndVals loads trustedHeader and trustedVals from
+// restoreTrustedLightBlock loads the latest trusted light block from
 // trustedStore.
-func (c *Client) restoreTrustedHeaderAndVals() error {
-	lastHeight, err := c.trustedStore.LastSignedHeaderHeight()
+func (c *Client) restoreTrustedLightBlock() error {
+	lastHeight, err := c.trustedStore.LastLightBlockHeight()
 	if err != nil {
-		return fmt.Errorf("can't get last trusted header height: %w", err)
+		return fmt.Errorf("can't get last trusted light block height: %w", err)
 	}
 
 	if lastHeight > 0 {
-		trustedHeader, err := c.trustedStore.SignedHeader(lastHeight)
+		trustedBlock, err := c.trustedStore.LightBlock(lastHeight)
 		if err != nil {
-			return fmt.Errorf("can't get last trusted header: %w", err)
+			return fmt.Errorf("can't get last trusted light block: %w", err)
 		}
-
-		trustedVals, err := c.trustedStore.ValidatorSet(lastHeight)
-		if err != nil {
-			return fmt.Errorf("can't get last trusted validators: %w", err)
-		}
-
-		c.latestTrustedHeader = trustedHeader
-		c.latestTrustedVals = trustedVals
-
-		c.logger.Info("Restored trusted header and vals", "height", lastHeight)
+		c.latestTrustedBlock = trustedBlock
+		c.metrics.LatestTrustedHeight.Set(float64(lastHeight))
+		c.logger.Info("Restored trusted light block", "height", lastHeight)
 	}
 
 	return nil
@@ -293,24 +295,24 @@ func (c *Client) restoreTrustedHeaderAndVals() error {
 func (c *Client) checkTrustedHeaderUsingOptions(options TrustOptions) error {
 	var primaryHash []byte
 	switch {
-	case options.Height > c.latestTrustedHeader.Height:
-		h, err := c.signedHeaderFromPrimary(c.latestTrustedHeader.Height)
+	case options.Height > c.latestTrustedBlock.Height:
+		l, err := c.lightBlockFromPrimary(c.latestTrustedBlock.Height)
 		if err != nil {
 			return err
 		}
-		primaryHash = h.Hash()
-	case options.Height == c.latestTrustedHeader.Height:
+		primaryHash = l.Hash()
+	case options.Height == c.latestTrustedBlock.Height:
 		primaryHash = options.Hash
-	case options.Height < c.latestTrustedHeader.Height:
+	case options.Height < c.latestTrustedBlock.Height:
 		c.logger.Info("Client initialized with old header (trusted is more recent)",
 			"old", options.Height,
-			"trustedHeight", c.latestTrustedHeader.Height,
-			"trustedHash", hash2str(c.latestTrustedHeader.Hash()))
+			"trustedHeight", c.latestTrustedBlock.Height,
+			"trustedHash", hash2str(c.latestTrustedBlock.Hash()))
 
 		action := fmt.Sprintf(
 			"Rollback to %d (%X)? Note this will remove newer headers up to %d (%X)",
 			options.Height, options.Hash,
-			c.latestTrustedHeader.Height, c.latestTrustedHeader.Hash())
+			c.latestTrustedBlock.Height, c.latestTrustedBlock.Hash())
 		if c.confirmationFn(action) {
 			// remove all the headers (options.Height, trustedHeader.Height]
 			err := c.cleanupAfter(options.Height)
@@ -327,13 +329,13 @@ func (c *Client) checkTrustedHeaderUsingOptions(options TrustOptions) error {
 		primaryHash = options.Hash
 	}
 
-	if !bytes.Equal(primaryHash, c.latestTrustedHeader.Hash()) {
+	if !bytes.Equal(primaryHash, c.latestTrustedBlock.Hash()) {
 		c.logger.Info("Prev. trusted header's hash (h1) doesn't match hash from primary provider (h2)",
-			"h1", hash2str(c.latestTrustedHeader.Hash()), "h2", hash2str(primaryHash))
+			"h1", hash2str(c.latestTrustedBlock.Hash()), "h2", hash2str(primaryHash))
 
 		action := fmt.Sprintf(
 			"Prev. trusted header's hash %X doesn't match hash %X from primary provider. Remove all the stored headers?",
-			c.latestTrustedHeader.Hash(), primaryHash)
+			c.latestTrustedBlock.Hash(), primaryHash)
 		if c.confirmationFn(action) {
 			err := c.Cleanup()
 			if err != nil {
@@ -347,47 +349,32 @@ func (c *Client) checkTrustedHeaderUsingOptions(options TrustOptions) error {
 	return nil
 }
 
-// initializeWithTrustOptions fetches the weakly-trusted header and vals from
+// initializeWithTrustOptions fetches the weakly-trusted light block from
 // primary provider.
 func (c *Client) initializeWithTrustOptions(options TrustOptions) error {
-	// 1) Fetch and verify the header.
-	h, err := c.signedHeaderFromPrimary(options.Height)
-	if err != nil {
-		return err
-	}
-
+	// 1) Fetch and verify the light block. lightBlockFromPrimary checks that
+	// the header is consistent with the validator set.
+	//
 	// NOTE: - Verify func will check if it's expired or not.
 	//       - h.Time is not being checked against time.Now() because we don't
 	//         want to add yet another argument to NewClient* functions.
-	if err := h.ValidateBasic(c.chainID); err != nil {
-		return err
-	}
-
-	if !bytes.Equal(h.Hash(), options.Hash) {
-		return fmt.Errorf("expected header's hash %X, but got %X", options.Hash, h.Hash())
-	}
-
-	// 2) Fetch and verify the vals.
-	vals, err := c.validatorSetFromPrimary(options.Height)
+	l, err := c.lightBlockFromPrimary(options.Height)
 	if err != nil {
 		return err
 	}
 
-	if !bytes.Equal(h.ValidatorsHash, vals.Hash()) {
-		return fmt.Errorf("expected header's validators (%X) to match those that were supplied (%X)",
-			h.ValidatorsHash,
-			vals.Hash(),
-		)
+	if !bytes.Equal(l.Hash(), options.Hash) {
+		return fmt.Errorf("expected header's hash %X, but got %X", options.Hash, l.Hash())
 	}
 
-	// Ensure that +2/3 of validators signed correctly.
-	err = vals.VerifyCommitLight(c.chainID, h.Commit.BlockID, h.Height, h.Commit)
+	// 2) Ensure that +2/3 of validators signed correctly.
+	err = l.ValidatorSet.VerifyCommitLight(c.chainID, l.Commit.BlockID, l.Height, l.Commit)
 	if err != nil {
 		return fmt.Errorf("invalid commit: %w", err)
 	}
 
-	// 3) Persist both of them and continue.
-	return c.updateTrustedHeaderAndVals(h, vals)
+	// 3) Persist it and continue.
+	return c.updateTrustedLightBlock(l)
 }
 
 // TrustedHeader returns a trusted header at the given height (0 - the latest).
@@ -406,11 +393,11 @@ func (c *Client) initializeWithTrustOptions(options TrustOptions) error {
 //
 // Safe for concurrent use by multiple goroutines.
 func (c *Client) TrustedHeader(height int64) (*types.SignedHeader, error) {
-	height, err := c.compareWithLatestHeight(height)
+	l, err := c.TrustedLightBlock(height)
 	if err != nil {
 		return nil, err
 	}
-	return c.trustedStore.SignedHeader(height)
+	return l.SignedHeader, nil
 }
 
 // TrustedValidatorSet returns a trusted validator set at the given height (0 -
@@ -435,11 +422,31 @@ func (c *Client) TrustedValidatorSet(height int64) (valSet *types.ValidatorSet,
 	if err != nil {
 		return nil, heightUsed, err
 	}
-	valSet, err = c.trustedStore.ValidatorSet(heightUsed)
+	l, err := c.trustedStore.LightBlock(heightUsed)
 	if err != nil {
 		return nil, heightUsed, err
 	}
-	return valSet, heightUsed, err
+	return l.ValidatorSet, heightUsed, err
+}
+
+// TrustedLightBlock returns a trusted light block, i.e. a header along with
+// the validator set which signed it, at the given height (0 - the latest).
+//
+// height must be >= 0.
+//
+// It returns an error if:
+//  - there are some issues with the trusted store, although that should not
+//  happen normally;
+//  - negative height is passed;
+//  - header has not been verified yet and is therefore not in the store
+//
+// Safe for concurrent use by multiple goroutines.
+func (c *Client) TrustedLightBlock(height int64) (*types.LightBlock, error) {
+	height, err := c.compareWithLatestHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	return c.trustedStore.LightBlock(height)
 }
 
 func (c *Client) compareWithLatestHeight(height int64) (int64, error) {
@@ -463,13 +470,13 @@ func (c *Client) compareWithLatestHeight(height int64) (int64, error) {
 	return height, nil
 }
 
-// VerifyHeaderAtHeight fetches header and validators at the given height
-// and calls VerifyHeader. It returns header immediately if such exists in
-// trustedStore (no verification is needed).
+// VerifyHeaderAtHeight fetches the light block at the given height and
+// verifies it (see VerifyHeader). It returns header immediately if such exists
+// in trustedStore (no verification is needed).
 //
 // height must be > 0.
 //
-// It returns provider.ErrSignedHeaderNotFound if header is not found by
+// It returns provider.ErrLightBlockNotFound if the light block is not found by
 // primary.
 //
 // It will replace the primary provider if an error from a request to the provider occurs
@@ -486,13 +493,13 @@ func (c *Client) VerifyHeaderAtHeight(height int64, now time.Time) (*types.Signe
 		return h, nil
 	}
 
-	// Request the header and the vals.
-	newHeader, newVals, err := c.signedHeaderAndValSetFromPrimary(height)
+	// Request the light block.
+	l, err := c.lightBlockFromPrimary(height)
 	if err != nil {
 		return nil, err
 	}
 
-	return newHeader, c.verifyHeader(newHeader, newVals, now)
+	return l.SignedHeader, c.verifyLightBlock(l, now)
 }
 
 // VerifyHeader verifies new header against the trusted state. It returns
@@ -520,9 +527,8 @@ func (c *Client) VerifyHeaderAtHeight(height int64, now time.Time) (*types.Signe
 // If the primary provides an invalid header (ErrInvalidHeader), it is rejected
 // and replaced by another provider until all are exhausted.
 //
-// If, at any moment, SignedHeader or ValidatorSet are not found by the primary
-// provider, provider.ErrSignedHeaderNotFound /
-// provider.ErrValidatorSetNotFound error is returned.
+// If, at any moment, a LightBlock is not found by the primary provider,
+// provider.ErrLightBlockNotFound error is returned.
 func (c *Client) VerifyHeader(newHeader *types.SignedHeader, newVals *types.ValidatorSet, now time.Time) error {
 	if newHeader.Height <= 0 {
 		return errors.New("negative or zero height")
@@ -540,22 +546,30 @@ func (c *Client) VerifyHeader(newHeader *types.SignedHeader, newVals *types.Vali
 		return nil
 	}
 
-	return c.verifyHeader(newHeader, newVals, now)
+	l := &types.LightBlock{SignedHeader: newHeader, ValidatorSet: newVals}
+	if err := l.ValidateBasic(c.chainID); err != nil {
+		return err
+	}
+
+	return c.verifyLightBlock(l, now)
 }
 
-func (c *Client) verifyHeader(newHeader *types.SignedHeader, newVals *types.ValidatorSet, now time.Time) error {
-	c.logger.Info("VerifyHeader", "height", newHeader.Height, "hash", hash2str(newHeader.Hash()),
-		"vals", hash2str(newVals.Hash()))
+func (c *Client) verifyLightBlock(newLightBlock *types.LightBlock, now time.Time) error {
+	var (
+		newHeader = newLightBlock.SignedHeader
+		err       error
+	)
 
-	var err error
+	c.logger.Info("VerifyHeader", "height", newHeader.Height, "hash", hash2str(newHeader.Hash()),
+		"vals", hash2str(newLightBlock.ValidatorSet.Hash()))
 
 	// 1) If going forward, perform either bisection or sequential verification.
-	if newHeader.Height >= c.latestTrustedHeader.Height {
+	if newHeader.Height >= c.latestTrustedBlock.Height {
 		switch c.verificationMode {
 		case sequential:
-			err = c.sequence(c.latestTrustedHeader, newHeader, newVals, now)
+			err = c.sequence(c.latestTrustedBlock, newLightBlock, now)
 		case skipping:
-			err = c.bisectionAgainstPrimary(c.latestTrustedHeader, c.latestTrustedVals, newHeader, newVals, now)
+			err = c.bisectionAgainstPrimary(c.latestTrustedBlock, newLightBlock, now)
 		default:
 			panic(fmt.Sprintf("Unknown verification mode: %b", c.verificationMode))
 		}
@@ -576,63 +590,59 @@ func (c *Client) verifyHeader(newHeader *types.SignedHeader, newVals *types.Vali
 				return fmt.Errorf("can't get first signed header: %w", err)
 			}
 			if HeaderExpired(closestHeader, c.trustingPeriod, now) {
-				closestHeader = c.latestTrustedHeader
+				closestHeader = c.latestTrustedBlock.SignedHeader
 			}
 			err = c.backwards(closestHeader, newHeader, now)
 		} else {
 			// 3) OR if between trusted headers where the nearest has not expired,
 			// perform bisection verification, else backwards.
-			closestHeader, err = c.trustedStore.SignedHeaderBefore(newHeader.Height)
+			var closestBlock *types.LightBlock
+			closestBlock, err = c.trustedStore.LightBlockBefore(newHeader.Height)
 			if err != nil {
-				return fmt.Errorf("can't get signed header before height %d: %w", newHeader.Height, err)
+				return fmt.Errorf("can't get light block before height %d: %w", newHeader.Height, err)
 			}
-			var closestValidatorSet *types.ValidatorSet
-			if c.verificationMode == sequential || HeaderExpired(closestHeader, c.trustingPeriod, now) {
-				err = c.backwards(c.latestTrustedHeader, newHeader, now)
+			if c.verificationMode == sequential || HeaderExpired(closestBlock.SignedHeader, c.trustingPeriod, now) {
+				err = c.backwards(c.latestTrustedBlock.SignedHeader, newHeader, now)
 			} else {
-				closestValidatorSet, _, err = c.TrustedValidatorSet(closestHeader.Height)
-				if err != nil {
-					return fmt.Errorf("can't get validator set at height %d: %w", closestHeader.Height, err)
-				}
-				err = c.bisectionAgainstPrimary(closestHeader, closestValidatorSet, newHeader, newVals, now)
+				err = c.bisectionAgainstPrimary(closestBlock, newLightBlock, now)
 			}
 		}
 	}
 	if err != nil {
 		c.logger.Error("Can't verify", "err", err)
+		c.metrics.VerificationFailures.Add(1)
 		return err
 	}
 
 	// 4) Once verified, save and return
-	return c.updateTrustedHeaderAndVals(newHeader, newVals)
+	return c.updateTrustedLightBlock(newLightBlock)
 }
 
 // see VerifyHeader
 func (c *Client) sequence(
-	initiallyTrustedHeader *types.SignedHeader,
-	newHeader *types.SignedHeader,
-	newVals *types.ValidatorSet,
+	initiallyTrustedBlock *types.LightBlock,
+	newLightBlock *types.LightBlock,
 	now time.Time) error {
 
 	var (
-		trustedHeader = initiallyTrustedHeader
+		trustedHeader = initiallyTrustedBlock.SignedHeader
+		newHeader     = newLightBlock.SignedHeader
 
-		interimHeader *types.SignedHeader
-		interimVals   *types.ValidatorSet
-
-		err error
+		interimBlock *types.LightBlock
+		err          error
 	)
 
-	for height := initiallyTrustedHeader.Height + 1; height <= newHeader.Height; height++ {
-		// 1) Fetch interim headers and vals if needed.
+	for height := initiallyTrustedBlock.Height + 1; height <= newHeader.Height; height++ {
+		// 1) Fetch interim light blocks if needed.
 		if height == newHeader.Height { // last header
-			interimHeader, interimVals = newHeader, newVals
+			interimBlock = newLightBlock
 		} else { // intermediate headers
-			interimHeader, interimVals, err = c.signedHeaderAndValSetFromPrimary(height)
+			interimBlock, err = c.lightBlockFromPrimary(height)
 			if err != nil {
 				return ErrVerificationFailed{From: trustedHeader.Height, To: height, Reason: err}
 			}
 		}
+		interimHeader := interimBlock.SignedHeader
 
 		// 2) Verify them
 		c.logger.Debug("Verify adjacent newHeader against trustedHeader",
@@ -641,7 +651,7 @@ func (c *Client) sequence(
 			"newHeight", interimHeader.Height,
 			"newHash", hash2str(interimHeader.Hash()))
 
-		err = VerifyAdjacent(c.chainID, trustedHeader, interimHeader, interimVals,
+		err = VerifyAdjacent(c.chainID, trustedHeader, interimHeader, interimBlock.ValidatorSet,
 			c.trustingPeriod, now, c.maxClockDrift)
 		if err != nil {
 			err := ErrVerificationFailed{From: trustedHeader.Height, To: interimHeader.Height, Reason: err}
@@ -664,20 +674,20 @@ func (c *Client) sequence(
 					return err
 				}
 
-				replacementHeader, replacementVals, fErr := c.signedHeaderAndValSetFromPrimary(newHeader.Height)
+				replacementBlock, fErr := c.lightBlockFromPrimary(newHeader.Height)
 				if fErr != nil {
-					c.logger.Error("Can't fetch header/vals from primary", "err", fErr)
+					c.logger.Error("Can't fetch light block from primary", "err", fErr)
 					// return original error
 					return err
 				}
 
-				if !bytes.Equal(replacementHeader.Hash(), newHeader.Hash()) ||
-					!bytes.Equal(replacementVals.Hash(), newVals.Hash()) {
-					c.logger.Error("Replacement provider has a different header/vals",
+				if !bytes.Equal(replacementBlock.Hash(), newHeader.Hash()) ||
+					!bytes.Equal(replacementBlock.ValidatorSet.Hash(), newLightBlock.ValidatorSet.Hash()) {
+					c.logger.Error("Replacement provider has a different light block",
 						"newHash", newHeader.Hash(),
-						"newVals", newVals.Hash(),
-						"replHash", replacementHeader.Hash(),
-						"replVals", replacementVals.Hash())
+						"newVals", newLightBlock.ValidatorSet.Hash(),
+						"replHash", replacementBlock.Hash(),
+						"replVals", replacementBlock.ValidatorSet.Hash())
 					// return original error
 					return err
 				}
@@ -707,33 +717,26 @@ func (c *Client) sequence(
 // client does not need to ask for all the same headers again.
 func (c *Client) bisection(
 	source provider.Provider,
-	initiallyTrustedHeader *types.SignedHeader,
-	initiallyTrustedVals *types.ValidatorSet,
-	newHeader *types.SignedHeader,
-	newVals *types.ValidatorSet,
+	initiallyTrustedBlock *types.LightBlock,
+	newLightBlock *types.LightBlock,
 	now time.Time) error {
 
-	type headerSet struct {
-		sh     *types.SignedHeader
-		valSet *types.ValidatorSet
-	}
-
 	var (
-		headerCache = []headerSet{{newHeader, newVals}}
-		depth       = 0
+		blockCache = []*types.LightBlock{newLightBlock}
+		depth      = 0
 
-		trustedHeader = initiallyTrustedHeader
-		trustedVals   = initiallyTrustedVals
+		trustedBlock = initiallyTrustedBlock
 	)
 
 	for {
 		c.logger.Debug("Verify non-adjacent newHeader against trustedHeader",
-			"trustedHeight", trustedHeader.Height,
-			"trustedHash", hash2str(trustedHeader.Hash()),
-			"newHeight", headerCache[depth].sh.Height,
-			"newHash", hash2str(headerCache[depth].sh.Hash()))
+			"trustedHeight", trustedBlock.Height,
+			"trustedHash", hash2str(trustedBlock.Hash()),
+			"newHeight", blockCache[depth].Height,
+			"newHash", hash2str(blockCache[depth].Hash()))
 
-		err := Verify(c.chainID, trustedHeader, trustedVals, headerCache[depth].sh, headerCache[depth].valSet,
+		err := Verify(c.chainID, trustedBlock.SignedHeader, trustedBlock.ValidatorSet,
+			blockCache[depth].SignedHeader, blockCache[depth].ValidatorSet,
 			c.trustingPeriod, now, c.maxClockDrift, c.trustLevel)
 		switch err.(type) {
 		case nil:
@@ -742,27 +745,27 @@ func (c *Client) bisection(
 				return nil
 			}
 			// If not, update the lower bound to the previous upper bound
-			trustedHeader, trustedVals = headerCache[depth].sh, headerCache[depth].valSet
-			// Remove the untrusted header at the lower bound in the header cache - it's no longer useful
-			headerCache = headerCache[:depth]
+			trustedBlock = blockCache[depth]
+			// Remove the untrusted header at the lower bound in the block cache - it's no longer useful
+			blockCache = blockCache[:depth]
 			// Reset the cache depth so that we start from the upper bound again
 			depth = 0
 
 		case ErrNewValSetCantBeTrusted:
-			// do add another header to the end of the cache
-			if depth == len(headerCache)-1 {
-				pivotHeight := trustedHeader.Height + (headerCache[depth].sh.Height-trustedHeader.
+			// do add another light block to the end of the cache
+			if depth == len(blockCache)-1 {
+				pivotHeight := trustedBlock.Height + (blockCache[depth].Height-trustedBlock.
 					Height)*bisectionNumerator/bisectionDenominator
-				interimHeader, interimVals, err := c.signedHeaderAndValSetFrom(pivotHeight, source)
+				interimBlock, err := c.lightBlockFrom(pivotHeight, source)
 				if err != nil {
-					return ErrVerificationFailed{From: trustedHeader.Height, To: pivotHeight, Reason: err}
+					return ErrVerificationFailed{From: trustedBlock.Height, To: pivotHeight, Reason: err}
 				}
-				headerCache = append(headerCache, headerSet{interimHeader, interimVals})
+				blockCache = append(blockCache, interimBlock)
 			}
 			depth++
 
 		default:
-			return ErrVerificationFailed{From: trustedHeader.Height, To: headerCache[depth].sh.Height, Reason: err}
+			return ErrVerificationFailed{From: trustedBlock.Height, To: blockCache[depth].Height, Reason: err}
 		}
 	}
 }
@@ -771,13 +774,16 @@ func (c *Client) bisection(
 // witnesses and replaces primary if it does not respond after
 // MaxRetryAttempts.
 func (c *Client) bisectionAgainstPrimary(
-	initiallyTrustedHeader *types.SignedHeader,
-	initiallyTrustedVals *types.ValidatorSet,
-	newHeader *types.SignedHeader,
-	newVals *types.ValidatorSet,
+	initiallyTrustedBlock *types.LightBlock,
+	newLightBlock *types.LightBlock,
 	now time.Time) error {
 
-	err := c.bisection(c.primary, initiallyTrustedHeader, initiallyTrustedVals, newHeader, newVals, now)
+	var (
+		newHeader = newLightBlock.SignedHeader
+		newVals   = newLightBlock.ValidatorSet
+	)
+
+	err := c.bisection(c.primary, initiallyTrustedBlock, newLightBlock, now)
 
 	switch errors.Unwrap(err).(type) {
 	case ErrInvalidHeader:
@@ -798,32 +804,26 @@ func (c *Client) bisectionAgainstPrimary(
 			return err
 		}
 
-		replacementHeader, replacementVals, fErr := c.signedHeaderAndValSetFromPrimary(newHeader.Height)
+		replacementBlock, fErr := c.lightBlockFromPrimary(newHeader.Height)
 		if fErr != nil {
-			c.logger.Error("Can't fetch header/vals from primary", "err", fErr)
+			c.logger.Error("Can't fetch light block from primary", "err", fErr)
 			// return original error
 			return err
 		}
 
-		if !bytes.Equal(replacementHeader.Hash(), newHeader.Hash()) ||
-			!bytes.Equal(replacementVals.Hash(), newVals.Hash()) {
-			c.logger.Error("Replacement provider has a different header/vals",
+		if !bytes.Equal(replacementBlock.Hash(), newHeader.Hash()) ||
+			!bytes.Equal(replacementBlock.ValidatorSet.Hash(), newVals.Hash()) {
+			c.logger.Error("Replacement provider has a different light block",
 				"newHash", newHeader.Hash(),
 				"newVals", newVals.Hash(),
-				"replHash", replacementHeader.Hash(),
-				"replVals", replacementVals.Hash())
+				"replHash", replacementBlock.Hash(),
+				"replVals", replacementBlock.ValidatorSet.Hash())
 			// return original error
 			return err
 		}
 
 		// attempt to verify the header again
-		return c.bisectionAgainstPrimary(
-			initiallyTrustedHeader,
-			initiallyTrustedVals,
-			replacementHeader,
-			replacementVals,
-			now,
-		)
+		return c.bisectionAgainstPrimary(initiallyTrustedBlock, replacementBlock, now)
 	case nil:
 		// Compare header with the witnesses to ensure it's not a fork.
 		// More witnesses we have, more chance to notice one.
@@ -845,7 +845,7 @@ func (c *Client) bisectionAgainstPrimary(
 //
 // Safe for concurrent use by multiple goroutines.
 func (c *Client) LastTrustedHeight() (int64, error) {
-	return c.trustedStore.LastSignedHeaderHeight()
+	return c.trustedStore.LastLightBlockHeight()
 }
 
 // FirstTrustedHeight returns a first trusted height. -1 and nil are returned if
@@ -853,7 +853,7 @@ func (c *Client) LastTrustedHeight() (int64, error) {
 //
 // Safe for concurrent use by multiple goroutines.
 func (c *Client) FirstTrustedHeight() (int64, error) {
-	return c.trustedStore.FirstSignedHeaderHeight()
+	return c.trustedStore.FirstLightBlockHeight()
 }
 
 // ChainID returns the chain ID the light client was configured with.
@@ -885,36 +885,34 @@ func (c *Client) Witnesses() []provider.Provider {
 // client must be stopped at this point.
 func (c *Client) Cleanup() error {
 	c.logger.Info("Removing all the data")
-	c.latestTrustedHeader = nil
-	c.latestTrustedVals = nil
+	c.latestTrustedBlock = nil
 	return c.trustedStore.Prune(0)
 }
 
-// cleanupAfter deletes all headers & validator sets after +height+. It also
-// resets latestTrustedHeader to the latest header.
+// cleanupAfter deletes all light blocks after +height+. It also resets
+// latestTrustedBlock to the latest light block.
 func (c *Client) cleanupAfter(height int64) error {
-	prevHeight := c.latestTrustedHeader.Height
+	prevHeight := c.latestTrustedBlock.Height
 
 	for {
-		h, err := c.trustedStore.SignedHeaderBefore(prevHeight)
-		if err == store.ErrSignedHeaderNotFound || (h != nil && h.Height <= height) {
+		l, err := c.trustedStore.LightBlockBefore(prevHeight)
+		if err == store.ErrLightBlockNotFound || (l != nil && l.Height <= height) {
 			break
 		} else if err != nil {
-			return fmt.Errorf("failed to get header before %d: %w", prevHeight, err)
+			return fmt.Errorf("failed to get light block before %d: %w", prevHeight, err)
 		}
 
-		err = c.trustedStore.DeleteSignedHeaderAndValidatorSet(h.Height)
+		err = c.trustedStore.DeleteLightBlock(l.Height)
 		if err != nil {
-			c.logger.Error("can't remove a trusted header & validator set", "err", err,
-				"height", h.Height)
+			c.logger.Error("can't remove a trusted light block", "err", err,
+				"height", l.Height)
 		}
 
-		prevHeight = h.Height
+		prevHeight = l.Height
 	}
 
-	c.latestTrustedHeader = nil
-	c.latestTrustedVals = nil
-	err := c.restoreTrustedHeaderAndVals()
+	c.latestTrustedBlock = nil
+	err := c.restoreTrustedLightBlock()
 	if err != nil {
 		return err
 	}
@@ -922,13 +920,9 @@ func (c *Client) cleanupAfter(height int64) error {
 	return nil
 }
 
-func (c *Client) updateTrustedHeaderAndVals(h *types.SignedHeader, vals *types.ValidatorSet) error {
-	if !bytes.Equal(h.ValidatorsHash, vals.Hash()) {
-		return fmt.Errorf("expected validator's hash %X, but got %X", h.ValidatorsHash, vals.Hash())
-	}
-
-	if err := c.trustedStore.SaveSignedHeaderAndValidatorSet(h, vals); err != nil {
-		return fmt.Errorf("failed to save trusted header: %w", err)
+func (c *Client) updateTrustedLightBlock(l *types.LightBlock) error {
+	if err := c.trustedStore.SaveLightBlock(l); err != nil {
+		return fmt.Errorf("failed to save trusted light block: %w", err)
 	}
 
 	if c.pruningSize > 0 {
@@ -937,66 +931,41 @@ func (c *Client) updateTrustedHeaderAndVals(h *types.SignedHeader, vals *types.V
 		}
 	}
 
-	if c.latestTrustedHeader == nil || h.Height > c.latestTrustedHeader.Height {
-		c.latestTrustedHeader = h
-		c.latestTrustedVals = vals
+	if c.latestTrustedBlock == nil || l.Height > c.latestTrustedBlock.Height {
+		c.latestTrustedBlock = l
+		c.metrics.LatestTrustedHeight.Set(float64(l.Height))
 	}
 
 	return nil
 }
 
-// 0 - latest header
-// Note it swaps the primary with a witness if primary is not responding after
-// MaxRetryAttempts.
-func (c *Client) signedHeaderAndValSetFromPrimary(height int64) (*types.SignedHeader, *types.ValidatorSet, error) {
-	h, err := c.signedHeaderFromPrimary(height)
-	if err != nil {
-		return nil, nil, fmt.Errorf("can't fetch header: %w", err)
-	}
-	vals, err := c.validatorSetFromPrimary(height)
-	if err != nil {
-		return nil, nil, fmt.Errorf("can't fetch vals: %w", err)
-	}
-	return h, vals, nil
-}
-
 // 0 - latest header
 // Note it does not do retries nor swapping.
-func (c *Client) signedHeaderAndValSetFromWitness(height int64,
-	witness provider.Provider) (*types.SignedHeader, *types.ValidatorSet, *errBadWitness) {
-
-	h, err := witness.SignedHeader(height)
+func (c *Client) lightBlockFromWitness(height int64, witness provider.Provider) (*types.LightBlock, *errBadWitness) {
+	l, err := witness.LightBlock(context.Background(), height)
 	if err != nil {
-		return nil, nil, &errBadWitness{err, noResponse, -1}
+		return nil, &errBadWitness{err, noResponse, -1}
 	}
-	err = c.validateHeader(h, height)
+	err = c.validateLightBlock(l, height)
 	if err != nil {
-		return nil, nil, &errBadWitness{err, invalidHeader, -1}
+		return nil, &errBadWitness{err, invalidLightBlock, -1}
 	}
-
-	vals, err := witness.ValidatorSet(height)
-	if err != nil {
-		return nil, nil, &errBadWitness{err, noResponse, -1}
-	}
-	err = c.validateValidatorSet(vals)
-	if err != nil {
-		return nil, nil, &errBadWitness{err, invalidValidatorSet, -1}
-	}
-
-	return h, vals, nil
+	return l, nil
 }
 
-func (c *Client) signedHeaderAndValSetFrom(height int64,
-	source provider.Provider) (*types.SignedHeader, *types.ValidatorSet, error) {
-
+func (c *Client) lightBlockFrom(height int64, source provider.Provider) (*types.LightBlock, error) {
 	c.providerMutex.Lock()
 	sourceIsPrimary := (c.primary == source)
 	c.providerMutex.Unlock()
 
 	if sourceIsPrimary {
-		return c.signedHeaderAndValSetFromPrimary(height)
+		return c.lightBlockFromPrimary(height)
 	}
-	return c.signedHeaderAndValSetFromWitness(height, source)
+	l, err := c.lightBlockFromWitness(height, source)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
 }
 
 // backwards verification (see VerifyHeaderBackwards func in the spec) verifies
@@ -1012,17 +981,14 @@ func (c *Client) backwards(
 		return ErrOldHeaderExpired{initiallyTrustedHeader.Time.Add(c.trustingPeriod), now}
 	}
 
-	var (
-		trustedHeader = initiallyTrustedHeader
-		interimHeader *types.SignedHeader
-		err           error
-	)
+	trustedHeader := initiallyTrustedHeader
 
 	for trustedHeader.Height > newHeader.Height {
-		interimHeader, err = c.signedHeaderFromPrimary(trustedHeader.Height - 1)
+		interimBlock, err := c.lightBlockFromPrimary(trustedHeader.Height - 1)
 		if err != nil {
 			return fmt.Errorf("failed to obtain the header at height #%d: %w", trustedHeader.Height-1, err)
 		}
+		interimHeader := interimBlock.SignedHeader
 		c.logger.Debug("Verify newHeader against trustedHeader",
 			"trustedHeight", trustedHeader.Height,
 			"trustedHash", hash2str(trustedHeader.Hash()),
@@ -1071,6 +1037,7 @@ func (c *Client) compareNewHeaderWithWitnesses(h *types.SignedHeader, now time.T
 		}
 
 		witnessesToRemove := make([]int, 0)
+		conflicts := make([]ErrConflictingHeaders, 0)
 
 		// handle errors as they come
 		for i := 0; i < cap(errc); i++ {
@@ -1081,18 +1048,24 @@ func (c *Client) compareNewHeaderWithWitnesses(h *types.SignedHeader, now time.T
 				headerMatched = true
 			case ErrConflictingHeaders: // fork detected
 				c.logger.Info("FORK DETECTED", "witness", e.Witness, "err", err)
-				c.sendConflictingHeadersEvidence(&types.ConflictingHeadersEvidence{H1: h, H2: e.H2})
+				conflicts = append(conflicts, e)
 				lastErrConfHeaders = e
 			case errBadWitness:
 				c.logger.Info("Bad witness", "witness", c.witnesses[e.WitnessIndex], "err", err)
-				// if witness sent us invalid header / vals, remove it
-				if e.Code == invalidHeader || e.Code == invalidValidatorSet {
-					c.logger.Info("Witness sent us invalid header / vals -> removing it", "witness", c.witnesses[e.WitnessIndex])
+				// if witness sent us an invalid light block, remove it
+				if e.Code == invalidLightBlock {
+					c.logger.Info("Witness sent us invalid light block -> removing it", "witness", c.witnesses[e.WitnessIndex])
 					witnessesToRemove = append(witnessesToRemove, e.WitnessIndex)
 				}
 			}
 		}
 
+		// Once all witnesses have responded, look for the height where each
+		// conflicting witness diverged from the primary, and report it.
+		for _, e := range conflicts {
+			c.handleConflictingHeaders(e, now)
+		}
+
 		for _, idx := range witnessesToRemove {
 			c.removeWitness(idx)
 		}
@@ -1115,19 +1088,19 @@ func (c *Client) compareNewHeaderWithWitnesses(h *types.SignedHeader, now time.T
 func (c *Client) compareNewHeaderWithWitness(errc chan error, h *types.SignedHeader,
 	witness provider.Provider, witnessIndex int, now time.Time) {
 
-	altH, altVals, err := c.signedHeaderAndValSetFromWitness(h.Height, witness)
+	altBlock, err := c.lightBlockFromWitness(h.Height, witness)
 	if err != nil {
 		err.WitnessIndex = witnessIndex
-		errc <- err
+		errc <- *err
 		return
 	}
 
-	if !bytes.Equal(h.Hash(), altH.Hash()) {
-		if bsErr := c.bisection(witness, c.latestTrustedHeader, c.latestTrustedVals, altH, altVals, now); bsErr != nil {
-			errc <- errBadWitness{bsErr, invalidHeader, witnessIndex}
+	if !bytes.Equal(h.Hash(), altBlock.Hash()) {
+		if bsErr := c.bisection(witness, c.latestTrustedBlock, altBlock, now); bsErr != nil {
+			errc <- errBadWitness{bsErr, invalidLightBlock, witnessIndex}
 			return
 		}
-		errc <- ErrConflictingHeaders{H1: h, Primary: c.primary, H2: altH, Witness: witness}
+		errc <- ErrConflictingHeaders{H1: h, Primary: c.primary, H2: altBlock.SignedHeader, Witness: witness}
 	}
 
 	errc <- nil
@@ -1144,6 +1117,7 @@ func (c *Client) removeWitness(idx int) {
 		c.witnesses[idx] = c.witnesses[len(c.witnesses)-1]
 		c.witnesses = c.witnesses[:len(c.witnesses)-1]
 	}
+	c.metrics.WitnessesRemoved.Add(1)
 }
 
 // Update attempts to advance the state by downloading the latest header and
@@ -1160,18 +1134,18 @@ func (c *Client) Update(now time.Time) (*types.SignedHeader, error) {
 		return nil, nil
 	}
 
-	latestHeader, latestVals, err := c.signedHeaderAndValSetFromPrimary(0)
+	latestBlock, err := c.lightBlockFromPrimary(0)
 	if err != nil {
 		return nil, err
 	}
 
-	if latestHeader.Height > lastTrustedHeight {
-		err = c.VerifyHeader(latestHeader, latestVals, now)
+	if latestBlock.Height > lastTrustedHeight {
+		err = c.verifyLightBlock(latestBlock, now)
 		if err != nil {
 			return nil, err
 		}
-		c.logger.Info("Advanced to new state", "height", latestHeader.Height, "hash", hash2str(latestHeader.Hash()))
-		return latestHeader, nil
+		c.logger.Info("Advanced to new state", "height", latestBlock.Height, "hash", hash2str(latestBlock.Hash()))
+		return latestBlock.SignedHeader, nil
 	}
 
 	return nil, nil
@@ -1194,31 +1168,31 @@ func (c *Client) replacePrimaryProvider() error {
 	return nil
 }
 
-// signedHeaderFromPrimary retrieves the SignedHeader from the primary provider
+// lightBlockFromPrimary retrieves the LightBlock from the primary provider
 // at the specified height. Handles dropout by the primary provider by swapping
 // with an alternative provider.
-func (c *Client) signedHeaderFromPrimary(height int64) (*types.SignedHeader, error) {
+func (c *Client) lightBlockFromPrimary(height int64) (*types.LightBlock, error) {
 	for attempt := uint16(1); attempt <= c.maxRetryAttempts; attempt++ {
 		c.providerMutex.Lock()
-		h, providerErr := c.primary.SignedHeader(height)
+		l, providerErr := c.primary.LightBlock(context.Background(), height)
 		c.providerMutex.Unlock()
 		if providerErr == nil {
-			err := c.validateHeader(h, height)
+			err := c.validateLightBlock(l, height)
 			if err != nil {
 				replaceErr := c.replacePrimaryProvider()
 				if replaceErr != nil {
 					return nil, fmt.Errorf("%v. Tried to replace primary but: %w", err.Error(), replaceErr)
 				}
-				// replace primary and request signed header again
-				return c.signedHeaderFromPrimary(height)
+				// replace primary and request light block again
+				return c.lightBlockFromPrimary(height)
 			}
-			// valid header has been received
-			return h, nil
+			// valid light block has been received
+			return l, nil
 		}
-		if providerErr == provider.ErrSignedHeaderNotFound {
+		if providerErr == provider.ErrLightBlockNotFound {
 			return nil, providerErr
 		}
-		c.logger.Error("Failed to get signed header from primary", "attempt", attempt, "err", providerErr)
+		c.logger.Error("Failed to get light block from primary", "attempt", attempt, "err", providerErr)
 		time.Sleep(backoffTimeout(attempt))
 	}
 
@@ -1227,72 +1201,31 @@ func (c *Client) signedHeaderFromPrimary(height int64) (*types.SignedHeader, err
 		return nil, fmt.Errorf("primary dropped out. Tried to replace but: %w", err)
 	}
 
-	return c.signedHeaderFromPrimary(height)
+	return c.lightBlockFromPrimary(height)
 }
 
-func (c *Client) validateHeader(h *types.SignedHeader, expectedHeight int64) error {
-	if h == nil {
-		return errors.New("nil header")
+func (c *Client) validateLightBlock(l *types.LightBlock, expectedHeight int64) error {
+	if l == nil {
+		return errors.New("nil light block")
 	}
-	err := h.ValidateBasic(c.chainID)
+	err := l.ValidateBasic(c.chainID)
 	if err != nil {
 		return err
 	}
-	if expectedHeight > 0 && h.Height != expectedHeight {
+	if expectedHeight > 0 && l.Height != expectedHeight {
 		return errors.New("height mismatch")
 	}
 	return nil
 }
 
-// validatorSetFromPrimary retrieves the ValidatorSet from the primary provider
-// at the specified height. Handles dropout by the primary provider after 5
-// attempts by replacing it with an alternative provider.
-func (c *Client) validatorSetFromPrimary(height int64) (*types.ValidatorSet, error) {
-	for attempt := uint16(1); attempt <= c.maxRetryAttempts; attempt++ {
-		c.providerMutex.Lock()
-		vals, providerErr := c.primary.ValidatorSet(height)
-		c.providerMutex.Unlock()
-		if providerErr == nil {
-			err := c.validateValidatorSet(vals)
-			if err != nil {
-				replaceErr := c.replacePrimaryProvider()
-				if replaceErr != nil {
-					return nil, fmt.Errorf("%v. Tried to replace primary but: %w", err.Error(), replaceErr)
-				}
-				// replace primary and request signed header again
-				return c.validatorSetFromPrimary(height)
-			}
-			return vals, nil
-		}
-
-		if providerErr == provider.ErrValidatorSetNotFound {
-			return vals, providerErr
-		}
-		c.logger.Error("Failed to get validator set from primary", "attempt", attempt, "err", providerErr)
-		time.Sleep(backoffTimeout(attempt))
-	}
-
-	err := c.replacePrimaryProvider()
-	if err != nil {
-		return nil, fmt.Errorf("primary dropped out. Tried to replace but: %w", err)
-	}
-
-	return c.validatorSetFromPrimary(height)
-}
-
-func (c *Client) validateValidatorSet(vals *types.ValidatorSet) error {
-	if vals == nil {
-		return errors.New("validator set is nil")
-	}
-	return vals.ValidateBasic()
-}
-
-// sendConflictingHeadersEvidence sends evidence to all witnesses and primary
-// on best effort basis.
+// sendEvidence sends evidence to all witnesses and primary on best effort
+// basis.
 //
 // Evidence needs to be submitted to all full nodes since there's no way to
 // determine which full node is correct (honest).
-func (c *Client) sendConflictingHeadersEvidence(ev *types.ConflictingHeadersEvidence) {
+//
+// NOTE: requires a providerMutex locked.
+func (c *Client) sendEvidence(ev types.Evidence) {
 	err := c.primary.ReportEvidence(ev)
 	if err != nil {
 		c.logger.Error("Failed to report evidence to primary", "ev", ev, "primary", c.primary)
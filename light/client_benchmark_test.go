@@ -1,6 +1,7 @@
 package light_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -22,7 +23,7 @@ import (
 // Remember that none of these benchmarks account for network latency.
 var (
 	benchmarkFullNode = mockp.New(GenMockNode(chainID, 1000, 100, 1, bTime))
-	genesisHeader, _  = benchmarkFullNode.SignedHeader(1)
+	genesisHeader, _  = benchmarkFullNode.LightBlock(context.Background(), 1)
 )
 
 func BenchmarkSequence(b *testing.B) {
@@ -79,7 +80,7 @@ func BenchmarkBisection(b *testing.B) {
 }
 
 func BenchmarkBackwards(b *testing.B) {
-	trustedHeader, _ := benchmarkFullNode.SignedHeader(0)
+	trustedHeader, _ := benchmarkFullNode.LightBlock(context.Background(), 0)
 	c, err := light.NewClient(
 		chainID,
 		light.TrustOptions{
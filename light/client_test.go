@@ -1,6 +1,7 @@
 package light_test
 
 import (
+	"context"
 	"sync"
 	"testing"
 	"time"
@@ -350,7 +351,7 @@ func TestClient_SkippingVerification(t *testing.T) {
 // the appropriate range
 func TestClientLargeBisectionVerification(t *testing.T) {
 	veryLargeFullNode := mockp.New(GenMockNode(chainID, 100, 3, 1, bTime))
-	h1, err := veryLargeFullNode.SignedHeader(90)
+	h1, err := veryLargeFullNode.LightBlock(context.Background(), 90)
 	require.NoError(t, err)
 	c, err := light.NewClient(
 		chainID,
@@ -367,9 +368,9 @@ func TestClientLargeBisectionVerification(t *testing.T) {
 	require.NoError(t, err)
 	h, err := c.Update(bTime.Add(100 * time.Minute))
 	assert.NoError(t, err)
-	h2, err := veryLargeFullNode.SignedHeader(100)
+	h2, err := veryLargeFullNode.LightBlock(context.Background(), 100)
 	require.NoError(t, err)
-	assert.Equal(t, h, h2)
+	assert.Equal(t, h, h2.SignedHeader)
 }
 
 func TestClientBisectionBetweenTrustedHeaders(t *testing.T) {
@@ -430,7 +431,7 @@ func TestClientRestoresTrustedHeaderAfterStartup1(t *testing.T) {
 	// 1. options.Hash == trustedHeader.Hash
 	{
 		trustedStore := dbs.New(dbm.NewMemDB(), chainID)
-		err := trustedStore.SaveSignedHeaderAndValidatorSet(h1, vals)
+		err := trustedStore.SaveLightBlock(&types.LightBlock{SignedHeader: h1, ValidatorSet: vals})
 		require.NoError(t, err)
 
 		c, err := light.NewClient(
@@ -459,7 +460,7 @@ func TestClientRestoresTrustedHeaderAfterStartup1(t *testing.T) {
 	// 2. options.Hash != trustedHeader.Hash
 	{
 		trustedStore := dbs.New(dbm.NewMemDB(), chainID)
-		err := trustedStore.SaveSignedHeaderAndValidatorSet(h1, vals)
+		err := trustedStore.SaveLightBlock(&types.LightBlock{SignedHeader: h1, ValidatorSet: vals})
 		require.NoError(t, err)
 
 		// header1 != header
@@ -509,7 +510,7 @@ func TestClientRestoresTrustedHeaderAfterStartup2(t *testing.T) {
 	// 1. options.Hash == trustedHeader.Hash
 	{
 		trustedStore := dbs.New(dbm.NewMemDB(), chainID)
-		err := trustedStore.SaveSignedHeaderAndValidatorSet(h1, vals)
+		err := trustedStore.SaveLightBlock(&types.LightBlock{SignedHeader: h1, ValidatorSet: vals})
 		require.NoError(t, err)
 
 		c, err := light.NewClient(
@@ -544,7 +545,7 @@ func TestClientRestoresTrustedHeaderAfterStartup2(t *testing.T) {
 	// This could happen if previous provider was lying to us.
 	{
 		trustedStore := dbs.New(dbm.NewMemDB(), chainID)
-		err := trustedStore.SaveSignedHeaderAndValidatorSet(h1, vals)
+		err := trustedStore.SaveLightBlock(&types.LightBlock{SignedHeader: h1, ValidatorSet: vals})
 		require.NoError(t, err)
 
 		// header1 != header
@@ -594,10 +595,10 @@ func TestClientRestoresTrustedHeaderAfterStartup3(t *testing.T) {
 	{
 		// load the first three headers into the trusted store
 		trustedStore := dbs.New(dbm.NewMemDB(), chainID)
-		err := trustedStore.SaveSignedHeaderAndValidatorSet(h1, vals)
+		err := trustedStore.SaveLightBlock(&types.LightBlock{SignedHeader: h1, ValidatorSet: vals})
 		require.NoError(t, err)
 
-		err = trustedStore.SaveSignedHeaderAndValidatorSet(h2, vals)
+		err = trustedStore.SaveLightBlock(&types.LightBlock{SignedHeader: h2, ValidatorSet: vals})
 		require.NoError(t, err)
 
 		c, err := light.NewClient(
@@ -641,7 +642,7 @@ func TestClientRestoresTrustedHeaderAfterStartup3(t *testing.T) {
 	// This could happen if previous provider was lying to us.
 	{
 		trustedStore := dbs.New(dbm.NewMemDB(), chainID)
-		err := trustedStore.SaveSignedHeaderAndValidatorSet(h1, vals)
+		err := trustedStore.SaveLightBlock(&types.LightBlock{SignedHeader: h1, ValidatorSet: vals})
 		require.NoError(t, err)
 
 		// header1 != header
@@ -650,7 +651,7 @@ func TestClientRestoresTrustedHeaderAfterStartup3(t *testing.T) {
 
 		header2 := keys.GenSignedHeader(chainID, 2, bTime.Add(2*time.Hour), nil, vals, vals,
 			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys))
-		err = trustedStore.SaveSignedHeaderAndValidatorSet(header2, vals)
+		err = trustedStore.SaveLightBlock(&types.LightBlock{SignedHeader: header2, ValidatorSet: vals})
 		require.NoError(t, err)
 
 		primary := mockp.New(
@@ -789,7 +790,7 @@ func TestClientReplacesPrimaryWithWitnessIfPrimaryIsUnavailable(t *testing.T) {
 
 func TestClient_BackwardsVerification(t *testing.T) {
 	{
-		trustHeader, _ := largeFullNode.SignedHeader(6)
+		trustHeader, _ := largeFullNode.LightBlock(context.Background(), 6)
 		c, err := light.NewClient(
 			chainID,
 			light.TrustOptions{
@@ -898,7 +899,7 @@ func TestClient_BackwardsVerification(t *testing.T) {
 func TestClient_NewClientFromTrustedStore(t *testing.T) {
 	// 1) Initiate DB and fill with a "trusted" header
 	db := dbs.New(dbm.NewMemDB(), chainID)
-	err := db.SaveSignedHeaderAndValidatorSet(h1, vals)
+	err := db.SaveLightBlock(&types.LightBlock{SignedHeader: h1, ValidatorSet: vals})
 	require.NoError(t, err)
 
 	c, err := light.NewClientFromTrustedStore(
@@ -1069,9 +1070,66 @@ func TestClientReportsConflictingHeadersEvidence(t *testing.T) {
 	}
 
 	// Check evidence was sent to both full nodes.
-	ev := &types.ConflictingHeadersEvidence{H1: h2, H2: altH2}
+	ev := types.NewLightClientAttackEvidence(h2, altH2, 1)
 	assert.True(t, fullNode2.HasEvidence(ev))
 	assert.True(t, fullNode.HasEvidence(ev))
+
+	// Check the incident was recorded.
+	incidents, err := c.Incidents()
+	require.NoError(t, err)
+	if assert.Len(t, incidents, 1) {
+		assert.Equal(t, ev, incidents[0].Evidence)
+	}
+}
+
+func TestClientFindsDivergenceHeight(t *testing.T) {
+	// fullNode2 agrees with fullNode up to height 2 and diverges from height 3.
+	altH3 := keys.GenSignedHeaderLastBlockID(chainID, 3, bTime.Add(1*time.Hour), nil, vals, vals,
+		hash("app_hash3"), hash("cons_hash"), hash("results_hash"),
+		0, len(keys), types.BlockID{Hash: h2.Hash()})
+	altH4 := keys.GenSignedHeaderLastBlockID(chainID, 4, bTime.Add(90*time.Minute), nil, vals, vals,
+		hash("app_hash4"), hash("cons_hash"), hash("results_hash"),
+		0, len(keys), types.BlockID{Hash: altH3.Hash()})
+	h4 := keys.GenSignedHeaderLastBlockID(chainID, 4, bTime.Add(90*time.Minute), nil, vals, vals,
+		hash("app_hash"), hash("cons_hash"), hash("results_hash"),
+		0, len(keys), types.BlockID{Hash: h3.Hash()})
+	primary := mockp.New(
+		chainID,
+		map[int64]*types.SignedHeader{1: h1, 2: h2, 3: h3, 4: h4},
+		map[int64]*types.ValidatorSet{1: vals, 2: vals, 3: vals, 4: vals, 5: vals},
+	)
+	witness := mockp.New(
+		chainID,
+		map[int64]*types.SignedHeader{1: h1, 2: h2, 3: altH3, 4: altH4},
+		map[int64]*types.ValidatorSet{1: vals, 2: vals, 3: vals, 4: vals, 5: vals},
+	)
+
+	c, err := light.NewClient(
+		chainID,
+		trustOptions,
+		primary,
+		[]provider.Provider{witness},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+		light.MaxRetryAttempts(1),
+		light.SkippingVerification(light.DefaultTrustLevel),
+	)
+	require.NoError(t, err)
+
+	_, err = c.VerifyHeaderAtHeight(4, bTime.Add(2*time.Hour))
+	require.Error(t, err)
+
+	ev := types.NewLightClientAttackEvidence(h3, altH3, 2)
+	assert.True(t, primary.HasEvidence(ev))
+	assert.True(t, witness.HasEvidence(ev))
+
+	incidents, err := c.Incidents()
+	require.NoError(t, err)
+	if assert.Len(t, incidents, 1) {
+		assert.Equal(t, ev, incidents[0].Evidence)
+		assert.Equal(t, []*types.SignedHeader{h2, h3, h4}, incidents[0].PrimaryTrace)
+		assert.Equal(t, []*types.SignedHeader{h2, altH3, altH4}, incidents[0].WitnessTrace)
+	}
 }
 
 func TestClientPrunesHeadersAndValidatorSets(t *testing.T) {
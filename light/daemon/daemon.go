@@ -0,0 +1,102 @@
+package daemon
+
+import (
+	"time"
+
+	"github.com/mydexchain/tendermint0/libs/service"
+	tmsync "github.com/mydexchain/tendermint0/libs/sync"
+	"github.com/mydexchain/tendermint0/light"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+// DefaultUpdatePeriod is the default interval between two attempts to advance
+// the light client to the latest header of the primary.
+const DefaultUpdatePeriod = 10 * time.Second
+
+// lightClient is the subset of light.Client used by the daemon.
+type lightClient interface {
+	ChainID() string
+	Update(now time.Time) (*types.SignedHeader, error)
+	VerifyHeaderAtHeight(height int64, now time.Time) (*types.SignedHeader, error)
+	TrustedLightBlock(height int64) (*types.LightBlock, error)
+	FirstTrustedHeight() (int64, error)
+	LastTrustedHeight() (int64, error)
+}
+
+var _ lightClient = (*light.Client)(nil)
+
+// Daemon keeps the light client following the primary in the background and
+// serves the verified headers and validator sets stored by the light client
+// over a small REST API (see Handler).
+type Daemon struct {
+	service.BaseService
+
+	client       lightClient
+	updatePeriod time.Duration
+
+	// light.Client verification is not safe for concurrent use, so both the
+	// background updates and the on-demand verifications hold this mutex.
+	verifyMtx tmsync.Mutex
+}
+
+// NewDaemon returns a new daemon, which advances lc every updatePeriod once
+// started.
+func NewDaemon(lc *light.Client, updatePeriod time.Duration) *Daemon {
+	return newDaemon(lc, updatePeriod)
+}
+
+func newDaemon(client lightClient, updatePeriod time.Duration) *Daemon {
+	d := &Daemon{
+		client:       client,
+		updatePeriod: updatePeriod,
+	}
+	d.BaseService = *service.NewBaseService(nil, "LightDaemon", d)
+	return d
+}
+
+// OnStart implements service.Service.
+func (d *Daemon) OnStart() error {
+	go d.updateRoutine()
+	return nil
+}
+
+// updateRoutine advances the light client to the latest header of the primary
+// every updatePeriod until the daemon is stopped.
+func (d *Daemon) updateRoutine() {
+	ticker := time.NewTicker(d.updatePeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			d.verifyMtx.Lock()
+			sh, err := d.client.Update(time.Now())
+			d.verifyMtx.Unlock()
+			if err != nil {
+				d.Logger.Error("Failed to update light client", "err", err)
+				continue
+			}
+			if sh != nil {
+				d.Logger.Debug("Advanced to new header", "height", sh.Height)
+			}
+		case <-d.Quit():
+			return
+		}
+	}
+}
+
+// verifiedLightBlock returns the light block at the given height, verifying
+// it first unless it is already in the trusted store.
+func (d *Daemon) verifiedLightBlock(height int64) (*types.LightBlock, error) {
+	if lb, err := d.client.TrustedLightBlock(height); err == nil {
+		return lb, nil
+	}
+
+	d.verifyMtx.Lock()
+	defer d.verifyMtx.Unlock()
+
+	if _, err := d.client.VerifyHeaderAtHeight(height, time.Now()); err != nil {
+		return nil, err
+	}
+	return d.client.TrustedLightBlock(height)
+}
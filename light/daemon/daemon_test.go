@@ -0,0 +1,196 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tmjson "github.com/mydexchain/tendermint0/libs/json"
+	tmsync "github.com/mydexchain/tendermint0/libs/sync"
+	"github.com/mydexchain/tendermint0/light/provider"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+const chainID = "test"
+
+// fakeClient trusts the light blocks in trusted and verifies the ones in
+// primary on demand.
+type fakeClient struct {
+	mtx     tmsync.Mutex
+	trusted map[int64]*types.LightBlock
+	primary map[int64]*types.LightBlock
+	updates int32
+}
+
+func (c *fakeClient) ChainID() string { return chainID }
+
+func (c *fakeClient) Update(now time.Time) (*types.SignedHeader, error) {
+	atomic.AddInt32(&c.updates, 1)
+	return nil, nil
+}
+
+func (c *fakeClient) VerifyHeaderAtHeight(height int64, now time.Time) (*types.SignedHeader, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	lb, ok := c.primary[height]
+	if !ok {
+		return nil, provider.ErrLightBlockNotFound
+	}
+	c.trusted[height] = lb
+	return lb.SignedHeader, nil
+}
+
+func (c *fakeClient) TrustedLightBlock(height int64) (*types.LightBlock, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if height == 0 {
+		for h := range c.trusted {
+			if h > height {
+				height = h
+			}
+		}
+	}
+	lb, ok := c.trusted[height]
+	if !ok {
+		return nil, errors.New("unverified header")
+	}
+	return lb, nil
+}
+
+func (c *fakeClient) FirstTrustedHeight() (int64, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	first := int64(-1)
+	for h := range c.trusted {
+		if first == -1 || h < first {
+			first = h
+		}
+	}
+	return first, nil
+}
+
+func (c *fakeClient) LastTrustedHeight() (int64, error) {
+	lb, err := c.TrustedLightBlock(0)
+	if err != nil {
+		return -1, nil
+	}
+	return lb.Height, nil
+}
+
+func lightBlock(height int64) *types.LightBlock {
+	vals, _ := types.RandValidatorSet(2, 10)
+	return &types.LightBlock{
+		SignedHeader: &types.SignedHeader{
+			Header: &types.Header{
+				ChainID:        chainID,
+				Height:         height,
+				Time:           time.Unix(height, 0).UTC(),
+				ValidatorsHash: vals.Hash(),
+			},
+			Commit: &types.Commit{Height: height},
+		},
+		ValidatorSet: vals,
+	}
+}
+
+func newTestDaemon() (*Daemon, *fakeClient) {
+	client := &fakeClient{
+		trusted: map[int64]*types.LightBlock{2: lightBlock(2), 3: lightBlock(3)},
+		primary: map[int64]*types.LightBlock{1: lightBlock(1), 4: lightBlock(4)},
+	}
+	return newDaemon(client, time.Millisecond), client
+}
+
+func get(h http.Handler, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func TestDaemon_VerifiedHeader(t *testing.T) {
+	d, client := newTestDaemon()
+	h := d.Handler()
+
+	// already trusted
+	rec := get(h, "/verified_header/2")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var sh types.SignedHeader
+	require.NoError(t, tmjson.Unmarshal(rec.Body.Bytes(), &sh))
+	assert.Equal(t, client.trusted[2].Hash(), sh.Hash())
+
+	// verified on demand
+	rec = get(h, "/verified_header/4")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, tmjson.Unmarshal(rec.Body.Bytes(), &sh))
+	assert.EqualValues(t, 4, sh.Height)
+	_, err := client.TrustedLightBlock(4)
+	assert.NoError(t, err)
+
+	// unknown to the primary
+	rec = get(h, "/verified_header/5")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	for _, path := range []string{"/verified_header/", "/verified_header/0", "/verified_header/abc"} {
+		rec = get(h, path)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, path)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/verified_header/2", strings.NewReader("")))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestDaemon_VerifiedValidators(t *testing.T) {
+	d, client := newTestDaemon()
+	h := d.Handler()
+
+	for _, height := range []int64{3, 1} {
+		rec := get(h, fmt.Sprintf("/verified_validators/%d", height))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var vals types.ValidatorSet
+		require.NoError(t, tmjson.Unmarshal(rec.Body.Bytes(), &vals))
+		assert.Equal(t, client.trusted[height].ValidatorSet.Hash(), vals.Hash())
+	}
+
+	rec := get(h, "/verified_validators/7")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestDaemon_TrustedStatus(t *testing.T) {
+	d, client := newTestDaemon()
+
+	rec := get(d.Handler(), "/trusted_status")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var status TrustedStatus
+	require.NoError(t, tmjson.Unmarshal(rec.Body.Bytes(), &status))
+	assert.Equal(t, TrustedStatus{
+		ChainID:             chainID,
+		LatestTrustedHeight: 3,
+		LatestTrustedHash:   client.trusted[3].Hash(),
+		LatestTrustedTime:   client.trusted[3].Time,
+		FirstTrustedHeight:  2,
+	}, status)
+}
+
+func TestDaemon_Updates(t *testing.T) {
+	d, client := newTestDaemon()
+
+	require.NoError(t, d.Start())
+	t.Cleanup(func() {
+		if err := d.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&client.updates) >= 2
+	}, time.Second, 5*time.Millisecond)
+}
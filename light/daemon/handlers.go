@@ -0,0 +1,130 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	tmbytes "github.com/mydexchain/tendermint0/libs/bytes"
+	tmjson "github.com/mydexchain/tendermint0/libs/json"
+	"github.com/mydexchain/tendermint0/light/provider"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+const (
+	verifiedHeaderPath     = "/verified_header/"
+	verifiedValidatorsPath = "/verified_validators/"
+	trustedStatusPath      = "/trusted_status"
+)
+
+// TrustedStatus describes the light blocks trusted by the daemon.
+type TrustedStatus struct {
+	ChainID             string           `json:"chain_id"`
+	LatestTrustedHeight int64            `json:"latest_trusted_height"`
+	LatestTrustedHash   tmbytes.HexBytes `json:"latest_trusted_hash"`
+	LatestTrustedTime   time.Time        `json:"latest_trusted_time"`
+	FirstTrustedHeight  int64            `json:"first_trusted_height"`
+}
+
+// Handler returns the REST API of the daemon:
+//
+//	GET /verified_header/{height}     - verified signed header at height
+//	GET /verified_validators/{height} - validator set which signed the header
+//	GET /trusted_status               - range of trusted heights (TrustedStatus)
+//
+// Headers, which haven't been verified yet, are verified (and stored) on
+// demand.
+func (d *Daemon) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(verifiedHeaderPath, d.handleVerifiedHeader)
+	mux.HandleFunc(verifiedValidatorsPath, d.handleVerifiedValidators)
+	mux.HandleFunc(trustedStatusPath, d.handleTrustedStatus)
+	return mux
+}
+
+func (d *Daemon) handleVerifiedHeader(w http.ResponseWriter, r *http.Request) {
+	lb, ok := d.lightBlockFromRequest(w, r, verifiedHeaderPath)
+	if !ok {
+		return
+	}
+	d.writeJSON(w, http.StatusOK, lb.SignedHeader)
+}
+
+func (d *Daemon) handleVerifiedValidators(w http.ResponseWriter, r *http.Request) {
+	lb, ok := d.lightBlockFromRequest(w, r, verifiedValidatorsPath)
+	if !ok {
+		return
+	}
+	d.writeJSON(w, http.StatusOK, lb.ValidatorSet)
+}
+
+func (d *Daemon) handleTrustedStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		d.writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	firstHeight, err := d.client.FirstTrustedHeight()
+	if err != nil {
+		d.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	latest, err := d.client.TrustedLightBlock(0)
+	if err != nil {
+		d.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	d.writeJSON(w, http.StatusOK, &TrustedStatus{
+		ChainID:             d.client.ChainID(),
+		LatestTrustedHeight: latest.Height,
+		LatestTrustedHash:   latest.Hash(),
+		LatestTrustedTime:   latest.Time,
+		FirstTrustedHeight:  firstHeight,
+	})
+}
+
+// lightBlockFromRequest parses the height from the request path and returns
+// the verified light block at that height. If it fails, the error is written
+// to w and false is returned.
+func (d *Daemon) lightBlockFromRequest(w http.ResponseWriter, r *http.Request,
+	prefix string) (*types.LightBlock, bool) {
+	if r.Method != http.MethodGet {
+		d.writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return nil, false
+	}
+
+	height, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, prefix), 10, 64)
+	if err != nil || height <= 0 {
+		d.writeError(w, http.StatusBadRequest, fmt.Errorf("expected a positive height, got %q",
+			strings.TrimPrefix(r.URL.Path, prefix)))
+		return nil, false
+	}
+
+	lb, err := d.verifiedLightBlock(height)
+	switch {
+	case errors.Is(err, provider.ErrLightBlockNotFound):
+		d.writeError(w, http.StatusNotFound, err)
+		return nil, false
+	case err != nil:
+		d.writeError(w, http.StatusInternalServerError, err)
+		return nil, false
+	}
+
+	return lb, true
+}
+
+func (d *Daemon) writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := tmjson.Encode(w, v); err != nil {
+		d.Logger.Error("Failed to write response", "err", err)
+	}
+}
+
+func (d *Daemon) writeError(w http.ResponseWriter, code int, err error) {
+	d.writeJSON(w, code, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,132 @@
+package light
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/mydexchain/tendermint0/light/provider"
+	"github.com/mydexchain/tendermint0/light/store"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+// handleConflictingHeaders handles a divergence between the primary and a
+// witness: it looks for the height where they diverged, reports the resulting
+// evidence to the primary and all witnesses and persists the incident.
+//
+// The evidence is sent to every provider, since the light client can't tell
+// which of the diverging providers is honest: full nodes on the attacked
+// chain reject it, while full nodes on the honest chain break it up into the
+// misbehavior of the validators who signed the conflicting header.
+//
+// NOTE: requires a providerMutex locked.
+func (c *Client) handleConflictingHeaders(e ErrConflictingHeaders, now time.Time) {
+	incident, err := c.examineConflictingHeaders(e.H1, e.H2, e.Witness, now)
+	if err != nil {
+		c.logger.Error("Can't examine conflicting headers", "err", err)
+		return
+	}
+	c.logger.Info("Found divergence", "ev", incident.Evidence, "primary", c.primary, "witness", e.Witness)
+
+	c.sendEvidence(incident.Evidence)
+
+	if err := c.trustedStore.SaveIncident(incident); err != nil {
+		c.logger.Error("Failed to save incident", "err", err)
+	}
+}
+
+// examineConflictingHeaders walks back from the conflicting headers h1 (from
+// the primary) and h2 (from the witness) to the latest trusted header below
+// them, bisecting to find the last height at which the primary and the witness
+// agree, and the first height at which they diverge.
+//
+// Headers on which both providers agree become the new common header as long
+// as they can be verified from the previous one. The divergence is only moved
+// down to headers from the witness which can be verified from the common
+// header, so that full nodes can attribute the conflicting header to the
+// validators at the common height.
+//
+// NOTE: requires a providerMutex locked.
+func (c *Client) examineConflictingHeaders(h1, h2 *types.SignedHeader, witness provider.Provider,
+	now time.Time) (*store.Incident, error) {
+
+	common, err := c.trustedLightBlockBefore(h1.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		primaryTrace = []*types.SignedHeader{h1}
+		witnessTrace = []*types.SignedHeader{h2}
+	)
+	for h1.Height-common.Height > 1 {
+		height := common.Height + (h1.Height-common.Height)/2
+
+		primaryBlock, errBad := c.lightBlockFromWitness(height, c.primary)
+		if errBad != nil {
+			c.logger.Info("Can't fetch light block from primary", "height", height, "err", errBad)
+			break
+		}
+		witnessBlock, errBad := c.lightBlockFromWitness(height, witness)
+		if errBad != nil {
+			c.logger.Info("Can't fetch light block from witness", "height", height, "err", errBad)
+			break
+		}
+		primaryTrace = append(primaryTrace, primaryBlock.SignedHeader)
+		witnessTrace = append(witnessTrace, witnessBlock.SignedHeader)
+
+		if bytes.Equal(primaryBlock.Hash(), witnessBlock.Hash()) {
+			err = Verify(c.chainID, common.SignedHeader, common.ValidatorSet,
+				primaryBlock.SignedHeader, primaryBlock.ValidatorSet,
+				c.trustingPeriod, now, c.maxClockDrift, c.trustLevel)
+			if err != nil {
+				c.logger.Info("Can't verify common header", "height", height, "err", err)
+				break
+			}
+			common = primaryBlock
+		} else {
+			err = Verify(c.chainID, common.SignedHeader, common.ValidatorSet,
+				witnessBlock.SignedHeader, witnessBlock.ValidatorSet,
+				c.trustingPeriod, now, c.maxClockDrift, c.trustLevel)
+			if err != nil {
+				c.logger.Info("Can't verify conflicting header", "height", height, "err", err)
+				break
+			}
+			h1, h2 = primaryBlock.SignedHeader, witnessBlock.SignedHeader
+		}
+	}
+
+	sort.Slice(primaryTrace, func(i, j int) bool { return primaryTrace[i].Height < primaryTrace[j].Height })
+	sort.Slice(witnessTrace, func(i, j int) bool { return witnessTrace[i].Height < witnessTrace[j].Height })
+
+	return &store.Incident{
+		Evidence:     types.NewLightClientAttackEvidence(h1, h2, common.Height),
+		Primary:      fmt.Sprintf("%v", c.primary),
+		Witness:      fmt.Sprintf("%v", witness),
+		PrimaryTrace: primaryTrace,
+		WitnessTrace: witnessTrace,
+		Time:         now,
+	}, nil
+}
+
+// trustedLightBlockBefore returns the latest trusted light block below the
+// given height.
+func (c *Client) trustedLightBlockBefore(height int64) (*types.LightBlock, error) {
+	if c.latestTrustedBlock.Height < height {
+		return c.latestTrustedBlock, nil
+	}
+	l, err := c.trustedStore.LightBlockBefore(height)
+	if err != nil {
+		return nil, fmt.Errorf("can't get trusted light block before %d: %w", height, err)
+	}
+	return l, nil
+}
+
+// Incidents returns the divergences between providers detected by the light
+// client, oldest first.
+//
+// Safe for concurrent use by multiple goroutines.
+func (c *Client) Incidents() ([]*store.Incident, error) {
+	return c.trustedStore.Incidents()
+}
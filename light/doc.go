@@ -45,6 +45,12 @@ This lets the light client keep track of arbitrary changes to the validator set,
 as every change to the validator set must be approved by inclusion in the
 header and signed in the commit.
 
+* LightBlock
+
+LightBlock is a SignedHeader along with the validator set which signed it.
+Providers serve, and the trusted store persists, light blocks as a unit, so
+that the header and the validator set are always consistent with each other.
+
 In the worst case, with every block changing the validators around completely,
 a light client can sync up with every block header to verify each validator set
 change on the chain. In practice, most applications will not have frequent
@@ -109,6 +115,12 @@ the light client does not need to ask for all the same headers again.
 
 refer to docs/imgs/light_client_bisection_alg.png
 
+VerifyWithResult performs the same checks as Verify, but takes the trust
+parameters explicitly (TrustParams) and describes what was checked
+(VerificationResult): the trust level reached, the voting power tallied and the
+signatures verified. It is meant for verifying headers of another chain without
+running a full light client.
+
 ## 3. Secure RPC proxy
 
 Tendermint RPC exposes a lot of info, but a malicious node could return any
@@ -1,6 +1,7 @@
 package light_test
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	stdlog "log"
@@ -39,7 +40,7 @@ func ExampleClient_Update() {
 		stdlog.Fatal(err)
 	}
 
-	header, err := primary.SignedHeader(2)
+	header, err := primary.LightBlock(context.Background(), 2)
 	if err != nil {
 		stdlog.Fatal(err)
 	}
@@ -108,7 +109,7 @@ func ExampleClient_VerifyHeaderAtHeight() {
 		stdlog.Fatal(err)
 	}
 
-	header, err := primary.SignedHeader(2)
+	header, err := primary.LightBlock(context.Background(), 2)
 	if err != nil {
 		stdlog.Fatal(err)
 	}
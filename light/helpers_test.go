@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
 	"github.com/mydexchain/tendermint0/crypto/ed25519"
 	"github.com/mydexchain/tendermint0/crypto/tmhash"
 	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
@@ -29,6 +30,16 @@ func genPrivKeys(n int) privKeys {
 	return res
 }
 
+// genBLSPrivKeys produces an array of BLS12-381 private keys, whose commits
+// can be aggregated.
+func genBLSPrivKeys(n int) privKeys {
+	res := make(privKeys, n)
+	for i := range res {
+		res[i] = bls12381.GenPrivKey()
+	}
+	return res
+}
+
 // // Change replaces the key at index i.
 // func (pkz privKeys) Change(i int) privKeys {
 // 	res := make(privKeys, len(pkz))
@@ -0,0 +1,63 @@
+package light
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "light"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Height of the latest trusted light block.
+	LatestTrustedHeight metrics.Gauge
+	// Number of light blocks that failed verification.
+	VerificationFailures metrics.Counter
+	// Number of witnesses removed for sending invalid light blocks.
+	WitnessesRemoved metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		LatestTrustedHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "latest_trusted_height",
+			Help:      "Height of the latest trusted light block.",
+		}, labels).With(labelsAndValues...),
+		VerificationFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "verification_failures",
+			Help:      "Number of light blocks that failed verification.",
+		}, labels).With(labelsAndValues...),
+		WitnessesRemoved: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "witnesses_removed",
+			Help:      "Number of witnesses removed for sending invalid light blocks.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		LatestTrustedHeight:  discard.NewGauge(),
+		VerificationFailures: discard.NewCounter(),
+		WitnessesRemoved:     discard.NewCounter(),
+	}
+}
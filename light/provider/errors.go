@@ -3,10 +3,7 @@ package provider
 import "errors"
 
 var (
-	// ErrSignedHeaderNotFound is returned when a provider can't find the
-	// requested header.
-	ErrSignedHeaderNotFound = errors.New("signed header not found")
-	// ErrValidatorSetNotFound is returned when a provider can't find the
-	// requested validator set.
-	ErrValidatorSetNotFound = errors.New("validator set not found")
+	// ErrLightBlockNotFound is returned when a provider can't find the
+	// requested header or validator set.
+	ErrLightBlockNotFound = errors.New("light block not found")
 )
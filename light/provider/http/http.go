@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -55,19 +56,42 @@ func (p *http) String() string {
 	return fmt.Sprintf("http{%s}", p.client.Remote())
 }
 
-// SignedHeader fetches a SignedHeader at the given height and checks the
-// chainID matches.
-func (p *http) SignedHeader(height int64) (*types.SignedHeader, error) {
+// LightBlock fetches a LightBlock at the given height and checks the
+// chainID matches. The validator set is requested at the height of the
+// received header, so a LightBlock for the latest height (0) is consistent
+// even if the chain advances in between.
+func (p *http) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
 	h, err := validateHeight(height)
 	if err != nil {
 		return nil, err
 	}
 
-	commit, err := p.client.Commit(h)
+	sh, err := p.signedHeader(h)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	vals, err := p.validatorSet(&sh.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.LightBlock{
+		SignedHeader: sh,
+		ValidatorSet: vals,
+	}, nil
+}
+
+func (p *http) signedHeader(height *int64) (*types.SignedHeader, error) {
+	commit, err := p.client.Commit(height)
 	if err != nil {
 		// TODO: standartise errors on the RPC side
 		if regexpMissingHeight.MatchString(err.Error()) {
-			return nil, provider.ErrSignedHeaderNotFound
+			return nil, provider.ErrLightBlockNotFound
 		}
 		return nil, err
 	}
@@ -84,20 +108,15 @@ func (p *http) SignedHeader(height int64) (*types.SignedHeader, error) {
 	return &commit.SignedHeader, nil
 }
 
-// ValidatorSet fetches a ValidatorSet at the given height. Multiple HTTP
+// validatorSet fetches a ValidatorSet at the given height. Multiple HTTP
 // requests might be required if the validator set size is over 100.
-func (p *http) ValidatorSet(height int64) (*types.ValidatorSet, error) {
-	h, err := validateHeight(height)
-	if err != nil {
-		return nil, err
-	}
-
+func (p *http) validatorSet(height *int64) (*types.ValidatorSet, error) {
 	maxPerPage := 100
-	res, err := p.client.Validators(h, nil, &maxPerPage)
+	res, err := p.client.Validators(height, nil, &maxPerPage)
 	if err != nil {
 		// TODO: standartise errors on the RPC side
 		if regexpMissingHeight.MatchString(err.Error()) {
-			return nil, provider.ErrValidatorSetNotFound
+			return nil, provider.ErrLightBlockNotFound
 		}
 		return nil, err
 	}
@@ -109,7 +128,7 @@ func (p *http) ValidatorSet(height int64) (*types.ValidatorSet, error) {
 
 	// Check if there are more validators.
 	for len(res.Validators) == maxPerPage {
-		res, err = p.client.Validators(h, &page, &maxPerPage)
+		res, err = p.client.Validators(height, &page, &maxPerPage)
 		if err != nil {
 			return nil, err
 		}
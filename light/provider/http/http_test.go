@@ -1,6 +1,7 @@
 package http_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -65,34 +66,26 @@ func TestProvider(t *testing.T) {
 	require.NoError(t, err)
 
 	// let's get the highest block
-	sh, err := p.SignedHeader(0)
+	lb, err := p.LightBlock(context.Background(), 0)
 
 	require.NoError(t, err)
-	assert.True(t, sh.Height < 1000)
+	assert.True(t, lb.Height < 1000)
 
 	// let's check this is valid somehow
-	assert.Nil(t, sh.ValidateBasic(chainID))
+	assert.Nil(t, lb.ValidateBasic(chainID))
 
 	// historical queries now work :)
-	lower := sh.Height - 3
-	sh, err = p.SignedHeader(lower)
+	lower := lb.Height - 3
+	lb, err = p.LightBlock(context.Background(), lower)
 	require.NoError(t, err)
-	assert.Equal(t, lower, sh.Height)
+	assert.Equal(t, lower, lb.Height)
 
 	// fetching missing heights (both future and pruned) should return appropriate errors
-	_, err = p.SignedHeader(1000)
+	_, err = p.LightBlock(context.Background(), 1000)
 	require.Error(t, err)
-	assert.Equal(t, provider.ErrSignedHeaderNotFound, err)
+	assert.Equal(t, provider.ErrLightBlockNotFound, err)
 
-	_, err = p.ValidatorSet(1000)
+	_, err = p.LightBlock(context.Background(), 1)
 	require.Error(t, err)
-	assert.Equal(t, provider.ErrValidatorSetNotFound, err)
-
-	_, err = p.SignedHeader(1)
-	require.Error(t, err)
-	assert.Equal(t, provider.ErrSignedHeaderNotFound, err)
-
-	_, err = p.ValidatorSet(1)
-	require.Error(t, err)
-	assert.Equal(t, provider.ErrValidatorSetNotFound, err)
+	assert.Equal(t, provider.ErrLightBlockNotFound, err)
 }
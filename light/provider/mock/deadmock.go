@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"errors"
 
 	"github.com/mydexchain/tendermint0/light/provider"
@@ -22,13 +23,10 @@ func (p *deadMock) ChainID() string { return p.chainID }
 
 func (p *deadMock) String() string { return "deadMock" }
 
-func (p *deadMock) SignedHeader(height int64) (*types.SignedHeader, error) {
+func (p *deadMock) LightBlock(_ context.Context, height int64) (*types.LightBlock, error) {
 	return nil, errNoResp
 }
 
-func (p *deadMock) ValidatorSet(height int64) (*types.ValidatorSet, error) {
-	return nil, errNoResp
-}
 func (p *deadMock) ReportEvidence(ev types.Evidence) error {
 	return errNoResp
 }
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -47,24 +48,22 @@ func (p *Mock) String() string {
 	return fmt.Sprintf("Mock{headers: %s, vals: %v}", headers.String(), vals.String())
 }
 
-func (p *Mock) SignedHeader(height int64) (*types.SignedHeader, error) {
+func (p *Mock) LightBlock(_ context.Context, height int64) (*types.LightBlock, error) {
 	if height == 0 && len(p.headers) > 0 {
-		return p.headers[int64(len(p.headers))], nil
+		height = int64(len(p.headers))
 	}
-	if _, ok := p.headers[height]; ok {
-		return p.headers[height], nil
+	sh, ok := p.headers[height]
+	if !ok {
+		return nil, provider.ErrLightBlockNotFound
 	}
-	return nil, provider.ErrSignedHeaderNotFound
-}
-
-func (p *Mock) ValidatorSet(height int64) (*types.ValidatorSet, error) {
-	if height == 0 && len(p.vals) > 0 {
-		return p.vals[int64(len(p.vals))], nil
-	}
-	if _, ok := p.vals[height]; ok {
-		return p.vals[height], nil
+	vals, ok := p.vals[height]
+	if !ok {
+		return nil, provider.ErrLightBlockNotFound
 	}
-	return nil, provider.ErrValidatorSetNotFound
+	return &types.LightBlock{
+		SignedHeader: sh,
+		ValidatorSet: vals,
+	}, nil
 }
 
 func (p *Mock) ReportEvidence(ev types.Evidence) error {
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"context"
+
 	"github.com/mydexchain/tendermint0/types"
 )
 
@@ -10,28 +12,18 @@ type Provider interface {
 	// ChainID returns the blockchain ID.
 	ChainID() string
 
-	// SignedHeader returns the SignedHeader that corresponds to the given
-	// height.
-	//
-	// 0 - the latest.
-	// height must be >= 0.
-	//
-	// If the provider fails to fetch the SignedHeader due to the IO or other
-	// issues, an error will be returned.
-	// If there's no SignedHeader for the given height, ErrSignedHeaderNotFound
-	// error is returned.
-	SignedHeader(height int64) (*types.SignedHeader, error)
-
-	// ValidatorSet returns the ValidatorSet that corresponds to height.
+	// LightBlock returns the LightBlock that corresponds to the given
+	// height, i.e. the SignedHeader and the ValidatorSet at that height,
+	// fetched together so that they are consistent with each other.
 	//
 	// 0 - the latest.
 	// height must be >= 0.
 	//
-	// If the provider fails to fetch the ValidatorSet due to the IO or other
+	// If the provider fails to fetch the LightBlock due to the IO or other
 	// issues, an error will be returned.
-	// If there's no ValidatorSet for the given height, ErrValidatorSetNotFound
+	// If there's no LightBlock for the given height, ErrLightBlockNotFound
 	// error is returned.
-	ValidatorSet(height int64) (*types.ValidatorSet, error)
+	LightBlock(ctx context.Context, height int64) (*types.LightBlock, error)
 
 	// ReportEvidence reports an evidence of misbehavior.
 	ReportEvidence(ev types.Evidence) error
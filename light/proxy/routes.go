@@ -46,6 +46,9 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 
 		// evidence API
 		"broadcast_evidence": rpcserver.NewRPCFunc(makeBroadcastEvidenceFunc(c), "evidence"),
+		"pending_evidence":   rpcserver.NewRPCFunc(makePendingEvidenceFunc(c), "limit"),
+		"evidence":           rpcserver.NewRPCFunc(makeEvidenceFunc(c), "hash"),
+		"committed_evidence": rpcserver.NewRPCFunc(makeCommittedEvidenceFunc(c), "height"),
 	}
 }
 
@@ -237,3 +240,27 @@ func makeBroadcastEvidenceFunc(c *lrpc.Client) rpcBroadcastEvidenceFunc {
 		return c.BroadcastEvidence(ev)
 	}
 }
+
+type rpcPendingEvidenceFunc func(ctx *rpctypes.Context, limit *int) (*ctypes.ResultPendingEvidence, error)
+
+func makePendingEvidenceFunc(c *lrpc.Client) rpcPendingEvidenceFunc {
+	return func(ctx *rpctypes.Context, limit *int) (*ctypes.ResultPendingEvidence, error) {
+		return c.PendingEvidence(limit)
+	}
+}
+
+type rpcEvidenceFunc func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultEvidence, error)
+
+func makeEvidenceFunc(c *lrpc.Client) rpcEvidenceFunc {
+	return func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultEvidence, error) {
+		return c.Evidence(hash)
+	}
+}
+
+type rpcCommittedEvidenceFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultCommittedEvidence, error)
+
+func makeCommittedEvidenceFunc(c *lrpc.Client) rpcCommittedEvidenceFunc {
+	return func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultCommittedEvidence, error) {
+		return c.CommittedEvidence(height)
+	}
+}
@@ -8,8 +8,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/gogo/protobuf/proto"
-
 	abci "github.com/mydexchain/tendermint0/abci/types"
 	"github.com/mydexchain/tendermint0/crypto/merkle"
 	tmbytes "github.com/mydexchain/tendermint0/libs/bytes"
@@ -23,20 +21,31 @@ import (
 
 var errNegOrZeroHeight = errors.New("negative or zero height")
 
+//go:generate mockery -case underscore -name LightClient
+
+// LightClient is the light client functionality used by Client to verify data.
+type LightClient interface {
+	ChainID() string
+	TrustedHeader(height int64) (*types.SignedHeader, error)
+	VerifyHeaderAtHeight(height int64, now time.Time) (*types.SignedHeader, error)
+}
+
+var _ LightClient = (*light.Client)(nil)
+
 // Client is an RPC client, which uses light#Client to verify data (if it can be
 // proved!).
 type Client struct {
 	service.BaseService
 
 	next rpcclient.Client
-	lc   *light.Client
+	lc   LightClient
 	prt  *merkle.ProofRuntime
 }
 
 var _ rpcclient.Client = (*Client)(nil)
 
 // NewClient returns a new client.
-func NewClient(next rpcclient.Client, lc *light.Client) *Client {
+func NewClient(next rpcclient.Client, lc LightClient) *Client {
 	c := &Client{
 		next: next,
 		lc:   lc,
@@ -100,17 +109,18 @@ func (c *Client) ABCIQueryWithOptions(path string, data tmbytes.HexBytes,
 		return nil, err
 	}
 
+	// XXX How do we encode the key into a string...
+	storeName, err := parseQueryStorePath(path)
+	if err != nil {
+		return nil, err
+	}
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
+
 	// Validate the value proof against the trusted header.
 	if resp.Value != nil {
 		// Value exists
-		// XXX How do we encode the key into a string...
-		storeName, err := parseQueryStorePath(path)
-		if err != nil {
-			return nil, err
-		}
-		kp := merkle.KeyPath{}
-		kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
-		kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
 		err = c.prt.VerifyValue(resp.ProofOps, h.AppHash, kp.String(), resp.Value)
 		if err != nil {
 			return nil, fmt.Errorf("verify value proof: %w", err)
@@ -118,9 +128,8 @@ func (c *Client) ABCIQueryWithOptions(path string, data tmbytes.HexBytes,
 		return &ctypes.ResultABCIQuery{Response: resp}, nil
 	}
 
-	// OR validate the ansence proof against the trusted header.
-	// XXX How do we encode the key into a string...
-	err = c.prt.VerifyAbsence(resp.ProofOps, h.AppHash, string(resp.Key))
+	// OR validate the absence proof against the trusted header.
+	err = c.prt.VerifyAbsence(resp.ProofOps, h.AppHash, kp.String())
 	if err != nil {
 		return nil, fmt.Errorf("verify absence proof: %w", err)
 	}
@@ -311,6 +320,11 @@ func (c *Client) BlockByHash(hash []byte) (*ctypes.ResultBlock, error) {
 
 // BlockResults returns the block results for the given height. If no height is
 // provided, the results of the block preceding the latest are returned.
+//
+// The tx results are verified against LastResultsHash of the next header. Only
+// the deterministic fields (code, data, gas wanted and gas used) are covered
+// by the hash, so the events and the validator and consensus params updates
+// are returned as is.
 func (c *Client) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
 	var h int64
 	if height == nil {
@@ -334,39 +348,21 @@ func (c *Client) BlockResults(height *int64) (*ctypes.ResultBlockResults, error)
 	if res.Height <= 0 {
 		return nil, errNegOrZeroHeight
 	}
+	if res.Height != h {
+		return nil, fmt.Errorf("expected results for height %d, got %d", h, res.Height)
+	}
 
 	// Update the light client if we're behind.
+	// NOTE: LastResultsHash for height H is in header H+1.
 	trustedHeader, err := c.updateLightClientIfNeededTo(h + 1)
 	if err != nil {
 		return nil, err
 	}
 
-	// proto-encode BeginBlock events
-	bbeBytes, err := proto.Marshal(&abci.ResponseBeginBlock{
-		Events: res.BeginBlockEvents,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	// Build a Merkle tree of proto-encoded DeliverTx results and get a hash.
-	results := types.NewResults(res.TxsResults)
-
-	// proto-encode EndBlock events.
-	ebeBytes, err := proto.Marshal(&abci.ResponseEndBlock{
-		Events: res.EndBlockEvents,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	// Build a Merkle tree out of the above 3 binary slices.
-	rH := merkle.HashFromByteSlices([][]byte{bbeBytes, results.Hash(), ebeBytes})
-
 	// Verify block results.
-	if !bytes.Equal(rH, trustedHeader.LastResultsHash) {
+	if rH, tH := types.NewResults(res.TxsResults).Hash(), trustedHeader.LastResultsHash; !bytes.Equal(rH, tH) {
 		return nil, fmt.Errorf("last results %X does not match with trusted last results %X",
-			rH, trustedHeader.LastResultsHash)
+			rH, tH)
 	}
 
 	return res, nil
@@ -401,32 +397,195 @@ func (c *Client) Commit(height *int64) (*ctypes.ResultCommit, error) {
 	return res, nil
 }
 
-// Tx calls rpcclient#Tx method and then verifies the proof if such was
-// requested.
+// Tx calls rpcclient#Tx method and then verifies both the tx and its result.
+// Proofs are always requested from the full node, but only returned if prove
+// is true.
 func (c *Client) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
-	res, err := c.next.Tx(hash, prove)
-	if err != nil || !prove {
-		return res, err
+	res, err := c.next.Tx(hash, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(res.Hash, hash) {
+		return nil, fmt.Errorf("expected tx %X, got %X", hash, res.Hash)
+	}
+	if err := c.verifyTx(res); err != nil {
+		return nil, err
+	}
+
+	if !prove {
+		stripProofs(res)
+	}
+	return res, nil
+}
+
+// TxSearch calls rpcclient#TxSearch method and then verifies every tx and its
+// result. Proofs are always requested from the full node, but only returned if
+// prove is true.
+func (c *Client) TxSearch(query string, prove bool, page, perPage *int, orderBy string) (
+	*ctypes.ResultTxSearch, error) {
+	res, err := c.next.TxSearch(query, true, page, perPage, orderBy)
+	if err != nil {
+		return nil, err
 	}
 
+	// Txs found at the same height are verified together, with a single proof
+	// of the txs and of their results.
+	provenTxs := make(map[int64][]*ctypes.ResultTx, len(res.Proofs))
+	for i, proof := range res.Proofs {
+		if proof == nil {
+			return nil, fmt.Errorf("nil proof %d", i)
+		}
+		if _, ok := provenTxs[proof.Height]; ok {
+			return nil, fmt.Errorf("duplicate proof for height %d", proof.Height)
+		}
+		provenTxs[proof.Height] = nil
+	}
+	for i, tx := range res.Txs {
+		if tx == nil {
+			return nil, fmt.Errorf("nil tx %d", i)
+		}
+		if txs, ok := provenTxs[tx.Height]; ok {
+			provenTxs[tx.Height] = append(txs, tx)
+			continue
+		}
+		if err := c.verifyTx(tx); err != nil {
+			return nil, fmt.Errorf("tx %d (%X): %w", i, tx.Hash, err)
+		}
+	}
+	for _, proof := range res.Proofs {
+		if err := c.verifyTxs(provenTxs[proof.Height], proof); err != nil {
+			return nil, fmt.Errorf("txs at height %d: %w", proof.Height, err)
+		}
+	}
+
+	if !prove {
+		for _, tx := range res.Txs {
+			stripProofs(tx)
+		}
+		res.Proofs = nil
+	}
+	return res, nil
+}
+
+// verifyTx verifies the tx against DataHash of the header at the tx's height
+// and its result against LastResultsHash of the next header.
+func (c *Client) verifyTx(res *ctypes.ResultTx) error {
 	// Validate res.
 	if res.Height <= 0 {
-		return nil, errNegOrZeroHeight
+		return errNegOrZeroHeight
+	}
+	if tH := res.Tx.Hash(); !bytes.Equal(res.Hash, tH) {
+		return fmt.Errorf("hash %X does not match with tx hash %X", res.Hash, tH)
+	}
+	if !bytes.Equal(res.Proof.Data, res.Tx) {
+		return errors.New("proof is for a different tx")
+	}
+	if idx := int64(res.Index); res.Proof.Proof.Index != idx || res.ResultProof.Index != idx {
+		return fmt.Errorf("proofs are not for tx at index %d", res.Index)
 	}
 
 	// Update the light client if we're behind.
 	h, err := c.updateLightClientIfNeededTo(res.Height)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	// Validate the tx proof.
+	if err := res.Proof.Validate(h.DataHash); err != nil {
+		return fmt.Errorf("verify tx proof: %w", err)
+	}
+
+	// NOTE: LastResultsHash for height H is in header H+1.
+	nh, err := c.updateLightClientIfNeededTo(res.Height + 1)
+	if err != nil {
+		return err
+	}
+
+	// Validate the result proof.
+	if err := types.VerifyResult(nh.LastResultsHash, &res.TxResult, res.ResultProof); err != nil {
+		return fmt.Errorf("verify result proof: %w", err)
 	}
 
-	// Validate the proof.
-	return res, res.Proof.Validate(h.DataHash)
+	return nil
 }
 
-func (c *Client) TxSearch(query string, prove bool, page, perPage *int, orderBy string) (
-	*ctypes.ResultTxSearch, error) {
-	return c.next.TxSearch(query, prove, page, perPage, orderBy)
+// verifyTxs verifies the txs found at the same height against DataHash of the
+// header at that height and their results against LastResultsHash of the next
+// header, using the proof of all of them.
+func (c *Client) verifyTxs(txs []*ctypes.ResultTx, proof *ctypes.ResultTxsProof) error {
+	// Validate proof.
+	if proof.Height <= 0 {
+		return errNegOrZeroHeight
+	}
+	indices := proof.Proof.Proof.Indices
+	if len(txs) != len(indices) || len(proof.Proof.Data) != len(indices) {
+		return fmt.Errorf("proof is for %d txs, got %d", len(indices), len(txs))
+	}
+	if !equalIndices(proof.ResultProof.Indices, indices) {
+		return errors.New("proofs are not for the same txs")
+	}
+
+	// Order the results as the proven txs.
+	positions := make(map[int64]int, len(indices))
+	for i, index := range indices {
+		positions[index] = i
+	}
+	results := make([]*abci.ResponseDeliverTx, len(indices))
+	for _, tx := range txs {
+		if tH := tx.Tx.Hash(); !bytes.Equal(tx.Hash, tH) {
+			return fmt.Errorf("hash %X does not match with tx hash %X", tx.Hash, tH)
+		}
+		i, ok := positions[int64(tx.Index)]
+		if !ok || results[i] != nil {
+			return fmt.Errorf("proof is not for tx at index %d", tx.Index)
+		}
+		if !bytes.Equal(proof.Proof.Data[i], tx.Tx) {
+			return fmt.Errorf("proof is for a different tx at index %d", tx.Index)
+		}
+		results[i] = &tx.TxResult
+	}
+
+	// Update the light client if we're behind.
+	h, err := c.updateLightClientIfNeededTo(proof.Height)
+	if err != nil {
+		return err
+	}
+
+	// Validate the txs proof.
+	if err := proof.Proof.Validate(h.DataHash); err != nil {
+		return fmt.Errorf("verify txs proof: %w", err)
+	}
+
+	// NOTE: LastResultsHash for height H is in header H+1.
+	nh, err := c.updateLightClientIfNeededTo(proof.Height + 1)
+	if err != nil {
+		return err
+	}
+
+	// Validate the results proof.
+	if err := types.VerifyResults(nh.LastResultsHash, results, proof.ResultProof); err != nil {
+		return fmt.Errorf("verify results proof: %w", err)
+	}
+
+	return nil
+}
+
+func equalIndices(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func stripProofs(res *ctypes.ResultTx) {
+	res.Proof = types.TxProof{}
+	res.ResultProof = merkle.Proof{}
 }
 
 // Validators fetches and verifies validators.
@@ -482,9 +641,81 @@ func (c *Client) BroadcastEvidence(ev types.Evidence) (*ctypes.ResultBroadcastEv
 	return c.next.BroadcastEvidence(ev)
 }
 
+// PendingEvidence calls rpcclient#PendingEvidence. Pending evidence is not
+// part of any block and thus can't be verified.
+func (c *Client) PendingEvidence(limit *int) (*ctypes.ResultPendingEvidence, error) {
+	return c.next.PendingEvidence(limit)
+}
+
+// Evidence calls rpcclient#Evidence and, if the evidence is committed,
+// verifies it is part of the evidence of its block.
+func (c *Client) Evidence(hash []byte) (*ctypes.ResultEvidence, error) {
+	res, err := c.next.Evidence(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if res.Evidence == nil {
+		return nil, errors.New("empty evidence")
+	}
+	if !bytes.Equal(res.Evidence.Hash(), hash) {
+		return nil, fmt.Errorf("evidence hash %X does not match with requested hash %X", res.Evidence.Hash(), hash)
+	}
+	if res.Height == 0 {
+		return res, nil
+	}
+
+	// Verify the evidence is in its block.
+	committed, err := c.CommittedEvidence(&res.Height)
+	if err != nil {
+		return nil, err
+	}
+	for _, ev := range committed.Evidence {
+		if bytes.Equal(ev.Hash(), hash) {
+			return res, nil
+		}
+	}
+	return nil, fmt.Errorf("evidence %X is not in block #%d", hash, res.Height)
+}
+
+// CommittedEvidence calls rpcclient#CommittedEvidence and then verifies the
+// result against the evidence hash of the header.
+func (c *Client) CommittedEvidence(height *int64) (*ctypes.ResultCommittedEvidence, error) {
+	res, err := c.next.CommittedEvidence(height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if res.Height <= 0 {
+		return nil, errNegOrZeroHeight
+	}
+
+	// Update the light client if we're behind.
+	h, err := c.updateLightClientIfNeededTo(res.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify evidence.
+	if eH := types.EvidenceList(res.Evidence).Hash(); !bytes.Equal(eH, h.EvidenceHash) {
+		return nil, fmt.Errorf("evidence hash %X does not match with trusted evidence hash %X",
+			eH, h.EvidenceHash)
+	}
+
+	return res, nil
+}
+
+// Subscribe calls rpcclient#Subscribe and verifies the events carrying a
+// header before passing them on. Events failing verification are dropped.
 func (c *Client) Subscribe(ctx context.Context, subscriber, query string,
 	outCapacity ...int) (out <-chan ctypes.ResultEvent, err error) {
-	return c.next.Subscribe(ctx, subscriber, query, outCapacity...)
+	in, err := c.next.Subscribe(ctx, subscriber, query, outCapacity...)
+	if err != nil {
+		return nil, err
+	}
+	return c.verifiedEvents(in, cap(in)), nil
 }
 
 func (c *Client) Unsubscribe(ctx context.Context, subscriber, query string) error {
@@ -495,6 +726,74 @@ func (c *Client) UnsubscribeAll(ctx context.Context, subscriber string) error {
 	return c.next.UnsubscribeAll(ctx, subscriber)
 }
 
+// verifiedEvents returns a channel with the events read from in, which either
+// don't carry a header or carry one matching the trusted header at the same
+// height. The returned channel is closed when in is closed.
+func (c *Client) verifiedEvents(in <-chan ctypes.ResultEvent, capacity int) <-chan ctypes.ResultEvent {
+	out := make(chan ctypes.ResultEvent, capacity)
+
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case resultEvent, ok := <-in:
+				if !ok {
+					return
+				}
+				if err := c.verifyEvent(resultEvent); err != nil {
+					c.Logger.Error("Dropping event that failed verification", "query", resultEvent.Query, "err", err)
+					continue
+				}
+				select {
+				case out <- resultEvent:
+				case <-c.Quit():
+					return
+				}
+			case <-c.Quit():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// verifyEvent verifies the header or the block carried by the event against
+// the trusted header at the same height. Other events are not verified.
+func (c *Client) verifyEvent(resultEvent ctypes.ResultEvent) error {
+	var (
+		height int64
+		hash   tmbytes.HexBytes
+	)
+	switch data := resultEvent.Data.(type) {
+	case types.EventDataNewBlock:
+		if data.Block == nil {
+			return errors.New("nil block")
+		}
+		height, hash = data.Block.Height, data.Block.Hash()
+	case types.EventDataNewBlockHeader:
+		height, hash = data.Header.Height, data.Header.Hash()
+	default:
+		return nil
+	}
+
+	if height <= 0 {
+		return errNegOrZeroHeight
+	}
+
+	// Update the light client if we're behind.
+	h, err := c.updateLightClientIfNeededTo(height)
+	if err != nil {
+		return err
+	}
+
+	if tH := h.Hash(); !bytes.Equal(hash, tH) {
+		return fmt.Errorf("header %X does not match with trusted header %X", hash, tH)
+	}
+
+	return nil
+}
+
 func (c *Client) updateLightClientIfNeededTo(height int64) (*types.SignedHeader, error) {
 	h, err := c.lc.VerifyHeaderAtHeight(height, time.Now())
 	if err != nil {
@@ -508,10 +807,10 @@ func (c *Client) RegisterOpDecoder(typ string, dec merkle.OpDecoder) {
 }
 
 // SubscribeWS subscribes for events using the given query and remote address as
-// a subscriber, but does not verify responses (UNSAFE)!
-// TODO: verify data
+// a subscriber. Events carrying a header are verified (see Subscribe), other
+// events are passed on as is.
 func (c *Client) SubscribeWS(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, error) {
-	out, err := c.next.Subscribe(context.Background(), ctx.RemoteAddr(), query)
+	out, err := c.Subscribe(context.Background(), ctx.RemoteAddr(), query)
 	if err != nil {
 		return nil, err
 	}
@@ -519,9 +818,10 @@ func (c *Client) SubscribeWS(ctx *rpctypes.Context, query string) (*ctypes.Resul
 	go func() {
 		for {
 			select {
-			case resultEvent := <-out:
-				// We should have a switch here that performs a validation
-				// depending on the event's type.
+			case resultEvent, ok := <-out:
+				if !ok {
+					return
+				}
 				ctx.WSConn.TryWriteRPCResponse(
 					rpctypes.NewRPCSuccessResponse(
 						rpctypes.JSONRPCStringID(fmt.Sprintf("%v#event", ctx.JSONReq.ID)),
@@ -0,0 +1,360 @@
+package rpc
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/mydexchain/tendermint0/abci/types"
+	"github.com/mydexchain/tendermint0/crypto/merkle"
+	"github.com/mydexchain/tendermint0/crypto/tmhash"
+	tmbytes "github.com/mydexchain/tendermint0/libs/bytes"
+	lcmock "github.com/mydexchain/tendermint0/light/rpc/mocks"
+	tmcrypto "github.com/mydexchain/tendermint0/proto/tendermint/crypto"
+	rpcclient "github.com/mydexchain/tendermint0/rpc/client"
+	ctypes "github.com/mydexchain/tendermint0/rpc/core/types"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+// The txs of the block at height 2, and their results.
+var (
+	testTxs       = types.Txs{types.Tx("a=1"), types.Tx("b=2"), types.Tx("c=3")}
+	testResponses = []*abci.ResponseDeliverTx{
+		{Code: 0, Data: []byte("a"), GasWanted: 1, GasUsed: 1},
+		{Code: 1, Data: []byte("b"), GasWanted: 2, GasUsed: 2},
+		{Code: 0, Data: []byte("c"), GasWanted: 3, GasUsed: 3},
+	}
+	testResults = types.NewResults(testResponses)
+)
+
+// rpcClient mocks the RPC calls whose results are verified by Client.
+type rpcClient struct {
+	mock.Mock
+	rpcclient.Client
+}
+
+func (c *rpcClient) ABCIQueryWithOptions(path string, data tmbytes.HexBytes,
+	opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	args := c.Called(path, data, opts)
+	res, _ := args.Get(0).(*ctypes.ResultABCIQuery)
+	return res, args.Error(1)
+}
+
+func (c *rpcClient) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	args := c.Called(height)
+	res, _ := args.Get(0).(*ctypes.ResultBlockResults)
+	return res, args.Error(1)
+}
+
+func (c *rpcClient) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
+	args := c.Called(hash, prove)
+	res, _ := args.Get(0).(*ctypes.ResultTx)
+	return res, args.Error(1)
+}
+
+func (c *rpcClient) TxSearch(query string, prove bool, page, perPage *int, orderBy string) (
+	*ctypes.ResultTxSearch, error) {
+	args := c.Called(query, prove, page, perPage, orderBy)
+	res, _ := args.Get(0).(*ctypes.ResultTxSearch)
+	return res, args.Error(1)
+}
+
+// newTestClient returns a client verifying the data returned by next against the trusted headers at
+// heights 2 and 3, which hold the hashes of testTxs and testResults.
+func newTestClient(next *rpcClient) *Client {
+	validatorsHash := tmhash.Sum([]byte("validators"))
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyHeaderAtHeight", int64(2), mock.Anything).Return(&types.SignedHeader{Header: &types.Header{
+		Height: 2, DataHash: testTxs.Hash(), ValidatorsHash: validatorsHash}}, nil)
+	lc.On("VerifyHeaderAtHeight", int64(3), mock.Anything).Return(&types.SignedHeader{Header: &types.Header{
+		Height: 3, LastResultsHash: testResults.Hash(), ValidatorsHash: validatorsHash}}, nil)
+	lc.On("VerifyHeaderAtHeight", mock.Anything, mock.Anything).Return(nil, errors.New("no trusted header"))
+	return NewClient(next, lc)
+}
+
+// makeResultTx returns the tx at index i of testTxs, with its proofs.
+func makeResultTx(i int) *ctypes.ResultTx {
+	return &ctypes.ResultTx{
+		Hash:        testTxs[i].Hash(),
+		Height:      2,
+		Index:       uint32(i),
+		TxResult:    *testResponses[i],
+		Tx:          testTxs[i],
+		Proof:       testTxs.Proof(i),
+		ResultProof: testResults.ProveResult(i),
+	}
+}
+
+// makeResultTxsProof returns the proof of the txs at the given indices of testTxs.
+func makeResultTxsProof(t *testing.T, indices ...int64) *ctypes.ResultTxsProof {
+	proof, err := testTxs.MultiProof(indices)
+	require.NoError(t, err)
+	resultProof, err := testResults.ProveResults(indices)
+	require.NoError(t, err)
+	return &ctypes.ResultTxsProof{Height: 2, Proof: proof, ResultProof: resultProof}
+}
+
+func TestClient_ABCIQueryAbsence(t *testing.T) {
+	store := map[string][]byte{"b": []byte("2"), "d": []byte("4")}
+	storeRoot, absenceOp, err := merkle.AbsenceOpFromMap(store, []byte("c"))
+	require.NoError(t, err)
+
+	// the app hash is the root of a tree holding the root of the kv store
+	leaf := append([]byte{2}, "kv"...)
+	leaf = append(append(leaf, tmhash.Size), tmhash.Sum(storeRoot)...)
+	appHash, proofs := merkle.ProofsFromByteSlices([][]byte{leaf})
+	proofOps := &tmcrypto.ProofOps{Ops: []tmcrypto.ProofOp{
+		absenceOp.ProofOp(),
+		merkle.NewValueOp([]byte("kv"), proofs[0]).ProofOp(),
+	}}
+
+	testcases := map[string]struct {
+		path  string
+		key   string
+		valid bool
+	}{
+		"valid":        {"/store/kv/key", "c", true},
+		"present key":  {"/store/kv/key", "d", false},
+		"other key":    {"/store/kv/key", "e", false},
+		"other store":  {"/store/bank/key", "c", false},
+		"invalid path": {"/kv", "c", false},
+		"empty key":    {"/store/kv/key", "", false},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			res := &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{
+				Key: []byte(tc.key), ProofOps: proofOps, Height: 4}}
+			next := &rpcClient{}
+			next.On("ABCIQueryWithOptions", tc.path, mock.Anything, mock.Anything).Return(res, nil)
+			lc := &lcmock.LightClient{}
+			lc.On("VerifyHeaderAtHeight", int64(5), mock.Anything).Return(&types.SignedHeader{Header: &types.Header{
+				Height: 5, AppHash: appHash}}, nil)
+			c := NewClient(next, lc)
+
+			_, err := c.ABCIQueryWithOptions(tc.path, tmbytes.HexBytes(tc.key), rpcclient.ABCIQueryOptions{Prove: true})
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClient_Tx(t *testing.T) {
+	testcases := map[string]struct {
+		tamper func(res *ctypes.ResultTx)
+		valid  bool
+	}{
+		"valid":          {func(res *ctypes.ResultTx) {}, true},
+		"other tx":       {func(res *ctypes.ResultTx) { *res = *makeResultTx(1) }, false},
+		"tampered tx":    {func(res *ctypes.ResultTx) { res.Tx = types.Tx("a=2") }, false},
+		"tampered proof": {func(res *ctypes.ResultTx) { res.Proof.Data = types.Tx("a=2") }, false},
+		"tampered tx and proof": {func(res *ctypes.ResultTx) {
+			res.Tx = types.Tx("a=2")
+			res.Hash = res.Tx.Hash()
+			res.Proof.Data = res.Tx
+		}, false},
+		"tampered result": {func(res *ctypes.ResultTx) { res.TxResult.Code = 2 }, false},
+		"wrong height":    {func(res *ctypes.ResultTx) { res.Height = 3 }, false},
+		"zero height":     {func(res *ctypes.ResultTx) { res.Height = 0 }, false},
+		"wrong index":     {func(res *ctypes.ResultTx) { res.Index = 1 }, false},
+		"missing tx proof": {func(res *ctypes.ResultTx) {
+			res.Proof = types.TxProof{}
+		}, false},
+		"missing result proof": {func(res *ctypes.ResultTx) {
+			res.ResultProof = merkle.Proof{Index: res.ResultProof.Index}
+		}, false},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			res := makeResultTx(0)
+			tc.tamper(res)
+			next := &rpcClient{}
+			next.On("Tx", testTxs[0].Hash(), true).Return(res, nil)
+			c := newTestClient(next)
+
+			tx, err := c.Tx(testTxs[0].Hash(), true)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, makeResultTx(0), tx)
+		})
+	}
+
+	// Proofs are always requested, but only returned if asked for.
+	next := &rpcClient{}
+	next.On("Tx", testTxs[0].Hash(), true).Return(makeResultTx(0), nil)
+	tx, err := newTestClient(next).Tx(testTxs[0].Hash(), false)
+	require.NoError(t, err)
+	assert.Empty(t, tx.Proof)
+	assert.Empty(t, tx.ResultProof)
+}
+
+func TestClient_TxSearch(t *testing.T) {
+	// makeTxs returns the txs at the given indices of testTxs, without proofs.
+	makeTxs := func(indices ...int) []*ctypes.ResultTx {
+		txs := make([]*ctypes.ResultTx, 0, len(indices))
+		for _, i := range indices {
+			tx := makeResultTx(i)
+			stripProofs(tx)
+			txs = append(txs, tx)
+		}
+		return txs
+	}
+
+	testcases := map[string]struct {
+		makeResult func(t *testing.T) *ctypes.ResultTxSearch
+		valid      bool
+	}{
+		"single tx with its proofs": {func(t *testing.T) *ctypes.ResultTxSearch {
+			return &ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{makeResultTx(2)}}
+		}, true},
+		"txs proven together": {func(t *testing.T) *ctypes.ResultTxSearch {
+			return &ctypes.ResultTxSearch{Txs: makeTxs(0, 2), Proofs: []*ctypes.ResultTxsProof{
+				makeResultTxsProof(t, 0, 2)}}
+		}, true},
+		"tampered tx": {func(t *testing.T) *ctypes.ResultTxSearch {
+			res := &ctypes.ResultTxSearch{Txs: makeTxs(0, 2), Proofs: []*ctypes.ResultTxsProof{
+				makeResultTxsProof(t, 0, 2)}}
+			res.Txs[1].Tx = types.Tx("c=4")
+			res.Txs[1].Hash = res.Txs[1].Tx.Hash()
+			return res
+		}, false},
+		"tampered tx and proof": {func(t *testing.T) *ctypes.ResultTxSearch {
+			res := &ctypes.ResultTxSearch{Txs: makeTxs(0, 2), Proofs: []*ctypes.ResultTxsProof{
+				makeResultTxsProof(t, 0, 2)}}
+			res.Txs[1].Tx = types.Tx("c=4")
+			res.Txs[1].Hash = res.Txs[1].Tx.Hash()
+			res.Proofs[0].Proof.Data[1] = res.Txs[1].Tx
+			return res
+		}, false},
+		"tampered result": {func(t *testing.T) *ctypes.ResultTxSearch {
+			res := &ctypes.ResultTxSearch{Txs: makeTxs(0, 2), Proofs: []*ctypes.ResultTxsProof{
+				makeResultTxsProof(t, 0, 2)}}
+			res.Txs[0].TxResult.GasUsed = 10
+			return res
+		}, false},
+		"tampered single result": {func(t *testing.T) *ctypes.ResultTxSearch {
+			res := &ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{makeResultTx(2)}}
+			res.Txs[0].TxResult.Code = 1
+			return res
+		}, false},
+		"wrong height": {func(t *testing.T) *ctypes.ResultTxSearch {
+			res := &ctypes.ResultTxSearch{Txs: makeTxs(0, 2), Proofs: []*ctypes.ResultTxsProof{
+				makeResultTxsProof(t, 0, 2)}}
+			for _, tx := range res.Txs {
+				tx.Height = 3
+			}
+			res.Proofs[0].Height = 3
+			return res
+		}, false},
+		"missing proofs": {func(t *testing.T) *ctypes.ResultTxSearch {
+			return &ctypes.ResultTxSearch{Txs: makeTxs(0, 2)}
+		}, false},
+		"missing tx": {func(t *testing.T) *ctypes.ResultTxSearch {
+			return &ctypes.ResultTxSearch{Txs: makeTxs(0), Proofs: []*ctypes.ResultTxsProof{
+				makeResultTxsProof(t, 0, 2)}}
+		}, false},
+		"tx not proven": {func(t *testing.T) *ctypes.ResultTxSearch {
+			return &ctypes.ResultTxSearch{Txs: makeTxs(0, 1), Proofs: []*ctypes.ResultTxsProof{
+				makeResultTxsProof(t, 0, 2)}}
+		}, false},
+		"duplicate proof": {func(t *testing.T) *ctypes.ResultTxSearch {
+			return &ctypes.ResultTxSearch{Txs: makeTxs(0, 2), Proofs: []*ctypes.ResultTxsProof{
+				makeResultTxsProof(t, 0, 2), makeResultTxsProof(t, 0, 2)}}
+		}, false},
+		"nil proof": {func(t *testing.T) *ctypes.ResultTxSearch {
+			return &ctypes.ResultTxSearch{Txs: makeTxs(0, 2), Proofs: []*ctypes.ResultTxsProof{nil}}
+		}, false},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			next := &rpcClient{}
+			next.On("TxSearch", "tx.height=2", true, (*int)(nil), (*int)(nil), "").Return(tc.makeResult(t), nil)
+			c := newTestClient(next)
+
+			res, err := c.TxSearch("tx.height=2", false, nil, nil, "")
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Empty(t, res.Proofs)
+			for _, tx := range res.Txs {
+				assert.Empty(t, tx.Proof)
+				assert.Empty(t, tx.ResultProof)
+			}
+		})
+	}
+}
+
+func TestClient_BlockResults(t *testing.T) {
+	testcases := map[string]struct {
+		res   *ctypes.ResultBlockResults
+		valid bool
+	}{
+		"valid":           {&ctypes.ResultBlockResults{Height: 2, TxsResults: testResponses}, true},
+		"wrong height":    {&ctypes.ResultBlockResults{Height: 3, TxsResults: testResponses}, false},
+		"zero height":     {&ctypes.ResultBlockResults{TxsResults: testResponses}, false},
+		"missing results": {&ctypes.ResultBlockResults{Height: 2, TxsResults: testResponses[:2]}, false},
+		"tampered result": {&ctypes.ResultBlockResults{Height: 2, TxsResults: []*abci.ResponseDeliverTx{
+			testResponses[0], testResponses[1], {Code: 1, Data: []byte("c"), GasWanted: 3, GasUsed: 3},
+		}}, false},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			next := &rpcClient{}
+			next.On("BlockResults", mock.Anything).Return(tc.res, nil)
+			c := newTestClient(next)
+
+			height := int64(2)
+			res, err := c.BlockResults(&height)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.res, res)
+		})
+	}
+}
+
+func TestClient_verifiedEvents(t *testing.T) {
+	c := newTestClient(&rpcClient{})
+	trusted, err := c.lc.VerifyHeaderAtHeight(2, time.Now())
+	require.NoError(t, err)
+	tampered := *trusted.Header
+	tampered.DataHash = tmhash.Sum([]byte("tampered"))
+	untrusted := *trusted.Header
+	untrusted.Height = 4
+
+	events := []ctypes.ResultEvent{
+		{Query: "tampered", Data: types.EventDataNewBlockHeader{Header: tampered}},
+		{Query: "trusted", Data: types.EventDataNewBlockHeader{Header: *trusted.Header}},
+		{Query: "untrusted", Data: types.EventDataNewBlockHeader{Header: untrusted}},
+		{Query: "nil block", Data: types.EventDataNewBlock{}},
+		{Query: "tx", Data: types.EventDataTx{TxResult: abci.TxResult{Height: 2, Tx: testTxs[0]}}},
+	}
+	in := make(chan ctypes.ResultEvent, len(events))
+	for _, event := range events {
+		in <- event
+	}
+	close(in)
+
+	queries := []string{}
+	for event := range c.verifiedEvents(in, 0) {
+		queries = append(queries, event.Query)
+	}
+	assert.Equal(t, []string{"trusted", "tx"}, queries)
+}
@@ -10,5 +10,13 @@ func defaultProofRuntime() *merkle.ProofRuntime {
 		merkle.ProofOpValue,
 		merkle.ValueOpDecoder,
 	)
+	prt.RegisterOpDecoder(
+		merkle.ProofOpLeaves,
+		merkle.LeavesOpDecoder,
+	)
+	prt.RegisterOpDecoder(
+		merkle.ProofOpAbsence,
+		merkle.AbsenceOpDecoder,
+	)
 	return prt
 }
@@ -11,6 +11,7 @@ import (
 
 	tmsync "github.com/mydexchain/tendermint0/libs/sync"
 	"github.com/mydexchain/tendermint0/light/store"
+	lightproto "github.com/mydexchain/tendermint0/proto/tendermint/light"
 	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
 	"github.com/mydexchain/tendermint0/types"
 )
@@ -29,6 +30,9 @@ type dbs struct {
 
 // New returns a Store that wraps any DB (with an optional prefix in case you
 // want to use one DB with many light clients).
+//
+// The signed headers and validator sets stored separately by previous versions
+// are migrated to light blocks. New panics if the migration fails.
 func New(db dbm.DB, prefix string) store.Store {
 
 	size := uint16(0)
@@ -37,33 +41,112 @@ func New(db dbm.DB, prefix string) store.Store {
 		size = unmarshalSize(bz)
 	}
 
-	return &dbs{db: db, prefix: prefix, size: size}
+	s := &dbs{db: db, prefix: prefix, size: size}
+	if err := s.migrate(); err != nil {
+		panic(fmt.Sprintf("failed to migrate the light store: %v", err))
+	}
+	return s
 }
 
-// SaveSignedHeaderAndValidatorSet persists SignedHeader and ValidatorSet to
-// the db.
-//
-// Safe for concurrent use by multiple goroutines.
-func (s *dbs) SaveSignedHeaderAndValidatorSet(sh *types.SignedHeader, valSet *types.ValidatorSet) error {
-	if sh.Height <= 0 {
-		panic("negative or zero height")
+// migrate replaces the signed header and the validator set of each height,
+// stored under the sh/ and vs/ keys by previous versions, with a light block.
+// A signed header without its validator set is dropped.
+func (s *dbs) migrate() error {
+	b := s.db.NewBatch()
+	defer b.Close()
+
+	migrated, dropped, err := s.migrateLegacyKeys(b)
+	if err != nil || migrated+dropped == 0 {
+		return err
 	}
 
-	pbsh := sh.ToProto()
+	size := uint16(0)
+	if uint16(dropped) < s.size {
+		size = s.size - uint16(dropped)
+	}
+	if err := b.Set(sizeKey, marshalSize(size)); err != nil {
+		return err
+	}
+	if err := b.WriteSync(); err != nil {
+		return err
+	}
+	s.size = size
 
-	shBz, err := proto.Marshal(pbsh)
+	return nil
+}
+
+// migrateLegacyKeys adds the migration of the legacy keys to b. It returns the
+// number of light blocks migrated and of signed headers dropped.
+func (s *dbs) migrateLegacyKeys(b dbm.Batch) (migrated, dropped int, err error) {
+	itr, err := s.db.Iterator(
+		s.legacyKey("sh", 1),
+		append(s.legacyKey("sh", 1<<63-1), byte(0x00)),
+	)
 	if err != nil {
-		return fmt.Errorf("marshalling SignedHeader: %w", err)
+		return 0, 0, err
 	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		part, _, height, ok := parseKey(itr.Key())
+		if !ok || part != "sh" {
+			continue
+		}
 
-	pbvs, err := valSet.ToProto()
+		vsBz, err := s.db.Get(s.legacyKey("vs", height))
+		if err != nil {
+			return 0, 0, err
+		}
+		if len(vsBz) > 0 {
+			lbpb := tmproto.LightBlock{
+				SignedHeader: new(tmproto.SignedHeader),
+				ValidatorSet: new(tmproto.ValidatorSet),
+			}
+			if err := proto.Unmarshal(itr.Value(), lbpb.SignedHeader); err != nil {
+				return 0, 0, fmt.Errorf("unmarshalling SignedHeader at height %d: %w", height, err)
+			}
+			if err := proto.Unmarshal(vsBz, lbpb.ValidatorSet); err != nil {
+				return 0, 0, fmt.Errorf("unmarshalling ValidatorSet at height %d: %w", height, err)
+			}
+			lbBz, err := proto.Marshal(&lbpb)
+			if err != nil {
+				return 0, 0, fmt.Errorf("marshalling LightBlock: %w", err)
+			}
+			if err := b.Set(s.lbKey(height), lbBz); err != nil {
+				return 0, 0, err
+			}
+			migrated++
+		} else {
+			dropped++
+		}
+
+		if err := b.Delete(s.legacyKey("sh", height)); err != nil {
+			return 0, 0, err
+		}
+		if err := b.Delete(s.legacyKey("vs", height)); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return migrated, dropped, itr.Error()
+}
+
+// SaveLightBlock persists LightBlock to the db.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *dbs) SaveLightBlock(lb *types.LightBlock) error {
+	if lb.Height <= 0 {
+		panic("negative or zero height")
+	}
+
+	lbpb, err := lb.ToProto()
 	if err != nil {
-		return fmt.Errorf("unable to transition validator set to protobuf: %w", err)
+		return fmt.Errorf("unable to convert light block to protobuf: %w", err)
 	}
 
-	valSetBz, err := proto.Marshal(pbvs)
+	lbBz, err := proto.Marshal(lbpb)
 	if err != nil {
-		return fmt.Errorf("marshalling validator set: %w", err)
+		return fmt.Errorf("marshalling LightBlock: %w", err)
 	}
 
 	s.mtx.Lock()
@@ -71,10 +154,7 @@ func (s *dbs) SaveSignedHeaderAndValidatorSet(sh *types.SignedHeader, valSet *ty
 
 	b := s.db.NewBatch()
 	defer b.Close()
-	if err = b.Set(s.shKey(sh.Height), shBz); err != nil {
-		return err
-	}
-	if err = b.Set(s.vsKey(sh.Height), valSetBz); err != nil {
+	if err = b.Set(s.lbKey(lb.Height), lbBz); err != nil {
 		return err
 	}
 	if err = b.Set(sizeKey, marshalSize(s.size+1)); err != nil {
@@ -88,11 +168,10 @@ func (s *dbs) SaveSignedHeaderAndValidatorSet(sh *types.SignedHeader, valSet *ty
 	return nil
 }
 
-// DeleteSignedHeaderAndValidatorSet deletes SignedHeader and ValidatorSet from
-// the db.
+// DeleteLightBlock deletes the LightBlock from the db.
 //
 // Safe for concurrent use by multiple goroutines.
-func (s *dbs) DeleteSignedHeaderAndValidatorSet(height int64) error {
+func (s *dbs) DeleteLightBlock(height int64) error {
 	if height <= 0 {
 		panic("negative or zero height")
 	}
@@ -102,10 +181,7 @@ func (s *dbs) DeleteSignedHeaderAndValidatorSet(height int64) error {
 
 	b := s.db.NewBatch()
 	defer b.Close()
-	if err := b.Delete(s.shKey(height)); err != nil {
-		return err
-	}
-	if err := b.Delete(s.vsKey(height)); err != nil {
+	if err := b.Delete(s.lbKey(height)); err != nil {
 		return err
 	}
 	if err := b.Set(sizeKey, marshalSize(s.size-1)); err != nil {
@@ -119,73 +195,43 @@ func (s *dbs) DeleteSignedHeaderAndValidatorSet(height int64) error {
 	return nil
 }
 
-// SignedHeader loads SignedHeader at the given height.
-//
-// Safe for concurrent use by multiple goroutines.
-func (s *dbs) SignedHeader(height int64) (*types.SignedHeader, error) {
-	if height <= 0 {
-		panic("negative or zero height")
-	}
-
-	bz, err := s.db.Get(s.shKey(height))
-	if err != nil {
-		panic(err)
-	}
-	if len(bz) == 0 {
-		return nil, store.ErrSignedHeaderNotFound
-	}
-
-	var pbsh tmproto.SignedHeader
-	err = proto.Unmarshal(bz, &pbsh)
-	if err != nil {
-		return nil, err
-	}
-
-	signedHeader, err := types.SignedHeaderFromProto(&pbsh)
-	if err != nil {
-		return nil, err
-	}
-
-	return signedHeader, err
-}
-
-// ValidatorSet loads ValidatorSet at the given height.
+// LightBlock loads the LightBlock at the given height.
 //
 // Safe for concurrent use by multiple goroutines.
-func (s *dbs) ValidatorSet(height int64) (*types.ValidatorSet, error) {
+func (s *dbs) LightBlock(height int64) (*types.LightBlock, error) {
 	if height <= 0 {
 		panic("negative or zero height")
 	}
 
-	bz, err := s.db.Get(s.vsKey(height))
+	bz, err := s.db.Get(s.lbKey(height))
 	if err != nil {
 		panic(err)
 	}
 	if len(bz) == 0 {
-		return nil, store.ErrValidatorSetNotFound
+		return nil, store.ErrLightBlockNotFound
 	}
 
-	var pbvs tmproto.ValidatorSet
-	err = proto.Unmarshal(bz, &pbvs)
+	var lbpb tmproto.LightBlock
+	err = proto.Unmarshal(bz, &lbpb)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unmarshal error: %w", err)
 	}
 
-	valSet, err := types.ValidatorSetFromProto(&pbvs)
+	lightBlock, err := types.LightBlockFromProto(&lbpb)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("proto conversion error: %w", err)
 	}
 
-	return valSet, err
+	return lightBlock, err
 }
 
-// LastSignedHeaderHeight returns the last SignedHeader height stored.
+// LastLightBlockHeight returns the last LightBlock height stored.
 //
 // Safe for concurrent use by multiple goroutines.
-func (s *dbs) LastSignedHeaderHeight() (int64, error) {
+func (s *dbs) LastLightBlockHeight() (int64, error) {
 	itr, err := s.db.ReverseIterator(
-		s.shKey(1),
-		append(s.shKey(1<<63-1), byte(0x00)),
+		s.lbKey(1),
+		append(s.lbKey(1<<63-1), byte(0x00)),
 	)
 	if err != nil {
 		panic(err)
@@ -194,7 +240,7 @@ func (s *dbs) LastSignedHeaderHeight() (int64, error) {
 
 	for itr.Valid() {
 		key := itr.Key()
-		_, height, ok := parseShKey(key)
+		_, height, ok := parseLbKey(key)
 		if ok {
 			return height, nil
 		}
@@ -204,13 +250,13 @@ func (s *dbs) LastSignedHeaderHeight() (int64, error) {
 	return -1, itr.Error()
 }
 
-// FirstSignedHeaderHeight returns the first SignedHeader height stored.
+// FirstLightBlockHeight returns the first LightBlock height stored.
 //
 // Safe for concurrent use by multiple goroutines.
-func (s *dbs) FirstSignedHeaderHeight() (int64, error) {
+func (s *dbs) FirstLightBlockHeight() (int64, error) {
 	itr, err := s.db.Iterator(
-		s.shKey(1),
-		append(s.shKey(1<<63-1), byte(0x00)),
+		s.lbKey(1),
+		append(s.lbKey(1<<63-1), byte(0x00)),
 	)
 	if err != nil {
 		panic(err)
@@ -219,7 +265,7 @@ func (s *dbs) FirstSignedHeaderHeight() (int64, error) {
 
 	for itr.Valid() {
 		key := itr.Key()
-		_, height, ok := parseShKey(key)
+		_, height, ok := parseLbKey(key)
 		if ok {
 			return height, nil
 		}
@@ -229,18 +275,18 @@ func (s *dbs) FirstSignedHeaderHeight() (int64, error) {
 	return -1, itr.Error()
 }
 
-// SignedHeaderBefore iterates over headers until it finds a header before
-// the given height. It returns ErrSignedHeaderNotFound if no such header exists.
+// LightBlockBefore iterates over light blocks until it finds a block before
+// the given height. It returns ErrLightBlockNotFound if no such block exists.
 //
 // Safe for concurrent use by multiple goroutines.
-func (s *dbs) SignedHeaderBefore(height int64) (*types.SignedHeader, error) {
+func (s *dbs) LightBlockBefore(height int64) (*types.LightBlock, error) {
 	if height <= 0 {
 		panic("negative or zero height")
 	}
 
 	itr, err := s.db.ReverseIterator(
-		s.shKey(1),
-		s.shKey(height),
+		s.lbKey(1),
+		s.lbKey(height),
 	)
 	if err != nil {
 		panic(err)
@@ -249,9 +295,9 @@ func (s *dbs) SignedHeaderBefore(height int64) (*types.SignedHeader, error) {
 
 	for itr.Valid() {
 		key := itr.Key()
-		_, existingHeight, ok := parseShKey(key)
+		_, existingHeight, ok := parseLbKey(key)
 		if ok {
-			return s.SignedHeader(existingHeight)
+			return s.LightBlock(existingHeight)
 		}
 		itr.Next()
 	}
@@ -259,11 +305,10 @@ func (s *dbs) SignedHeaderBefore(height int64) (*types.SignedHeader, error) {
 		return nil, err
 	}
 
-	return nil, store.ErrSignedHeaderNotFound
+	return nil, store.ErrLightBlockNotFound
 }
 
-// Prune prunes header & validator set pairs until there are only size pairs
-// left.
+// Prune prunes light blocks until there are only size light blocks left.
 //
 // Safe for concurrent use by multiple goroutines.
 func (s *dbs) Prune(size uint16) error {
@@ -277,10 +322,10 @@ func (s *dbs) Prune(size uint16) error {
 	}
 	numToPrune := sSize - size
 
-	// 2) Iterate over headers and perform a batch operation.
+	// 2) Iterate over light blocks and perform a batch operation.
 	itr, err := s.db.Iterator(
-		s.shKey(1),
-		append(s.shKey(1<<63-1), byte(0x00)),
+		s.lbKey(1),
+		append(s.lbKey(1<<63-1), byte(0x00)),
 	)
 	if err != nil {
 		return err
@@ -293,12 +338,9 @@ func (s *dbs) Prune(size uint16) error {
 	pruned := 0
 	for itr.Valid() && numToPrune > 0 {
 		key := itr.Key()
-		_, height, ok := parseShKey(key)
+		_, height, ok := parseLbKey(key)
 		if ok {
-			if err = b.Delete(s.shKey(height)); err != nil {
-				return err
-			}
-			if err = b.Delete(s.vsKey(height)); err != nil {
+			if err = b.Delete(s.lbKey(height)); err != nil {
 				return err
 			}
 		}
@@ -328,7 +370,7 @@ func (s *dbs) Prune(size uint16) error {
 	return nil
 }
 
-// Size returns the number of header & validator set pairs.
+// Size returns the number of light blocks.
 //
 // Safe for concurrent use by multiple goroutines.
 func (s *dbs) Size() uint16 {
@@ -337,15 +379,96 @@ func (s *dbs) Size() uint16 {
 	return s.size
 }
 
-func (s *dbs) shKey(height int64) []byte {
-	return []byte(fmt.Sprintf("sh/%s/%020d", s.prefix, height))
+// SaveIncident persists an incident to the db.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *dbs) SaveIncident(incident *store.Incident) error {
+	pbi := &lightproto.Incident{
+		Evidence: incident.Evidence.ToProto(),
+		Primary:  incident.Primary,
+		Witness:  incident.Witness,
+		Time:     incident.Time,
+	}
+	for _, sh := range incident.PrimaryTrace {
+		pbi.PrimaryTrace = append(pbi.PrimaryTrace, sh.ToProto())
+	}
+	for _, sh := range incident.WitnessTrace {
+		pbi.WitnessTrace = append(pbi.WitnessTrace, sh.ToProto())
+	}
+
+	bz, err := proto.Marshal(pbi)
+	if err != nil {
+		return fmt.Errorf("marshalling incident: %w", err)
+	}
+
+	return s.db.SetSync(s.incidentKey(incident), bz)
+}
+
+// Incidents loads all incidents from the db, oldest first.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *dbs) Incidents() ([]*store.Incident, error) {
+	itr, err := dbm.IteratePrefix(s.db, []byte(fmt.Sprintf("incident/%s/", s.prefix)))
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	incidents := []*store.Incident{}
+	for ; itr.Valid(); itr.Next() {
+		var pbi lightproto.Incident
+		if err := proto.Unmarshal(itr.Value(), &pbi); err != nil {
+			return nil, fmt.Errorf("unmarshalling incident: %w", err)
+		}
+		ev, err := types.LightClientAttackEvidenceFromProto(pbi.Evidence)
+		if err != nil {
+			return nil, err
+		}
+		incident := &store.Incident{
+			Evidence: ev,
+			Primary:  pbi.Primary,
+			Witness:  pbi.Witness,
+			Time:     pbi.Time,
+		}
+		if incident.PrimaryTrace, err = signedHeadersFromProto(pbi.PrimaryTrace); err != nil {
+			return nil, err
+		}
+		if incident.WitnessTrace, err = signedHeadersFromProto(pbi.WitnessTrace); err != nil {
+			return nil, err
+		}
+		incidents = append(incidents, incident)
+	}
+
+	return incidents, itr.Error()
+}
+
+func signedHeadersFromProto(pbshs []*tmproto.SignedHeader) ([]*types.SignedHeader, error) {
+	shs := make([]*types.SignedHeader, 0, len(pbshs))
+	for _, pbsh := range pbshs {
+		sh, err := types.SignedHeaderFromProto(pbsh)
+		if err != nil {
+			return nil, err
+		}
+		shs = append(shs, sh)
+	}
+	return shs, nil
+}
+
+func (s *dbs) lbKey(height int64) []byte {
+	return []byte(fmt.Sprintf("lb/%s/%020d", s.prefix, height))
+}
+
+// legacyKey is the key of the signed header (part sh) or of the validator set
+// (part vs) at height, as stored by previous versions.
+func (s *dbs) legacyKey(part string, height int64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d", part, s.prefix, height))
 }
 
-func (s *dbs) vsKey(height int64) []byte {
-	return []byte(fmt.Sprintf("vs/%s/%020d", s.prefix, height))
+func (s *dbs) incidentKey(incident *store.Incident) []byte {
+	return []byte(fmt.Sprintf("incident/%s/%020d/%X", s.prefix, incident.Time.UnixNano(), incident.Evidence.Hash()))
 }
 
-var keyPattern = regexp.MustCompile(`^(sh|vs)/([^/]*)/([0-9]+)$`)
+var keyPattern = regexp.MustCompile(`^(lb|sh|vs)/([^/]*)/([0-9]+)$`)
 
 func parseKey(key []byte) (part string, prefix string, height int64, ok bool) {
 	submatch := keyPattern.FindSubmatch(key)
@@ -362,10 +485,10 @@ func parseKey(key []byte) (part string, prefix string, height int64, ok bool) {
 	return
 }
 
-func parseShKey(key []byte) (prefix string, height int64, ok bool) {
+func parseLbKey(key []byte) (prefix string, height int64, ok bool) {
 	var part string
 	part, prefix, height, ok = parseKey(key)
-	if part != "sh" {
+	if part != "lb" {
 		return "", 0, false
 	}
 	return
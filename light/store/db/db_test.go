@@ -1,8 +1,10 @@
 package db
 
 import (
+	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -11,90 +13,79 @@ import (
 
 	"github.com/mydexchain/tendermint0/crypto"
 	tmrand "github.com/mydexchain/tendermint0/libs/rand"
+	"github.com/mydexchain/tendermint0/light/store"
+	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
 	"github.com/mydexchain/tendermint0/types"
+	tmtime "github.com/mydexchain/tendermint0/types/time"
 )
 
-func TestLast_FirstSignedHeaderHeight(t *testing.T) {
-	dbStore := New(dbm.NewMemDB(), "TestLast_FirstSignedHeaderHeight")
+func TestLast_FirstLightBlockHeight(t *testing.T) {
+	dbStore := New(dbm.NewMemDB(), "TestLast_FirstLightBlockHeight")
 	vals, _ := types.RandValidatorSet(10, 100)
 
 	// Empty store
-	height, err := dbStore.LastSignedHeaderHeight()
+	height, err := dbStore.LastLightBlockHeight()
 	require.NoError(t, err)
 	assert.EqualValues(t, -1, height)
 
-	height, err = dbStore.FirstSignedHeaderHeight()
+	height, err = dbStore.FirstLightBlockHeight()
 	require.NoError(t, err)
 	assert.EqualValues(t, -1, height)
 
 	// 1 key
-	err = dbStore.SaveSignedHeaderAndValidatorSet(
-		&types.SignedHeader{Header: &types.Header{Height: 1}}, vals)
+	err = dbStore.SaveLightBlock(randLightBlock(1, vals))
 	require.NoError(t, err)
 
-	height, err = dbStore.LastSignedHeaderHeight()
+	height, err = dbStore.LastLightBlockHeight()
 	require.NoError(t, err)
 	assert.EqualValues(t, 1, height)
 
-	height, err = dbStore.FirstSignedHeaderHeight()
+	height, err = dbStore.FirstLightBlockHeight()
 	require.NoError(t, err)
 	assert.EqualValues(t, 1, height)
 }
 
-func Test_SaveSignedHeaderAndValidatorSet(t *testing.T) {
-	dbStore := New(dbm.NewMemDB(), "Test_SaveSignedHeaderAndValidatorSet")
+func Test_SaveLightBlock(t *testing.T) {
+	dbStore := New(dbm.NewMemDB(), "Test_SaveLightBlock")
 	vals, _ := types.RandValidatorSet(10, 100)
 	// Empty store
-	h, err := dbStore.SignedHeader(1)
+	h, err := dbStore.LightBlock(1)
 	require.Error(t, err)
 	assert.Nil(t, h)
 
-	valSet, err := dbStore.ValidatorSet(1)
-	require.Error(t, err)
-	assert.Nil(t, valSet)
-
 	// 1 key
-	pa := vals.Validators[0].Address
-	err = dbStore.SaveSignedHeaderAndValidatorSet(
-		&types.SignedHeader{Header: &types.Header{Height: 1, ProposerAddress: pa}}, vals)
-	require.NoError(t, err)
-
-	h, err = dbStore.SignedHeader(1)
+	err = dbStore.SaveLightBlock(randLightBlock(1, vals))
 	require.NoError(t, err)
-	assert.NotNil(t, h)
 
-	valSet, err = dbStore.ValidatorSet(1)
+	h, err = dbStore.LightBlock(1)
 	require.NoError(t, err)
-	assert.NotNil(t, valSet)
+	if assert.NotNil(t, h) {
+		assert.EqualValues(t, 1, h.Height)
+		assert.Equal(t, vals.Hash(), h.ValidatorSet.Hash())
+	}
 
 	// Empty store
-	err = dbStore.DeleteSignedHeaderAndValidatorSet(1)
+	err = dbStore.DeleteLightBlock(1)
 	require.NoError(t, err)
 
-	h, err = dbStore.SignedHeader(1)
+	h, err = dbStore.LightBlock(1)
 	require.Error(t, err)
 	assert.Nil(t, h)
-
-	valSet, err = dbStore.ValidatorSet(1)
-	require.Error(t, err)
-	assert.Nil(t, valSet)
 }
 
-func Test_SignedHeaderBefore(t *testing.T) {
-	dbStore := New(dbm.NewMemDB(), "Test_SignedHeaderBefore")
+func Test_LightBlockBefore(t *testing.T) {
+	dbStore := New(dbm.NewMemDB(), "Test_LightBlockBefore")
 	valSet, _ := types.RandValidatorSet(10, 100)
-	pa := valSet.Proposer.Address
 
 	assert.Panics(t, func() {
-		_, _ = dbStore.SignedHeaderBefore(0)
-		_, _ = dbStore.SignedHeaderBefore(100)
+		_, _ = dbStore.LightBlockBefore(0)
+		_, _ = dbStore.LightBlockBefore(100)
 	})
 
-	err := dbStore.SaveSignedHeaderAndValidatorSet(
-		&types.SignedHeader{Header: &types.Header{Height: 2, ProposerAddress: pa}}, valSet)
+	err := dbStore.SaveLightBlock(randLightBlock(2, valSet))
 	require.NoError(t, err)
 
-	h, err := dbStore.SignedHeaderBefore(3)
+	h, err := dbStore.LightBlockBefore(3)
 	require.NoError(t, err)
 	if assert.NotNil(t, h) {
 		assert.EqualValues(t, 2, h.Height)
@@ -111,8 +102,7 @@ func Test_Prune(t *testing.T) {
 	require.NoError(t, err)
 
 	// One header
-	err = dbStore.SaveSignedHeaderAndValidatorSet(
-		&types.SignedHeader{Header: &types.Header{Height: 2}}, valSet)
+	err = dbStore.SaveLightBlock(randLightBlock(2, valSet))
 	require.NoError(t, err)
 
 	assert.EqualValues(t, 1, dbStore.Size())
@@ -127,8 +117,7 @@ func Test_Prune(t *testing.T) {
 
 	// Multiple headers
 	for i := 1; i <= 10; i++ {
-		err = dbStore.SaveSignedHeaderAndValidatorSet(
-			&types.SignedHeader{Header: &types.Header{Height: int64(i)}}, valSet)
+		err = dbStore.SaveLightBlock(randLightBlock(int64(i), valSet))
 		require.NoError(t, err)
 	}
 
@@ -151,24 +140,18 @@ func Test_Concurrency(t *testing.T) {
 		go func(i int64) {
 			defer wg.Done()
 
-			err := dbStore.SaveSignedHeaderAndValidatorSet(
-				&types.SignedHeader{Header: &types.Header{Height: i,
-					ProposerAddress: tmrand.Bytes(crypto.AddressSize)}}, vals)
+			err := dbStore.SaveLightBlock(randLightBlock(i, vals))
 			require.NoError(t, err)
 
-			_, err = dbStore.SignedHeader(i)
+			_, err = dbStore.LightBlock(i)
 			if err != nil {
 				t.Log(err)
 			}
-			_, err = dbStore.ValidatorSet(i)
-			if err != nil {
-				t.Log(err) // could not find validator set
-			}
-			_, err = dbStore.LastSignedHeaderHeight()
+			_, err = dbStore.LastLightBlockHeight()
 			if err != nil {
 				t.Log(err)
 			}
-			_, err = dbStore.FirstSignedHeaderHeight()
+			_, err = dbStore.FirstLightBlockHeight()
 			if err != nil {
 				t.Log(err)
 			}
@@ -179,7 +162,7 @@ func Test_Concurrency(t *testing.T) {
 			}
 			_ = dbStore.Size()
 
-			err = dbStore.DeleteSignedHeaderAndValidatorSet(1)
+			err = dbStore.DeleteLightBlock(1)
 			if err != nil {
 				t.Log(err)
 			}
@@ -188,3 +171,110 @@ func Test_Concurrency(t *testing.T) {
 
 	wg.Wait()
 }
+
+func Test_Incidents(t *testing.T) {
+	dbStore := New(dbm.NewMemDB(), "Test_Incidents")
+
+	incidents, err := dbStore.Incidents()
+	require.NoError(t, err)
+	assert.Empty(t, incidents)
+
+	var (
+		vals, privVals = types.RandValidatorSet(4, 10)
+		common         = randSignedHeader(t, 1, vals, privVals)
+		h1             = randSignedHeader(t, 2, vals, privVals)
+		h2             = randSignedHeader(t, 2, vals, privVals)
+	)
+	incident := &store.Incident{
+		Evidence:     types.NewLightClientAttackEvidence(h1, h2, 1),
+		Primary:      "primary",
+		Witness:      "witness",
+		PrimaryTrace: []*types.SignedHeader{common, h1},
+		WitnessTrace: []*types.SignedHeader{common, h2},
+		Time:         tmtime.Now(),
+	}
+	require.NoError(t, dbStore.SaveIncident(incident))
+
+	later := *incident
+	later.Time = incident.Time.Add(time.Second)
+	require.NoError(t, dbStore.SaveIncident(&later))
+
+	incidents, err = dbStore.Incidents()
+	require.NoError(t, err)
+	assert.Equal(t, []*store.Incident{incident, &later}, incidents)
+}
+
+func randSignedHeader(t *testing.T, height int64, vals *types.ValidatorSet,
+	privVals []types.PrivValidator) *types.SignedHeader {
+
+	header := &types.Header{
+		ChainID:            "Test_Incidents",
+		Height:             height,
+		Time:               tmtime.Now(),
+		ValidatorsHash:     vals.Hash(),
+		NextValidatorsHash: vals.Hash(),
+		AppHash:            tmrand.Bytes(32),
+		ProposerAddress:    vals.Validators[0].Address,
+	}
+	blockID := types.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmrand.Bytes(32)},
+	}
+	voteSet := types.NewVoteSet(header.ChainID, height, 1, tmproto.PrecommitType, vals)
+	commit, err := types.MakeCommit(blockID, height, 1, voteSet, privVals, header.Time)
+	require.NoError(t, err)
+	return &types.SignedHeader{Header: header, Commit: commit}
+}
+
+func Test_MigrateLegacyKeys(t *testing.T) {
+	db := dbm.NewMemDB()
+	vals, _ := types.RandValidatorSet(10, 100)
+
+	// two heights stored by a previous version, the second without its
+	// validator set
+	lbs := []*types.LightBlock{randLightBlock(1, vals), randLightBlock(2, vals)}
+	for _, lb := range lbs {
+		shBz, err := lb.SignedHeader.ToProto().Marshal()
+		require.NoError(t, err)
+		require.NoError(t, db.Set([]byte(fmt.Sprintf("sh/prefix/%020d", lb.Height)), shBz))
+	}
+	pbvs, err := vals.ToProto()
+	require.NoError(t, err)
+	vsBz, err := pbvs.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, db.Set([]byte(fmt.Sprintf("vs/prefix/%020d", 1)), vsBz))
+	require.NoError(t, db.Set(sizeKey, marshalSize(2)))
+
+	dbStore := New(db, "prefix")
+	assert.EqualValues(t, 1, dbStore.Size())
+	lb, err := dbStore.LightBlock(1)
+	require.NoError(t, err)
+	assert.Equal(t, lbs[0].Hash(), lb.Hash())
+	assert.Equal(t, vals.Hash(), lb.ValidatorSet.Hash())
+	_, err = dbStore.LightBlock(2)
+	assert.Equal(t, store.ErrLightBlockNotFound, err)
+
+	has, err := db.Has([]byte(fmt.Sprintf("sh/prefix/%020d", 1)))
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	// the migrated store is opened as is
+	dbStore = New(db, "prefix")
+	assert.EqualValues(t, 1, dbStore.Size())
+	height, err := dbStore.LastLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, height)
+}
+
+func randLightBlock(height int64, vals *types.ValidatorSet) *types.LightBlock {
+	return &types.LightBlock{
+		SignedHeader: &types.SignedHeader{
+			Header: &types.Header{
+				Height:          height,
+				ValidatorsHash:  vals.Hash(),
+				ProposerAddress: tmrand.Bytes(crypto.AddressSize),
+			},
+		},
+		ValidatorSet: vals,
+	}
+}
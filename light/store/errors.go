@@ -3,11 +3,7 @@ package store
 import "errors"
 
 var (
-	// ErrSignedHeaderNotFound is returned when a store does not have the
-	// requested header.
-	ErrSignedHeaderNotFound = errors.New("signed header not found")
-
-	// ErrValidatorSetNotFound is returned when a store does not have the
-	// requested validator set.
-	ErrValidatorSetNotFound = errors.New("validator set not found")
+	// ErrLightBlockNotFound is returned when a store does not have the
+	// requested header or validator set.
+	ErrLightBlockNotFound = errors.New("light block not found")
 )
@@ -1,55 +1,74 @@
 package store
 
-import "github.com/mydexchain/tendermint0/types"
+import (
+	"time"
 
-// Store is anything that can persistenly store headers.
-type Store interface {
-	// SaveSignedHeaderAndValidatorSet saves a SignedHeader (h: sh.Height) and a
-	// ValidatorSet (h: sh.Height).
-	//
-	// height must be > 0.
-	SaveSignedHeaderAndValidatorSet(sh *types.SignedHeader, valSet *types.ValidatorSet) error
+	"github.com/mydexchain/tendermint0/types"
+)
 
-	// DeleteSignedHeaderAndValidatorSet deletes SignedHeader (h: height) and
-	// ValidatorSet (h: height).
+// Store is anything that can persistently store headers.
+type Store interface {
+	// SaveLightBlock saves a LightBlock (h: lb.Height), i.e. a SignedHeader
+	// and the ValidatorSet that signed it, atomically.
 	//
 	// height must be > 0.
-	DeleteSignedHeaderAndValidatorSet(height int64) error
+	SaveLightBlock(lb *types.LightBlock) error
 
-	// SignedHeader returns the SignedHeader that corresponds to the given
-	// height.
+	// DeleteLightBlock deletes the LightBlock (h: height).
 	//
 	// height must be > 0.
-	//
-	// If SignedHeader is not found, ErrSignedHeaderNotFound is returned.
-	SignedHeader(height int64) (*types.SignedHeader, error)
+	DeleteLightBlock(height int64) error
 
-	// ValidatorSet returns the ValidatorSet that corresponds to height.
+	// LightBlock returns the LightBlock that corresponds to the given height.
 	//
 	// height must be > 0.
 	//
-	// If ValidatorSet is not found, ErrValidatorSetNotFound is returned.
-	ValidatorSet(height int64) (*types.ValidatorSet, error)
+	// If LightBlock is not found, ErrLightBlockNotFound is returned.
+	LightBlock(height int64) (*types.LightBlock, error)
 
-	// LastSignedHeaderHeight returns the last (newest) SignedHeader height.
+	// LastLightBlockHeight returns the last (newest) LightBlock height.
 	//
 	// If the store is empty, -1 and nil error are returned.
-	LastSignedHeaderHeight() (int64, error)
+	LastLightBlockHeight() (int64, error)
 
-	// FirstSignedHeaderHeight returns the first (oldest) SignedHeader height.
+	// FirstLightBlockHeight returns the first (oldest) LightBlock height.
 	//
 	// If the store is empty, -1 and nil error are returned.
-	FirstSignedHeaderHeight() (int64, error)
+	FirstLightBlockHeight() (int64, error)
 
-	// SignedHeaderBefore returns the SignedHeader before a certain height.
+	// LightBlockBefore returns the LightBlock before a certain height.
 	//
-	// height must be > 0 && <= LastSignedHeaderHeight.
-	SignedHeaderBefore(height int64) (*types.SignedHeader, error)
+	// height must be > 0 && <= LastLightBlockHeight.
+	LightBlockBefore(height int64) (*types.LightBlock, error)
 
-	// Prune removes headers & the associated validator sets when Store reaches a
-	// defined size (number of header & validator set pairs).
+	// Prune removes light blocks when Store reaches a defined size (number of
+	// light blocks).
 	Prune(size uint16) error
 
-	// Size returns a number of currently existing header & validator set pairs.
+	// Size returns a number of currently existing light blocks.
 	Size() uint16
+
+	// SaveIncident persists a divergence between providers detected by the
+	// light client. Incidents are not pruned.
+	SaveIncident(incident *Incident) error
+
+	// Incidents returns all persisted incidents, oldest first.
+	Incidents() ([]*Incident, error)
+}
+
+// Incident records a divergence between the light client's primary and a
+// witness, for later inspection.
+type Incident struct {
+	// Evidence submitted to the providers.
+	Evidence *types.LightClientAttackEvidence
+	// Primary and Witness describe the diverging providers.
+	Primary string
+	Witness string
+	// PrimaryTrace and WitnessTrace are the headers fetched from the primary
+	// and the witness while looking for the height where they diverged,
+	// ordered by height.
+	PrimaryTrace []*types.SignedHeader
+	WitnessTrace []*types.SignedHeader
+	// Time at which the divergence was detected.
+	Time time.Time
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/mydexchain/tendermint0/libs/bits"
 	tmmath "github.com/mydexchain/tendermint0/libs/math"
 	"github.com/mydexchain/tendermint0/types"
 )
@@ -16,6 +17,101 @@ var (
 	DefaultTrustLevel = tmmath.Fraction{Numerator: 1, Denominator: 3}
 )
 
+// TrustParams are the parameters used to verify an untrusted header against
+// a trusted one.
+type TrustParams struct {
+	// Period during which a trusted header can be used to verify new ones.
+	TrustingPeriod time.Duration
+	// Fraction of the trusted validators' voting power, which must sign a
+	// non-adjacent untrusted header. Must be within [1/3, 1].
+	TrustLevel tmmath.Fraction
+	// How much an untrusted header's time can drift into the future.
+	MaxClockDrift time.Duration
+}
+
+// ValidateBasic performs basic validation.
+func (p TrustParams) ValidateBasic() error {
+	if p.TrustingPeriod <= 0 {
+		return errors.New("negative or zero trusting period")
+	}
+	if p.MaxClockDrift < 0 {
+		return errors.New("negative max clock drift")
+	}
+	return ValidateTrustLevel(p.TrustLevel)
+}
+
+// VerificationResult describes what was checked while verifying an untrusted
+// header. It is returned even if the verification fails, in which case it
+// covers the checks performed up to the failure.
+type VerificationResult struct {
+	// Adjacent is true if the untrusted header directly follows the trusted
+	// one, in which case the trusted validators are not checked.
+	Adjacent bool
+
+	// Voting power of the trusted validators, which signed the untrusted
+	// header, out of their total voting power. Signatures are only checked
+	// until more than TrustedVotingPowerNeeded is reached.
+	TrustedVotingPower       int64
+	TrustedVotingPowerNeeded int64
+	TrustedTotalVotingPower  int64
+
+	// Voting power of the untrusted validators, which signed the untrusted
+	// header, out of their total voting power. Signatures are only checked
+	// until more than VotingPowerNeeded (2/3 of the total) is reached.
+	VotingPower       int64
+	VotingPowerNeeded int64
+	TotalVotingPower  int64
+
+	// Signatures of the untrusted commit, which were verified with the keys of
+	// the trusted or of the untrusted validators.
+	VerifiedSignatures *bits.BitArray
+}
+
+// TrustLevelReached returns the fraction of the trusted validators' voting
+// power found to have signed the untrusted header. It is zero for adjacent
+// headers.
+func (r VerificationResult) TrustLevelReached() tmmath.Fraction {
+	if r.TrustedTotalVotingPower == 0 {
+		return tmmath.Fraction{Numerator: 0, Denominator: 1}
+	}
+	return tmmath.Fraction{Numerator: r.TrustedVotingPower, Denominator: r.TrustedTotalVotingPower}
+}
+
+// VerifyWithResult verifies untrustedHeader and untrustedVals against
+// trustedHeader and trustedVals with the given trust parameters, the same way
+// Verify does, and describes what was checked.
+//
+// trustedVals are the validators of trustedHeader (height=X) or of the next
+// height (X+1). They are ignored if the headers are adjacent.
+//
+// It is meant for verifying headers of another chain, without running a full
+// light client.
+func VerifyWithResult(
+	chainID string,
+	trustedHeader *types.SignedHeader, // height=X
+	trustedVals *types.ValidatorSet, // height=X or height=X+1
+	untrustedHeader *types.SignedHeader, // height=Y
+	untrustedVals *types.ValidatorSet, // height=Y
+	params TrustParams,
+	now time.Time) (VerificationResult, error) {
+
+	if err := params.ValidateBasic(); err != nil {
+		return VerificationResult{}, fmt.Errorf("invalid trust params: %w", err)
+	}
+	switch {
+	case trustedHeader == nil || trustedHeader.Header == nil:
+		return VerificationResult{}, errors.New("nil trusted header")
+	case untrustedHeader == nil || untrustedHeader.Header == nil || untrustedHeader.Commit == nil:
+		return VerificationResult{}, ErrInvalidHeader{errors.New("nil untrusted header")}
+	case untrustedVals == nil:
+		return VerificationResult{}, ErrInvalidHeader{errors.New("nil untrusted validators")}
+	case trustedVals == nil && untrustedHeader.Height != trustedHeader.Height+1:
+		return VerificationResult{}, errors.New("nil trusted validators")
+	}
+
+	return verify(chainID, trustedHeader, trustedVals, untrustedHeader, untrustedVals, params, now)
+}
+
 // VerifyNonAdjacent verifies non-adjacent untrustedHeader against
 // trustedHeader. It ensures that:
 //
@@ -44,40 +140,9 @@ func VerifyNonAdjacent(
 		return errors.New("headers must be non adjacent in height")
 	}
 
-	if HeaderExpired(trustedHeader, trustingPeriod, now) {
-		return ErrOldHeaderExpired{trustedHeader.Time.Add(trustingPeriod), now}
-	}
-
-	if err := verifyNewHeaderAndVals(
-		chainID,
-		untrustedHeader, untrustedVals,
-		trustedHeader,
-		now, maxClockDrift); err != nil {
-		return ErrInvalidHeader{err}
-	}
-
-	// Ensure that +`trustLevel` (default 1/3) or more of last trusted validators signed correctly.
-	err := trustedVals.VerifyCommitLightTrusting(chainID, untrustedHeader.Commit, trustLevel)
-	if err != nil {
-		switch e := err.(type) {
-		case types.ErrNotEnoughVotingPowerSigned:
-			return ErrNewValSetCantBeTrusted{e}
-		default:
-			return e
-		}
-	}
-
-	// Ensure that +2/3 of new validators signed correctly.
-	//
-	// NOTE: this should always be the last check because untrustedVals can be
-	// intentionally made very large to DOS the light client. not the case for
-	// VerifyAdjacent, where validator set is known in advance.
-	if err := untrustedVals.VerifyCommitLight(chainID, untrustedHeader.Commit.BlockID, untrustedHeader.Height,
-		untrustedHeader.Commit); err != nil {
-		return ErrInvalidHeader{err}
-	}
-
-	return nil
+	_, err := verify(chainID, trustedHeader, trustedVals, untrustedHeader, untrustedVals,
+		TrustParams{TrustingPeriod: trustingPeriod, TrustLevel: trustLevel, MaxClockDrift: maxClockDrift}, now)
+	return err
 }
 
 // VerifyAdjacent verifies directly adjacent untrustedHeader against
@@ -105,34 +170,9 @@ func VerifyAdjacent(
 		return errors.New("headers must be adjacent in height")
 	}
 
-	if HeaderExpired(trustedHeader, trustingPeriod, now) {
-		return ErrOldHeaderExpired{trustedHeader.Time.Add(trustingPeriod), now}
-	}
-
-	if err := verifyNewHeaderAndVals(
-		chainID,
-		untrustedHeader, untrustedVals,
-		trustedHeader,
-		now, maxClockDrift); err != nil {
-		return ErrInvalidHeader{err}
-	}
-
-	// Check the validator hashes are the same
-	if !bytes.Equal(untrustedHeader.ValidatorsHash, trustedHeader.NextValidatorsHash) {
-		err := fmt.Errorf("expected old header next validators (%X) to match those from new header (%X)",
-			trustedHeader.NextValidatorsHash,
-			untrustedHeader.ValidatorsHash,
-		)
-		return err
-	}
-
-	// Ensure that +2/3 of new validators signed correctly.
-	if err := untrustedVals.VerifyCommitLight(chainID, untrustedHeader.Commit.BlockID, untrustedHeader.Height,
-		untrustedHeader.Commit); err != nil {
-		return ErrInvalidHeader{err}
-	}
-
-	return nil
+	_, err := verify(chainID, trustedHeader, nil, untrustedHeader, untrustedVals,
+		TrustParams{TrustingPeriod: trustingPeriod, MaxClockDrift: maxClockDrift}, now)
+	return err
 }
 
 // Verify combines both VerifyAdjacent and VerifyNonAdjacent functions.
@@ -155,6 +195,80 @@ func Verify(
 	return VerifyAdjacent(chainID, trustedHeader, untrustedHeader, untrustedVals, trustingPeriod, now, maxClockDrift)
 }
 
+// verify performs either adjacent or non-adjacent verification depending on
+// the heights of the headers. See VerifyAdjacent and VerifyNonAdjacent.
+func verify(
+	chainID string,
+	trustedHeader *types.SignedHeader,
+	trustedVals *types.ValidatorSet,
+	untrustedHeader *types.SignedHeader,
+	untrustedVals *types.ValidatorSet,
+	params TrustParams,
+	now time.Time) (VerificationResult, error) {
+
+	result := VerificationResult{
+		Adjacent: untrustedHeader.Height == trustedHeader.Height+1,
+	}
+
+	if HeaderExpired(trustedHeader, params.TrustingPeriod, now) {
+		return result, ErrOldHeaderExpired{trustedHeader.Time.Add(params.TrustingPeriod), now}
+	}
+
+	if err := verifyNewHeaderAndVals(
+		chainID,
+		untrustedHeader, untrustedVals,
+		trustedHeader,
+		now, params.MaxClockDrift); err != nil {
+		return result, ErrInvalidHeader{err}
+	}
+
+	commit := untrustedHeader.Commit
+
+	if result.Adjacent {
+		// Check the validator hashes are the same
+		if !bytes.Equal(untrustedHeader.ValidatorsHash, trustedHeader.NextValidatorsHash) {
+			err := fmt.Errorf("expected old header next validators (%X) to match those from new header (%X)",
+				trustedHeader.NextValidatorsHash,
+				untrustedHeader.ValidatorsHash,
+			)
+			return result, err
+		}
+	} else {
+		// Ensure that +`trustLevel` (default 1/3) or more of last trusted validators signed correctly.
+		res, err := trustedVals.VerifyCommitLightTrustingWithResult(chainID, commit, params.TrustLevel)
+		result.TrustedVotingPower = res.VotingPower
+		result.TrustedVotingPowerNeeded = res.VotingPowerNeeded
+		result.TrustedTotalVotingPower = res.TotalVotingPower
+		result.VerifiedSignatures = res.VerifiedSignatures
+		if err != nil {
+			switch e := err.(type) {
+			case types.ErrNotEnoughVotingPowerSigned:
+				return result, ErrNewValSetCantBeTrusted{e}
+			default:
+				return result, e
+			}
+		}
+	}
+
+	// Ensure that +2/3 of new validators signed correctly. The signatures
+	// verified with the trusted keys are verified again with the keys of the
+	// untrusted validators.
+	//
+	// NOTE: this should always be the last check because untrustedVals can be
+	// intentionally made very large to DOS the light client. not the case for
+	// adjacent headers, where validator set is known in advance.
+	res, err := untrustedVals.VerifyCommitLightWithResult(chainID, commit.BlockID, untrustedHeader.Height, commit)
+	result.VotingPower = res.VotingPower
+	result.VotingPowerNeeded = res.VotingPowerNeeded
+	result.TotalVotingPower = res.TotalVotingPower
+	result.VerifiedSignatures = result.VerifiedSignatures.Or(res.VerifiedSignatures)
+	if err != nil {
+		return result, ErrInvalidHeader{err}
+	}
+
+	return result, nil
+}
+
 func verifyNewHeaderAndVals(
 	chainID string,
 	untrustedHeader *types.SignedHeader,
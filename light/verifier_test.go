@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	tmmath "github.com/mydexchain/tendermint0/libs/math"
 	"github.com/mydexchain/tendermint0/light"
@@ -285,6 +286,192 @@ func TestVerifyNonAdjacentHeaders(t *testing.T) {
 	}
 }
 
+func TestVerifyWithResult(t *testing.T) {
+	const (
+		chainID    = "TestVerifyWithResult"
+		lastHeight = 1
+	)
+
+	var (
+		keys = genPrivKeys(4)
+		// 20, 30, 40, 50 - the first 3 don't have 2/3, the last 3 do!
+		vals     = keys.ToValidators(20, 10)
+		bTime, _ = time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		header   = keys.GenSignedHeader(chainID, lastHeight, bTime, nil, vals, vals,
+			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys))
+		params = light.TrustParams{
+			TrustingPeriod: 3 * time.Hour,
+			TrustLevel:     light.DefaultTrustLevel,
+			MaxClockDrift:  maxClockDrift,
+		}
+		now = bTime.Add(2 * time.Hour)
+
+		// 20
+		lessThanOneThird     = keys[0:1]
+		lessThanOneThirdVals = lessThanOneThird.ToValidators(20, 10)
+	)
+
+	countVerified := func(r light.VerificationResult) int {
+		n := 0
+		for i := 0; i < r.VerifiedSignatures.Size(); i++ {
+			if r.VerifiedSignatures.GetIndex(i) {
+				n++
+			}
+		}
+		return n
+	}
+
+	t.Run("adjacent", func(t *testing.T) {
+		newHeader := keys.GenSignedHeader(chainID, lastHeight+1, bTime.Add(1*time.Hour), nil, vals, vals,
+			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys))
+		res, err := light.VerifyWithResult(chainID, header, vals, newHeader, vals, params, now)
+		require.NoError(t, err)
+		assert.True(t, res.Adjacent)
+		assert.Zero(t, res.TrustedVotingPower)
+		assert.EqualValues(t, 0, res.TrustLevelReached().Numerator)
+		// 50 + 40 + 30 > 93
+		assert.EqualValues(t, 120, res.VotingPower)
+		assert.EqualValues(t, 93, res.VotingPowerNeeded)
+		assert.EqualValues(t, 140, res.TotalVotingPower)
+		assert.Equal(t, 3, countVerified(res))
+	})
+
+	t.Run("non-adjacent", func(t *testing.T) {
+		newHeader := keys.GenSignedHeader(chainID, 3, bTime.Add(1*time.Hour), nil, vals, vals,
+			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys))
+		res, err := light.VerifyWithResult(chainID, header, vals, newHeader, vals, params, now)
+		require.NoError(t, err)
+		assert.False(t, res.Adjacent)
+		// 50 > 46
+		assert.EqualValues(t, 50, res.TrustedVotingPower)
+		assert.EqualValues(t, 46, res.TrustedVotingPowerNeeded)
+		assert.Equal(t, tmmath.Fraction{Numerator: 50, Denominator: 140}, res.TrustLevelReached())
+		assert.EqualValues(t, 120, res.VotingPower)
+		assert.Equal(t, 3, countVerified(res))
+	})
+
+	t.Run("wrong signature", func(t *testing.T) {
+		// the signatures are verified in a batch by the validator set
+		newHeader := keys.GenSignedHeader(chainID, lastHeight+1, bTime.Add(1*time.Hour), nil, vals, vals,
+			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys))
+		newHeader.Commit.Signatures[1].Signature = newHeader.Commit.Signatures[2].Signature
+		res, err := light.VerifyWithResult(chainID, header, vals, newHeader, vals, params, now)
+		if assert.IsType(t, light.ErrInvalidHeader{}, err) {
+			assert.Contains(t, err.Error(), "wrong signature (#1)")
+		}
+		assert.True(t, res.VerifiedSignatures.GetIndex(0))
+		assert.False(t, res.VerifiedSignatures.GetIndex(1))
+	})
+
+	t.Run("not enough trust", func(t *testing.T) {
+		newHeader := lessThanOneThird.GenSignedHeader(chainID, 5, bTime.Add(1*time.Hour), nil,
+			lessThanOneThirdVals, lessThanOneThirdVals,
+			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(lessThanOneThird))
+		res, err := light.VerifyWithResult(chainID, header, vals, newHeader, lessThanOneThirdVals, params, now)
+		assert.Equal(t, light.ErrNewValSetCantBeTrusted{types.ErrNotEnoughVotingPowerSigned{Got: 20, Needed: 46}}, err)
+		assert.EqualValues(t, 20, res.TrustedVotingPower)
+		assert.Zero(t, res.VotingPower)
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		invalid := params
+		invalid.TrustLevel = tmmath.Fraction{Numerator: 1, Denominator: 4}
+		_, err := light.VerifyWithResult(chainID, header, vals, header, vals, invalid, now)
+		assert.Error(t, err)
+	})
+
+	t.Run("nil headers and validators", func(t *testing.T) {
+		newHeader := keys.GenSignedHeader(chainID, 3, bTime.Add(1*time.Hour), nil, vals, vals,
+			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys))
+		noCommit := &types.SignedHeader{Header: newHeader.Header}
+		for _, args := range []struct {
+			trustedHeader, untrustedHeader *types.SignedHeader
+			trustedVals, untrustedVals     *types.ValidatorSet
+		}{
+			{nil, newHeader, vals, vals},
+			{header, nil, vals, vals},
+			{header, noCommit, vals, vals},
+			{header, newHeader, nil, vals},
+			{header, newHeader, vals, nil},
+		} {
+			assert.NotPanics(t, func() {
+				_, err := light.VerifyWithResult(chainID, args.trustedHeader, args.trustedVals,
+					args.untrustedHeader, args.untrustedVals, params, now)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestVerifyAggregatedCommit(t *testing.T) {
+	const (
+		chainID    = "TestVerifyAggregatedCommit"
+		lastHeight = 1
+	)
+
+	var (
+		keys = genBLSPrivKeys(4)
+		// 20, 30, 40, 50
+		vals     = keys.ToValidators(20, 10)
+		bTime, _ = time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		header   = keys.GenSignedHeader(chainID, lastHeight, bTime, nil, vals, vals,
+			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys))
+		params = light.TrustParams{
+			TrustingPeriod: 3 * time.Hour,
+			TrustLevel:     light.DefaultTrustLevel,
+			MaxClockDrift:  maxClockDrift,
+		}
+		now = bTime.Add(2 * time.Hour)
+	)
+
+	genAggregatedHeader := func(keys privKeys, vals *types.ValidatorSet) *types.SignedHeader {
+		h := keys.GenSignedHeader(chainID, 3, bTime.Add(1*time.Hour), nil, vals, vals,
+			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys))
+		commit, err := types.AggregateCommit(h.Commit, vals)
+		require.NoError(t, err)
+		require.True(t, commit.Aggregated())
+		h.Commit = commit
+		return h
+	}
+
+	t.Run("same validators", func(t *testing.T) {
+		newHeader := genAggregatedHeader(keys, vals)
+		res, err := light.VerifyWithResult(chainID, header, vals, newHeader, vals, params, now)
+		require.NoError(t, err)
+		// all the signatures are verified at once
+		assert.EqualValues(t, 140, res.TrustedVotingPower)
+		assert.EqualValues(t, 140, res.VotingPower)
+		for i := 0; i < len(keys); i++ {
+			assert.True(t, res.VerifiedSignatures.GetIndex(i))
+		}
+	})
+
+	t.Run("new validator", func(t *testing.T) {
+		// the aggregated signature can't be verified with the trusted keys
+		newKeys := append(keys[1:], genBLSPrivKeys(1)...)
+		newVals := newKeys.ToValidators(20, 10)
+		newHeader := genAggregatedHeader(newKeys, newVals)
+		res, err := light.VerifyWithResult(chainID, header, vals, newHeader, newVals, params, now)
+		assert.IsType(t, light.ErrNewValSetCantBeTrusted{}, err)
+		assert.Zero(t, res.TrustedVotingPower)
+	})
+
+	t.Run("signers claiming to be trusted validators", func(t *testing.T) {
+		evilKeys := genBLSPrivKeys(4)
+		evilVals := evilKeys.ToValidators(20, 10)
+		newHeader := genAggregatedHeader(evilKeys, evilVals)
+		for i := range newHeader.Commit.Signatures {
+			newHeader.Commit.Signatures[i].ValidatorAddress = vals.Validators[i].Address
+		}
+		// the aggregated signature is verified with the trusted keys
+		res, err := light.VerifyWithResult(chainID, header, vals, newHeader, evilVals, params, now)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "wrong aggregated signature")
+		}
+		assert.Zero(t, res.TrustedVotingPower)
+	})
+}
+
 func TestVerifyReturnsErrorIfTrustLevelIsInvalid(t *testing.T) {
 	const (
 		chainID    = "TestVerifyReturnsErrorIfTrustLevelIsInvalid"
@@ -8,6 +8,7 @@ import (
 	"net"
 	"net/http"
 	_ "net/http/pprof" // nolint: gosec // securely exposed on separate, optional port
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	cfg "github.com/mydexchain/tendermint0/config"
 	cs "github.com/mydexchain/tendermint0/consensus"
 	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
 	"github.com/mydexchain/tendermint0/evidence"
 	tmjson "github.com/mydexchain/tendermint0/libs/json"
 	"github.com/mydexchain/tendermint0/libs/log"
@@ -103,20 +105,44 @@ func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 	)
 }
 
-// MetricsProvider returns a consensus, p2p and mempool Metrics.
-type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics)
+// NodeMetrics contains the metrics of the node's components.
+type NodeMetrics struct {
+	Consensus *cs.Metrics
+	P2P       *p2p.Metrics
+	Mempool   *mempl.Metrics
+	State     *sm.Metrics
+	StateSync *statesync.Metrics
+	Evidence  *evidence.Metrics
+	FastSync  *bcv0.Metrics // fast sync (v0)
+}
+
+// MetricsProvider returns the metrics of the node's components.
+type MetricsProvider func(chainID string) *NodeMetrics
 
 // DefaultMetricsProvider returns Metrics build using Prometheus client library
 // if Prometheus is enabled. Otherwise, it returns no-op Metrics.
 func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
-	return func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics) {
+	return func(chainID string) *NodeMetrics {
 		if config.Prometheus {
-			return cs.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				p2p.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				mempl.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID)
+			return &NodeMetrics{
+				Consensus: cs.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				P2P:       p2p.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				Mempool:   mempl.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				State:     sm.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				StateSync: statesync.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				Evidence:  evidence.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				FastSync:  bcv0.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+			}
+		}
+		return &NodeMetrics{
+			Consensus: cs.NopMetrics(),
+			P2P:       p2p.NopMetrics(),
+			Mempool:   mempl.NopMetrics(),
+			State:     sm.NopMetrics(),
+			StateSync: statesync.NopMetrics(),
+			Evidence:  evidence.NopMetrics(),
+			FastSync:  bcv0.NopMetrics(),
 		}
-		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics()
 	}
 }
 
@@ -190,15 +216,17 @@ type Node struct {
 	bcReactor         p2p.Reactor       // for fast-syncing
 	mempoolReactor    *mempl.Reactor    // for gossipping transactions
 	mempool           mempl.Mempool
-	stateSync         bool                    // whether the node should state sync on startup
-	stateSyncReactor  *statesync.Reactor      // for hosting and restoring state sync snapshots
-	stateSyncProvider statesync.StateProvider // provides state data for bootstrapping a node
-	consensusState    *cs.State               // latest consensus state
-	consensusReactor  *cs.Reactor             // for participating in the consensus
-	pexReactor        *pex.Reactor            // for exchanging peer addresses
-	evidencePool      *evidence.Pool          // tracking evidence
-	proxyApp          proxy.AppConns          // connection to the application
-	rpcListeners      []net.Listener          // rpc servers
+	stateSync         bool                       // whether the node should state sync on startup
+	stateSyncReactor  *statesync.Reactor         // for hosting and restoring state sync snapshots
+	stateSyncProvider statesync.StateProvider    // provides state data for bootstrapping a node
+	snapshotManager   *statesync.SnapshotManager // takes node-side snapshots of the app, if enabled
+	consensusState    *cs.State                  // latest consensus state
+	consensusReactor  *cs.Reactor                // for participating in the consensus
+	pexReactor        *pex.Reactor               // for exchanging peer addresses
+	evidencePool      *evidence.Pool             // tracking evidence
+	evidenceNotifier  *evidence.WebhookNotifier  // posts evidence to a webhook, if enabled
+	proxyApp          proxy.AppConns             // connection to the application
+	rpcListeners      []net.Listener             // rpc servers
 	txIndexer         txindex.TxIndexer
 	indexerService    *txindex.IndexerService
 	prometheusSrv     *http.Server
@@ -261,6 +289,22 @@ func createAndStartIndexerService(config *cfg.Config, dbProvider DBProvider,
 	return indexerService, txIndexer, nil
 }
 
+// createAndStartSnapshotManager starts taking node-side snapshots of the app, if configured.
+// Returns nil otherwise.
+func createAndStartSnapshotManager(config *cfg.Config, proxyApp proxy.AppConns,
+	logger log.Logger) (*statesync.SnapshotManager, error) {
+	if config.StateSync.SnapshotInterval == 0 {
+		return nil, nil
+	}
+	snapshotManager := statesync.NewSnapshotManager(proxyApp.Snapshot(), filepath.Join(config.DBDir(), "snapshots"),
+		config.StateSync.SnapshotInterval, config.StateSync.SnapshotKeepRecent)
+	snapshotManager.SetLogger(logger.With("module", "snapshots"))
+	if err := snapshotManager.Start(); err != nil {
+		return nil, err
+	}
+	return snapshotManager, nil
+}
+
 func doHandshake(
 	stateDB dbm.DB,
 	state sm.State,
@@ -268,17 +312,40 @@ func doHandshake(
 	genDoc *types.GenesisDoc,
 	eventBus types.BlockEventPublisher,
 	proxyApp proxy.AppConns,
-	consensusLogger log.Logger) error {
+	consensusLogger log.Logger,
+	blockExecOptions []sm.BlockExecutorOption) error {
 
 	handshaker := cs.NewHandshaker(stateDB, state, blockStore, genDoc)
 	handshaker.SetLogger(consensusLogger)
 	handshaker.SetEventBus(eventBus)
+	handshaker.SetBlockExecutorOptions(blockExecOptions...)
 	if err := handshaker.Handshake(proxyApp); err != nil {
 		return fmt.Errorf("error during handshake: %v", err)
 	}
 	return nil
 }
 
+// checkExperimentalBLS returns an error if the chain accepts BLS12-381
+// validator keys, or the node validates with one, but the experimental BLS
+// support isn't enabled.
+func checkExperimentalBLS(config *cfg.ConsensusConfig, state sm.State, pubKey crypto.PubKey) error {
+	if config.ExperimentalBLS {
+		if !bls12381.Enabled {
+			return fmt.Errorf("consensus.experimental_bls is set: %w", bls12381.ErrDisabled)
+		}
+		return nil
+	}
+	if types.IsValidPubkeyType(state.ConsensusParams.Validator, types.ABCIPubKeyTypeBls12381) {
+		return errors.New("the consensus params accept BLS12-381 validator keys, which are experimental " +
+			"and must be enabled with consensus.experimental_bls")
+	}
+	if _, ok := pubKey.(bls12381.PubKey); ok {
+		return errors.New("the validator key is a BLS12-381 key, which is experimental " +
+			"and must be enabled with consensus.experimental_bls")
+	}
+	return nil
+}
+
 func logNodeStartupInfo(state sm.State, pubKey crypto.PubKey, logger, consensusLogger log.Logger) {
 	// Log the version info.
 	logger.Info("Version info",
@@ -334,20 +401,37 @@ func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns,
 }
 
 func createEvidenceReactor(config *cfg.Config, dbProvider DBProvider,
-	stateDB dbm.DB, blockStore *store.BlockStore, logger log.Logger) (*evidence.Reactor, *evidence.Pool, error) {
+	stateDB dbm.DB, blockStore *store.BlockStore, evMetrics *evidence.Metrics,
+	logger log.Logger) (*evidence.Reactor, *evidence.Pool, *evidence.WebhookNotifier, error) {
 
 	evidenceDB, err := dbProvider(&DBContext{"evidence", config})
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	evidenceLogger := logger.With("module", "evidence")
-	evidencePool, err := evidence.NewPool(stateDB, evidenceDB, blockStore)
+	options := []evidence.PoolOption{evidence.WithMetrics(evMetrics)}
+
+	var notifier *evidence.WebhookNotifier
+	if config.Instrumentation.EvidenceWebhookURL != "" {
+		outboxDB, err := dbProvider(&DBContext{"evidence_webhook", config})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		notifier, err = evidence.NewWebhookNotifier(config.Instrumentation.EvidenceWebhookURL, outboxDB)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		notifier.SetLogger(evidenceLogger)
+		options = append(options, evidence.WithNotifier(notifier))
+	}
+
+	evidencePool, err := evidence.NewPool(stateDB, evidenceDB, blockStore, options...)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	evidenceReactor := evidence.NewReactor(evidencePool)
 	evidenceReactor.SetLogger(evidenceLogger)
-	return evidenceReactor, evidencePool, nil
+	return evidenceReactor, evidencePool, notifier, nil
 }
 
 func createBlockchainReactor(config *cfg.Config,
@@ -355,11 +439,13 @@ func createBlockchainReactor(config *cfg.Config,
 	blockExec *sm.BlockExecutor,
 	blockStore *store.BlockStore,
 	fastSync bool,
+	bcMetrics *bcv0.Metrics,
 	logger log.Logger) (bcReactor p2p.Reactor, err error) {
 
 	switch config.FastSync.Version {
 	case "v0":
-		bcReactor = bcv0.NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync)
+		bcReactor = bcv0.NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync,
+			bcv0.ReactorMetrics(bcMetrics))
 	case "v1":
 		bcReactor = bcv1.NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync)
 	case "v2":
@@ -564,13 +650,20 @@ func startStateSync(ssR *statesync.Reactor, bcR fastSyncReactor, conR *cs.Reacto
 	state := sm.LoadState(stateDB)
 	if stateProvider == nil {
 		var err error
-		stateProvider, err = statesync.NewLightClientStateProvider(
-			state.ChainID, state.Version, state.InitialHeight,
-			config.RPCServers, light.TrustOptions{
-				Period: config.TrustPeriod,
-				Height: config.TrustHeight,
-				Hash:   config.TrustHashBytes(),
-			}, ssR.Logger.With("module", "light"))
+		trustOptions := light.TrustOptions{
+			Period: config.TrustPeriod,
+			Height: config.TrustHeight,
+			Hash:   config.TrustHashBytes(),
+		}
+		// Without RPC servers, light blocks and consensus params are fetched from peers.
+		if len(config.RPCServers) == 0 {
+			stateProvider, err = statesync.NewP2PStateProvider(ssR, state.ChainID, state.Version,
+				state.InitialHeight, trustOptions, ssR.Logger.With("module", "light"))
+		} else {
+			stateProvider, err = statesync.NewLightClientStateProvider(
+				state.ChainID, state.Version, state.InitialHeight,
+				config.RPCServers, trustOptions, ssR.Logger.With("module", "light"))
+		}
 		if err != nil {
 			return fmt.Errorf("failed to set up light client state provider: %w", err)
 		}
@@ -665,6 +758,13 @@ func NewNode(config *cfg.Config,
 	if err != nil {
 		return nil, fmt.Errorf("can't get pubkey: %w", err)
 	}
+	if err := checkExperimentalBLS(config.Consensus, state, pubKey); err != nil {
+		return nil, err
+	}
+	var blsOptions []sm.BlockExecutorOption
+	if config.Consensus.ExperimentalBLS {
+		blsOptions = append(blsOptions, sm.BlockExecutorWithExperimentalBLS())
+	}
 
 	// Determine whether we should attempt state sync.
 	stateSync := config.StateSync.Enable && !onlyValidatorIsUs(state, pubKey)
@@ -677,7 +777,8 @@ func NewNode(config *cfg.Config,
 	// and replays any blocks as necessary to sync tendermint with the app.
 	consensusLogger := logger.With("module", "consensus")
 	if !stateSync {
-		if err := doHandshake(stateDB, state, blockStore, genDoc, eventBus, proxyApp, consensusLogger); err != nil {
+		err := doHandshake(stateDB, state, blockStore, genDoc, eventBus, proxyApp, consensusLogger, blsOptions)
+		if err != nil {
 			return nil, err
 		}
 
@@ -685,6 +786,9 @@ func NewNode(config *cfg.Config,
 		// Handshake, and may have other modifications as well (ie. depending on
 		// what happened during block replay).
 		state = sm.LoadState(stateDB)
+		if err := checkExperimentalBLS(config.Consensus, state, pubKey); err != nil {
+			return nil, err
+		}
 	}
 
 	// Determine whether we should do fast sync. This must happen after the handshake, since the
@@ -693,16 +797,27 @@ func NewNode(config *cfg.Config,
 
 	logNodeStartupInfo(state, pubKey, logger, consensusLogger)
 
-	csMetrics, p2pMetrics, memplMetrics, smMetrics := metricsProvider(genDoc.ChainID)
+	metrics := metricsProvider(genDoc.ChainID)
 
 	// Make MempoolReactor
-	mempoolReactor, mempool := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger)
+	mempoolReactor, mempool := createMempoolAndMempoolReactor(config, proxyApp, state, metrics.Mempool, logger)
 
 	// Make Evidence Reactor
-	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateDB, blockStore, logger)
+	evidenceReactor, evidencePool, evidenceNotifier, err := createEvidenceReactor(config, dbProvider, stateDB,
+		blockStore, metrics.Evidence, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// Take node-side snapshots of the app after committing blocks, if enabled.
+	snapshotManager, err := createAndStartSnapshotManager(config, proxyApp, logger)
 	if err != nil {
 		return nil, err
 	}
+	blockExecOptions := append(blsOptions, sm.BlockExecutorWithMetrics(metrics.State))
+	if snapshotManager != nil {
+		blockExecOptions = append(blockExecOptions, sm.BlockExecutorWithSnapshotter(snapshotManager))
+	}
 
 	// make block executor for consensus and blockchain reactors to execute blocks
 	blockExec := sm.NewBlockExecutor(
@@ -711,11 +826,12 @@ func NewNode(config *cfg.Config,
 		proxyApp.Consensus(),
 		mempool,
 		evidencePool,
-		sm.BlockExecutorWithMetrics(smMetrics),
+		blockExecOptions...,
 	)
 
 	// Make BlockchainReactor. Don't start fast sync if we're doing a state sync first.
-	bcReactor, err := createBlockchainReactor(config, state, blockExec, blockStore, fastSync && !stateSync, logger)
+	bcReactor, err := createBlockchainReactor(config, state, blockExec, blockStore, fastSync && !stateSync,
+		metrics.FastSync, logger)
 	if err != nil {
 		return nil, fmt.Errorf("could not create blockchain reactor: %w", err)
 	}
@@ -723,21 +839,25 @@ func NewNode(config *cfg.Config,
 	// Make ConsensusReactor. Don't enable fully if doing a state sync and/or fast sync first.
 	// FIXME We need to update metrics here, since other reactors don't have access to them.
 	if stateSync {
-		csMetrics.StateSyncing.Set(1)
+		metrics.Consensus.StateSyncing.Set(1)
 	} else if fastSync {
-		csMetrics.FastSyncing.Set(1)
+		metrics.Consensus.FastSyncing.Set(1)
 	}
 	consensusReactor, consensusState := createConsensusReactor(
 		config, state, blockExec, blockStore, mempool, evidencePool,
-		privValidator, csMetrics, stateSync || fastSync, eventBus, consensusLogger,
+		privValidator, metrics.Consensus, stateSync || fastSync, eventBus, consensusLogger,
 	)
 
 	// Set up state sync reactor, and schedule a sync if requested.
 	// FIXME The way we do phased startups (e.g. replay -> fast sync -> consensus) is very messy,
 	// we should clean this whole thing up. See:
 	// https://github.com/mydexchain/tendermint0/issues/4644
-	stateSyncReactor := statesync.NewReactor(proxyApp.Snapshot(), proxyApp.Query(),
-		config.StateSync.TempDir)
+	var snapshotConn proxy.AppConnSnapshot = proxyApp.Snapshot()
+	if snapshotManager != nil {
+		snapshotConn = snapshotManager
+	}
+	stateSyncReactor := statesync.NewReactor(snapshotConn, proxyApp.Query(), stateDB, blockStore,
+		config.StateSync.TempDir, statesync.ReactorMetrics(metrics.StateSync))
 	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
 
 	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state)
@@ -751,7 +871,7 @@ func NewNode(config *cfg.Config,
 	// Setup Switch.
 	p2pLogger := logger.With("module", "p2p")
 	sw := createSwitch(
-		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
+		config, transport, metrics.P2P, peerFilters, mempoolReactor, bcReactor,
 		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger,
 	)
 
@@ -813,8 +933,10 @@ func NewNode(config *cfg.Config,
 		consensusReactor: consensusReactor,
 		stateSyncReactor: stateSyncReactor,
 		stateSync:        stateSync,
+		snapshotManager:  snapshotManager,
 		pexReactor:       pexReactor,
 		evidencePool:     evidencePool,
+		evidenceNotifier: evidenceNotifier,
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
@@ -856,6 +978,12 @@ func (n *Node) OnStart() error {
 		n.prometheusSrv = n.startPrometheusServer(n.config.Instrumentation.PrometheusListenAddr)
 	}
 
+	if n.evidenceNotifier != nil {
+		if err := n.evidenceNotifier.Start(); err != nil {
+			return err
+		}
+	}
+
 	// Start the transport.
 	addr, err := p2p.NewNetAddressString(p2p.IDAddressString(n.nodeKey.ID(), n.config.P2P.ListenAddress))
 	if err != nil {
@@ -911,6 +1039,11 @@ func (n *Node) OnStop() {
 	// first stop the non-reactor services
 	n.eventBus.Stop()
 	n.indexerService.Stop()
+	if n.snapshotManager != nil {
+		if err := n.snapshotManager.Stop(); err != nil {
+			n.Logger.Error("Error stopping snapshot manager", "err", err)
+		}
+	}
 
 	// now stop the reactors
 	n.sw.Stop()
@@ -944,6 +1077,12 @@ func (n *Node) OnStop() {
 			n.Logger.Error("Prometheus HTTP server Shutdown", "err", err)
 		}
 	}
+
+	if n.evidenceNotifier != nil {
+		if err := n.evidenceNotifier.Stop(); err != nil {
+			n.Logger.Error("Error stopping evidence notifier", "err", err)
+		}
+	}
 }
 
 // ConfigureRPC makes sure RPC has all the objects it needs to operate.
@@ -967,6 +1106,7 @@ func (n *Node) ConfigureRPC() error {
 		GenDoc:           n.genesisDoc,
 		TxIndexer:        n.txIndexer,
 		ConsensusReactor: n.consensusReactor,
+		StateSyncReactor: n.stateSyncReactor,
 		EventBus:         n.eventBus,
 		Mempool:          n.mempool,
 
@@ -988,11 +1128,13 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 	if n.config.RPC.Unsafe {
 		rpccore.AddUnsafeRoutes()
 	}
+	openAPI := rpccore.OpenAPI(rpccore.Routes)
 
 	config := rpcserver.DefaultConfig()
 	config.MaxBodyBytes = n.config.RPC.MaxBodyBytes
 	config.MaxHeaderBytes = n.config.RPC.MaxHeaderBytes
 	config.MaxOpenConnections = n.config.RPC.MaxOpenConnections
+	config.CompressResponses = n.config.RPC.CompressResponses
 	// If necessary adjust global WriteTimeout to ensure it's greater than
 	// TimeoutBroadcastTxCommit.
 	// See https://github.com/mydexchain/tendermint0/issues/3435
@@ -1017,7 +1159,10 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		)
 		wm.SetLogger(wmLogger)
 		mux.HandleFunc("/websocket", wm.WebsocketHandler)
-		rpcserver.RegisterRPCFuncs(mux, rpccore.Routes, rpcLogger)
+		mux.HandleFunc("/openapi.json", rpcserver.OpenAPIHandler(openAPI))
+		rpcserver.RegisterRPCFuncs(mux, rpccore.Routes, rpcLogger,
+			rpcserver.MaxBatchConcurrency(n.config.RPC.MaxBatchConcurrency),
+		)
 		listener, err := rpcserver.Listen(
 			listenAddr,
 			config,
@@ -1224,6 +1369,7 @@ func makeNodeInfo(
 			mempl.MempoolChannel,
 			evidence.EvidenceChannel,
 			statesync.SnapshotChannel, statesync.ChunkChannel,
+			statesync.LightBlockChannel, statesync.ParamsChannel,
 		},
 		Moniker: config.Moniker,
 		Other: p2p.DefaultNodeInfoOther{
@@ -281,12 +281,13 @@ func TestCreateProposalBlock(t *testing.T) {
 		evidencePool,
 	)
 
-	commit := types.NewCommit(height-1, 0, types.BlockID{}, nil)
-	block, _ := blockExec.CreateProposalBlock(
+	commit := types.NewExtendedCommit(height-1, 0, types.BlockID{}, nil)
+	block, _, err := blockExec.CreateProposalBlock(
 		height,
 		state, commit,
 		proposerAddr,
 	)
+	require.NoError(t, err)
 
 	err = blockExec.ValidateBlock(state, block)
 	assert.NoError(t, err)
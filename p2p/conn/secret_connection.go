@@ -94,6 +94,12 @@ func MakeSecretConnection(conn io.ReadWriteCloser, locPrivKey crypto.PrivKey) (*
 		locPubKey = locPrivKey.PubKey()
 	)
 
+	// Like the remote key below, the local key must be an ed25519 key, even if
+	// other key types can be encoded.
+	if _, ok := locPubKey.(ed25519.PubKey); locPubKey != nil && !ok {
+		return nil, fmt.Errorf("node key type %s is not supported", locPubKey.Type())
+	}
+
 	// Generate ephemeral keys for perfect forward secrecy.
 	locEphPub, locEphPriv := genEphKeys()
 
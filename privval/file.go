@@ -10,7 +10,10 @@ import (
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
 	"github.com/mydexchain/tendermint0/crypto/ed25519"
+	"github.com/mydexchain/tendermint0/crypto/secp256k1"
+	"github.com/mydexchain/tendermint0/crypto/sr25519"
 	tmbytes "github.com/mydexchain/tendermint0/libs/bytes"
 	tmjson "github.com/mydexchain/tendermint0/libs/json"
 	tmos "github.com/mydexchain/tendermint0/libs/os"
@@ -49,6 +52,10 @@ type FilePVKey struct {
 	PubKey  crypto.PubKey  `json:"pub_key"`
 	PrivKey crypto.PrivKey `json:"priv_key"`
 
+	// NextPubKey and NextPrivKey are the key the validator rotates to, if any.
+	NextPubKey  crypto.PubKey  `json:"next_pub_key,omitempty"`
+	NextPrivKey crypto.PrivKey `json:"next_priv_key,omitempty"`
+
 	filePath string
 }
 
@@ -152,11 +159,38 @@ type FilePV struct {
 	LastSignState FilePVLastSignState
 }
 
-// GenFilePV generates a new validator with randomly generated private key
-// and sets the filePaths, but does not call Save().
+// GenFilePV generates a new validator with randomly generated ed25519 private
+// key and sets the filePaths, but does not call Save().
 func GenFilePV(keyFilePath, stateFilePath string) *FilePV {
-	privKey := ed25519.GenPrivKey()
+	return NewFilePV(ed25519.GenPrivKey(), keyFilePath, stateFilePath)
+}
+
+// GenFilePVWithKeyType generates a new validator with randomly generated
+// private key of the given type (ed25519, secp256k1, sr25519 or bls12381) and
+// sets the filePaths, but does not call Save().
+func GenFilePVWithKeyType(keyFilePath, stateFilePath, keyType string) (*FilePV, error) {
+	var privKey crypto.PrivKey
+	switch keyType {
+	case types.ABCIPubKeyTypeEd25519:
+		privKey = ed25519.GenPrivKey()
+	case types.ABCIPubKeyTypeSecp256k1:
+		privKey = secp256k1.GenPrivKey()
+	case types.ABCIPubKeyTypeSr25519:
+		privKey = sr25519.GenPrivKey()
+	case types.ABCIPubKeyTypeBls12381:
+		if !bls12381.Enabled {
+			return nil, bls12381.ErrDisabled
+		}
+		privKey = bls12381.GenPrivKey()
+	default:
+		return nil, fmt.Errorf("key type %q is not supported", keyType)
+	}
+	return NewFilePV(privKey, keyFilePath, stateFilePath), nil
+}
 
+// NewFilePV generates a new validator from the given key and paths, but does
+// not call Save().
+func NewFilePV(privKey crypto.PrivKey, keyFilePath, stateFilePath string) *FilePV {
 	return &FilePV{
 		Key: FilePVKey{
 			Address:  privKey.PubKey().Address(),
@@ -199,6 +233,9 @@ func loadFilePV(keyFilePath, stateFilePath string, loadState bool) *FilePV {
 	// overwrite pubkey and address for convenience
 	pvKey.PubKey = pvKey.PrivKey.PubKey()
 	pvKey.Address = pvKey.PubKey.Address()
+	if pvKey.NextPrivKey != nil {
+		pvKey.NextPubKey = pvKey.NextPrivKey.PubKey()
+	}
 	pvKey.filePath = keyFilePath
 
 	pvState := FilePVLastSignState{}
@@ -247,6 +284,38 @@ func (pv *FilePV) GetPubKey() (crypto.PubKey, error) {
 	return pv.Key.PubKey, nil
 }
 
+// GetNextPubKey returns the public key the validator rotates to, or nil.
+// Implements RotatingPrivValidator.
+func (pv *FilePV) GetNextPubKey() (crypto.PubKey, error) {
+	return pv.Key.NextPubKey, nil
+}
+
+// SetNextKey sets the key the validator rotates to, but does not call Save().
+func (pv *FilePV) SetNextKey(privKey crypto.PrivKey) {
+	pv.Key.NextPrivKey = privKey
+	pv.Key.NextPubKey = privKey.PubKey()
+}
+
+// RotateKey makes the next key the key of the validator and saves it. As both
+// keys share the last sign state, the validator can't rotate at a height it
+// already signed at. Implements RotatingPrivValidator.
+func (pv *FilePV) RotateKey(height int64) error {
+	if pv.Key.NextPrivKey == nil {
+		return errors.New("no next key to rotate to")
+	}
+	if height <= pv.LastSignState.Height {
+		return fmt.Errorf("can't rotate key at height %d, already signed at height %d",
+			height, pv.LastSignState.Height)
+	}
+	pv.Key.PrivKey = pv.Key.NextPrivKey
+	pv.Key.PubKey = pv.Key.NextPubKey
+	pv.Key.Address = pv.Key.PubKey.Address()
+	pv.Key.NextPrivKey = nil
+	pv.Key.NextPubKey = nil
+	pv.Key.Save()
+	return nil
+}
+
 // SignVote signs a canonical representation of the vote, along with the
 // chainID. Implements PrivValidator.
 func (pv *FilePV) SignVote(chainID string, vote *tmproto.Vote) error {
@@ -323,9 +392,9 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 			vote.Timestamp = timestamp
 			vote.Signature = lss.Signature
 		} else {
-			err = fmt.Errorf("conflicting data")
+			return fmt.Errorf("conflicting data")
 		}
-		return err
+		return pv.signVoteExtension(chainID, vote)
 	}
 
 	// It passed the checks. Sign the vote
@@ -335,6 +404,21 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 	}
 	pv.saveSigned(height, round, step, signBytes, sig)
 	vote.Signature = sig
+	return pv.signVoteExtension(chainID, vote)
+}
+
+// signVoteExtension signs the vote extension of precommits for a block. Vote
+// extensions can't be used to double sign, so unlike the vote itself they are
+// signed again if we re-sign the vote.
+func (pv *FilePV) signVoteExtension(chainID string, vote *tmproto.Vote) error {
+	if !types.IsVoteExtendable(vote) {
+		return nil
+	}
+	sig, err := pv.Key.PrivKey.Sign(types.VoteExtensionSignBytes(chainID, vote))
+	if err != nil {
+		return err
+	}
+	vote.ExtensionSignature = sig
 	return nil
 }
 
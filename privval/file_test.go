@@ -40,6 +40,82 @@ func TestGenLoadValidator(t *testing.T) {
 	assert.Equal(height, privVal.LastSignState.Height, "expected privval.LastHeight to have been saved")
 }
 
+func TestGenLoadValidatorWithKeyType(t *testing.T) {
+	keyTypes := []string{types.ABCIPubKeyTypeEd25519, types.ABCIPubKeyTypeSecp256k1, types.ABCIPubKeyTypeSr25519,
+		types.ABCIPubKeyTypeBls12381}
+
+	for _, keyType := range keyTypes {
+		tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
+		require.Nil(t, err)
+		tempStateFile, err := ioutil.TempFile("", "priv_validator_state_")
+		require.Nil(t, err)
+
+		privVal, err := GenFilePVWithKeyType(tempKeyFile.Name(), tempStateFile.Name(), keyType)
+		require.NoError(t, err, keyType)
+		privVal.Save()
+		pubKey, err := privVal.GetPubKey()
+		require.NoError(t, err)
+		assert.Equal(t, keyType, pubKey.Type())
+
+		privVal = LoadFilePV(tempKeyFile.Name(), tempStateFile.Name())
+		loadedPubKey, err := privVal.GetPubKey()
+		require.NoError(t, err)
+		assert.Equal(t, pubKey, loadedPubKey, keyType)
+
+		// the loaded key can sign votes
+		blockID := types.BlockID{Hash: tmrand.Bytes(tmhash.Size), PartSetHeader: types.PartSetHeader{}}
+		vote := newVote(privVal.Key.Address, 0, 1, 0, tmproto.PrevoteType, blockID)
+		v := vote.ToProto()
+		require.NoError(t, privVal.SignVote("mychainid", v), keyType)
+		assert.True(t, pubKey.VerifySignature(types.VoteSignBytes("mychainid", v), v.Signature), keyType)
+	}
+
+	_, err := GenFilePVWithKeyType("", "", "potatoes")
+	assert.Error(t, err)
+}
+
+func TestRotateKey(t *testing.T) {
+	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
+	require.Nil(t, err)
+	tempStateFile, err := ioutil.TempFile("", "priv_validator_state_")
+	require.Nil(t, err)
+
+	privVal := GenFilePV(tempKeyFile.Name(), tempStateFile.Name())
+	privVal.Save()
+	assert.Error(t, privVal.RotateKey(1), "no next key")
+
+	// the next key is saved with the key
+	nextPrivKey := ed25519.GenPrivKey()
+	privVal.SetNextKey(nextPrivKey)
+	privVal.Save()
+	privVal = LoadFilePV(tempKeyFile.Name(), tempStateFile.Name())
+	nextPubKey, err := privVal.GetNextPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, nextPrivKey.PubKey(), nextPubKey)
+
+	blockID := types.BlockID{Hash: tmrand.Bytes(tmhash.Size), PartSetHeader: types.PartSetHeader{}}
+	vote := newVote(privVal.Key.Address, 0, 10, 0, tmproto.PrevoteType, blockID).ToProto()
+	require.NoError(t, privVal.SignVote("mychainid", vote))
+
+	// the key can't be rotated at a height the validator signed at
+	assert.Error(t, privVal.RotateKey(10))
+	require.NoError(t, privVal.RotateKey(11))
+
+	// the rotated key is saved and signs the votes
+	privVal = LoadFilePV(tempKeyFile.Name(), tempStateFile.Name())
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, nextPrivKey.PubKey(), pubKey)
+	assert.Equal(t, pubKey.Address(), privVal.GetAddress())
+	nextPubKey, err = privVal.GetNextPubKey()
+	require.NoError(t, err)
+	assert.Nil(t, nextPubKey)
+
+	vote = newVote(privVal.Key.Address, 0, 11, 0, tmproto.PrevoteType, blockID).ToProto()
+	require.NoError(t, privVal.SignVote("mychainid", vote))
+	assert.True(t, pubKey.VerifySignature(types.VoteSignBytes("mychainid", vote), vote.Signature))
+}
+
 func TestResetValidator(t *testing.T) {
 	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
 	require.Nil(t, err)
@@ -9,6 +9,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/ed25519"
+	"github.com/mydexchain/tendermint0/crypto/secp256k1"
+	"github.com/mydexchain/tendermint0/crypto/sr25519"
 	"github.com/mydexchain/tendermint0/crypto/tmhash"
 	tmrand "github.com/mydexchain/tendermint0/libs/rand"
 	privvalproto "github.com/mydexchain/tendermint0/proto/tendermint/privval"
@@ -26,28 +29,31 @@ type signerTestCase struct {
 func getSignerTestCases(t *testing.T) []signerTestCase {
 	testCases := make([]signerTestCase, 0)
 
-	// Get test cases for each possible dialer (DialTCP / DialUnix / etc)
-	for _, dtc := range getDialerTestCases(t) {
-		chainID := tmrand.Str(12)
-		mockPV := types.NewMockPV()
-
-		// get a pair of signer listener, signer dialer endpoints
-		sl, sd := getMockEndpoints(t, dtc.addr, dtc.dialer)
-		sc, err := NewSignerClient(sl)
-		require.NoError(t, err)
-		ss := NewSignerServer(sd, chainID, mockPV)
-
-		err = ss.Start()
-		require.NoError(t, err)
+	// Get test cases for each supported validator key type and each possible
+	// dialer (DialTCP / DialUnix / etc)
+	for _, privKey := range []crypto.PrivKey{ed25519.GenPrivKey(), secp256k1.GenPrivKey(), sr25519.GenPrivKey()} {
+		for _, dtc := range getDialerTestCases(t) {
+			chainID := tmrand.Str(12)
+			mockPV := types.NewMockPVWithParams(privKey, false, false)
+
+			// get a pair of signer listener, signer dialer endpoints
+			sl, sd := getMockEndpoints(t, dtc.addr, dtc.dialer)
+			sc, err := NewSignerClient(sl)
+			require.NoError(t, err)
+			ss := NewSignerServer(sd, chainID, mockPV)
+
+			err = ss.Start()
+			require.NoError(t, err)
+
+			tc := signerTestCase{
+				chainID:      chainID,
+				mockPV:       mockPV,
+				signerClient: sc,
+				signerServer: ss,
+			}
 
-		tc := signerTestCase{
-			chainID:      chainID,
-			mockPV:       mockPV,
-			signerClient: sc,
-			signerServer: ss,
+			testCases = append(testCases, tc)
 		}
-
-		testCases = append(testCases, tc)
 	}
 
 	return testCases
@@ -9,6 +9,7 @@ import (
 	"github.com/mydexchain/tendermint0/libs/service"
 	tmsync "github.com/mydexchain/tendermint0/libs/sync"
 	privvalproto "github.com/mydexchain/tendermint0/proto/tendermint/privval"
+	"github.com/mydexchain/tendermint0/types"
 )
 
 const (
@@ -94,7 +95,8 @@ func (se *signerEndpoint) ReadMessage() (msg privvalproto.Message, err error) {
 	if err != nil {
 		return
 	}
-	const maxRemoteSignerMsgSize = 1024 * 10
+	// votes may carry a vote extension
+	const maxRemoteSignerMsgSize = 1024*10 + types.MaxVoteExtensionSize
 	protoReader := protoio.NewDelimitedReader(se.conn, maxRemoteSignerMsgSize)
 	err = protoReader.ReadMsg(&msg)
 	if _, ok := err.(timeoutError); ok {
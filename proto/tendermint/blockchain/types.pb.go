@@ -116,6 +116,9 @@ func (m *NoBlockResponse) GetHeight() int64 {
 // BlockResponse returns block to the requested
 type BlockResponse struct {
 	Block *types.Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// The seen commit of the block along with the vote extensions, if the peer
+	// has it.
+	ExtCommit *types.ExtendedCommit `protobuf:"bytes,2,opt,name=ext_commit,json=extCommit,proto3" json:"ext_commit,omitempty"`
 }
 
 func (m *BlockResponse) Reset()         { *m = BlockResponse{} }
@@ -158,6 +161,13 @@ func (m *BlockResponse) GetBlock() *types.Block {
 	return nil
 }
 
+func (m *BlockResponse) GetExtCommit() *types.ExtendedCommit {
+	if m != nil {
+		return m.ExtCommit
+	}
+	return nil
+}
+
 // StatusRequest requests the status of a peer.
 type StatusRequest struct {
 }
@@ -384,30 +394,32 @@ func init() {
 func init() { proto.RegisterFile("tendermint/blockchain/types.proto", fileDescriptor_2927480384e78499) }
 
 var fileDescriptor_2927480384e78499 = []byte{
-	// 370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x4e, 0xfa, 0x40,
-	0x10, 0xc6, 0xdb, 0x7f, 0x81, 0x7f, 0x32, 0x50, 0x1a, 0x9b, 0xa8, 0xc4, 0x98, 0x46, 0xab, 0x12,
-	0x3d, 0xd8, 0x26, 0x78, 0x25, 0x1e, 0x38, 0x11, 0x13, 0x8c, 0xa9, 0xc6, 0x83, 0x17, 0xd2, 0xe2,
-	0x86, 0x36, 0x4a, 0x17, 0xd9, 0xed, 0xc1, 0xb7, 0xf0, 0x19, 0x7c, 0x1a, 0x8f, 0x1c, 0x3d, 0x1a,
-	0x78, 0x11, 0xc3, 0x6c, 0x29, 0x4b, 0x03, 0xf5, 0xb6, 0x3b, 0xfd, 0xe6, 0x37, 0xdf, 0x7e, 0x99,
-	0xc2, 0x31, 0x27, 0xf1, 0x33, 0x99, 0x8c, 0xa2, 0x98, 0xbb, 0xc1, 0x2b, 0x1d, 0xbc, 0x0c, 0x42,
-	0x3f, 0x8a, 0x5d, 0xfe, 0x3e, 0x26, 0xcc, 0x19, 0x4f, 0x28, 0xa7, 0xe6, 0xee, 0x4a, 0xe2, 0xac,
-	0x24, 0x07, 0x87, 0x52, 0x27, 0xca, 0x45, 0xbf, 0x68, 0xb2, 0x9b, 0x50, 0xeb, 0x2c, 0xae, 0x1e,
-	0x79, 0x4b, 0x08, 0xe3, 0xe6, 0x1e, 0x54, 0x42, 0x12, 0x0d, 0x43, 0xde, 0x50, 0x8f, 0xd4, 0x73,
-	0xcd, 0x4b, 0x6f, 0xf6, 0x05, 0x18, 0xb7, 0x34, 0x55, 0xb2, 0x31, 0x8d, 0x19, 0xd9, 0x2a, 0xbd,
-	0x06, 0x7d, 0x5d, 0x78, 0x09, 0x65, 0x1c, 0x89, 0xba, 0x6a, 0x6b, 0xdf, 0x91, 0x8c, 0x8a, 0x07,
-	0x08, 0xbd, 0x50, 0xd9, 0x06, 0xe8, 0xf7, 0xdc, 0xe7, 0x09, 0x4b, 0x3d, 0xd9, 0x6d, 0xa8, 0x2f,
-	0x0b, 0xc5, 0xa3, 0x4d, 0x13, 0x4a, 0x81, 0xcf, 0x48, 0xe3, 0x1f, 0x56, 0xf1, 0x6c, 0x7f, 0x6a,
-	0xf0, 0xbf, 0x47, 0x18, 0xf3, 0x87, 0xc4, 0xbc, 0x01, 0x1d, 0x67, 0xf4, 0x27, 0x02, 0x9d, 0x3a,
-	0x3a, 0x71, 0x36, 0x46, 0xe7, 0xc8, 0xc9, 0x74, 0x15, 0xaf, 0x16, 0xc8, 0x49, 0x3d, 0xc0, 0x4e,
-	0x4c, 0xfb, 0x4b, 0x9c, 0x30, 0x86, 0x83, 0xab, 0xad, 0xe6, 0x16, 0x5e, 0x2e, 0xc1, 0xae, 0xe2,
-	0x19, 0x71, 0x2e, 0xd4, 0x1e, 0xd4, 0x73, 0x48, 0x0d, 0x91, 0xa7, 0xc5, 0x16, 0x33, 0xa0, 0x1e,
-	0xe4, 0x71, 0x0c, 0xa3, 0xcb, 0x5e, 0x5c, 0x2a, 0xc4, 0xad, 0x05, 0xbf, 0xc0, 0x31, 0xb9, 0x60,
-	0xde, 0x81, 0x91, 0xe1, 0x52, 0x7b, 0x65, 0xe4, 0x9d, 0xfd, 0xc1, 0xcb, 0xfc, 0xd5, 0xd9, 0x5a,
-	0xa5, 0x53, 0x06, 0x8d, 0x25, 0xa3, 0xce, 0xe3, 0xd7, 0xcc, 0x52, 0xa7, 0x33, 0x4b, 0xfd, 0x99,
-	0x59, 0xea, 0xc7, 0xdc, 0x52, 0xa6, 0x73, 0x4b, 0xf9, 0x9e, 0x5b, 0xca, 0x53, 0x7b, 0x18, 0xf1,
-	0x30, 0x09, 0x9c, 0x01, 0x1d, 0xb9, 0xf2, 0x26, 0xaf, 0x8e, 0xb8, 0xc8, 0xee, 0xc6, 0xff, 0x23,
-	0xa8, 0xe0, 0xc7, 0xab, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x59, 0x07, 0xbd, 0x3f, 0x03,
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0xc7, 0x93, 0x2f, 0x6d, 0x3f, 0xbe, 0xf9, 0x9a, 0x06, 0x03, 0x6a, 0x11, 0x09, 0x35, 0x6a,
+	0xd1, 0x83, 0x09, 0xe8, 0xb5, 0x20, 0x54, 0x84, 0x22, 0x54, 0x24, 0x8a, 0x07, 0x2f, 0x25, 0x49,
+	0x97, 0x26, 0x68, 0xb2, 0xb5, 0xbb, 0x81, 0x7a, 0xf2, 0x15, 0x7c, 0x06, 0x9f, 0xc6, 0x63, 0x8f,
+	0x1e, 0xa5, 0x7d, 0x11, 0xe9, 0x6e, 0x9a, 0x6e, 0x63, 0x5b, 0x6f, 0x93, 0xd9, 0xff, 0xfc, 0xf2,
+	0x9f, 0x19, 0x06, 0xf6, 0x28, 0x8a, 0xbb, 0x68, 0x10, 0x85, 0x31, 0xb5, 0xbd, 0x27, 0xec, 0x3f,
+	0xfa, 0x81, 0x1b, 0xc6, 0x36, 0x7d, 0xe9, 0x23, 0x62, 0xf5, 0x07, 0x98, 0x62, 0x7d, 0x73, 0x2e,
+	0xb1, 0xe6, 0x92, 0x9d, 0x5d, 0xa1, 0x92, 0xc9, 0x79, 0x3d, 0x2f, 0x5a, 0xf2, 0x2a, 0x20, 0xcd,
+	0x3a, 0x94, 0x9b, 0x53, 0xb1, 0x83, 0x9e, 0x13, 0x44, 0xa8, 0xbe, 0x05, 0xa5, 0x00, 0x85, 0xbd,
+	0x80, 0x56, 0xe5, 0x9a, 0x7c, 0xa4, 0x38, 0xe9, 0x97, 0x79, 0x0c, 0xda, 0x35, 0x4e, 0x95, 0xa4,
+	0x8f, 0x63, 0x82, 0x56, 0x4a, 0x5f, 0x41, 0x5d, 0x14, 0x9e, 0x40, 0x91, 0x19, 0x62, 0xba, 0xff,
+	0xa7, 0xdb, 0x96, 0xd0, 0x06, 0xf7, 0xc2, 0xf5, 0x5c, 0xa5, 0x9f, 0x03, 0xa0, 0x21, 0xed, 0xf8,
+	0x38, 0x8a, 0x42, 0x5a, 0xfd, 0xc3, 0x6a, 0x6a, 0x3f, 0x6b, 0x2e, 0x87, 0x2c, 0xd5, 0xbd, 0x60,
+	0x3a, 0xe7, 0x1f, 0x1a, 0x52, 0x1e, 0x9a, 0x1a, 0xa8, 0xb7, 0xd4, 0xa5, 0x09, 0x49, 0x9b, 0x32,
+	0x1b, 0x50, 0x99, 0x25, 0xd6, 0x7b, 0xd7, 0x75, 0x28, 0x78, 0x2e, 0x41, 0xec, 0xaf, 0x8a, 0xc3,
+	0x62, 0xf3, 0x5d, 0x81, 0xbf, 0x6d, 0x44, 0x88, 0xdb, 0x43, 0xfa, 0x15, 0xa8, 0xcc, 0x64, 0x67,
+	0xc0, 0xd1, 0x69, 0x4b, 0xfb, 0xd6, 0xd2, 0xcd, 0x58, 0xe2, 0x68, 0x5b, 0x92, 0x53, 0xf6, 0xc4,
+	0x51, 0xdf, 0xc1, 0x46, 0x8c, 0x3b, 0x33, 0x1c, 0x37, 0x96, 0xb6, 0x5b, 0x5f, 0xc1, 0xcb, 0xad,
+	0xa0, 0x25, 0x39, 0x5a, 0x9c, 0xdb, 0x4a, 0x1b, 0x2a, 0x39, 0xa4, 0xc2, 0x90, 0x07, 0xeb, 0x2d,
+	0x66, 0x40, 0xd5, 0xcb, 0xe3, 0x08, 0x1b, 0x5d, 0xd6, 0x71, 0x61, 0x2d, 0x6e, 0x61, 0xf0, 0x53,
+	0x1c, 0x11, 0x13, 0xfa, 0x0d, 0x68, 0x19, 0x2e, 0xb5, 0x57, 0x64, 0xbc, 0xc3, 0x5f, 0x78, 0x99,
+	0xbf, 0x0a, 0x59, 0xc8, 0x34, 0x8b, 0xa0, 0x90, 0x24, 0x6a, 0xde, 0x7f, 0x8c, 0x0d, 0x79, 0x34,
+	0x36, 0xe4, 0xaf, 0xb1, 0x21, 0xbf, 0x4d, 0x0c, 0x69, 0x34, 0x31, 0xa4, 0xcf, 0x89, 0x21, 0x3d,
+	0x34, 0x7a, 0x21, 0x0d, 0x12, 0xcf, 0xf2, 0x71, 0x64, 0x8b, 0xa7, 0x30, 0x0f, 0xd9, 0x25, 0xd8,
+	0x4b, 0xcf, 0xcf, 0x2b, 0xb1, 0xc7, 0xb3, 0xef, 0x01, 0x00, 0x96, 0x18, 0xe0, 0xca, 0x9e, 0x03,
 	0x00, 0x00,
 }
 
@@ -487,6 +499,18 @@ func (m *BlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExtCommit != nil {
+		{
+			size, err := m.ExtCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Block != nil {
 		{
 			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
@@ -740,6 +764,10 @@ func (m *BlockResponse) Size() (n int) {
 		l = m.Block.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.ExtCommit != nil {
+		l = m.ExtCommit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1055,6 +1083,42 @@ func (m *BlockResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtCommit == nil {
+				m.ExtCommit = &types.ExtendedCommit{}
+			}
+			if err := m.ExtCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -145,6 +145,200 @@ func (m *ValueOp) GetProof() *Proof {
 	return nil
 }
 
+// MultiProof is a proof of several leaves of the same Merkle tree.
+type MultiProof struct {
+	Total      int64    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Indices    []int64  `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	LeafHashes [][]byte `protobuf:"bytes,3,rep,name=leaf_hashes,json=leafHashes,proto3" json:"leaf_hashes,omitempty"`
+	Aunts      [][]byte `protobuf:"bytes,4,rep,name=aunts,proto3" json:"aunts,omitempty"`
+}
+
+func (m *MultiProof) Reset()         { *m = MultiProof{} }
+func (m *MultiProof) String() string { return proto.CompactTextString(m) }
+func (*MultiProof) ProtoMessage()    {}
+func (*MultiProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b60b6ba2ab5b856, []int{2}
+}
+func (m *MultiProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiProof.Merge(m, src)
+}
+func (m *MultiProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiProof proto.InternalMessageInfo
+
+func (m *MultiProof) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *MultiProof) GetIndices() []int64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *MultiProof) GetLeafHashes() [][]byte {
+	if m != nil {
+		return m.LeafHashes
+	}
+	return nil
+}
+
+func (m *MultiProof) GetAunts() [][]byte {
+	if m != nil {
+		return m.Aunts
+	}
+	return nil
+}
+
+type LeavesOp struct {
+	// Encoded in ProofOp.Key.
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// To encode in ProofOp.Data
+	Proof *MultiProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *LeavesOp) Reset()         { *m = LeavesOp{} }
+func (m *LeavesOp) String() string { return proto.CompactTextString(m) }
+func (*LeavesOp) ProtoMessage()    {}
+func (*LeavesOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b60b6ba2ab5b856, []int{3}
+}
+func (m *LeavesOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeavesOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeavesOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LeavesOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeavesOp.Merge(m, src)
+}
+func (m *LeavesOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeavesOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeavesOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeavesOp proto.InternalMessageInfo
+
+func (m *LeavesOp) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *LeavesOp) GetProof() *MultiProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type AbsenceOp struct {
+	// Encoded in ProofOp.Key.
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// To encode in ProofOp.Data
+	Proof *MultiProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// Key-value pairs next to the key, in the order of the proof indices
+	Keys        [][]byte `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	ValueHashes [][]byte `protobuf:"bytes,4,rep,name=value_hashes,json=valueHashes,proto3" json:"value_hashes,omitempty"`
+}
+
+func (m *AbsenceOp) Reset()         { *m = AbsenceOp{} }
+func (m *AbsenceOp) String() string { return proto.CompactTextString(m) }
+func (*AbsenceOp) ProtoMessage()    {}
+func (*AbsenceOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b60b6ba2ab5b856, []int{4}
+}
+func (m *AbsenceOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AbsenceOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AbsenceOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AbsenceOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbsenceOp.Merge(m, src)
+}
+func (m *AbsenceOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *AbsenceOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbsenceOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbsenceOp proto.InternalMessageInfo
+
+func (m *AbsenceOp) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *AbsenceOp) GetProof() *MultiProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *AbsenceOp) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *AbsenceOp) GetValueHashes() [][]byte {
+	if m != nil {
+		return m.ValueHashes
+	}
+	return nil
+}
+
 type DominoOp struct {
 	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Input  string `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
@@ -155,7 +349,7 @@ func (m *DominoOp) Reset()         { *m = DominoOp{} }
 func (m *DominoOp) String() string { return proto.CompactTextString(m) }
 func (*DominoOp) ProtoMessage()    {}
 func (*DominoOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b60b6ba2ab5b856, []int{2}
+	return fileDescriptor_6b60b6ba2ab5b856, []int{5}
 }
 func (m *DominoOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +412,7 @@ func (m *ProofOp) Reset()         { *m = ProofOp{} }
 func (m *ProofOp) String() string { return proto.CompactTextString(m) }
 func (*ProofOp) ProtoMessage()    {}
 func (*ProofOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b60b6ba2ab5b856, []int{3}
+	return fileDescriptor_6b60b6ba2ab5b856, []int{6}
 }
 func (m *ProofOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +471,7 @@ func (m *ProofOps) Reset()         { *m = ProofOps{} }
 func (m *ProofOps) String() string { return proto.CompactTextString(m) }
 func (*ProofOps) ProtoMessage()    {}
 func (*ProofOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b60b6ba2ab5b856, []int{4}
+	return fileDescriptor_6b60b6ba2ab5b856, []int{7}
 }
 func (m *ProofOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,6 +510,9 @@ func (m *ProofOps) GetOps() []ProofOp {
 func init() {
 	proto.RegisterType((*Proof)(nil), "tendermint.crypto.Proof")
 	proto.RegisterType((*ValueOp)(nil), "tendermint.crypto.ValueOp")
+	proto.RegisterType((*MultiProof)(nil), "tendermint.crypto.MultiProof")
+	proto.RegisterType((*LeavesOp)(nil), "tendermint.crypto.LeavesOp")
+	proto.RegisterType((*AbsenceOp)(nil), "tendermint.crypto.AbsenceOp")
 	proto.RegisterType((*DominoOp)(nil), "tendermint.crypto.DominoOp")
 	proto.RegisterType((*ProofOp)(nil), "tendermint.crypto.ProofOp")
 	proto.RegisterType((*ProofOps)(nil), "tendermint.crypto.ProofOps")
@@ -324,29 +521,36 @@ func init() {
 func init() { proto.RegisterFile("tendermint/crypto/proof.proto", fileDescriptor_6b60b6ba2ab5b856) }
 
 var fileDescriptor_6b60b6ba2ab5b856 = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xbb, 0x4e, 0xc3, 0x30,
-	0x14, 0x4d, 0xea, 0xf4, 0x75, 0xdb, 0x01, 0xac, 0x0a, 0x45, 0x45, 0x84, 0x28, 0x53, 0xa6, 0x44,
-	0x2a, 0x0b, 0x13, 0x43, 0x61, 0x40, 0x30, 0x14, 0x79, 0x60, 0x60, 0x41, 0x6e, 0xeb, 0x36, 0x11,
-	0x6d, 0x6c, 0x25, 0x8e, 0x44, 0xff, 0x82, 0xcf, 0xea, 0xd8, 0x91, 0x09, 0xa1, 0xf6, 0x47, 0x90,
-	0xed, 0xa0, 0x16, 0x55, 0x6c, 0xe7, 0x71, 0x7d, 0x7c, 0xac, 0x6b, 0xb8, 0x90, 0x2c, 0x9b, 0xb2,
-	0x7c, 0x99, 0x66, 0x32, 0x9e, 0xe4, 0x2b, 0x21, 0x79, 0x2c, 0x72, 0xce, 0x67, 0x91, 0xc8, 0xb9,
-	0xe4, 0xf8, 0x74, 0x6f, 0x47, 0xc6, 0xee, 0xf7, 0xe6, 0x7c, 0xce, 0xb5, 0x1b, 0x2b, 0x64, 0x06,
-	0x83, 0x19, 0xd4, 0x9f, 0xd4, 0x39, 0xdc, 0x83, 0xba, 0xe4, 0x92, 0x2e, 0x5c, 0xdb, 0xb7, 0x43,
-	0x44, 0x0c, 0x51, 0x6a, 0x9a, 0x4d, 0xd9, 0xbb, 0x5b, 0x33, 0xaa, 0x26, 0xf8, 0x1c, 0xda, 0x0b,
-	0x46, 0x67, 0xaf, 0x09, 0x2d, 0x12, 0x17, 0xf9, 0x76, 0xd8, 0x25, 0x2d, 0x25, 0xdc, 0xd3, 0x22,
-	0x51, 0x47, 0x68, 0x99, 0xc9, 0xc2, 0x75, 0x7c, 0x14, 0x76, 0x89, 0x21, 0xc1, 0x23, 0x34, 0x9f,
-	0xe9, 0xa2, 0x64, 0x23, 0x81, 0x4f, 0x00, 0xbd, 0xb1, 0x95, 0xbe, 0xa7, 0x4b, 0x14, 0xc4, 0x11,
-	0xd4, 0x75, 0x79, 0x7d, 0x4b, 0x67, 0xe0, 0x46, 0x47, 0xed, 0x23, 0x5d, 0x92, 0x98, 0xb1, 0xe0,
-	0x01, 0x5a, 0x77, 0x7c, 0x99, 0x66, 0xfc, 0x6f, 0x5a, 0xdb, 0xa4, 0xe9, 0xce, 0xa2, 0x94, 0x3a,
-	0xad, 0x4d, 0x0c, 0xc1, 0x67, 0xd0, 0xe0, 0xa5, 0x54, 0x32, 0xd2, 0x72, 0xc5, 0x82, 0x5b, 0x68,
-	0xea, 0xec, 0x91, 0xc0, 0x18, 0x1c, 0xb9, 0x12, 0xac, 0xca, 0xd2, 0xf8, 0x37, 0xbe, 0xb6, 0x2f,
-	0x8b, 0xc1, 0x99, 0x52, 0x49, 0xab, 0x77, 0x6b, 0x1c, 0xdc, 0x40, 0xab, 0x0a, 0x29, 0xf0, 0x00,
-	0x10, 0x17, 0x85, 0x6b, 0xfb, 0x28, 0xec, 0x0c, 0xfa, 0xff, 0x3d, 0x65, 0x24, 0x86, 0xce, 0xfa,
-	0xeb, 0xd2, 0x22, 0x6a, 0x78, 0x48, 0xd6, 0x5b, 0xcf, 0xde, 0x6c, 0x3d, 0xfb, 0x7b, 0xeb, 0xd9,
-	0x1f, 0x3b, 0xcf, 0xda, 0xec, 0x3c, 0xeb, 0x73, 0xe7, 0x59, 0x2f, 0xd7, 0xf3, 0x54, 0x26, 0xe5,
-	0x38, 0x9a, 0xf0, 0x65, 0x7c, 0xb0, 0xf2, 0x03, 0x68, 0x56, 0x7a, 0xf4, 0x1d, 0xc6, 0x0d, 0x6d,
-	0x5c, 0xfd, 0x04, 0x00, 0x00, 0xff, 0xff, 0x43, 0x5d, 0xb9, 0x45, 0x2a, 0x02, 0x00, 0x00,
+	// 462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0xba, 0x5d, 0xdb, 0xb7, 0x3d, 0x80, 0x35, 0x21, 0x6b, 0x68, 0x59, 0xc8, 0x29,
+	0xa7, 0x04, 0x75, 0x27, 0x2e, 0x48, 0x0c, 0x0e, 0x88, 0x3f, 0x2a, 0x04, 0x89, 0x03, 0x17, 0xe4,
+	0x26, 0x6e, 0x13, 0xad, 0xb5, 0xad, 0xda, 0x99, 0x96, 0x8f, 0xc0, 0x8d, 0x8f, 0xb5, 0xe3, 0x8e,
+	0x9c, 0x10, 0x6a, 0xbf, 0x08, 0xb2, 0xdd, 0x10, 0x4d, 0xdb, 0x38, 0xed, 0xf6, 0x3e, 0xcf, 0xeb,
+	0xbc, 0xfe, 0x3d, 0xd1, 0x6b, 0x38, 0xd6, 0x8c, 0xe7, 0x6c, 0xb3, 0x2e, 0xb9, 0x4e, 0xb2, 0x4d,
+	0x2d, 0xb5, 0x48, 0xe4, 0x46, 0x88, 0x45, 0x2c, 0x37, 0x42, 0x0b, 0xfc, 0xb8, 0x6d, 0xc7, 0xae,
+	0x7d, 0x74, 0xb8, 0x14, 0x4b, 0x61, 0xbb, 0x89, 0xa9, 0xdc, 0xc1, 0x70, 0x01, 0xfd, 0x4f, 0xe6,
+	0x3b, 0x7c, 0x08, 0x7d, 0x2d, 0x34, 0x5d, 0x11, 0x2f, 0xf0, 0x22, 0x94, 0x3a, 0x61, 0xdc, 0x92,
+	0xe7, 0xec, 0x92, 0x74, 0x9d, 0x6b, 0x05, 0x7e, 0x0a, 0xa3, 0x15, 0xa3, 0x8b, 0xef, 0x05, 0x55,
+	0x05, 0x41, 0x81, 0x17, 0x4d, 0xd2, 0xa1, 0x31, 0xde, 0x52, 0x55, 0x98, 0x4f, 0x68, 0xc5, 0xb5,
+	0x22, 0xbd, 0x00, 0x45, 0x93, 0xd4, 0x89, 0xf0, 0x3d, 0x0c, 0xbe, 0xd2, 0x55, 0xc5, 0x66, 0x12,
+	0x3f, 0x02, 0x74, 0xce, 0x6a, 0x7b, 0xcf, 0x24, 0x35, 0x25, 0x8e, 0xa1, 0x6f, 0xe1, 0xed, 0x2d,
+	0xe3, 0x29, 0x89, 0x6f, 0xd1, 0xc7, 0x16, 0x32, 0x75, 0xc7, 0x42, 0x05, 0xf0, 0xb1, 0x5a, 0xe9,
+	0xf2, 0x7f, 0xe4, 0x04, 0x06, 0x25, 0xcf, 0xcb, 0x8c, 0x29, 0xd2, 0x0d, 0x50, 0x84, 0xd2, 0x46,
+	0xe2, 0x13, 0x18, 0xff, 0xa3, 0x67, 0x8a, 0x20, 0x8b, 0x09, 0x0d, 0x3f, 0x53, 0xf7, 0x24, 0xf8,
+	0x0c, 0xc3, 0x0f, 0x8c, 0x5e, 0x30, 0x75, 0x67, 0x84, 0xd3, 0x9b, 0x11, 0x8e, 0xef, 0x88, 0xd0,
+	0x22, 0x37, 0x39, 0x7e, 0x78, 0x30, 0x7a, 0x35, 0x57, 0x8c, 0x67, 0xec, 0xc1, 0x86, 0x62, 0x0c,
+	0xbd, 0x73, 0x56, 0x37, 0xb9, 0x6c, 0x8d, 0x9f, 0xc1, 0xe4, 0xc2, 0xfc, 0xfd, 0x26, 0xb3, 0x0b,
+	0x36, 0xb6, 0x9e, 0x0b, 0x1d, 0xbe, 0x83, 0xe1, 0x1b, 0xb1, 0x2e, 0xb9, 0xb8, 0x49, 0x32, 0x72,
+	0x24, 0x76, 0x0f, 0x64, 0xa5, 0x2d, 0xc9, 0x28, 0x75, 0x02, 0x3f, 0x81, 0x03, 0x51, 0x69, 0x63,
+	0x23, 0x6b, 0xef, 0x55, 0xf8, 0x1a, 0x06, 0x16, 0x69, 0x26, 0x0d, 0x8d, 0xae, 0x25, 0xdb, 0xcf,
+	0xb2, 0x75, 0x33, 0xbe, 0xdb, 0x06, 0xc5, 0xd0, 0xcb, 0xa9, 0xa6, 0xfb, 0x5d, 0xb2, 0x75, 0xf8,
+	0x12, 0x86, 0xfb, 0x21, 0x0a, 0x4f, 0x01, 0x09, 0xa9, 0x88, 0x17, 0xa0, 0x68, 0x3c, 0x3d, 0xba,
+	0x6f, 0x3d, 0x66, 0xf2, 0xac, 0x77, 0xf5, 0xfb, 0xa4, 0x93, 0x9a, 0xc3, 0x67, 0x5f, 0xae, 0xb6,
+	0xbe, 0x77, 0xbd, 0xf5, 0xbd, 0x3f, 0x5b, 0xdf, 0xfb, 0xb9, 0xf3, 0x3b, 0xd7, 0x3b, 0xbf, 0xf3,
+	0x6b, 0xe7, 0x77, 0xbe, 0xbd, 0x58, 0x96, 0xba, 0xa8, 0xe6, 0x71, 0x26, 0xd6, 0xc9, 0xba, 0xce,
+	0xd9, 0x65, 0x56, 0xd0, 0x92, 0x27, 0xed, 0xd4, 0xe7, 0x89, 0x7b, 0x27, 0xb7, 0xde, 0xd8, 0xfc,
+	0xc0, 0x36, 0x4e, 0xff, 0x0e, 0x00, 0x7e, 0x28, 0x43, 0xfd, 0x7f, 0x03, 0x00, 0x00,
 }
 
 func (m *Proof) Marshal() (dAtA []byte, err error) {
@@ -440,7 +644,7 @@ func (m *ValueOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DominoOp) Marshal() (dAtA []byte, err error) {
+func (m *MultiProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -450,41 +654,62 @@ func (m *DominoOp) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DominoOp) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DominoOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Output) > 0 {
-		i -= len(m.Output)
-		copy(dAtA[i:], m.Output)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.Output)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.Aunts) > 0 {
+		for iNdEx := len(m.Aunts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aunts[iNdEx])
+			copy(dAtA[i:], m.Aunts[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.Aunts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.Input) > 0 {
-		i -= len(m.Input)
-		copy(dAtA[i:], m.Input)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.Input)))
+	if len(m.LeafHashes) > 0 {
+		for iNdEx := len(m.LeafHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LeafHashes[iNdEx])
+			copy(dAtA[i:], m.LeafHashes[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.LeafHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Indices) > 0 {
+		dAtA3 := make([]byte, len(m.Indices)*10)
+		var j2 int
+		for _, num1 := range m.Indices {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintProof(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.Key)))
+	if m.Total != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Total))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ProofOp) Marshal() (dAtA []byte, err error) {
+func (m *LeavesOp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -494,41 +719,39 @@ func (m *ProofOp) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProofOp) MarshalTo(dAtA []byte) (int, error) {
+func (m *LeavesOp) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProofOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LeavesOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.Data)))
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
 	if len(m.Key) > 0 {
 		i -= len(m.Key)
 		copy(dAtA[i:], m.Key)
 		i = encodeVarintProof(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.Type)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ProofOps) Marshal() (dAtA []byte, err error) {
+func (m *AbsenceOp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -538,51 +761,199 @@ func (m *ProofOps) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProofOps) MarshalTo(dAtA []byte) (int, error) {
+func (m *AbsenceOp) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProofOps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AbsenceOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Ops) > 0 {
-		for iNdEx := len(m.Ops) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProof(dAtA, i, uint64(size))
-			}
+	if len(m.ValueHashes) > 0 {
+		for iNdEx := len(m.ValueHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValueHashes[iNdEx])
+			copy(dAtA[i:], m.ValueHashes[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.ValueHashes[iNdEx])))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProof(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DominoOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Proof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DominoOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DominoOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Total != 0 {
+	if len(m.Output) > 0 {
+		i -= len(m.Output)
+		copy(dAtA[i:], m.Output)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Output)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Input) > 0 {
+		i -= len(m.Input)
+		copy(dAtA[i:], m.Input)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Input)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofOps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofOps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofOps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ops) > 0 {
+		for iNdEx := len(m.Ops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProof(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Proof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
 		n += 1 + sovProof(uint64(m.Total))
 	}
 	if m.Index != 0 {
@@ -618,6 +989,83 @@ func (m *ValueOp) Size() (n int) {
 	return n
 }
 
+func (m *MultiProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovProof(uint64(m.Total))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovProof(uint64(e))
+		}
+		n += 1 + sovProof(uint64(l)) + l
+	}
+	if len(m.LeafHashes) > 0 {
+		for _, b := range m.LeafHashes {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	if len(m.Aunts) > 0 {
+		for _, b := range m.Aunts {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LeavesOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *AbsenceOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	if len(m.ValueHashes) > 0 {
+		for _, b := range m.ValueHashes {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DominoOp) Size() (n int) {
 	if m == nil {
 		return 0
@@ -961,6 +1409,528 @@ func (m *ValueOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MultiProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProof
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProof
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProof
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeafHashes = append(m.LeafHashes, make([]byte, postIndex-iNdEx))
+			copy(m.LeafHashes[len(m.LeafHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aunts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aunts = append(m.Aunts, make([]byte, postIndex-iNdEx))
+			copy(m.Aunts[len(m.Aunts)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeavesOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeavesOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeavesOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &MultiProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AbsenceOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbsenceOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbsenceOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &MultiProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueHashes = append(m.ValueHashes, make([]byte, postIndex-iNdEx))
+			copy(m.ValueHashes[len(m.ValueHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DominoOp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
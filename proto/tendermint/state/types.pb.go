@@ -33,10 +33,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // ABCIResponses retains the responses
 // of the various ABCI calls during block processing.
 // It is persisted to disk for each height before calling Commit.
+//
+// Blocks are executed with a single FinalizeBlock call. deliver_txs, end_block
+// and begin_block are only set for heights executed before FinalizeBlock, and
+// are converted to finalize_block when loaded.
 type ABCIResponses struct {
-	DeliverTxs []*types.ResponseDeliverTx `protobuf:"bytes,1,rep,name=deliver_txs,json=deliverTxs,proto3" json:"deliver_txs,omitempty"`
-	EndBlock   *types.ResponseEndBlock    `protobuf:"bytes,2,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
-	BeginBlock *types.ResponseBeginBlock  `protobuf:"bytes,3,opt,name=begin_block,json=beginBlock,proto3" json:"begin_block,omitempty"`
+	DeliverTxs    []*types.ResponseDeliverTx   `protobuf:"bytes,1,rep,name=deliver_txs,json=deliverTxs,proto3" json:"deliver_txs,omitempty"`
+	EndBlock      *types.ResponseEndBlock      `protobuf:"bytes,2,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	BeginBlock    *types.ResponseBeginBlock    `protobuf:"bytes,3,opt,name=begin_block,json=beginBlock,proto3" json:"begin_block,omitempty"`
+	FinalizeBlock *types.ResponseFinalizeBlock `protobuf:"bytes,4,opt,name=finalize_block,json=finalizeBlock,proto3" json:"finalize_block,omitempty"`
 }
 
 func (m *ABCIResponses) Reset()         { *m = ABCIResponses{} }
@@ -93,6 +98,13 @@ func (m *ABCIResponses) GetBeginBlock() *types.ResponseBeginBlock {
 	return nil
 }
 
+func (m *ABCIResponses) GetFinalizeBlock() *types.ResponseFinalizeBlock {
+	if m != nil {
+		return m.FinalizeBlock
+	}
+	return nil
+}
+
 // ValidatorsInfo represents the latest validator set, or the last height it changed
 type ValidatorsInfo struct {
 	ValidatorSet      *types1.ValidatorSet `protobuf:"bytes,1,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
@@ -422,55 +434,57 @@ func init() {
 func init() { proto.RegisterFile("tendermint/state/types.proto", fileDescriptor_ccfacf933f22bf93) }
 
 var fileDescriptor_ccfacf933f22bf93 = []byte{
-	// 763 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xe8, 0xb6, 0xb6, 0xce, 0xda, 0x0e, 0x8f, 0x43, 0xd6, 0xb1, 0xb4, 0x2b, 0x3f, 0x34,
-	0x71, 0x48, 0xa5, 0x71, 0x40, 0x5c, 0x26, 0x2d, 0x2d, 0x62, 0x95, 0x26, 0x04, 0xd9, 0xb4, 0x03,
-	0x97, 0xc8, 0x6d, 0xbc, 0x24, 0xa2, 0x4d, 0xa2, 0xd8, 0x2d, 0xe3, 0x0f, 0xe0, 0xbe, 0x2b, 0xff,
-	0xd1, 0x8e, 0x3b, 0x22, 0x0e, 0x03, 0xba, 0x7f, 0x04, 0xd9, 0xce, 0x0f, 0xb7, 0x65, 0xd2, 0x10,
-	0x37, 0xfb, 0x7d, 0xdf, 0xfb, 0xfc, 0xf9, 0xf9, 0x3d, 0x19, 0x3c, 0xa6, 0x38, 0x70, 0x70, 0x3c,
-	0xf6, 0x03, 0xda, 0x21, 0x14, 0x51, 0xdc, 0xa1, 0x5f, 0x22, 0x4c, 0x8c, 0x28, 0x0e, 0x69, 0x08,
-	0x37, 0x72, 0xd4, 0xe0, 0x68, 0xe3, 0x91, 0x1b, 0xba, 0x21, 0x07, 0x3b, 0x6c, 0x25, 0x78, 0x8d,
-	0x6d, 0x49, 0x05, 0x0d, 0x86, 0xbe, 0x2c, 0xd2, 0x90, 0x8f, 0xe0, 0xf1, 0x39, 0xb4, 0xb5, 0x84,
-	0x4e, 0xd1, 0xc8, 0x77, 0x10, 0x0d, 0xe3, 0x84, 0xb1, 0xb3, 0xc4, 0x88, 0x50, 0x8c, 0xc6, 0xa9,
-	0x80, 0x2e, 0xc1, 0x53, 0x1c, 0x13, 0x3f, 0x0c, 0xe6, 0x0e, 0x68, 0xba, 0x61, 0xe8, 0x8e, 0x70,
-	0x87, 0xef, 0x06, 0x93, 0xf3, 0x0e, 0xf5, 0xc7, 0x98, 0x50, 0x34, 0x8e, 0x04, 0xa1, 0xfd, 0x43,
-	0x01, 0xd5, 0x43, 0xb3, 0xdb, 0xb7, 0x30, 0x89, 0xc2, 0x80, 0x60, 0x02, 0xbb, 0x40, 0x75, 0xf0,
-	0xc8, 0x9f, 0xe2, 0xd8, 0xa6, 0x17, 0x44, 0x53, 0x5a, 0xc5, 0x3d, 0x75, 0xbf, 0x6d, 0x48, 0xc5,
-	0x60, 0x97, 0x34, 0xd2, 0x84, 0x9e, 0xe0, 0x9e, 0x5e, 0x58, 0xc0, 0x49, 0x97, 0x04, 0x1e, 0x80,
-	0x0a, 0x0e, 0x1c, 0x7b, 0x30, 0x0a, 0x87, 0x9f, 0xb4, 0x07, 0x2d, 0x65, 0x4f, 0xdd, 0xdf, 0xbd,
-	0x53, 0xe2, 0x4d, 0xe0, 0x98, 0x8c, 0x68, 0x95, 0x71, 0xb2, 0x82, 0x3d, 0xa0, 0x0e, 0xb0, 0xeb,
-	0x07, 0x89, 0x42, 0x91, 0x2b, 0x3c, 0xb9, 0x53, 0xc1, 0x64, 0x5c, 0xa1, 0x01, 0x06, 0xd9, 0xba,
-	0xfd, 0x55, 0x01, 0xb5, 0xb3, 0xb4, 0xa0, 0xa4, 0x1f, 0x9c, 0x87, 0xb0, 0x0b, 0xaa, 0x59, 0x89,
-	0x6d, 0x82, 0xa9, 0xa6, 0x70, 0x69, 0x5d, 0x96, 0x16, 0x05, 0xcc, 0x12, 0x4f, 0x30, 0xb5, 0xd6,
-	0xa7, 0xd2, 0x0e, 0x1a, 0x60, 0x73, 0x84, 0x08, 0xb5, 0x3d, 0xec, 0xbb, 0x1e, 0xb5, 0x87, 0x1e,
-	0x0a, 0x5c, 0xec, 0xf0, 0x7b, 0x16, 0xad, 0x87, 0x0c, 0x3a, 0xe2, 0x48, 0x57, 0x00, 0xed, 0x6f,
-	0x0a, 0xd8, 0xec, 0x32, 0x9f, 0x01, 0x99, 0x90, 0xf7, 0xfc, 0xfd, 0xb8, 0x19, 0x0b, 0x6c, 0x0c,
-	0xd3, 0xb0, 0x2d, 0xde, 0x35, 0xf1, 0xb3, 0xbb, 0xec, 0x67, 0x41, 0xc0, 0x5c, 0xb9, 0xba, 0x69,
-	0x16, 0xac, 0xfa, 0x70, 0x3e, 0xfc, 0xcf, 0xde, 0x3c, 0x50, 0x3a, 0x13, 0x8d, 0x03, 0x0f, 0x41,
-	0x25, 0x53, 0x4b, 0x7c, 0xec, 0xc8, 0x3e, 0x92, 0x06, 0xcb, 0x9d, 0x24, 0x1e, 0xf2, 0x2c, 0xd8,
-	0x00, 0x65, 0x12, 0x9e, 0xd3, 0xcf, 0x28, 0xc6, 0xfc, 0xc8, 0x8a, 0x95, 0xed, 0xdb, 0xbf, 0xd7,
-	0xc0, 0xea, 0x09, 0x9b, 0x23, 0xf8, 0x1a, 0x94, 0x12, 0xad, 0xe4, 0x98, 0x2d, 0x63, 0x71, 0xd6,
-	0x8c, 0xc4, 0x54, 0x72, 0x44, 0xca, 0x87, 0xcf, 0x41, 0x79, 0xe8, 0x21, 0x3f, 0xb0, 0x7d, 0x71,
-	0xa7, 0x8a, 0xa9, 0xce, 0x6e, 0x9a, 0xa5, 0x2e, 0x8b, 0xf5, 0x7b, 0x56, 0x89, 0x83, 0x7d, 0x07,
-	0x3e, 0x03, 0x35, 0x3f, 0xf0, 0xa9, 0x8f, 0x46, 0x49, 0x25, 0xb4, 0x1a, 0xaf, 0x40, 0x35, 0x89,
-	0x8a, 0x22, 0xc0, 0x17, 0x80, 0x97, 0x44, 0xb4, 0x59, 0xca, 0x2c, 0x72, 0x66, 0x9d, 0x01, 0xbc,
-	0x8f, 0x12, 0xae, 0x05, 0xaa, 0x12, 0xd7, 0x77, 0xb4, 0x95, 0x65, 0xef, 0xe2, 0xa9, 0x78, 0x56,
-	0xbf, 0x67, 0x6e, 0x32, 0xef, 0xb3, 0x9b, 0xa6, 0x7a, 0x9c, 0x4a, 0xf5, 0x7b, 0x96, 0x9a, 0xe9,
-	0xf6, 0x1d, 0x78, 0x0c, 0xea, 0x92, 0x26, 0x1b, 0x4e, 0x6d, 0x95, 0xab, 0x36, 0x0c, 0x31, 0xb9,
-	0x46, 0x3a, 0xb9, 0xc6, 0x69, 0x3a, 0xb9, 0x66, 0x99, 0xc9, 0x5e, 0xfe, 0x6c, 0x2a, 0x56, 0x35,
-	0xd3, 0x62, 0x28, 0x7c, 0x0b, 0xea, 0x01, 0xbe, 0xa0, 0x76, 0xd6, 0xac, 0x44, 0x5b, 0xbb, 0x57,
-	0x7b, 0xd7, 0x58, 0x5a, 0x3e, 0x29, 0xf0, 0x00, 0x00, 0x49, 0xa3, 0x74, 0x2f, 0x0d, 0x29, 0x83,
-	0x19, 0xe1, 0xd7, 0x92, 0x44, 0xca, 0xf7, 0x33, 0xc2, 0xd2, 0x24, 0x23, 0x5d, 0xa0, 0xcb, 0xdd,
-	0x9c, 0xeb, 0x65, 0x8d, 0x5d, 0xe1, 0x8f, 0xb5, 0x9d, 0x37, 0x76, 0x9e, 0x9d, 0xb4, 0xf8, 0x5f,
-	0xc7, 0x0c, 0xfc, 0xe7, 0x98, 0xbd, 0x03, 0x4f, 0xe7, 0xc6, 0x6c, 0x41, 0x3f, 0xb3, 0xa7, 0x72,
-	0x7b, 0x2d, 0x69, 0xee, 0xe6, 0x85, 0x52, 0x8f, 0x69, 0x23, 0xc6, 0x98, 0x4c, 0x46, 0x94, 0xd8,
-	0x1e, 0x22, 0x9e, 0xb6, 0xde, 0x52, 0xf6, 0xd6, 0x45, 0x23, 0x5a, 0x22, 0x7e, 0x84, 0x88, 0x07,
-	0xb7, 0x40, 0x19, 0x45, 0x91, 0xa0, 0x54, 0x39, 0xa5, 0x84, 0xa2, 0x88, 0x41, 0xe6, 0x87, 0xab,
-	0x99, 0xae, 0x5c, 0xcf, 0x74, 0xe5, 0xd7, 0x4c, 0x57, 0x2e, 0x6f, 0xf5, 0xc2, 0xf5, 0xad, 0x5e,
-	0xf8, 0x7e, 0xab, 0x17, 0x3e, 0xbe, 0x72, 0x7d, 0xea, 0x4d, 0x06, 0xc6, 0x30, 0x1c, 0x77, 0xe4,
-	0x3f, 0x25, 0x5f, 0x8a, 0x8f, 0x6d, 0xf1, 0x4b, 0x1c, 0xac, 0xf1, 0xf8, 0xcb, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa5, 0x17, 0xac, 0x23, 0x2d, 0x07, 0x00, 0x00,
+	// 798 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x37, 0xdd, 0x26, 0x19, 0x37, 0xc9, 0x32, 0xe5, 0xe0, 0xcd, 0xb2, 0x4e, 0x08, 0xb0,
+	0xaa, 0x38, 0xd8, 0x68, 0xb9, 0xc0, 0x05, 0x69, 0x9d, 0x00, 0x1b, 0x69, 0x41, 0xc8, 0xad, 0x7a,
+	0xe0, 0x62, 0x4d, 0xe2, 0x89, 0x3d, 0xc2, 0xb1, 0x2d, 0xcf, 0x24, 0xa4, 0xdc, 0xb9, 0xf7, 0xca,
+	0x9f, 0xc0, 0x7f, 0xd2, 0x63, 0x8f, 0x9c, 0x0a, 0xa4, 0xff, 0x08, 0x9a, 0x1f, 0x76, 0x26, 0x09,
+	0x95, 0x8a, 0xb8, 0x79, 0xde, 0xfb, 0xde, 0x37, 0xdf, 0xbc, 0xf9, 0xde, 0x18, 0x7c, 0xc0, 0x70,
+	0x1a, 0xe2, 0x62, 0x41, 0x52, 0xe6, 0x52, 0x86, 0x18, 0x76, 0xd9, 0x55, 0x8e, 0xa9, 0x93, 0x17,
+	0x19, 0xcb, 0xe0, 0xb3, 0x6d, 0xd6, 0x11, 0xd9, 0xde, 0xfb, 0x51, 0x16, 0x65, 0x22, 0xe9, 0xf2,
+	0x2f, 0x89, 0xeb, 0xbd, 0xd0, 0x58, 0xd0, 0x74, 0x46, 0x74, 0x92, 0x9e, 0xbe, 0x85, 0x88, 0xef,
+	0x64, 0x07, 0x07, 0xd9, 0x15, 0x4a, 0x48, 0x88, 0x58, 0x56, 0x28, 0xc4, 0xcb, 0x03, 0x44, 0x8e,
+	0x0a, 0xb4, 0x28, 0x09, 0x6c, 0x2d, 0xbd, 0xc2, 0x05, 0x25, 0x59, 0xba, 0xb3, 0x41, 0x3f, 0xca,
+	0xb2, 0x28, 0xc1, 0xae, 0x58, 0x4d, 0x97, 0x73, 0x97, 0x91, 0x05, 0xa6, 0x0c, 0x2d, 0x72, 0x09,
+	0x18, 0xfe, 0xfe, 0x04, 0xb4, 0xdf, 0x78, 0xa3, 0x89, 0x8f, 0x69, 0x9e, 0xa5, 0x14, 0x53, 0x38,
+	0x02, 0x66, 0x88, 0x13, 0xb2, 0xc2, 0x45, 0xc0, 0xd6, 0xd4, 0x32, 0x06, 0xf5, 0x33, 0xf3, 0xf5,
+	0xd0, 0xd1, 0x9a, 0xc1, 0x0f, 0xe9, 0x94, 0x05, 0x63, 0x89, 0xbd, 0x58, 0xfb, 0x20, 0x2c, 0x3f,
+	0x29, 0xfc, 0x0a, 0xb4, 0x70, 0x1a, 0x06, 0xd3, 0x24, 0x9b, 0xfd, 0x64, 0x3d, 0x19, 0x18, 0x67,
+	0xe6, 0xeb, 0x0f, 0x1f, 0xa4, 0xf8, 0x3a, 0x0d, 0x3d, 0x0e, 0xf4, 0x9b, 0x58, 0x7d, 0xc1, 0x31,
+	0x30, 0xa7, 0x38, 0x22, 0xa9, 0x62, 0xa8, 0x0b, 0x86, 0x8f, 0x1e, 0x64, 0xf0, 0x38, 0x56, 0x72,
+	0x80, 0x69, 0xf5, 0x0d, 0xbf, 0x03, 0x9d, 0x39, 0x49, 0x51, 0x42, 0x7e, 0xc1, 0x8a, 0xe8, 0x48,
+	0x10, 0xbd, 0x7a, 0x90, 0xe8, 0x1b, 0x05, 0x97, 0x5c, 0xed, 0xb9, 0xbe, 0x1c, 0xfe, 0x6a, 0x80,
+	0xce, 0x65, 0x79, 0x3f, 0x74, 0x92, 0xce, 0x33, 0x38, 0x02, 0xed, 0xea, 0xc6, 0x02, 0x8a, 0x99,
+	0x65, 0x88, 0x0d, 0x6c, 0x7d, 0x03, 0x79, 0x1f, 0x55, 0xe1, 0x39, 0x66, 0xfe, 0xc9, 0x4a, 0x5b,
+	0x41, 0x07, 0x9c, 0x26, 0x88, 0xb2, 0x20, 0xc6, 0x24, 0x8a, 0x59, 0x30, 0x8b, 0x51, 0x1a, 0xe1,
+	0x50, 0xb4, 0xad, 0xee, 0xbf, 0xc7, 0x53, 0x6f, 0x45, 0x66, 0x24, 0x13, 0xc3, 0xdf, 0x0c, 0x70,
+	0x3a, 0xe2, 0x6a, 0x53, 0xba, 0xa4, 0x3f, 0x08, 0x3b, 0x08, 0x31, 0x3e, 0x78, 0x36, 0x2b, 0xc3,
+	0x81, 0xb4, 0x89, 0x65, 0x1c, 0xf6, 0x5e, 0xea, 0xd9, 0x23, 0xf0, 0x8e, 0x6e, 0xee, 0xfa, 0x35,
+	0xbf, 0x3b, 0xdb, 0x0d, 0xff, 0x67, 0x6d, 0x31, 0x68, 0x5c, 0x4a, 0x1f, 0xc2, 0x37, 0xa0, 0x55,
+	0xb1, 0x29, 0x1d, 0x2f, 0x75, 0x1d, 0xca, 0xaf, 0x5b, 0x25, 0x4a, 0xc3, 0xb6, 0x0a, 0xf6, 0x40,
+	0x93, 0x66, 0x73, 0xf6, 0x33, 0x2a, 0xb0, 0xd8, 0xb2, 0xe5, 0x57, 0xeb, 0xe1, 0xdf, 0xc7, 0xe0,
+	0xe9, 0x39, 0x1f, 0x4b, 0xf8, 0x25, 0x68, 0x28, 0x2e, 0xb5, 0xcd, 0x73, 0x67, 0x7f, 0x74, 0x1d,
+	0x25, 0x4a, 0x6d, 0x51, 0xe2, 0xe1, 0x2b, 0xd0, 0x9c, 0xc5, 0x88, 0xa4, 0x01, 0x91, 0x67, 0x6a,
+	0x79, 0xe6, 0xe6, 0xae, 0xdf, 0x18, 0xf1, 0xd8, 0x64, 0xec, 0x37, 0x44, 0x72, 0x12, 0xc2, 0x4f,
+	0x40, 0x87, 0xa4, 0x84, 0x11, 0x94, 0xa8, 0x4e, 0x58, 0x1d, 0xd1, 0x81, 0xb6, 0x8a, 0xca, 0x26,
+	0xc0, 0x4f, 0x81, 0x68, 0x89, 0x34, 0x5b, 0x89, 0xac, 0x0b, 0x64, 0x97, 0x27, 0x84, 0x8f, 0x14,
+	0xd6, 0x07, 0x6d, 0x0d, 0x4b, 0x42, 0xeb, 0xe8, 0x50, 0xbb, 0xbc, 0x2a, 0x51, 0x35, 0x19, 0x7b,
+	0xa7, 0x5c, 0xfb, 0xe6, 0xae, 0x6f, 0xbe, 0x2b, 0xa9, 0x26, 0x63, 0xdf, 0xac, 0x78, 0x27, 0x21,
+	0x7c, 0x07, 0xba, 0x1a, 0x27, 0x9f, 0x75, 0xeb, 0xa9, 0x60, 0xed, 0x39, 0xf2, 0x21, 0x70, 0xca,
+	0x87, 0xc0, 0xb9, 0x28, 0x1f, 0x02, 0xaf, 0xc9, 0x69, 0xaf, 0xff, 0xec, 0x1b, 0x7e, 0xbb, 0xe2,
+	0xe2, 0x59, 0xf8, 0x2d, 0xe8, 0xa6, 0x78, 0xcd, 0x82, 0xca, 0xac, 0xd4, 0x3a, 0x7e, 0x94, 0xbd,
+	0x3b, 0xbc, 0xac, 0x8a, 0xf0, 0xd7, 0x00, 0x68, 0x1c, 0x8d, 0x47, 0x71, 0x68, 0x15, 0x5c, 0x88,
+	0x38, 0x96, 0x46, 0xd2, 0x7c, 0x9c, 0x10, 0x5e, 0xa6, 0x09, 0x19, 0x01, 0x5b, 0x77, 0xf3, 0x96,
+	0xaf, 0x32, 0x76, 0x4b, 0x5c, 0xd6, 0x8b, 0xad, 0xb1, 0xb7, 0xd5, 0xca, 0xe2, 0xff, 0x3a, 0x66,
+	0xe0, 0x7f, 0x8e, 0xd9, 0xf7, 0xe0, 0xe3, 0x9d, 0x31, 0xdb, 0xe3, 0xaf, 0xe4, 0x99, 0x42, 0xde,
+	0x40, 0x9b, 0xbb, 0x5d, 0xa2, 0x52, 0x63, 0x69, 0xc4, 0x02, 0xd3, 0x65, 0xc2, 0x68, 0x10, 0x23,
+	0x1a, 0x5b, 0x27, 0x03, 0xe3, 0xec, 0x44, 0x1a, 0xd1, 0x97, 0xf1, 0xb7, 0x88, 0xc6, 0xf0, 0x39,
+	0x68, 0xa2, 0x3c, 0x97, 0x90, 0xb6, 0x80, 0x34, 0x50, 0x9e, 0xf3, 0x94, 0xe7, 0xdf, 0x6c, 0x6c,
+	0xe3, 0x76, 0x63, 0x1b, 0x7f, 0x6d, 0x6c, 0xe3, 0xfa, 0xde, 0xae, 0xdd, 0xde, 0xdb, 0xb5, 0x3f,
+	0xee, 0xed, 0xda, 0x8f, 0x5f, 0x44, 0x84, 0xc5, 0xcb, 0xa9, 0x33, 0xcb, 0x16, 0xee, 0xe2, 0x2a,
+	0xc4, 0x6b, 0x31, 0x29, 0xee, 0xf6, 0xfc, 0x9f, 0xc9, 0x7f, 0x8e, 0xbb, 0xff, 0x8b, 0x9d, 0x1e,
+	0x8b, 0xf8, 0xe7, 0xff, 0x0c, 0x00, 0xd4, 0xf3, 0xe0, 0xa1, 0x7d, 0x07, 0x00, 0x00,
 }
 
 func (m *ABCIResponses) Marshal() (dAtA []byte, err error) {
@@ -493,6 +507,18 @@ func (m *ABCIResponses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FinalizeBlock != nil {
+		{
+			size, err := m.FinalizeBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.BeginBlock != nil {
 		{
 			size, err := m.BeginBlock.MarshalToSizedBuffer(dAtA[:i])
@@ -747,12 +773,12 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x32
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBlockTime):])
-	if err10 != nil {
-		return 0, err10
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBlockTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x2a
 	{
@@ -821,6 +847,10 @@ func (m *ABCIResponses) Size() (n int) {
 		l = m.BeginBlock.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.FinalizeBlock != nil {
+		l = m.FinalizeBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1063,6 +1093,42 @@ func (m *ABCIResponses) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizeBlock == nil {
+				m.FinalizeBlock = &types.ResponseFinalizeBlock{}
+			}
+			if err := m.FinalizeBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -262,6 +262,69 @@ func (m *ConflictingHeadersEvidence) GetH2() *SignedHeader {
 	return nil
 }
 
+// LightClientAttackEvidence is submitted by a light client whose primary and
+// a witness diverge after a common trusted height. h1 is the primary's header
+// and h2 the witness', at the first height where they were seen to diverge.
+type LightClientAttackEvidence struct {
+	H1           *SignedHeader `protobuf:"bytes,1,opt,name=h1,proto3" json:"h1,omitempty"`
+	H2           *SignedHeader `protobuf:"bytes,2,opt,name=h2,proto3" json:"h2,omitempty"`
+	CommonHeight int64         `protobuf:"varint,3,opt,name=common_height,json=commonHeight,proto3" json:"common_height,omitempty"`
+}
+
+func (m *LightClientAttackEvidence) Reset()         { *m = LightClientAttackEvidence{} }
+func (m *LightClientAttackEvidence) String() string { return proto.CompactTextString(m) }
+func (*LightClientAttackEvidence) ProtoMessage()    {}
+func (*LightClientAttackEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6825fabc78e0a168, []int{4}
+}
+func (m *LightClientAttackEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttackEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttackEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttackEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttackEvidence.Merge(m, src)
+}
+func (m *LightClientAttackEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttackEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttackEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttackEvidence proto.InternalMessageInfo
+
+func (m *LightClientAttackEvidence) GetH1() *SignedHeader {
+	if m != nil {
+		return m.H1
+	}
+	return nil
+}
+
+func (m *LightClientAttackEvidence) GetH2() *SignedHeader {
+	if m != nil {
+		return m.H2
+	}
+	return nil
+}
+
+func (m *LightClientAttackEvidence) GetCommonHeight() int64 {
+	if m != nil {
+		return m.CommonHeight
+	}
+	return 0
+}
+
 type LunaticValidatorEvidence struct {
 	Header             *Header   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Vote               *Vote     `protobuf:"bytes,2,opt,name=vote,proto3" json:"vote,omitempty"`
@@ -273,7 +336,7 @@ func (m *LunaticValidatorEvidence) Reset()         { *m = LunaticValidatorEviden
 func (m *LunaticValidatorEvidence) String() string { return proto.CompactTextString(m) }
 func (*LunaticValidatorEvidence) ProtoMessage()    {}
 func (*LunaticValidatorEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6825fabc78e0a168, []int{4}
+	return fileDescriptor_6825fabc78e0a168, []int{5}
 }
 func (m *LunaticValidatorEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,6 +400,7 @@ type Evidence struct {
 	//	*Evidence_LunaticValidatorEvidence
 	//	*Evidence_PotentialAmnesiaEvidence
 	//	*Evidence_AmnesiaEvidence
+	//	*Evidence_LightClientAttackEvidence
 	Sum isEvidence_Sum `protobuf_oneof:"sum"`
 }
 
@@ -344,7 +408,7 @@ func (m *Evidence) Reset()         { *m = Evidence{} }
 func (m *Evidence) String() string { return proto.CompactTextString(m) }
 func (*Evidence) ProtoMessage()    {}
 func (*Evidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6825fabc78e0a168, []int{5}
+	return fileDescriptor_6825fabc78e0a168, []int{6}
 }
 func (m *Evidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,12 +458,16 @@ type Evidence_PotentialAmnesiaEvidence struct {
 type Evidence_AmnesiaEvidence struct {
 	AmnesiaEvidence *AmnesiaEvidence `protobuf:"bytes,5,opt,name=amnesia_evidence,json=amnesiaEvidence,proto3,oneof" json:"amnesia_evidence,omitempty"`
 }
+type Evidence_LightClientAttackEvidence struct {
+	LightClientAttackEvidence *LightClientAttackEvidence `protobuf:"bytes,6,opt,name=light_client_attack_evidence,json=lightClientAttackEvidence,proto3,oneof" json:"light_client_attack_evidence,omitempty"`
+}
 
 func (*Evidence_DuplicateVoteEvidence) isEvidence_Sum()      {}
 func (*Evidence_ConflictingHeadersEvidence) isEvidence_Sum() {}
 func (*Evidence_LunaticValidatorEvidence) isEvidence_Sum()   {}
 func (*Evidence_PotentialAmnesiaEvidence) isEvidence_Sum()   {}
 func (*Evidence_AmnesiaEvidence) isEvidence_Sum()            {}
+func (*Evidence_LightClientAttackEvidence) isEvidence_Sum()  {}
 
 func (m *Evidence) GetSum() isEvidence_Sum {
 	if m != nil {
@@ -443,6 +511,13 @@ func (m *Evidence) GetAmnesiaEvidence() *AmnesiaEvidence {
 	return nil
 }
 
+func (m *Evidence) GetLightClientAttackEvidence() *LightClientAttackEvidence {
+	if x, ok := m.GetSum().(*Evidence_LightClientAttackEvidence); ok {
+		return x.LightClientAttackEvidence
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Evidence) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -451,6 +526,7 @@ func (*Evidence) XXX_OneofWrappers() []interface{} {
 		(*Evidence_LunaticValidatorEvidence)(nil),
 		(*Evidence_PotentialAmnesiaEvidence)(nil),
 		(*Evidence_AmnesiaEvidence)(nil),
+		(*Evidence_LightClientAttackEvidence)(nil),
 	}
 }
 
@@ -464,7 +540,7 @@ func (m *EvidenceData) Reset()         { *m = EvidenceData{} }
 func (m *EvidenceData) String() string { return proto.CompactTextString(m) }
 func (*EvidenceData) ProtoMessage()    {}
 func (*EvidenceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6825fabc78e0a168, []int{6}
+	return fileDescriptor_6825fabc78e0a168, []int{7}
 }
 func (m *EvidenceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -516,7 +592,7 @@ func (m *ProofOfLockChange) Reset()         { *m = ProofOfLockChange{} }
 func (m *ProofOfLockChange) String() string { return proto.CompactTextString(m) }
 func (*ProofOfLockChange) ProtoMessage()    {}
 func (*ProofOfLockChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6825fabc78e0a168, []int{7}
+	return fileDescriptor_6825fabc78e0a168, []int{8}
 }
 func (m *ProofOfLockChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -564,6 +640,7 @@ func init() {
 	proto.RegisterType((*PotentialAmnesiaEvidence)(nil), "tendermint.types.PotentialAmnesiaEvidence")
 	proto.RegisterType((*AmnesiaEvidence)(nil), "tendermint.types.AmnesiaEvidence")
 	proto.RegisterType((*ConflictingHeadersEvidence)(nil), "tendermint.types.ConflictingHeadersEvidence")
+	proto.RegisterType((*LightClientAttackEvidence)(nil), "tendermint.types.LightClientAttackEvidence")
 	proto.RegisterType((*LunaticValidatorEvidence)(nil), "tendermint.types.LunaticValidatorEvidence")
 	proto.RegisterType((*Evidence)(nil), "tendermint.types.Evidence")
 	proto.RegisterType((*EvidenceData)(nil), "tendermint.types.EvidenceData")
@@ -573,52 +650,57 @@ func init() {
 func init() { proto.RegisterFile("tendermint/types/evidence.proto", fileDescriptor_6825fabc78e0a168) }
 
 var fileDescriptor_6825fabc78e0a168 = []byte{
-	// 710 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0xb5, 0x89, 0xc9, 0x83, 0x01, 0x09, 0x9e, 0x05, 0xef, 0x59, 0x16, 0x72, 0x4a, 0xba, 0x68,
-	0x85, 0xa8, 0x0d, 0x54, 0x15, 0x9b, 0x6e, 0x08, 0xb4, 0x8a, 0x04, 0x6a, 0xa9, 0xa9, 0x58, 0x74,
-	0xe3, 0x8e, 0xed, 0x89, 0x3d, 0xe0, 0x78, 0xac, 0x78, 0x1c, 0x35, 0x52, 0xbf, 0xa1, 0xe2, 0x63,
-	0xba, 0xe9, 0x1f, 0xb0, 0x64, 0xd9, 0x55, 0xa9, 0x60, 0xdf, 0x6f, 0xa8, 0x3c, 0x1e, 0xdb, 0x10,
-	0xc7, 0xa0, 0x56, 0x55, 0x37, 0x91, 0x33, 0xf7, 0xdc, 0x7b, 0xee, 0x99, 0x39, 0x77, 0x06, 0xb4,
-	0x28, 0x0a, 0x5d, 0x34, 0xe8, 0xe3, 0x90, 0x1a, 0x74, 0x14, 0xa1, 0xd8, 0x40, 0x43, 0xec, 0xa2,
-	0xd0, 0x41, 0x7a, 0x34, 0x20, 0x94, 0xc8, 0x8b, 0x25, 0x40, 0x67, 0x00, 0x75, 0xc9, 0x23, 0x1e,
-	0x61, 0x41, 0x23, 0xfd, 0xca, 0x70, 0x6a, 0xcb, 0x23, 0xc4, 0x0b, 0x90, 0xc1, 0xfe, 0xd9, 0x49,
-	0xcf, 0xa0, 0xb8, 0x8f, 0x62, 0x0a, 0xfb, 0x11, 0x07, 0xac, 0x54, 0x98, 0xd8, 0xef, 0x84, 0xa8,
-	0x33, 0x18, 0x45, 0x94, 0x18, 0xa7, 0x68, 0xc4, 0xa3, 0xed, 0x2f, 0x22, 0x58, 0xde, 0x4b, 0xa2,
-	0x00, 0x3b, 0x90, 0xa2, 0x63, 0x42, 0xd1, 0x0b, 0xde, 0xa4, 0xfc, 0x04, 0x34, 0x87, 0x84, 0x22,
-	0x0b, 0x2a, 0xe2, 0x03, 0xf1, 0xf1, 0xdc, 0xd6, 0x7f, 0xfa, 0x78, 0xbf, 0x7a, 0x8a, 0x37, 0xa7,
-	0x53, 0xd4, 0x4e, 0x01, 0xb7, 0x95, 0xa9, 0xfb, 0xe1, 0x1d, 0xb9, 0x03, 0x66, 0x0b, 0x19, 0x4a,
-	0x83, 0x65, 0xa8, 0x7a, 0x26, 0x54, 0xcf, 0x85, 0xea, 0x6f, 0x73, 0x44, 0x67, 0xe6, 0xfc, 0x5b,
-	0x4b, 0x38, 0xbb, 0x6c, 0x89, 0x66, 0x99, 0xd6, 0xbe, 0x14, 0x81, 0x72, 0x48, 0x28, 0x0a, 0x29,
-	0x86, 0xc1, 0x4e, 0x3f, 0x44, 0x31, 0x86, 0x7f, 0xa9, 0xfd, 0x55, 0x30, 0xef, 0x23, 0xec, 0xf9,
-	0xd4, 0x2a, 0x15, 0x34, 0xcc, 0xb9, 0x6c, 0xed, 0x28, 0x5d, 0xba, 0xad, 0x50, 0xfa, 0x3d, 0x85,
-	0x9f, 0x45, 0xb0, 0x30, 0x2e, 0xcc, 0x07, 0x6a, 0x94, 0x8b, 0xb6, 0x60, 0x16, 0xb4, 0x72, 0x6b,
-	0x71, 0xb1, 0x6b, 0xd5, 0xee, 0xeb, 0x36, 0xca, 0x54, 0xa2, 0xba, 0x2d, 0xdc, 0x06, 0x52, 0x44,
-	0x02, 0x87, 0xef, 0xc8, 0xc3, 0x09, 0x35, 0x07, 0x84, 0xf4, 0x5e, 0xf7, 0x0e, 0x88, 0x73, 0xba,
-	0xeb, 0xc3, 0xd0, 0x43, 0x26, 0x4b, 0x68, 0x7f, 0x04, 0xea, 0x2e, 0x09, 0x7b, 0x01, 0x76, 0x28,
-	0x0e, 0xbd, 0x2e, 0x82, 0x2e, 0x1a, 0xc4, 0x45, 0x59, 0x1d, 0x4c, 0xf9, 0x9b, 0xbc, 0x51, 0xad,
-	0x5a, 0xf4, 0x08, 0x7b, 0x21, 0x72, 0xb3, 0x24, 0x73, 0xca, 0xdf, 0x64, 0xf8, 0x2d, 0xde, 0xc4,
-	0xfd, 0xf8, 0xad, 0xf6, 0x0f, 0x11, 0x28, 0x07, 0x49, 0x08, 0x29, 0x76, 0x8e, 0x61, 0x80, 0x5d,
-	0x48, 0xc9, 0xa0, 0x20, 0xdf, 0x00, 0x4d, 0x9f, 0x41, 0x79, 0x03, 0x4a, 0xb5, 0x20, 0x2f, 0xc5,
-	0x71, 0xf2, 0x1a, 0x90, 0xd2, 0x33, 0xbf, 0xc7, 0x17, 0x0c, 0x23, 0x6f, 0x80, 0x25, 0x1c, 0x0e,
-	0x53, 0x52, 0x2b, 0xcb, 0xb6, 0x7a, 0x18, 0x05, 0x2e, 0xb3, 0xc7, 0xac, 0x29, 0xf3, 0x58, 0x46,
-	0xf0, 0x32, 0x8d, 0xfc, 0x11, 0x97, 0x7c, 0x92, 0xc0, 0x4c, 0x21, 0x10, 0x82, 0xff, 0xdd, 0x7c,
-	0x9e, 0x2d, 0x66, 0xe9, 0x31, 0x6f, 0x3c, 0xaa, 0x2a, 0x98, 0x78, 0x01, 0x74, 0x05, 0x73, 0xd9,
-	0x9d, 0x78, 0x33, 0x44, 0x60, 0xc5, 0x29, 0x8f, 0x97, 0x2b, 0x8d, 0x4b, 0x9e, 0x6c, 0xa7, 0xd6,
-	0xab, 0x3c, 0xf5, 0xa6, 0xe8, 0x0a, 0xa6, 0xea, 0xd4, 0x5b, 0xe6, 0x04, 0xa8, 0x41, 0x76, 0xa2,
-	0xd6, 0x30, 0x3f, 0xd2, 0x92, 0xaf, 0x51, 0xe7, 0xf9, 0x3a, 0x17, 0x74, 0x05, 0x53, 0x09, 0xea,
-	0x1c, 0x72, 0x72, 0xe7, 0x7c, 0x49, 0xbf, 0x3a, 0x5f, 0x29, 0x57, 0xed, 0x84, 0xbd, 0x02, 0x8b,
-	0x15, 0x86, 0x69, 0xc6, 0xb0, 0x5a, 0x65, 0xa8, 0x16, 0x5e, 0x80, 0xb7, 0x97, 0x3a, 0xd3, 0xa0,
-	0x11, 0x27, 0xfd, 0xf6, 0x7b, 0x30, 0x9f, 0x2f, 0xed, 0x41, 0x0a, 0xe5, 0xe7, 0x60, 0xe6, 0x86,
-	0x09, 0x1a, 0xcc, 0x63, 0x95, 0xf2, 0x45, 0x11, 0x29, 0xf5, 0x98, 0x59, 0x64, 0xc8, 0x32, 0x90,
-	0x7c, 0x18, 0xfb, 0xec, 0x58, 0xe7, 0x4d, 0xf6, 0xdd, 0xfe, 0x00, 0xfe, 0xad, 0x0c, 0xbf, 0xbc,
-	0x0e, 0xd8, 0xed, 0x18, 0x73, 0x8e, 0x3b, 0xaf, 0xd0, 0x58, 0x7e, 0x06, 0xfe, 0x89, 0x12, 0xdb,
-	0x3a, 0x45, 0x23, 0x6e, 0x98, 0x95, 0x9b, 0xf8, 0xec, 0xa5, 0xd2, 0x0f, 0x13, 0x3b, 0xc0, 0xce,
-	0x3e, 0x1a, 0x99, 0xcd, 0x28, 0xb1, 0xf7, 0xd1, 0xa8, 0xf3, 0xe6, 0xfc, 0x4a, 0x13, 0x2f, 0xae,
-	0x34, 0xf1, 0xfb, 0x95, 0x26, 0x9e, 0x5d, 0x6b, 0xc2, 0xc5, 0xb5, 0x26, 0x7c, 0xbd, 0xd6, 0x84,
-	0x77, 0xdb, 0x1e, 0xa6, 0x7e, 0x62, 0xeb, 0x0e, 0xe9, 0x1b, 0x37, 0x5f, 0xc4, 0xf2, 0x33, 0x7b,
-	0x5a, 0xc7, 0x5f, 0x4b, 0xbb, 0xc9, 0xd6, 0x9f, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x84, 0x99,
-	0x61, 0x70, 0xb2, 0x07, 0x00, 0x00,
+	// 787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0xb6, 0x93, 0x90, 0x85, 0x47, 0x56, 0xb0, 0x16, 0xec, 0x9a, 0x28, 0x4a, 0x96, 0x70, 0xd8,
+	0x15, 0xcb, 0xda, 0x40, 0x55, 0xb5, 0x87, 0x5e, 0x08, 0xb4, 0x8a, 0x04, 0x6a, 0x91, 0xa9, 0x38,
+	0xf4, 0xe2, 0x4e, 0xec, 0x89, 0x3d, 0xc4, 0xf6, 0x58, 0xf1, 0x38, 0x22, 0x52, 0x7f, 0x04, 0x3f,
+	0xa1, 0x3f, 0xa2, 0x97, 0xfe, 0x03, 0x8e, 0x1c, 0x7b, 0x2a, 0x15, 0xdc, 0xfb, 0x13, 0xaa, 0xca,
+	0x63, 0x27, 0xa6, 0x71, 0x0c, 0x6d, 0x55, 0x71, 0x89, 0x9c, 0x79, 0xdf, 0x7b, 0xdf, 0xfb, 0xc6,
+	0xdf, 0xbc, 0x31, 0x34, 0x18, 0xf6, 0x4c, 0xdc, 0x77, 0x89, 0xc7, 0x54, 0x36, 0xf4, 0x71, 0xa0,
+	0xe2, 0x01, 0x31, 0xb1, 0x67, 0x60, 0xc5, 0xef, 0x53, 0x46, 0xa5, 0xc5, 0x14, 0xa0, 0x70, 0x40,
+	0x75, 0xc9, 0xa2, 0x16, 0xe5, 0x41, 0x35, 0x7a, 0x8a, 0x71, 0xd5, 0x86, 0x45, 0xa9, 0xe5, 0x60,
+	0x95, 0xff, 0xeb, 0x84, 0x5d, 0x95, 0x11, 0x17, 0x07, 0x0c, 0xb9, 0x7e, 0x02, 0xa8, 0x65, 0x98,
+	0xf8, 0xef, 0x94, 0xa8, 0xd1, 0x1f, 0xfa, 0x8c, 0xaa, 0x3d, 0x3c, 0x4c, 0xa2, 0xcd, 0xf7, 0x22,
+	0x2c, 0xef, 0x85, 0xbe, 0x43, 0x0c, 0xc4, 0xf0, 0x31, 0x65, 0xf8, 0x69, 0xd2, 0xa4, 0xf4, 0x3f,
+	0x94, 0x07, 0x94, 0x61, 0x1d, 0xc9, 0xe2, 0xdf, 0xe2, 0xbf, 0xf3, 0xdb, 0x7f, 0x2a, 0x93, 0xfd,
+	0x2a, 0x11, 0x5e, 0x9b, 0x89, 0x50, 0x3b, 0x63, 0x78, 0x47, 0x2e, 0xdc, 0x0d, 0x6f, 0x49, 0x2d,
+	0x98, 0x1b, 0xcb, 0x90, 0x8b, 0x3c, 0xa3, 0xaa, 0xc4, 0x42, 0x95, 0x91, 0x50, 0xe5, 0xe5, 0x08,
+	0xd1, 0x9a, 0x3d, 0xff, 0xd8, 0x10, 0xce, 0x2e, 0x1b, 0xa2, 0x96, 0xa6, 0x35, 0x2f, 0x45, 0x90,
+	0x0f, 0x29, 0xc3, 0x1e, 0x23, 0xc8, 0xd9, 0x71, 0x3d, 0x1c, 0x10, 0x74, 0x4f, 0xed, 0xaf, 0x42,
+	0xc5, 0xc6, 0xc4, 0xb2, 0x99, 0x9e, 0x2a, 0x28, 0x6a, 0xf3, 0xf1, 0xda, 0x51, 0xb4, 0xf4, 0xad,
+	0xc2, 0xd2, 0xcf, 0x29, 0x7c, 0x27, 0xc2, 0xc2, 0xa4, 0x30, 0x1b, 0xaa, 0xfe, 0x48, 0xb4, 0x8e,
+	0xe2, 0xa0, 0x3e, 0xb2, 0x56, 0x22, 0x76, 0x3d, 0xdb, 0x7d, 0xde, 0x46, 0x69, 0xb2, 0x9f, 0xb7,
+	0x85, 0x8f, 0xa0, 0xe4, 0x53, 0xc7, 0x48, 0x76, 0x64, 0x6d, 0x4a, 0xcd, 0x3e, 0xa5, 0xdd, 0x17,
+	0xdd, 0x03, 0x6a, 0xf4, 0x76, 0x6d, 0xe4, 0x59, 0x58, 0xe3, 0x09, 0xcd, 0x37, 0x50, 0xdd, 0xa5,
+	0x5e, 0xd7, 0x21, 0x06, 0x23, 0x9e, 0xd5, 0xc6, 0xc8, 0xc4, 0xfd, 0x60, 0x5c, 0x56, 0x81, 0x82,
+	0xbd, 0x95, 0x34, 0x5a, 0xcf, 0x16, 0x3d, 0x22, 0x96, 0x87, 0xcd, 0x38, 0x49, 0x2b, 0xd8, 0x5b,
+	0x1c, 0xbf, 0x2d, 0x17, 0xbe, 0x13, 0xbf, 0xdd, 0x7c, 0x2b, 0xc2, 0xca, 0x41, 0xf4, 0x1e, 0x76,
+	0x1d, 0x82, 0x3d, 0xb6, 0xc3, 0x18, 0x32, 0x7a, 0xf7, 0xc5, 0x2e, 0xad, 0xc1, 0xef, 0x06, 0x75,
+	0x5d, 0xea, 0xe9, 0xb1, 0x19, 0x12, 0x6b, 0x54, 0xe2, 0xc5, 0x36, 0x5f, 0x6b, 0x7e, 0x16, 0x41,
+	0x3e, 0x08, 0x3d, 0xc4, 0x88, 0x71, 0x8c, 0x1c, 0x62, 0x22, 0x46, 0xfb, 0xe3, 0x0e, 0x37, 0xa1,
+	0x6c, 0xf3, 0x7a, 0x49, 0x97, 0x72, 0x96, 0x35, 0xe1, 0x4b, 0x70, 0xd2, 0x3a, 0x94, 0x22, 0x5b,
+	0xde, 0x61, 0x5d, 0x8e, 0x91, 0x36, 0x61, 0x89, 0x78, 0x83, 0x88, 0x54, 0x8f, 0xb3, 0xf5, 0x2e,
+	0xc1, 0x8e, 0xc9, 0xdb, 0x9c, 0xd3, 0xa4, 0x24, 0x16, 0x13, 0x3c, 0x8b, 0x22, 0xbf, 0xc4, 0xc8,
+	0x5f, 0x4a, 0x30, 0x3b, 0x16, 0x88, 0xe0, 0x2f, 0x73, 0x34, 0x72, 0x74, 0x7e, 0xea, 0x26, 0xec,
+	0xfb, 0x4f, 0x56, 0xc1, 0xd4, 0x19, 0xd5, 0x16, 0xb4, 0x65, 0x73, 0x5a, 0x40, 0xf2, 0xa1, 0x66,
+	0xa4, 0x0e, 0x4c, 0x94, 0x06, 0x29, 0x4f, 0xbc, 0x53, 0x1b, 0x59, 0x9e, 0x7c, 0xdf, 0xb6, 0x05,
+	0xad, 0x6a, 0xe4, 0xbb, 0xfa, 0x04, 0xaa, 0x4e, 0xfc, 0x46, 0xf5, 0xc1, 0xe8, 0x95, 0xa6, 0x7c,
+	0xc5, 0xbc, 0x63, 0x99, 0xe7, 0x82, 0xb6, 0xa0, 0xc9, 0x4e, 0x9e, 0x43, 0x4e, 0x6e, 0x1d, 0x01,
+	0xa5, 0x1f, 0x1d, 0x01, 0x11, 0x57, 0xee, 0x10, 0x78, 0x0e, 0x8b, 0x19, 0x86, 0x19, 0xce, 0xb0,
+	0x9a, 0x65, 0xc8, 0x16, 0x5e, 0x40, 0x13, 0xf5, 0x3c, 0xa8, 0x39, 0x7c, 0x70, 0x1a, 0xfc, 0x74,
+	0xea, 0x88, 0x1f, 0xcf, 0xb4, 0x76, 0x99, 0xd7, 0xfe, 0x6f, 0xca, 0x4e, 0xe5, 0x1d, 0xe9, 0xb6,
+	0xa0, 0xad, 0x38, 0x79, 0xc1, 0xd6, 0x0c, 0x14, 0x83, 0xd0, 0x6d, 0xbe, 0x86, 0xca, 0x68, 0x69,
+	0x0f, 0x31, 0x24, 0x3d, 0x81, 0xd9, 0x1b, 0xa6, 0x2b, 0x72, 0x4f, 0x67, 0x28, 0xc7, 0x45, 0x4a,
+	0x91, 0xa7, 0xb5, 0x71, 0x86, 0x24, 0x41, 0xc9, 0x46, 0x81, 0xcd, 0x6d, 0x54, 0xd1, 0xf8, 0x73,
+	0xf3, 0x14, 0xfe, 0xc8, 0xcc, 0x43, 0x69, 0x03, 0xf8, 0x85, 0x11, 0x24, 0x1c, 0xb7, 0xde, 0x2a,
+	0x81, 0xf4, 0x10, 0x7e, 0xf3, 0xc3, 0x8e, 0xde, 0xc3, 0xc3, 0xc4, 0xa0, 0xb5, 0x9b, 0xf8, 0xf8,
+	0xf2, 0x56, 0x0e, 0xc3, 0x8e, 0x43, 0x8c, 0x7d, 0x3c, 0xd4, 0xca, 0x7e, 0xd8, 0xd9, 0xc7, 0xc3,
+	0x96, 0x76, 0x7e, 0x55, 0x17, 0x2f, 0xae, 0xea, 0xe2, 0xa7, 0xab, 0xba, 0x78, 0x76, 0x5d, 0x17,
+	0x2e, 0xae, 0xeb, 0xc2, 0x87, 0xeb, 0xba, 0xf0, 0xea, 0xb1, 0x45, 0x98, 0x1d, 0x76, 0x14, 0x83,
+	0xba, 0xaa, 0x3b, 0x34, 0xf1, 0xa9, 0x61, 0x23, 0xe2, 0xa9, 0x69, 0xd1, 0xcd, 0xf8, 0xab, 0x42,
+	0x9d, 0xfc, 0x82, 0xe8, 0x94, 0xf9, 0xfa, 0x83, 0xaf, 0x03, 0x00, 0x93, 0x69, 0xbe, 0x2e, 0xc6,
+	0x08, 0x00, 0x00,
 }
 
 func (m *DuplicateVoteEvidence) Marshal() (dAtA []byte, err error) {
@@ -830,6 +912,58 @@ func (m *ConflictingHeadersEvidence) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *LightClientAttackEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttackEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommonHeight != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.CommonHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.H2 != nil {
+		{
+			size, err := m.H2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.H1 != nil {
+		{
+			size, err := m.H1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LunaticValidatorEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -850,12 +984,12 @@ func (m *LunaticValidatorEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
-	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err11 != nil {
-		return 0, err11
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n11
-	i = encodeVarintEvidence(dAtA, i, uint64(n11))
+	i -= n13
+	i = encodeVarintEvidence(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x22
 	if len(m.InvalidHeaderField) > 0 {
@@ -1029,6 +1163,27 @@ func (m *Evidence_AmnesiaEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Evidence_LightClientAttackEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence_LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LightClientAttackEvidence != nil {
+		{
+			size, err := m.LightClientAttackEvidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EvidenceData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1208,6 +1363,26 @@ func (m *ConflictingHeadersEvidence) Size() (n int) {
 	return n
 }
 
+func (m *LightClientAttackEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.H1 != nil {
+		l = m.H1.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.H2 != nil {
+		l = m.H2.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.CommonHeight != 0 {
+		n += 1 + sovEvidence(uint64(m.CommonHeight))
+	}
+	return n
+}
+
 func (m *LunaticValidatorEvidence) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1303,6 +1478,18 @@ func (m *Evidence_AmnesiaEvidence) Size() (n int) {
 	}
 	return n
 }
+func (m *Evidence_LightClientAttackEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LightClientAttackEvidence != nil {
+		l = m.LightClientAttackEvidence.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
 func (m *EvidenceData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1932,6 +2119,150 @@ func (m *ConflictingHeadersEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttackEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttackEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field H1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.H1 == nil {
+				m.H1 = &SignedHeader{}
+			}
+			if err := m.H1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field H2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.H2 == nil {
+				m.H2 = &SignedHeader{}
+			}
+			if err := m.H2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonHeight", wireType)
+			}
+			m.CommonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommonHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LunaticValidatorEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2326,6 +2657,41 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Evidence_AmnesiaEvidence{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LightClientAttackEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LightClientAttackEvidence{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Evidence_LightClientAttackEvidence{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvidence(dAtA[iNdEx:])
@@ -34,6 +34,7 @@ type ConsensusParams struct {
 	Evidence  EvidenceParams  `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence"`
 	Validator ValidatorParams `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator"`
 	Version   VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version"`
+	Timestamp TimestampParams `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
@@ -97,6 +98,13 @@ func (m *ConsensusParams) GetVersion() VersionParams {
 	return VersionParams{}
 }
 
+func (m *ConsensusParams) GetTimestamp() TimestampParams {
+	if m != nil {
+		return m.Timestamp
+	}
+	return TimestampParams{}
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Max block size, in bytes.
@@ -342,19 +350,87 @@ func (m *VersionParams) GetAppVersion() uint64 {
 	return 0
 }
 
+// TimestampParams determine how the time of a block is chosen and checked.
+type TimestampParams struct {
+	// If true, the time of a block is the time of its proposer, instead of the
+	// median time of the votes in its LastCommit. Validators prevote nil for
+	// proposals whose time does not fall within the bounds given by precision
+	// and message_delay of the time they received them.
+	ProposerBased bool `protobuf:"varint,1,opt,name=proposer_based,json=proposerBased,proto3" json:"proposer_based,omitempty"`
+	// Bound on the clock drift between the validators.
+	Precision time.Duration `protobuf:"bytes,2,opt,name=precision,proto3,stdduration" json:"precision"`
+	// Bound on the time it takes a proposal to reach the validators.
+	MessageDelay time.Duration `protobuf:"bytes,3,opt,name=message_delay,json=messageDelay,proto3,stdduration" json:"message_delay"`
+}
+
+func (m *TimestampParams) Reset()         { *m = TimestampParams{} }
+func (m *TimestampParams) String() string { return proto.CompactTextString(m) }
+func (*TimestampParams) ProtoMessage()    {}
+func (*TimestampParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{5}
+}
+func (m *TimestampParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimestampParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimestampParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimestampParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimestampParams.Merge(m, src)
+}
+func (m *TimestampParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimestampParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimestampParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimestampParams proto.InternalMessageInfo
+
+func (m *TimestampParams) GetProposerBased() bool {
+	if m != nil {
+		return m.ProposerBased
+	}
+	return false
+}
+
+func (m *TimestampParams) GetPrecision() time.Duration {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *TimestampParams) GetMessageDelay() time.Duration {
+	if m != nil {
+		return m.MessageDelay
+	}
+	return 0
+}
+
 // HashedParams is a subset of ConsensusParams.
 //
 // It is hashed into the Header.ConsensusHash.
 type HashedParams struct {
-	BlockMaxBytes int64 `protobuf:"varint,1,opt,name=block_max_bytes,json=blockMaxBytes,proto3" json:"block_max_bytes,omitempty"`
-	BlockMaxGas   int64 `protobuf:"varint,2,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+	BlockMaxBytes          int64 `protobuf:"varint,1,opt,name=block_max_bytes,json=blockMaxBytes,proto3" json:"block_max_bytes,omitempty"`
+	BlockMaxGas            int64 `protobuf:"varint,2,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+	TimestampProposerBased bool  `protobuf:"varint,3,opt,name=timestamp_proposer_based,json=timestampProposerBased,proto3" json:"timestamp_proposer_based,omitempty"`
 }
 
 func (m *HashedParams) Reset()         { *m = HashedParams{} }
 func (m *HashedParams) String() string { return proto.CompactTextString(m) }
 func (*HashedParams) ProtoMessage()    {}
 func (*HashedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e12598271a686f57, []int{5}
+	return fileDescriptor_e12598271a686f57, []int{6}
 }
 func (m *HashedParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,55 +473,70 @@ func (m *HashedParams) GetBlockMaxGas() int64 {
 	return 0
 }
 
+func (m *HashedParams) GetTimestampProposerBased() bool {
+	if m != nil {
+		return m.TimestampProposerBased
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ConsensusParams)(nil), "tendermint.types.ConsensusParams")
 	proto.RegisterType((*BlockParams)(nil), "tendermint.types.BlockParams")
 	proto.RegisterType((*EvidenceParams)(nil), "tendermint.types.EvidenceParams")
 	proto.RegisterType((*ValidatorParams)(nil), "tendermint.types.ValidatorParams")
 	proto.RegisterType((*VersionParams)(nil), "tendermint.types.VersionParams")
+	proto.RegisterType((*TimestampParams)(nil), "tendermint.types.TimestampParams")
 	proto.RegisterType((*HashedParams)(nil), "tendermint.types.HashedParams")
 }
 
 func init() { proto.RegisterFile("tendermint/types/params.proto", fileDescriptor_e12598271a686f57) }
 
 var fileDescriptor_e12598271a686f57 = []byte{
-	// 572 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xeb, 0x7e, 0x6d, 0x7a, 0xd3, 0x34, 0xd1, 0xe8, 0x93, 0x08, 0x45, 0xb5, 0x83, 0x17,
-	0xa8, 0x12, 0xc8, 0x96, 0x60, 0x81, 0xe8, 0xa6, 0xc2, 0x50, 0x15, 0x84, 0x52, 0x55, 0x56, 0x61,
-	0xd1, 0x8d, 0x35, 0x8e, 0xa7, 0xae, 0xd5, 0x8c, 0x67, 0xe4, 0x19, 0x47, 0xc9, 0x5b, 0xb0, 0xec,
-	0xb2, 0x4b, 0x1e, 0x81, 0x47, 0xe8, 0xb2, 0x12, 0x1b, 0x56, 0x80, 0x92, 0x0d, 0x8f, 0x81, 0x3c,
-	0xce, 0x90, 0x9f, 0xb2, 0xb3, 0xef, 0xf9, 0x99, 0xb9, 0xe7, 0x68, 0x60, 0x4f, 0x92, 0x2c, 0x26,
-	0x39, 0x4d, 0x33, 0xe9, 0xc9, 0x31, 0x27, 0xc2, 0xe3, 0x38, 0xc7, 0x54, 0xb8, 0x3c, 0x67, 0x92,
-	0xa1, 0xf6, 0x1c, 0x76, 0x15, 0xbc, 0xfb, 0x7f, 0xc2, 0x12, 0xa6, 0x40, 0xaf, 0xfc, 0xaa, 0x78,
-	0xbb, 0x56, 0xc2, 0x58, 0x32, 0x20, 0x9e, 0xfa, 0x8b, 0x8a, 0x0b, 0x2f, 0x2e, 0x72, 0x2c, 0x53,
-	0x96, 0x55, 0xb8, 0x73, 0xbd, 0x06, 0xad, 0x37, 0x2c, 0x13, 0x24, 0x13, 0x85, 0x38, 0x55, 0x27,
-	0xa0, 0x57, 0xf0, 0x5f, 0x34, 0x60, 0xfd, 0xab, 0x8e, 0xd1, 0x35, 0xf6, 0x1b, 0xcf, 0xf7, 0xdc,
-	0xd5, 0xb3, 0x5c, 0xbf, 0x84, 0x2b, 0xb6, 0xbf, 0x7e, 0xfb, 0xc3, 0xae, 0x05, 0x95, 0x02, 0xf9,
-	0x50, 0x27, 0xc3, 0x34, 0x26, 0x59, 0x9f, 0x74, 0xd6, 0x94, 0xba, 0x7b, 0x5f, 0x7d, 0x34, 0x63,
-	0x2c, 0x19, 0xfc, 0xd5, 0xa1, 0x23, 0xd8, 0x1a, 0xe2, 0x41, 0x1a, 0x63, 0xc9, 0xf2, 0x8e, 0xa9,
-	0x4c, 0x1e, 0xdf, 0x37, 0xf9, 0xa4, 0x29, 0x4b, 0x2e, 0x73, 0x25, 0x3a, 0x84, 0xcd, 0x21, 0xc9,
-	0x45, 0xca, 0xb2, 0xce, 0xba, 0x32, 0xb1, 0xff, 0x61, 0x52, 0x11, 0x96, 0x2c, 0xb4, 0xca, 0x21,
-	0xd0, 0x58, 0xd8, 0x13, 0x3d, 0x82, 0x2d, 0x8a, 0x47, 0x61, 0x34, 0x96, 0x44, 0xa8, 0x64, 0xcc,
-	0xa0, 0x4e, 0xf1, 0xc8, 0x2f, 0xff, 0xd1, 0x03, 0xd8, 0x2c, 0xc1, 0x04, 0x0b, 0xb5, 0xb6, 0x19,
-	0x6c, 0x50, 0x3c, 0x3a, 0xc6, 0x02, 0x75, 0x61, 0x5b, 0xa6, 0x94, 0x84, 0x29, 0x93, 0x38, 0xa4,
-	0x42, 0xed, 0x63, 0x06, 0x50, 0xce, 0xde, 0x33, 0x89, 0x7b, 0xc2, 0xf9, 0x66, 0xc0, 0xce, 0x72,
-	0x22, 0xe8, 0x29, 0xa0, 0xd2, 0x0d, 0x27, 0x24, 0xcc, 0x0a, 0x1a, 0xaa, 0x68, 0xf5, 0x99, 0x2d,
-	0x8a, 0x47, 0xaf, 0x13, 0x72, 0x52, 0x50, 0x75, 0x39, 0x81, 0x7a, 0xd0, 0xd6, 0x64, 0xdd, 0xed,
-	0x2c, 0xfa, 0x87, 0x6e, 0x55, 0xbe, 0xab, 0xcb, 0x77, 0xdf, 0xce, 0x08, 0x7e, 0xbd, 0x5c, 0xf5,
-	0xfa, 0xa7, 0x6d, 0x04, 0x3b, 0x95, 0x9f, 0x46, 0xf4, 0x26, 0x59, 0x41, 0xd5, 0x5d, 0x9b, 0x6a,
-	0x93, 0x93, 0x82, 0xa2, 0x67, 0x80, 0x78, 0xce, 0xd8, 0x45, 0x28, 0xf3, 0x14, 0x0f, 0x42, 0x4e,
-	0xf2, 0x94, 0xc5, 0x2a, 0x5a, 0x33, 0x68, 0x2b, 0xe4, 0xac, 0x04, 0x4e, 0xd5, 0xdc, 0x39, 0x84,
-	0xd6, 0x4a, 0x43, 0xc8, 0x81, 0x26, 0x2f, 0xa2, 0xf0, 0x8a, 0x8c, 0x43, 0x95, 0x7e, 0xc7, 0xe8,
-	0x9a, 0xfb, 0x5b, 0x41, 0x83, 0x17, 0xd1, 0x07, 0x32, 0x3e, 0x2b, 0x47, 0x07, 0xf5, 0xaf, 0x37,
-	0xb6, 0xf1, 0xfb, 0xc6, 0x36, 0x9c, 0x03, 0x68, 0x2e, 0xb5, 0x83, 0x6c, 0x68, 0x60, 0xce, 0x43,
-	0xdd, 0x69, 0x99, 0xc6, 0x7a, 0x00, 0x98, 0xf3, 0x19, 0x6d, 0x41, 0x7b, 0x0e, 0xdb, 0xef, 0xb0,
-	0xb8, 0x24, 0xf1, 0x4c, 0xfa, 0x04, 0x5a, 0x2a, 0xc3, 0x70, 0xb5, 0xc0, 0xa6, 0x1a, 0xf7, 0x74,
-	0x8b, 0x0e, 0x34, 0xe7, 0xbc, 0x79, 0x97, 0x0d, 0xcd, 0x3a, 0xc6, 0xc2, 0xff, 0xf8, 0x65, 0x62,
-	0x19, 0xb7, 0x13, 0xcb, 0xb8, 0x9b, 0x58, 0xc6, 0xaf, 0x89, 0x65, 0x7c, 0x9e, 0x5a, 0xb5, 0xbb,
-	0xa9, 0x55, 0xfb, 0x3e, 0xb5, 0x6a, 0xe7, 0x2f, 0x93, 0x54, 0x5e, 0x16, 0x91, 0xdb, 0x67, 0xd4,
-	0x5b, 0x7c, 0xc0, 0xf3, 0xcf, 0xea, 0x85, 0xae, 0x3e, 0xee, 0x68, 0x43, 0xcd, 0x5f, 0xfc, 0x09,
-	0x00, 0x00, 0xff, 0xff, 0xf4, 0xdd, 0x09, 0xef, 0xf7, 0x03, 0x00, 0x00,
+	// 682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x31, 0x3f, 0xc9, 0x09, 0x26, 0x68, 0x74, 0x75, 0xaf, 0x2f, 0x57, 0x38, 0xb9, 0x96,
+	0x5a, 0x21, 0xb5, 0xb2, 0xab, 0x76, 0x43, 0xd9, 0x20, 0x5c, 0x50, 0xa9, 0x2a, 0x10, 0xb2, 0x10,
+	0x8b, 0x6e, 0xac, 0x71, 0x3c, 0x18, 0x8b, 0x8c, 0x67, 0xe4, 0xb1, 0x51, 0xf2, 0x16, 0x5d, 0x74,
+	0xd1, 0x25, 0xcb, 0x3e, 0x42, 0x5f, 0xa0, 0x12, 0x4b, 0xa4, 0x6e, 0xba, 0x2a, 0x55, 0xd8, 0xf4,
+	0x31, 0xaa, 0x19, 0xc7, 0x09, 0x09, 0xad, 0xd4, 0xee, 0xe2, 0xf3, 0xfd, 0x78, 0xe6, 0x3b, 0x5f,
+	0x0c, 0xeb, 0x39, 0x49, 0x23, 0x92, 0xd1, 0x24, 0xcd, 0xdd, 0x7c, 0xc0, 0x89, 0x70, 0x39, 0xce,
+	0x30, 0x15, 0x0e, 0xcf, 0x58, 0xce, 0xd0, 0xea, 0x04, 0x76, 0x14, 0xbc, 0xf6, 0x57, 0xcc, 0x62,
+	0xa6, 0x40, 0x57, 0xfe, 0x2a, 0x79, 0x6b, 0x56, 0xcc, 0x58, 0xdc, 0x23, 0xae, 0x7a, 0x0a, 0x8b,
+	0x53, 0x37, 0x2a, 0x32, 0x9c, 0x27, 0x2c, 0x2d, 0x71, 0xfb, 0x66, 0x0e, 0x5a, 0x2f, 0x58, 0x2a,
+	0x48, 0x2a, 0x0a, 0x71, 0xa4, 0xde, 0x80, 0x9e, 0xc3, 0x42, 0xd8, 0x63, 0xdd, 0x73, 0x53, 0xeb,
+	0x68, 0x1b, 0xcd, 0xa7, 0xeb, 0xce, 0xec, 0xbb, 0x1c, 0x4f, 0xc2, 0x25, 0xdb, 0x9b, 0xbf, 0xfa,
+	0xda, 0xae, 0xf9, 0xa5, 0x02, 0x79, 0x50, 0x27, 0x17, 0x49, 0x44, 0xd2, 0x2e, 0x31, 0xe7, 0x94,
+	0xba, 0x73, 0x5f, 0xbd, 0x37, 0x62, 0x4c, 0x19, 0x8c, 0x75, 0x68, 0x0f, 0x1a, 0x17, 0xb8, 0x97,
+	0x44, 0x38, 0x67, 0x99, 0xa9, 0x2b, 0x93, 0xff, 0xef, 0x9b, 0x9c, 0x54, 0x94, 0x29, 0x97, 0x89,
+	0x12, 0x6d, 0xc3, 0xd2, 0x05, 0xc9, 0x44, 0xc2, 0x52, 0x73, 0x5e, 0x99, 0xb4, 0x7f, 0x62, 0x52,
+	0x12, 0xa6, 0x2c, 0x2a, 0x95, 0x3c, 0x47, 0x9e, 0x50, 0x22, 0x72, 0x4c, 0xb9, 0xb9, 0xf0, 0xab,
+	0x73, 0x1c, 0x57, 0x94, 0xe9, 0x73, 0x8c, 0x95, 0x36, 0x81, 0xe6, 0x9d, 0xb8, 0xd0, 0x7f, 0xd0,
+	0xa0, 0xb8, 0x1f, 0x84, 0x83, 0x9c, 0x08, 0x15, 0xb0, 0xee, 0xd7, 0x29, 0xee, 0x7b, 0xf2, 0x19,
+	0xfd, 0x03, 0x4b, 0x12, 0x8c, 0xb1, 0x50, 0xe9, 0xe9, 0xfe, 0x22, 0xc5, 0xfd, 0x97, 0x58, 0xa0,
+	0x0e, 0x2c, 0x4b, 0xc7, 0x20, 0x61, 0x39, 0x0e, 0xa8, 0x50, 0xb1, 0xe8, 0x3e, 0xc8, 0xd9, 0x2b,
+	0x96, 0xe3, 0x03, 0x61, 0x7f, 0xd6, 0x60, 0x65, 0x3a, 0x58, 0xf4, 0x08, 0x90, 0x74, 0xc3, 0x31,
+	0x09, 0xd2, 0x82, 0x06, 0x6a, 0x43, 0xd5, 0x3b, 0x5b, 0x14, 0xf7, 0x77, 0x62, 0x72, 0x58, 0x50,
+	0x75, 0x38, 0x81, 0x0e, 0x60, 0xb5, 0x22, 0x57, 0x15, 0x19, 0x6d, 0xf0, 0x5f, 0xa7, 0xec, 0x90,
+	0x53, 0x75, 0xc8, 0xd9, 0x1d, 0x11, 0xbc, 0xba, 0xbc, 0xec, 0xfb, 0x9b, 0xb6, 0xe6, 0xaf, 0x94,
+	0x7e, 0x15, 0x52, 0xdd, 0x24, 0x2d, 0xa8, 0x3a, 0xab, 0xa1, 0x6e, 0x72, 0x58, 0x50, 0xf4, 0x18,
+	0x10, 0xcf, 0x18, 0x3b, 0x0d, 0xf2, 0x2c, 0xc1, 0xbd, 0x80, 0x93, 0x2c, 0x61, 0x91, 0xda, 0x90,
+	0xee, 0xaf, 0x2a, 0xe4, 0x58, 0x02, 0x47, 0x6a, 0x6e, 0x6f, 0x43, 0x6b, 0x66, 0xd1, 0xc8, 0x06,
+	0x83, 0x17, 0x61, 0x70, 0x4e, 0x06, 0x81, 0xda, 0x80, 0xa9, 0x75, 0xf4, 0x8d, 0x86, 0xdf, 0xe4,
+	0x45, 0xf8, 0x9a, 0x0c, 0x8e, 0xe5, 0x68, 0xab, 0xfe, 0xf1, 0xb2, 0xad, 0x7d, 0xbf, 0x6c, 0x6b,
+	0xf6, 0x16, 0x18, 0x53, 0x4b, 0x46, 0x6d, 0x68, 0x62, 0xce, 0x83, 0xaa, 0x1a, 0x32, 0x8d, 0x79,
+	0x1f, 0x30, 0xe7, 0x23, 0xda, 0x1d, 0xed, 0x27, 0x0d, 0x5a, 0x33, 0xeb, 0x45, 0x0f, 0x60, 0x85,
+	0x67, 0x8c, 0x33, 0x41, 0xb2, 0x20, 0xc4, 0x82, 0x44, 0xca, 0xa1, 0xee, 0x1b, 0xd5, 0xd4, 0x93,
+	0x43, 0xb4, 0x03, 0x0d, 0x9e, 0x91, 0x6e, 0x22, 0xfe, 0x30, 0xc6, 0x89, 0x0a, 0xed, 0x83, 0x41,
+	0x89, 0x10, 0x6a, 0x21, 0xa4, 0x87, 0x07, 0xa6, 0xfe, 0xfb, 0x36, 0xcb, 0x23, 0xe5, 0xae, 0x14,
+	0xda, 0xef, 0x34, 0x58, 0xde, 0xc7, 0xe2, 0x8c, 0x44, 0xa3, 0x4b, 0x3c, 0x84, 0x96, 0x2a, 0x43,
+	0x30, 0xdb, 0x44, 0x43, 0x8d, 0x0f, 0xaa, 0x3a, 0xda, 0x60, 0x4c, 0x78, 0x93, 0x52, 0x36, 0x2b,
+	0x96, 0x6c, 0xe6, 0x26, 0x98, 0xe3, 0xae, 0x07, 0x33, 0xd1, 0xe8, 0x2a, 0x9a, 0xbf, 0xc7, 0xf8,
+	0xd1, 0xdd, 0x8c, 0xbc, 0x93, 0x0f, 0x43, 0x4b, 0xbb, 0x1a, 0x5a, 0xda, 0xf5, 0xd0, 0xd2, 0xbe,
+	0x0d, 0x2d, 0xed, 0xed, 0xad, 0x55, 0xbb, 0xbe, 0xb5, 0x6a, 0x5f, 0x6e, 0xad, 0xda, 0x9b, 0xcd,
+	0x38, 0xc9, 0xcf, 0x8a, 0xd0, 0xe9, 0x32, 0xea, 0xd2, 0x41, 0x44, 0xfa, 0xdd, 0x33, 0x9c, 0xa4,
+	0xee, 0xe4, 0xff, 0xf7, 0xa4, 0xfc, 0xa6, 0xb9, 0xb3, 0xdf, 0xc9, 0x70, 0x51, 0xcd, 0x9f, 0xfd,
+	0x18, 0x00, 0xe7, 0x6a, 0xc2, 0xa7, 0x42, 0x05, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -479,6 +570,9 @@ func (this *ConsensusParams) Equal(that interface{}) bool {
 	if !this.Version.Equal(&that1.Version) {
 		return false
 	}
+	if !this.Timestamp.Equal(&that1.Timestamp) {
+		return false
+	}
 	return true
 }
 func (this *BlockParams) Equal(that interface{}) bool {
@@ -597,6 +691,36 @@ func (this *VersionParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TimestampParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TimestampParams)
+	if !ok {
+		that2, ok := that.(TimestampParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposerBased != that1.ProposerBased {
+		return false
+	}
+	if this.Precision != that1.Precision {
+		return false
+	}
+	if this.MessageDelay != that1.MessageDelay {
+		return false
+	}
+	return true
+}
 func (this *HashedParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -622,6 +746,9 @@ func (this *HashedParams) Equal(that interface{}) bool {
 	if this.BlockMaxGas != that1.BlockMaxGas {
 		return false
 	}
+	if this.TimestampProposerBased != that1.TimestampProposerBased {
+		return false
+	}
 	return true
 }
 func (m *ConsensusParams) Marshal() (dAtA []byte, err error) {
@@ -644,6 +771,16 @@ func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -755,12 +892,12 @@ func (m *EvidenceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
-	if err5 != nil {
-		return 0, err5
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n5
-	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
 	if m.MaxAgeNumBlocks != 0 {
@@ -831,6 +968,55 @@ func (m *VersionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TimestampParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimestampParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimestampParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MessageDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MessageDelay):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintParams(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Precision, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Precision):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintParams(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if m.ProposerBased {
+		i--
+		if m.ProposerBased {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HashedParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -851,6 +1037,16 @@ func (m *HashedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TimestampProposerBased {
+		i--
+		if m.TimestampProposerBased {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.BlockMaxGas != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.BlockMaxGas))
 		i--
@@ -981,6 +1177,8 @@ func (m *ConsensusParams) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.Version.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.Timestamp.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -1049,6 +1247,22 @@ func (m *VersionParams) Size() (n int) {
 	return n
 }
 
+func (m *TimestampParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposerBased {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Precision)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MessageDelay)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
 func (m *HashedParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1061,6 +1275,9 @@ func (m *HashedParams) Size() (n int) {
 	if m.BlockMaxGas != 0 {
 		n += 1 + sovParams(uint64(m.BlockMaxGas))
 	}
+	if m.TimestampProposerBased {
+		n += 2
+	}
 	return n
 }
 
@@ -1231,6 +1448,39 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1665,6 +1915,145 @@ func (m *VersionParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TimestampParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimestampParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimestampParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerBased", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProposerBased = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Precision, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MessageDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HashedParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1732,6 +2121,26 @@ func (m *HashedParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampProposerBased", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimestampProposerBased = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
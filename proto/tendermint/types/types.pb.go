@@ -484,6 +484,11 @@ type Vote struct {
 	ValidatorAddress []byte        `protobuf:"bytes,6,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	ValidatorIndex   int32         `protobuf:"varint,7,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
 	Signature        []byte        `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Vote extension provided by the application. Only valid for precommits
+	// for a block.
+	Extension []byte `protobuf:"bytes,9,opt,name=extension,proto3" json:"extension,omitempty"`
+	// Signature of the vote extension, see CanonicalVoteExtension.
+	ExtensionSignature []byte `protobuf:"bytes,10,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
@@ -575,6 +580,20 @@ func (m *Vote) GetSignature() []byte {
 	return nil
 }
 
+func (m *Vote) GetExtension() []byte {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
+func (m *Vote) GetExtensionSignature() []byte {
+	if m != nil {
+		return m.ExtensionSignature
+	}
+	return nil
+}
+
 // Commit contains the evidence that a block was committed by a set of validators.
 type Commit struct {
 	Height     int64          `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
@@ -583,6 +602,8 @@ type Commit struct {
 	Signatures []CommitSig    `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures"`
 	Hash       []byte         `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
 	BitArray   *bits.BitArray `protobuf:"bytes,6,opt,name=bit_array,json=bitArray,proto3" json:"bit_array,omitempty"`
+	// BLS aggregate of the signatures, which are then omitted from the CommitSigs.
+	AggregatedSignature []byte `protobuf:"bytes,7,opt,name=aggregated_signature,json=aggregatedSignature,proto3" json:"aggregated_signature,omitempty"`
 }
 
 func (m *Commit) Reset()         { *m = Commit{} }
@@ -660,6 +681,13 @@ func (m *Commit) GetBitArray() *bits.BitArray {
 	return nil
 }
 
+func (m *Commit) GetAggregatedSignature() []byte {
+	if m != nil {
+		return m.AggregatedSignature
+	}
+	return nil
+}
+
 // CommitSig is a part of the Vote included in a Commit.
 type CommitSig struct {
 	BlockIdFlag      BlockIDFlag `protobuf:"varint,1,opt,name=block_id_flag,json=blockIdFlag,proto3,enum=tendermint.types.BlockIDFlag" json:"block_id_flag,omitempty"`
@@ -873,6 +901,58 @@ func (m *SignedHeader) GetCommit() *Commit {
 	return nil
 }
 
+type LightBlock struct {
+	SignedHeader *SignedHeader `protobuf:"bytes,1,opt,name=signed_header,json=signedHeader,proto3" json:"signed_header,omitempty"`
+	ValidatorSet *ValidatorSet `protobuf:"bytes,2,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+}
+
+func (m *LightBlock) Reset()         { *m = LightBlock{} }
+func (m *LightBlock) String() string { return proto.CompactTextString(m) }
+func (*LightBlock) ProtoMessage()    {}
+func (*LightBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3a6e55e2345de56, []int{10}
+}
+func (m *LightBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightBlock.Merge(m, src)
+}
+func (m *LightBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightBlock proto.InternalMessageInfo
+
+func (m *LightBlock) GetSignedHeader() *SignedHeader {
+	if m != nil {
+		return m.SignedHeader
+	}
+	return nil
+}
+
+func (m *LightBlock) GetValidatorSet() *ValidatorSet {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return nil
+}
+
 type BlockMeta struct {
 	BlockID   BlockID `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id"`
 	BlockSize int64   `protobuf:"varint,2,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
@@ -884,7 +964,7 @@ func (m *BlockMeta) Reset()         { *m = BlockMeta{} }
 func (m *BlockMeta) String() string { return proto.CompactTextString(m) }
 func (*BlockMeta) ProtoMessage()    {}
 func (*BlockMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{10}
+	return fileDescriptor_d3a6e55e2345de56, []int{11}
 }
 func (m *BlockMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -952,7 +1032,7 @@ func (m *TxProof) Reset()         { *m = TxProof{} }
 func (m *TxProof) String() string { return proto.CompactTextString(m) }
 func (*TxProof) ProtoMessage()    {}
 func (*TxProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{11}
+	return fileDescriptor_d3a6e55e2345de56, []int{12}
 }
 func (m *TxProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1002,6 +1082,145 @@ func (m *TxProof) GetProof() *crypto.Proof {
 	return nil
 }
 
+// ExtendedCommitSig is a CommitSig along with the vote extension of the
+// precommit it was made from.
+type ExtendedCommitSig struct {
+	CommitSig          CommitSig `protobuf:"bytes,1,opt,name=commit_sig,json=commitSig,proto3" json:"commit_sig"`
+	Extension          []byte    `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
+	ExtensionSignature []byte    `protobuf:"bytes,3,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
+}
+
+func (m *ExtendedCommitSig) Reset()         { *m = ExtendedCommitSig{} }
+func (m *ExtendedCommitSig) String() string { return proto.CompactTextString(m) }
+func (*ExtendedCommitSig) ProtoMessage()    {}
+func (*ExtendedCommitSig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3a6e55e2345de56, []int{13}
+}
+func (m *ExtendedCommitSig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendedCommitSig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendedCommitSig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtendedCommitSig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendedCommitSig.Merge(m, src)
+}
+func (m *ExtendedCommitSig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendedCommitSig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendedCommitSig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendedCommitSig proto.InternalMessageInfo
+
+func (m *ExtendedCommitSig) GetCommitSig() CommitSig {
+	if m != nil {
+		return m.CommitSig
+	}
+	return CommitSig{}
+}
+
+func (m *ExtendedCommitSig) GetExtension() []byte {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
+func (m *ExtendedCommitSig) GetExtensionSignature() []byte {
+	if m != nil {
+		return m.ExtensionSignature
+	}
+	return nil
+}
+
+// ExtendedCommit is a Commit whose signatures include the vote extensions of
+// the precommits.
+type ExtendedCommit struct {
+	Height              int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round               int32               `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	BlockID             BlockID             `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id"`
+	ExtendedSignatures  []ExtendedCommitSig `protobuf:"bytes,4,rep,name=extended_signatures,json=extendedSignatures,proto3" json:"extended_signatures"`
+	AggregatedSignature []byte              `protobuf:"bytes,5,opt,name=aggregated_signature,json=aggregatedSignature,proto3" json:"aggregated_signature,omitempty"`
+}
+
+func (m *ExtendedCommit) Reset()         { *m = ExtendedCommit{} }
+func (m *ExtendedCommit) String() string { return proto.CompactTextString(m) }
+func (*ExtendedCommit) ProtoMessage()    {}
+func (*ExtendedCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3a6e55e2345de56, []int{14}
+}
+func (m *ExtendedCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendedCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendedCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtendedCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendedCommit.Merge(m, src)
+}
+func (m *ExtendedCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendedCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendedCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendedCommit proto.InternalMessageInfo
+
+func (m *ExtendedCommit) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExtendedCommit) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *ExtendedCommit) GetBlockID() BlockID {
+	if m != nil {
+		return m.BlockID
+	}
+	return BlockID{}
+}
+
+func (m *ExtendedCommit) GetExtendedSignatures() []ExtendedCommitSig {
+	if m != nil {
+		return m.ExtendedSignatures
+	}
+	return nil
+}
+
+func (m *ExtendedCommit) GetAggregatedSignature() []byte {
+	if m != nil {
+		return m.AggregatedSignature
+	}
+	return nil
+}
 func init() {
 	proto.RegisterEnum("tendermint.types.BlockIDFlag", BlockIDFlag_name, BlockIDFlag_value)
 	proto.RegisterEnum("tendermint.types.SignedMsgType", SignedMsgType_name, SignedMsgType_value)
@@ -1015,96 +1234,111 @@ func init() {
 	proto.RegisterType((*CommitSig)(nil), "tendermint.types.CommitSig")
 	proto.RegisterType((*Proposal)(nil), "tendermint.types.Proposal")
 	proto.RegisterType((*SignedHeader)(nil), "tendermint.types.SignedHeader")
+	proto.RegisterType((*LightBlock)(nil), "tendermint.types.LightBlock")
 	proto.RegisterType((*BlockMeta)(nil), "tendermint.types.BlockMeta")
 	proto.RegisterType((*TxProof)(nil), "tendermint.types.TxProof")
+	proto.RegisterType((*ExtendedCommitSig)(nil), "tendermint.types.ExtendedCommitSig")
+	proto.RegisterType((*ExtendedCommit)(nil), "tendermint.types.ExtendedCommit")
 }
 
 func init() { proto.RegisterFile("tendermint/types/types.proto", fileDescriptor_d3a6e55e2345de56) }
 
 var fileDescriptor_d3a6e55e2345de56 = []byte{
-	// 1312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0x36, 0x25, 0xca, 0x92, 0x46, 0x96, 0x2d, 0x2f, 0x9c, 0x44, 0x51, 0x62, 0x99, 0xd0, 0x0f,
-	0xbf, 0xd6, 0x49, 0x03, 0x2a, 0x75, 0x8a, 0xfe, 0x41, 0xd1, 0x83, 0x64, 0x3b, 0x89, 0x10, 0x5b,
-	0x56, 0x29, 0x25, 0x45, 0x7b, 0x21, 0x28, 0x71, 0x23, 0xb1, 0xa1, 0xb8, 0x04, 0xb9, 0x72, 0xed,
-	0x3c, 0x41, 0xe1, 0x53, 0xfa, 0x00, 0x3a, 0xb5, 0x87, 0xde, 0xfb, 0x06, 0x3d, 0xe5, 0x98, 0x5b,
-	0x7b, 0x69, 0x5a, 0x38, 0x40, 0xd1, 0xc7, 0x28, 0xf6, 0x8f, 0x28, 0xca, 0xb2, 0xdb, 0x20, 0x08,
-	0x7a, 0x11, 0x76, 0x67, 0xbe, 0x99, 0xdd, 0xf9, 0xf6, 0xdb, 0x1d, 0x0a, 0xae, 0x53, 0xec, 0xd9,
-	0x38, 0x18, 0x3a, 0x1e, 0xad, 0xd2, 0x63, 0x1f, 0x87, 0xe2, 0x57, 0xf7, 0x03, 0x42, 0x09, 0x2a,
-	0x4c, 0xbd, 0x3a, 0xb7, 0x97, 0xd6, 0xfa, 0xa4, 0x4f, 0xb8, 0xb3, 0xca, 0x46, 0x02, 0x57, 0xda,
-	0xe8, 0x13, 0xd2, 0x77, 0x71, 0x95, 0xcf, 0xba, 0xa3, 0xc7, 0x55, 0xea, 0x0c, 0x71, 0x48, 0xad,
-	0xa1, 0x2f, 0x01, 0x5a, 0x6c, 0x19, 0xd7, 0xe9, 0x86, 0xd5, 0xae, 0x43, 0x67, 0x96, 0x2a, 0xad,
-	0xc7, 0x10, 0xbd, 0xe0, 0xd8, 0xa7, 0x84, 0x65, 0x23, 0x8f, 0xa5, 0xbb, 0x1c, 0x73, 0x1f, 0xe2,
-	0x20, 0x74, 0x88, 0x17, 0x0f, 0xaf, 0x7c, 0x02, 0xf9, 0x96, 0x15, 0xd0, 0x36, 0xa6, 0xf7, 0xb1,
-	0x65, 0xe3, 0x00, 0xad, 0x41, 0x8a, 0x12, 0x6a, 0xb9, 0x45, 0x45, 0x53, 0x36, 0xf3, 0x86, 0x98,
-	0x20, 0x04, 0xea, 0xc0, 0x0a, 0x07, 0xc5, 0x84, 0xa6, 0x6c, 0x2e, 0x19, 0x7c, 0x5c, 0x19, 0x80,
-	0xca, 0x42, 0x59, 0x84, 0xe3, 0xd9, 0xf8, 0x68, 0x12, 0xc1, 0x27, 0xcc, 0xda, 0x3d, 0xa6, 0x38,
-	0x94, 0x21, 0x62, 0x82, 0x3e, 0x80, 0x14, 0xdf, 0x5d, 0x31, 0xa9, 0x29, 0x9b, 0xb9, 0xad, 0xa2,
-	0x1e, 0x23, 0x4a, 0xec, 0x5e, 0x6f, 0x31, 0x7f, 0x5d, 0x7d, 0xfe, 0x72, 0x63, 0xc1, 0x10, 0xe0,
-	0x8a, 0x0b, 0xe9, 0xba, 0x4b, 0x7a, 0x4f, 0x1a, 0x3b, 0xd1, 0x46, 0x94, 0xe9, 0x46, 0xd0, 0x3e,
-	0xac, 0xf8, 0x56, 0x40, 0xcd, 0x10, 0x53, 0x73, 0xc0, 0xab, 0xe0, 0x8b, 0xe6, 0xb6, 0x36, 0xf4,
-	0xb3, 0xe7, 0xa0, 0xcf, 0x14, 0x2b, 0x57, 0xc9, 0xfb, 0x71, 0x63, 0xe5, 0x4f, 0x15, 0x16, 0x25,
-	0x19, 0x9f, 0x41, 0x5a, 0x92, 0xc6, 0x17, 0xcc, 0x6d, 0xad, 0xc7, 0x33, 0x4a, 0x97, 0xbe, 0x4d,
-	0xbc, 0x10, 0x7b, 0xe1, 0x28, 0x94, 0xf9, 0x26, 0x31, 0xe8, 0x1d, 0xc8, 0xf4, 0x06, 0x96, 0xe3,
-	0x99, 0x8e, 0xcd, 0x77, 0x94, 0xad, 0xe7, 0x4e, 0x5f, 0x6e, 0xa4, 0xb7, 0x99, 0xad, 0xb1, 0x63,
-	0xa4, 0xb9, 0xb3, 0x61, 0xa3, 0xcb, 0xb0, 0x38, 0xc0, 0x4e, 0x7f, 0x40, 0x39, 0x2d, 0x49, 0x43,
-	0xce, 0xd0, 0xc7, 0xa0, 0x32, 0x41, 0x14, 0x55, 0xbe, 0x76, 0x49, 0x17, 0x6a, 0xd1, 0x27, 0x6a,
-	0xd1, 0x3b, 0x13, 0xb5, 0xd4, 0x33, 0x6c, 0xe1, 0x67, 0xbf, 0x6f, 0x28, 0x06, 0x8f, 0x40, 0xdb,
-	0x90, 0x77, 0xad, 0x90, 0x9a, 0x5d, 0x46, 0x1b, 0x5b, 0x3e, 0xc5, 0x53, 0x5c, 0x9d, 0x27, 0x44,
-	0x12, 0x2b, 0xb7, 0x9e, 0x63, 0x51, 0xc2, 0x64, 0xa3, 0x4d, 0x28, 0xf0, 0x24, 0x3d, 0x32, 0x1c,
-	0x3a, 0xd4, 0xe4, 0xbc, 0x2f, 0x72, 0xde, 0x97, 0x99, 0x7d, 0x9b, 0x9b, 0xef, 0xb3, 0x13, 0xb8,
-	0x06, 0x59, 0xdb, 0xa2, 0x96, 0x80, 0xa4, 0x39, 0x24, 0xc3, 0x0c, 0xdc, 0xf9, 0x2e, 0xac, 0x1c,
-	0x5a, 0xae, 0x63, 0x5b, 0x94, 0x04, 0xa1, 0x80, 0x64, 0x44, 0x96, 0xa9, 0x99, 0x03, 0x6f, 0xc3,
-	0x9a, 0x87, 0x8f, 0xa8, 0x79, 0x16, 0x9d, 0xe5, 0x68, 0xc4, 0x7c, 0x8f, 0x66, 0x23, 0xfe, 0x0f,
-	0xcb, 0xbd, 0x09, 0xf9, 0x02, 0x0b, 0x1c, 0x9b, 0x8f, 0xac, 0x1c, 0x76, 0x15, 0x32, 0x96, 0xef,
-	0x0b, 0x40, 0x8e, 0x03, 0xd2, 0x96, 0xef, 0x73, 0xd7, 0x4d, 0x58, 0xe5, 0x35, 0x06, 0x38, 0x1c,
-	0xb9, 0x54, 0x26, 0x59, 0xe2, 0x98, 0x15, 0xe6, 0x30, 0x84, 0x9d, 0x63, 0xff, 0x07, 0x79, 0x7c,
-	0xe8, 0xd8, 0xd8, 0xeb, 0x61, 0x81, 0xcb, 0x73, 0xdc, 0xd2, 0xc4, 0xc8, 0x41, 0x37, 0xa0, 0xe0,
-	0x07, 0xc4, 0x27, 0x21, 0x0e, 0x4c, 0xcb, 0xb6, 0x03, 0x1c, 0x86, 0xc5, 0x65, 0x91, 0x6f, 0x62,
-	0xaf, 0x09, 0x73, 0xe5, 0x16, 0xa8, 0x3b, 0x16, 0xb5, 0x50, 0x01, 0x92, 0xf4, 0x28, 0x2c, 0x2a,
-	0x5a, 0x72, 0x73, 0xc9, 0x60, 0xc3, 0x73, 0xaf, 0xdb, 0x5f, 0x09, 0x50, 0x1f, 0x11, 0x8a, 0xd1,
-	0x1d, 0x50, 0xd9, 0xd1, 0x71, 0x45, 0x2e, 0x9f, 0xa7, 0xf1, 0xb6, 0xd3, 0xf7, 0xb0, 0xbd, 0x1f,
-	0xf6, 0x3b, 0xc7, 0x3e, 0x36, 0x38, 0x38, 0x26, 0xb1, 0xc4, 0x8c, 0xc4, 0xd6, 0x20, 0x15, 0x90,
-	0x91, 0x67, 0x73, 0xe5, 0xa5, 0x0c, 0x31, 0x41, 0xbb, 0x90, 0x89, 0x94, 0xa3, 0xfe, 0x9b, 0x72,
-	0x56, 0x98, 0x72, 0x98, 0xae, 0xa5, 0xc1, 0x48, 0x77, 0xa5, 0x80, 0xea, 0x90, 0x8d, 0x1e, 0x34,
-	0xa9, 0xc0, 0xd7, 0x13, 0xf1, 0x34, 0x0c, 0xbd, 0x07, 0xab, 0x91, 0x1e, 0x22, 0x42, 0x85, 0x0a,
-	0x0b, 0x91, 0x43, 0x32, 0x3a, 0x23, 0x35, 0x53, 0x3c, 0x4a, 0x69, 0x5e, 0xd7, 0x54, 0x6a, 0x0d,
-	0xfe, 0x3a, 0x5d, 0x87, 0x6c, 0xe8, 0xf4, 0x3d, 0x8b, 0x8e, 0x02, 0x2c, 0xd5, 0x38, 0x35, 0x54,
-	0xbe, 0x4b, 0xc0, 0xa2, 0x50, 0x77, 0x8c, 0x37, 0xe5, 0x7c, 0xde, 0x12, 0x17, 0xf1, 0x96, 0x7c,
-	0x73, 0xde, 0x6a, 0x00, 0xd1, 0x66, 0xc2, 0xa2, 0xaa, 0x25, 0x37, 0x73, 0x5b, 0xd7, 0xe6, 0x13,
-	0x89, 0x2d, 0xb6, 0x9d, 0xbe, 0xbc, 0xbc, 0xb1, 0xa0, 0x48, 0x41, 0xa9, 0xd8, 0x3b, 0xf9, 0x29,
-	0x64, 0xbb, 0x0e, 0x35, 0xad, 0x20, 0xb0, 0x8e, 0x39, 0x85, 0xb9, 0xad, 0x72, 0x3c, 0x2b, 0x6b,
-	0x30, 0x3a, 0x6b, 0x30, 0x7a, 0xdd, 0xa1, 0x35, 0x86, 0x32, 0x32, 0x5d, 0x39, 0xaa, 0xfc, 0xa6,
-	0x40, 0x36, 0x5a, 0x10, 0xd5, 0x20, 0x3f, 0x29, 0xd4, 0x7c, 0xec, 0x5a, 0x7d, 0x29, 0xc6, 0xf5,
-	0x0b, 0xab, 0xbd, 0xeb, 0x5a, 0x7d, 0x23, 0x27, 0x0b, 0x64, 0x93, 0xf3, 0x0f, 0x36, 0x71, 0xc1,
-	0xc1, 0xce, 0x28, 0x29, 0xf9, 0x66, 0x4a, 0x9a, 0x39, 0x73, 0xf5, 0xec, 0x99, 0xff, 0x94, 0x80,
-	0x4c, 0x8b, 0x5f, 0x50, 0xcb, 0xfd, 0x2f, 0xae, 0xd8, 0x35, 0xc8, 0xfa, 0xc4, 0x35, 0x85, 0x47,
-	0xe5, 0x9e, 0x8c, 0x4f, 0x5c, 0x63, 0x4e, 0x47, 0xa9, 0xb7, 0x74, 0xff, 0x16, 0xdf, 0x02, 0x6b,
-	0xe9, 0xb3, 0xac, 0x05, 0xb0, 0x24, 0xa8, 0x90, 0x0d, 0xf3, 0x36, 0xe3, 0x80, 0x77, 0x60, 0x65,
-	0xbe, 0xc1, 0x8b, 0x6d, 0x0b, 0xa4, 0x21, 0x71, 0x2c, 0x42, 0xf4, 0x17, 0xd9, 0xb3, 0x8b, 0x17,
-	0xe9, 0xdc, 0x90, 0xb8, 0xca, 0xcf, 0x0a, 0x64, 0x79, 0xa9, 0xfb, 0x98, 0x5a, 0x33, 0x54, 0x29,
-	0x6f, 0x4e, 0xd5, 0x3a, 0x80, 0x48, 0x13, 0x3a, 0x4f, 0xb1, 0x3c, 0xc0, 0x2c, 0xb7, 0xb4, 0x9d,
-	0xa7, 0x18, 0x7d, 0x18, 0xd5, 0x95, 0xfc, 0xe7, 0xba, 0xe4, 0x55, 0x9c, 0x54, 0x77, 0x05, 0xd2,
-	0xde, 0x68, 0x68, 0xb2, 0xe7, 0x5d, 0x15, 0xa2, 0xf0, 0x46, 0xc3, 0xce, 0x51, 0x58, 0xf9, 0x1a,
-	0xd2, 0x9d, 0x23, 0xfe, 0xa9, 0xc3, 0x94, 0x10, 0x10, 0x22, 0xfb, 0xab, 0xf8, 0xae, 0xc9, 0x30,
-	0x03, 0x6f, 0x27, 0x08, 0x54, 0xd6, 0x48, 0x27, 0x9d, 0x80, 0x8d, 0x91, 0xfe, 0x9a, 0x1f, 0x51,
-	0xf2, 0xf3, 0xe9, 0xe6, 0x2f, 0x0a, 0xe4, 0x62, 0xd7, 0x10, 0xbd, 0x0f, 0x97, 0xea, 0x7b, 0x07,
-	0xdb, 0x0f, 0xcc, 0xc6, 0x8e, 0x79, 0x77, 0xaf, 0x76, 0xcf, 0x7c, 0xd8, 0x7c, 0xd0, 0x3c, 0xf8,
-	0xa2, 0x59, 0x58, 0x28, 0x5d, 0x3e, 0x19, 0x6b, 0x28, 0x86, 0x7d, 0xe8, 0x3d, 0xf1, 0xc8, 0x37,
-	0x1e, 0xaa, 0xc2, 0xda, 0x6c, 0x48, 0xad, 0xde, 0xde, 0x6d, 0x76, 0x0a, 0x4a, 0xe9, 0xd2, 0xc9,
-	0x58, 0x5b, 0x8d, 0x45, 0xd4, 0xba, 0x21, 0xf6, 0xe8, 0x7c, 0xc0, 0xf6, 0xc1, 0xfe, 0x7e, 0xa3,
-	0x53, 0x48, 0xcc, 0x05, 0xc8, 0x87, 0xf6, 0x06, 0xac, 0xce, 0x06, 0x34, 0x1b, 0x7b, 0x85, 0x64,
-	0x09, 0x9d, 0x8c, 0xb5, 0xe5, 0x18, 0xba, 0xe9, 0xb8, 0xa5, 0xcc, 0xb7, 0xdf, 0x97, 0x17, 0x7e,
-	0xfc, 0xa1, 0xac, 0xb0, 0xca, 0xf2, 0x33, 0x57, 0x11, 0xdd, 0x82, 0x2b, 0xed, 0xc6, 0xbd, 0xe6,
-	0xee, 0x8e, 0xb9, 0xdf, 0xbe, 0x67, 0x76, 0xbe, 0x6c, 0xed, 0xc6, 0xaa, 0x5b, 0x39, 0x19, 0x6b,
-	0x39, 0x59, 0xd2, 0x45, 0xe8, 0x96, 0xb1, 0xfb, 0xe8, 0xa0, 0xb3, 0x5b, 0x50, 0x04, 0xba, 0x15,
-	0xe0, 0x43, 0x42, 0x31, 0x47, 0xdf, 0x86, 0xab, 0xe7, 0xa0, 0xa3, 0xc2, 0x56, 0x4f, 0xc6, 0x5a,
-	0xbe, 0x15, 0x60, 0x21, 0x53, 0x1e, 0xa1, 0x43, 0x71, 0x3e, 0xe2, 0xa0, 0x75, 0xd0, 0xae, 0xed,
-	0x15, 0xb4, 0x52, 0xe1, 0x64, 0xac, 0x2d, 0x4d, 0xde, 0x1c, 0x86, 0x9f, 0x56, 0x56, 0xff, 0xfc,
-	0xf9, 0x69, 0x59, 0x79, 0x71, 0x5a, 0x56, 0xfe, 0x38, 0x2d, 0x2b, 0xcf, 0x5e, 0x95, 0x17, 0x5e,
-	0xbc, 0x2a, 0x2f, 0xfc, 0xfa, 0xaa, 0xbc, 0xf0, 0xd5, 0x47, 0x7d, 0x87, 0x0e, 0x46, 0x5d, 0xbd,
-	0x47, 0x86, 0xd5, 0xf8, 0x9f, 0x90, 0xe9, 0x50, 0xfc, 0xcd, 0x38, 0xfb, 0x07, 0xa5, 0xbb, 0xc8,
-	0xed, 0x77, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x5b, 0x78, 0xe7, 0xbb, 0x0c, 0x00, 0x00,
+	// 1493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xfa, 0x47, 0x6c, 0x3f, 0xdb, 0x89, 0x33, 0x4d, 0x5b, 0xd7, 0x6d, 0x1c, 0xcb, 0xd5,
+	0xf7, 0x4b, 0x5a, 0x2a, 0x3b, 0x4d, 0x11, 0x2a, 0x42, 0x48, 0xd8, 0x49, 0xda, 0x5a, 0xcd, 0x0f,
+	0x6b, 0xed, 0x16, 0xd1, 0xcb, 0x6a, 0xed, 0x9d, 0xae, 0x97, 0xda, 0xbb, 0xab, 0xdd, 0x71, 0x70,
+	0xfa, 0x17, 0xa0, 0x9c, 0x7a, 0x40, 0xdc, 0x72, 0xa2, 0x07, 0xee, 0x1c, 0xb8, 0x23, 0x0e, 0x3d,
+	0xf6, 0x06, 0x17, 0x0a, 0x4a, 0x25, 0xfe, 0x0e, 0x34, 0x3f, 0xf6, 0x57, 0x1c, 0x43, 0xa9, 0x2a,
+	0xb8, 0x58, 0x33, 0xef, 0x7d, 0xde, 0x9b, 0x37, 0x9f, 0xf9, 0xec, 0xbc, 0x31, 0x5c, 0x21, 0xd8,
+	0xd4, 0xb0, 0x33, 0x32, 0x4c, 0x52, 0x27, 0x87, 0x36, 0x76, 0xf9, 0x6f, 0xcd, 0x76, 0x2c, 0x62,
+	0xa1, 0x42, 0xe0, 0xad, 0x31, 0x7b, 0x69, 0x59, 0xb7, 0x74, 0x8b, 0x39, 0xeb, 0x74, 0xc4, 0x71,
+	0xa5, 0x55, 0xdd, 0xb2, 0xf4, 0x21, 0xae, 0xb3, 0x59, 0x6f, 0xfc, 0xb8, 0x4e, 0x8c, 0x11, 0x76,
+	0x89, 0x3a, 0xb2, 0x05, 0xa0, 0x12, 0x5a, 0x66, 0x68, 0xf4, 0xdc, 0x7a, 0xcf, 0x20, 0x91, 0xa5,
+	0x4a, 0x2b, 0x21, 0x44, 0xdf, 0x39, 0xb4, 0x89, 0x45, 0xb3, 0x59, 0x8f, 0x85, 0xbb, 0x1c, 0x72,
+	0x1f, 0x60, 0xc7, 0x35, 0x2c, 0x33, 0x12, 0x5e, 0x99, 0xda, 0xc7, 0x81, 0x3a, 0x34, 0x34, 0x95,
+	0x58, 0x0e, 0x47, 0x54, 0x3f, 0x82, 0x7c, 0x5b, 0x75, 0x48, 0x07, 0x93, 0x7b, 0x58, 0xd5, 0xb0,
+	0x83, 0x96, 0x21, 0x49, 0x2c, 0xa2, 0x0e, 0x8b, 0x52, 0x45, 0x5a, 0xcb, 0xcb, 0x7c, 0x82, 0x10,
+	0x24, 0x06, 0xaa, 0x3b, 0x28, 0xc6, 0x2a, 0xd2, 0x5a, 0x4e, 0x66, 0xe3, 0xea, 0x00, 0x12, 0x34,
+	0x94, 0x46, 0x18, 0xa6, 0x86, 0x27, 0x5e, 0x04, 0x9b, 0x50, 0x6b, 0xef, 0x90, 0x60, 0x57, 0x84,
+	0xf0, 0x09, 0xfa, 0x00, 0x92, 0xac, 0xfe, 0x62, 0xbc, 0x22, 0xad, 0x65, 0x37, 0x8a, 0xb5, 0x10,
+	0x95, 0x7c, 0x7f, 0xb5, 0x36, 0xf5, 0x37, 0x13, 0x2f, 0x5e, 0xad, 0xce, 0xc9, 0x1c, 0x5c, 0x1d,
+	0x42, 0xaa, 0x39, 0xb4, 0xfa, 0x4f, 0x5a, 0x5b, 0x7e, 0x21, 0x52, 0x50, 0x08, 0xda, 0x85, 0x45,
+	0x5b, 0x75, 0x88, 0xe2, 0x62, 0xa2, 0x0c, 0xd8, 0x2e, 0xd8, 0xa2, 0xd9, 0x8d, 0xd5, 0xda, 0xe9,
+	0x93, 0xaa, 0x45, 0x36, 0x2b, 0x56, 0xc9, 0xdb, 0x61, 0x63, 0xf5, 0x8f, 0x04, 0xcc, 0xf3, 0x21,
+	0xfa, 0x04, 0x52, 0x82, 0x56, 0xb6, 0x60, 0x76, 0x63, 0x25, 0x9c, 0x51, 0xb8, 0x6a, 0x9b, 0x96,
+	0xe9, 0x62, 0xd3, 0x1d, 0xbb, 0x22, 0x9f, 0x17, 0x83, 0xfe, 0x0f, 0xe9, 0xfe, 0x40, 0x35, 0x4c,
+	0xc5, 0xd0, 0x58, 0x45, 0x99, 0x66, 0xf6, 0xe4, 0xd5, 0x6a, 0x6a, 0x93, 0xda, 0x5a, 0x5b, 0x72,
+	0x8a, 0x39, 0x5b, 0x1a, 0xba, 0x00, 0xf3, 0x03, 0x6c, 0xe8, 0x03, 0xc2, 0x68, 0x89, 0xcb, 0x62,
+	0x86, 0x6e, 0x43, 0x82, 0x4a, 0xa6, 0x98, 0x60, 0x6b, 0x97, 0x6a, 0x5c, 0x4f, 0x35, 0x4f, 0x4f,
+	0xb5, 0xae, 0xa7, 0xa7, 0x66, 0x9a, 0x2e, 0xfc, 0xec, 0xb7, 0x55, 0x49, 0x66, 0x11, 0x68, 0x13,
+	0xf2, 0x43, 0xd5, 0x25, 0x4a, 0x8f, 0xd2, 0x46, 0x97, 0x4f, 0xb2, 0x14, 0x97, 0xa6, 0x09, 0x11,
+	0xc4, 0x8a, 0xd2, 0xb3, 0x34, 0x8a, 0x9b, 0x34, 0xb4, 0x06, 0x05, 0x96, 0xa4, 0x6f, 0x8d, 0x46,
+	0x06, 0x51, 0x18, 0xef, 0xf3, 0x8c, 0xf7, 0x05, 0x6a, 0xdf, 0x64, 0xe6, 0x7b, 0xf4, 0x04, 0x2e,
+	0x43, 0x46, 0x53, 0x89, 0xca, 0x21, 0x29, 0x06, 0x49, 0x53, 0x03, 0x73, 0xbe, 0x07, 0x8b, 0xbe,
+	0xea, 0x5c, 0x0e, 0x49, 0xf3, 0x2c, 0x81, 0x99, 0x01, 0xd7, 0x61, 0xd9, 0xc4, 0x13, 0xa2, 0x9c,
+	0x46, 0x67, 0x18, 0x1a, 0x51, 0xdf, 0xc3, 0x68, 0xc4, 0xff, 0x60, 0xa1, 0xef, 0x91, 0xcf, 0xb1,
+	0xc0, 0xb0, 0x79, 0xdf, 0xca, 0x60, 0x97, 0x20, 0xad, 0xda, 0x36, 0x07, 0x64, 0x19, 0x20, 0xa5,
+	0xda, 0x36, 0x73, 0x5d, 0x87, 0x25, 0xb6, 0x47, 0x07, 0xbb, 0xe3, 0x21, 0x11, 0x49, 0x72, 0x0c,
+	0xb3, 0x48, 0x1d, 0x32, 0xb7, 0x33, 0xec, 0x55, 0xc8, 0xe3, 0x03, 0x43, 0xc3, 0x66, 0x1f, 0x73,
+	0x5c, 0x9e, 0xe1, 0x72, 0x9e, 0x91, 0x81, 0xae, 0x41, 0xc1, 0x76, 0x2c, 0xdb, 0x72, 0xb1, 0xa3,
+	0xa8, 0x9a, 0xe6, 0x60, 0xd7, 0x2d, 0x2e, 0xf0, 0x7c, 0x9e, 0xbd, 0xc1, 0xcd, 0xd5, 0x1b, 0x90,
+	0xd8, 0x52, 0x89, 0x8a, 0x0a, 0x10, 0x27, 0x13, 0xb7, 0x28, 0x55, 0xe2, 0x6b, 0x39, 0x99, 0x0e,
+	0xcf, 0xfc, 0xdc, 0x7e, 0x88, 0x43, 0xe2, 0xa1, 0x45, 0x30, 0xba, 0x05, 0x09, 0x7a, 0x74, 0x4c,
+	0x91, 0x0b, 0x67, 0x69, 0xbc, 0x63, 0xe8, 0x26, 0xd6, 0x76, 0x5d, 0xbd, 0x7b, 0x68, 0x63, 0x99,
+	0x81, 0x43, 0x12, 0x8b, 0x45, 0x24, 0xb6, 0x0c, 0x49, 0xc7, 0x1a, 0x9b, 0x1a, 0x53, 0x5e, 0x52,
+	0xe6, 0x13, 0xb4, 0x0d, 0x69, 0x5f, 0x39, 0x89, 0xbf, 0x53, 0xce, 0x22, 0x55, 0x0e, 0xd5, 0xb5,
+	0x30, 0xc8, 0xa9, 0x9e, 0x10, 0x50, 0x13, 0x32, 0xfe, 0x95, 0x57, 0x4c, 0xfe, 0x03, 0x11, 0x07,
+	0x61, 0xe8, 0x7d, 0x58, 0xf2, 0xf5, 0xe0, 0x13, 0xca, 0x55, 0x58, 0xf0, 0x1d, 0x82, 0xd1, 0x88,
+	0xd4, 0x14, 0x7e, 0x29, 0xa5, 0xd8, 0xbe, 0x02, 0xa9, 0xb5, 0xa8, 0x15, 0x5d, 0x81, 0x8c, 0x6b,
+	0xe8, 0xa6, 0x4a, 0xc6, 0x0e, 0x16, 0x6a, 0x0c, 0x0c, 0xd4, 0x8b, 0x27, 0x04, 0x9b, 0xec, 0xc3,
+	0xe7, 0xea, 0x0b, 0x0c, 0xa8, 0x0e, 0xe7, 0xfc, 0x89, 0x12, 0x64, 0xe1, 0xca, 0x43, 0xbe, 0xab,
+	0xe3, 0x79, 0xaa, 0x3f, 0xc5, 0x60, 0x9e, 0x7f, 0x2c, 0xa1, 0x63, 0x90, 0xce, 0x3e, 0x86, 0xd8,
+	0xac, 0x63, 0x88, 0xbf, 0xfd, 0x31, 0x34, 0x00, 0xfc, 0x32, 0xdd, 0x62, 0xa2, 0x12, 0x5f, 0xcb,
+	0x6e, 0x5c, 0x9e, 0x4e, 0xc4, 0x4b, 0xec, 0x18, 0xba, 0xb8, 0x0b, 0x42, 0x41, 0xbe, 0x20, 0x93,
+	0xa1, 0x6b, 0xf7, 0x63, 0xc8, 0xf4, 0x0c, 0xa2, 0xa8, 0x8e, 0xa3, 0x1e, 0xb2, 0x13, 0xc9, 0x6e,
+	0x94, 0xc3, 0x59, 0x69, 0x47, 0xab, 0xd1, 0x8e, 0x56, 0x6b, 0x1a, 0xa4, 0x41, 0x51, 0x72, 0xba,
+	0x27, 0x46, 0xe8, 0x26, 0x2c, 0xab, 0xba, 0xee, 0x60, 0x5d, 0x25, 0x58, 0x0b, 0xb1, 0xc8, 0x2f,
+	0x8f, 0x73, 0x81, 0x2f, 0xa0, 0xf1, 0x57, 0x09, 0x32, 0x7e, 0x8d, 0xa8, 0x01, 0x79, 0x8f, 0x1b,
+	0xe5, 0xf1, 0x50, 0xd5, 0xc5, 0xe7, 0xb0, 0x32, 0x93, 0xa0, 0x3b, 0x43, 0x55, 0x97, 0xb3, 0x82,
+	0x13, 0x3a, 0x39, 0x5b, 0x5a, 0xb1, 0x19, 0xd2, 0x8a, 0x68, 0x39, 0xfe, 0x76, 0x5a, 0x8e, 0xa8,
+	0x2e, 0x71, 0x4a, 0x75, 0xd5, 0xef, 0x63, 0x90, 0x6e, 0xb3, 0x2b, 0x42, 0x1d, 0xfe, 0x1b, 0x1f,
+	0xf9, 0x65, 0xc8, 0xd8, 0xd6, 0x50, 0xe1, 0x9e, 0x04, 0xf3, 0xa4, 0x6d, 0x6b, 0x28, 0x4f, 0x49,
+	0x2f, 0xf9, 0x8e, 0x6e, 0x80, 0xf9, 0x77, 0xc0, 0x5a, 0xea, 0x34, 0x6b, 0x0e, 0xe4, 0x38, 0x15,
+	0xa2, 0x65, 0xaf, 0x53, 0x0e, 0xe8, 0xa8, 0x28, 0x4d, 0x3f, 0x31, 0x78, 0xd9, 0x1c, 0x29, 0xcf,
+	0x0f, 0xfc, 0x08, 0xde, 0xe1, 0x8a, 0xb1, 0x59, 0x11, 0x5c, 0x76, 0xb2, 0xc0, 0x55, 0xbf, 0x91,
+	0x00, 0x76, 0x28, 0xb3, 0x6c, 0xbf, 0xb4, 0xd9, 0xba, 0xac, 0x04, 0x25, 0xb2, 0x72, 0x79, 0xd6,
+	0xa1, 0x89, 0xf5, 0x73, 0x6e, 0xb8, 0xee, 0x4d, 0xc8, 0x07, 0x62, 0x74, 0xb1, 0x57, 0xcc, 0x19,
+	0x49, 0xfc, 0x1e, 0xd8, 0xc1, 0x44, 0xce, 0x1d, 0x84, 0x66, 0xd5, 0x1f, 0x25, 0xc8, 0xb0, 0x9a,
+	0x76, 0x31, 0x51, 0x23, 0x67, 0x28, 0xbd, 0xfd, 0x19, 0xae, 0x00, 0xf0, 0x34, 0xae, 0xf1, 0x14,
+	0x0b, 0x65, 0x65, 0x98, 0xa5, 0x63, 0x3c, 0xc5, 0xe8, 0x43, 0x9f, 0xf0, 0xf8, 0x5f, 0x13, 0x2e,
+	0xae, 0x15, 0x8f, 0xf6, 0x8b, 0x90, 0x32, 0xc7, 0x23, 0x85, 0x76, 0xbe, 0x04, 0x57, 0xab, 0x39,
+	0x1e, 0x75, 0x27, 0x6e, 0xf5, 0x0b, 0x48, 0x75, 0x27, 0xec, 0x15, 0x48, 0x25, 0xea, 0x58, 0x96,
+	0x78, 0x7a, 0xf0, 0x27, 0x5f, 0x9a, 0x1a, 0x58, 0xa7, 0x45, 0x90, 0xa0, 0x6f, 0x0c, 0xaf, 0x49,
+	0xd2, 0x31, 0xaa, 0xbd, 0xe1, 0xfb, 0xd2, 0x7b, 0x59, 0x3e, 0x97, 0x60, 0x69, 0x7b, 0xc2, 0x40,
+	0x5a, 0x70, 0xb7, 0x7c, 0x0a, 0x20, 0xde, 0x3c, 0xae, 0xa1, 0x0b, 0xea, 0xde, 0xe0, 0xc2, 0xcc,
+	0xf4, 0xfd, 0x0c, 0x91, 0x0e, 0x12, 0x7b, 0xc3, 0x0e, 0x12, 0x9f, 0xd9, 0x41, 0xbe, 0x8e, 0xc1,
+	0x42, 0xb4, 0xcc, 0xff, 0xa6, 0x93, 0x3c, 0x12, 0x85, 0x6b, 0xe1, 0x3b, 0xdb, 0x6b, 0x29, 0x57,
+	0xa7, 0x33, 0x4e, 0x51, 0x2b, 0x98, 0x42, 0x5e, 0x96, 0x4e, 0xd0, 0x62, 0x66, 0x75, 0x84, 0xe4,
+	0xcc, 0x8e, 0x70, 0xfd, 0x67, 0x09, 0xb2, 0xa1, 0xdb, 0x1d, 0xdd, 0x84, 0xf3, 0xcd, 0x9d, 0xfd,
+	0xcd, 0xfb, 0x4a, 0x6b, 0x4b, 0xb9, 0xb3, 0xd3, 0xb8, 0xab, 0x3c, 0xd8, 0xbb, 0xbf, 0xb7, 0xff,
+	0xd9, 0x5e, 0x61, 0xae, 0x74, 0xe1, 0xe8, 0xb8, 0x82, 0x42, 0xd8, 0x07, 0xe6, 0x13, 0xd3, 0xfa,
+	0x92, 0x1e, 0xc5, 0x72, 0x34, 0xa4, 0xd1, 0xec, 0x6c, 0xef, 0x75, 0x0b, 0x52, 0xe9, 0xfc, 0xd1,
+	0x71, 0x65, 0x29, 0x14, 0xd1, 0xe8, 0xb9, 0xd8, 0x24, 0xd3, 0x01, 0x9b, 0xfb, 0xbb, 0xbb, 0xad,
+	0x6e, 0x21, 0x36, 0x15, 0x20, 0x0e, 0xea, 0x1a, 0x2c, 0x45, 0x03, 0xf6, 0x5a, 0x3b, 0x85, 0x78,
+	0x09, 0x1d, 0x1d, 0x57, 0x16, 0x42, 0xe8, 0x3d, 0x63, 0x58, 0x4a, 0x7f, 0xf5, 0x6d, 0x79, 0xee,
+	0xbb, 0xe7, 0x65, 0x89, 0xee, 0x2c, 0x1f, 0xb9, 0xe1, 0xd1, 0x0d, 0xb8, 0xd8, 0x69, 0xdd, 0xdd,
+	0xdb, 0xde, 0x52, 0x76, 0x3b, 0x77, 0x95, 0xee, 0xe7, 0xed, 0xed, 0xd0, 0xee, 0x16, 0x8f, 0x8e,
+	0x2b, 0x59, 0xb1, 0xa5, 0x59, 0xe8, 0xb6, 0xbc, 0xfd, 0x70, 0xbf, 0xbb, 0x5d, 0x90, 0x38, 0xba,
+	0xed, 0xe0, 0x03, 0x8b, 0x60, 0x86, 0x5e, 0x87, 0x4b, 0x67, 0xa0, 0xfd, 0x8d, 0x2d, 0x1d, 0x1d,
+	0x57, 0xf2, 0x6d, 0x07, 0x73, 0x79, 0xb3, 0x88, 0x1a, 0x14, 0xa7, 0x23, 0xf6, 0xdb, 0xfb, 0x9d,
+	0xc6, 0x4e, 0xa1, 0x52, 0x2a, 0x1c, 0x1d, 0x57, 0x72, 0x5e, 0x2b, 0xa3, 0xf8, 0x60, 0x67, 0x4d,
+	0xf9, 0xc5, 0x49, 0x59, 0x7a, 0x79, 0x52, 0x96, 0x7e, 0x3f, 0x29, 0x4b, 0xcf, 0x5e, 0x97, 0xe7,
+	0x5e, 0xbe, 0x2e, 0xcf, 0xfd, 0xf2, 0xba, 0x3c, 0xf7, 0xe8, 0xb6, 0x6e, 0x90, 0xc1, 0xb8, 0x57,
+	0xeb, 0x5b, 0xa3, 0xfa, 0xe8, 0x50, 0xc3, 0x13, 0xf6, 0xf7, 0xa8, 0x1e, 0x88, 0x6a, 0x9d, 0xff,
+	0x93, 0xae, 0x9f, 0xfe, 0x53, 0xdb, 0x9b, 0x67, 0xf6, 0x5b, 0x7f, 0x0e, 0x00, 0xf6, 0x31, 0x2f,
+	0xcf, 0xb7, 0x0f, 0x00, 0x00,
 }
 
 func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
@@ -1412,6 +1646,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExtensionSignature) > 0 {
+		i -= len(m.ExtensionSignature)
+		copy(dAtA[i:], m.ExtensionSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExtensionSignature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -1487,6 +1735,13 @@ func (m *Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AggregatedSignature) > 0 {
+		i -= len(m.AggregatedSignature)
+		copy(dAtA[i:], m.AggregatedSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AggregatedSignature)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.BitArray != nil {
 		{
 			size, err := m.BitArray.MarshalToSizedBuffer(dAtA[:i])
@@ -1708,6 +1963,53 @@ func (m *SignedHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LightBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorSet != nil {
+		{
+			size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SignedHeader != nil {
+		{
+			size, err := m.SignedHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BlockMeta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1810,49 +2112,160 @@ func (m *TxProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExtendedCommitSig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PartSetHeader) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ExtendedCommitSig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtendedCommitSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Total != 0 {
-		n += 1 + sovTypes(uint64(m.Total))
+	if len(m.ExtensionSignature) > 0 {
+		i -= len(m.ExtensionSignature)
+		copy(dAtA[i:], m.ExtensionSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExtensionSignature)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	{
+		size, err := m.CommitSig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Part) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExtendedCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtendedCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtendedCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		n += 1 + sovTypes(uint64(m.Index))
-	}
-	l = len(m.Bytes)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.AggregatedSignature) > 0 {
+		i -= len(m.AggregatedSignature)
+		copy(dAtA[i:], m.AggregatedSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AggregatedSignature)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = m.Proof.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	if len(m.ExtendedSignatures) > 0 {
+		for iNdEx := len(m.ExtendedSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExtendedSignatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.BlockID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PartSetHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovTypes(uint64(m.Total))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Part) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = len(m.Bytes)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
 }
 
 func (m *BlockID) Size() (n int) {
@@ -1977,6 +2390,14 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExtensionSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2008,6 +2429,10 @@ func (m *Commit) Size() (n int) {
 		l = m.BitArray.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.AggregatedSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2079,6 +2504,23 @@ func (m *SignedHeader) Size() (n int) {
 	return n
 }
 
+func (m *LightBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedHeader != nil {
+		l = m.SignedHeader.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ValidatorSet != nil {
+		l = m.ValidatorSet.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *BlockMeta) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2119,6 +2561,52 @@ func (m *TxProof) Size() (n int) {
 	return n
 }
 
+func (m *ExtendedCommitSig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommitSig.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExtensionSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtendedCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	l = m.BlockID.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.ExtendedSignatures) > 0 {
+		for _, e := range m.ExtendedSignatures {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.AggregatedSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3357,6 +3845,74 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignature = append(m.ExtensionSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignature == nil {
+				m.ExtensionSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3585,6 +4141,40 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedSignature = append(m.AggregatedSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregatedSignature == nil {
+				m.AggregatedSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4136,7 +4726,7 @@ func (m *SignedHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockMeta) Unmarshal(dAtA []byte) error {
+func (m *LightBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4159,15 +4749,15 @@ func (m *BlockMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: LightBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LightBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4194,32 +4784,16 @@ func (m *BlockMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SignedHeader == nil {
+				m.SignedHeader = &SignedHeader{}
+			}
+			if err := m.SignedHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockSize", wireType)
-			}
-			m.BlockSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockSize |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4246,12 +4820,153 @@ func (m *BlockMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ValidatorSet == nil {
+				m.ValidatorSet = &ValidatorSet{}
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSize", wireType)
+			}
+			m.BlockSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
 			}
 			m.NumTxs = 0
@@ -4450,6 +5165,352 @@ func (m *TxProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExtendedCommitSig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendedCommitSig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendedCommitSig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommitSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignature = append(m.ExtensionSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignature == nil {
+				m.ExtensionSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtendedCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendedCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendedCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedSignatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedSignatures = append(m.ExtendedSignatures, ExtendedCommitSig{})
+			if err := m.ExtendedSignatures[len(m.ExtendedSignatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedSignature = append(m.AggregatedSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregatedSignature == nil {
+				m.AggregatedSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
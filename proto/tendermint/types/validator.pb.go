@@ -89,6 +89,7 @@ type Validator struct {
 	PubKey           crypto.PublicKey `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key"`
 	VotingPower      int64            `protobuf:"varint,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
 	ProposerPriority int64            `protobuf:"varint,4,opt,name=proposer_priority,json=proposerPriority,proto3" json:"proposer_priority,omitempty"`
+	OperatorAddress  []byte           `protobuf:"bytes,5,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -152,9 +153,17 @@ func (m *Validator) GetProposerPriority() int64 {
 	return 0
 }
 
+func (m *Validator) GetOperatorAddress() []byte {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return nil
+}
+
 type SimpleValidator struct {
-	PubKey      *crypto.PublicKey `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	VotingPower int64             `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	PubKey          *crypto.PublicKey `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	VotingPower     int64             `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	OperatorAddress []byte            `protobuf:"bytes,3,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
 }
 
 func (m *SimpleValidator) Reset()         { *m = SimpleValidator{} }
@@ -204,6 +213,13 @@ func (m *SimpleValidator) GetVotingPower() int64 {
 	return 0
 }
 
+func (m *SimpleValidator) GetOperatorAddress() []byte {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSet)(nil), "tendermint.types.ValidatorSet")
 	proto.RegisterType((*Validator)(nil), "tendermint.types.Validator")
@@ -213,30 +229,32 @@ func init() {
 func init() { proto.RegisterFile("tendermint/types/validator.proto", fileDescriptor_4e92274df03d3088) }
 
 var fileDescriptor_4e92274df03d3088 = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x4e, 0xc2, 0x40,
-	0x10, 0xc6, 0xbb, 0x40, 0x40, 0x17, 0x12, 0x71, 0xe3, 0xa1, 0x41, 0x52, 0x2b, 0x27, 0x12, 0x4d,
-	0x9b, 0x68, 0x0c, 0x07, 0x6e, 0x5c, 0xb9, 0x60, 0x49, 0x38, 0x78, 0x69, 0x5a, 0xba, 0xa9, 0x1b,
-	0x0a, 0xbb, 0xd9, 0x6e, 0x31, 0xfb, 0x16, 0x3e, 0x8b, 0x4f, 0xc1, 0x91, 0xa3, 0x27, 0x63, 0xe0,
-	0x45, 0x4c, 0x5b, 0xfa, 0x27, 0xa8, 0xe1, 0x36, 0x9d, 0xef, 0x9b, 0x99, 0x5f, 0x37, 0x1f, 0xd4,
-	0x05, 0x5e, 0x79, 0x98, 0x2f, 0xc9, 0x4a, 0x98, 0x42, 0x32, 0x1c, 0x9a, 0x6b, 0x27, 0x20, 0x9e,
-	0x23, 0x28, 0x37, 0x18, 0xa7, 0x82, 0xa2, 0x76, 0xe1, 0x30, 0x12, 0x47, 0xe7, 0xca, 0xa7, 0x3e,
-	0x4d, 0x44, 0x33, 0xae, 0x52, 0x5f, 0xa7, 0x5b, 0xda, 0x34, 0xe7, 0x92, 0x09, 0x6a, 0x2e, 0xb0,
-	0x0c, 0x53, 0xb5, 0xf7, 0x01, 0x60, 0x6b, 0x96, 0x6d, 0x9e, 0x62, 0x81, 0x86, 0x10, 0xe6, 0x97,
-	0x42, 0x15, 0xe8, 0xd5, 0x7e, 0xf3, 0xe1, 0xda, 0x38, 0xbe, 0x65, 0xe4, 0x33, 0x56, 0xc9, 0x8e,
-	0x06, 0xf0, 0x8c, 0x71, 0xca, 0x68, 0x88, 0xb9, 0x5a, 0xd1, 0xc1, 0xa9, 0xd1, 0xdc, 0x8c, 0xee,
-	0x21, 0x12, 0x54, 0x38, 0x81, 0xbd, 0xa6, 0x82, 0xac, 0x7c, 0x9b, 0xd1, 0x37, 0xcc, 0xd5, 0xaa,
-	0x0e, 0xfa, 0x55, 0xab, 0x9d, 0x28, 0xb3, 0x44, 0x98, 0xc4, 0xfd, 0x18, 0xfa, 0x3c, 0xdf, 0x82,
-	0x54, 0xd8, 0x70, 0x3c, 0x8f, 0xe3, 0x30, 0xc6, 0x05, 0xfd, 0x96, 0x95, 0x7d, 0xa2, 0x21, 0x6c,
-	0xb0, 0xc8, 0xb5, 0x17, 0x58, 0x1e, 0x68, 0xba, 0x65, 0x9a, 0xf4, 0x31, 0x8c, 0x49, 0xe4, 0x06,
-	0x64, 0x3e, 0xc6, 0x72, 0x54, 0xdb, 0x7c, 0xdd, 0x28, 0x56, 0x9d, 0x45, 0xee, 0x18, 0x4b, 0x74,
-	0x0b, 0x5b, 0x7f, 0xc0, 0x34, 0xd7, 0x05, 0x07, 0xba, 0x83, 0x97, 0xd9, 0x1f, 0xd8, 0x8c, 0x13,
-	0xca, 0x89, 0x90, 0x6a, 0x2d, 0x85, 0xce, 0x84, 0xc9, 0xa1, 0xdf, 0x5b, 0xc0, 0x8b, 0x29, 0x59,
-	0xb2, 0x00, 0x17, 0xe4, 0x4f, 0x05, 0x1f, 0x38, 0xcd, 0xf7, 0x2f, 0x59, 0xe5, 0x17, 0xd9, 0xe8,
-	0x79, 0xb3, 0xd3, 0xc0, 0x76, 0xa7, 0x81, 0xef, 0x9d, 0x06, 0xde, 0xf7, 0x9a, 0xb2, 0xdd, 0x6b,
-	0xca, 0xe7, 0x5e, 0x53, 0x5e, 0x06, 0x3e, 0x11, 0xaf, 0x91, 0x6b, 0xcc, 0xe9, 0xd2, 0x2c, 0x67,
-	0xac, 0x28, 0xd3, 0x04, 0x1d, 0xe7, 0xcf, 0xad, 0x27, 0xfd, 0xc7, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x48, 0xbf, 0x34, 0x35, 0x9a, 0x02, 0x00, 0x00,
+	// 396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0xef, 0xd2, 0x30,
+	0x1c, 0xc6, 0x57, 0x86, 0xa0, 0x85, 0x04, 0x6c, 0x3c, 0x2c, 0x48, 0xe6, 0xe4, 0x84, 0xd1, 0x6c,
+	0x46, 0x63, 0x34, 0xe1, 0x24, 0x57, 0x2e, 0x64, 0x24, 0x1c, 0xbc, 0x2c, 0xfb, 0xd3, 0x8c, 0x86,
+	0x6d, 0x6d, 0xba, 0x0e, 0xed, 0xbb, 0xf0, 0xe4, 0x0b, 0xf1, 0x55, 0x70, 0xe4, 0xe8, 0x45, 0x63,
+	0xe0, 0x8d, 0x98, 0x6d, 0x6c, 0x23, 0xc8, 0x2f, 0xdc, 0xb6, 0xe7, 0x79, 0xfa, 0xed, 0xe7, 0x69,
+	0xbe, 0xd0, 0x10, 0x38, 0x09, 0x30, 0x8f, 0x49, 0x22, 0x2c, 0x21, 0x19, 0x4e, 0xad, 0x9d, 0x1b,
+	0x91, 0xc0, 0x15, 0x94, 0x9b, 0x8c, 0x53, 0x41, 0xd1, 0xb0, 0x49, 0x98, 0x45, 0x62, 0xf4, 0x2c,
+	0xa4, 0x21, 0x2d, 0x4c, 0x2b, 0xff, 0x2a, 0x73, 0xa3, 0xf1, 0xc5, 0x24, 0x9f, 0x4b, 0x26, 0xa8,
+	0xb5, 0xc5, 0x32, 0x2d, 0xdd, 0xc9, 0x4f, 0x00, 0xfb, 0xeb, 0x6a, 0xf2, 0x0a, 0x0b, 0x34, 0x83,
+	0xb0, 0xbe, 0x29, 0xd5, 0x80, 0xa1, 0x4e, 0x7b, 0xef, 0x9e, 0x9b, 0xd7, 0x77, 0x99, 0xf5, 0x19,
+	0xfb, 0x22, 0x8e, 0x3e, 0xc2, 0xc7, 0x8c, 0x53, 0x46, 0x53, 0xcc, 0xb5, 0x96, 0x01, 0xee, 0x1d,
+	0xad, 0xc3, 0xe8, 0x0d, 0x44, 0x82, 0x0a, 0x37, 0x72, 0x76, 0x54, 0x90, 0x24, 0x74, 0x18, 0xfd,
+	0x8a, 0xb9, 0xa6, 0x1a, 0x60, 0xaa, 0xda, 0xc3, 0xc2, 0x59, 0x17, 0xc6, 0x32, 0xd7, 0x27, 0xbf,
+	0x01, 0x7c, 0x52, 0x4f, 0x41, 0x1a, 0xec, 0xba, 0x41, 0xc0, 0x71, 0x9a, 0xe3, 0x82, 0x69, 0xdf,
+	0xae, 0x7e, 0xd1, 0x0c, 0x76, 0x59, 0xe6, 0x39, 0x5b, 0x2c, 0xcf, 0x34, 0xe3, 0x4b, 0x9a, 0xf2,
+	0x31, 0xcc, 0x65, 0xe6, 0x45, 0xc4, 0x5f, 0x60, 0x39, 0x6f, 0xef, 0xff, 0xbc, 0x50, 0xec, 0x0e,
+	0xcb, 0xbc, 0x05, 0x96, 0xe8, 0x25, 0xec, 0xdf, 0x80, 0xe9, 0xed, 0x1a, 0x0e, 0xf4, 0x1a, 0x3e,
+	0xad, 0x1a, 0x38, 0x8c, 0x13, 0xca, 0x89, 0x90, 0x5a, 0xbb, 0x84, 0xae, 0x8c, 0xe5, 0x59, 0x47,
+	0xaf, 0xe0, 0x90, 0x32, 0xcc, 0x73, 0x64, 0xa7, 0xe2, 0x7d, 0x54, 0xf0, 0x0e, 0x2a, 0xfd, 0x73,
+	0x29, 0x4f, 0x7e, 0x00, 0x38, 0x58, 0x91, 0x98, 0x45, 0xb8, 0x69, 0xf9, 0xa1, 0xe9, 0x02, 0xee,
+	0x77, 0x79, 0xb0, 0x45, 0xeb, 0xff, 0x16, 0xb7, 0xc0, 0xd4, 0x9b, 0x60, 0x73, 0x7b, 0x7f, 0xd4,
+	0xc1, 0xe1, 0xa8, 0x83, 0xbf, 0x47, 0x1d, 0x7c, 0x3f, 0xe9, 0xca, 0xe1, 0xa4, 0x2b, 0xbf, 0x4e,
+	0xba, 0xf2, 0xe5, 0x53, 0x48, 0xc4, 0x26, 0xf3, 0x4c, 0x9f, 0xc6, 0x56, 0x2c, 0x03, 0xfc, 0xcd,
+	0xdf, 0xb8, 0x24, 0xb1, 0x1a, 0xc4, 0xb7, 0x56, 0xb9, 0x99, 0xd7, 0x7b, 0xed, 0x75, 0x0a, 0xfd,
+	0xfd, 0xbf, 0x01, 0x00, 0x27, 0xd3, 0x6b, 0xe8, 0xf2, 0x02, 0x00, 0x00,
 }
 
 func (m *ValidatorSet) Marshal() (dAtA []byte, err error) {
@@ -313,6 +331,13 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ProposerPriority != 0 {
 		i = encodeVarintValidator(dAtA, i, uint64(m.ProposerPriority))
 		i--
@@ -363,6 +388,13 @@ func (m *SimpleValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.VotingPower != 0 {
 		i = encodeVarintValidator(dAtA, i, uint64(m.VotingPower))
 		i--
@@ -434,6 +466,10 @@ func (m *Validator) Size() (n int) {
 	if m.ProposerPriority != 0 {
 		n += 1 + sovValidator(uint64(m.ProposerPriority))
 	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
 	return n
 }
 
@@ -450,6 +486,10 @@ func (m *SimpleValidator) Size() (n int) {
 	if m.VotingPower != 0 {
 		n += 1 + sovValidator(uint64(m.VotingPower))
 	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
 	return n
 }
 
@@ -735,6 +775,40 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = append(m.OperatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OperatorAddress == nil {
+				m.OperatorAddress = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValidator(dAtA[iNdEx:])
@@ -843,6 +917,40 @@ func (m *SimpleValidator) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = append(m.OperatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OperatorAddress == nil {
+				m.OperatorAddress = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValidator(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package proxy
 import (
 	abcicli "github.com/mydexchain/tendermint0/abci/client"
 	"github.com/mydexchain/tendermint0/abci/types"
+	tmsync "github.com/mydexchain/tendermint0/libs/sync"
 )
 
 //go:generate mockery -case underscore -name AppConnConsensus|AppConnMempool|AppConnQuery|AppConnSnapshot
@@ -19,7 +20,18 @@ type AppConnConsensus interface {
 	BeginBlockSync(types.RequestBeginBlock) (*types.ResponseBeginBlock, error)
 	DeliverTxAsync(types.RequestDeliverTx) *abcicli.ReqRes
 	EndBlockSync(types.RequestEndBlock) (*types.ResponseEndBlock, error)
+	FinalizeBlockSync(types.RequestFinalizeBlock) (*types.ResponseFinalizeBlock, error)
 	CommitSync() (*types.ResponseCommit, error)
+
+	// SupportsFinalizeBlockSync returns true if the app executes blocks with
+	// FinalizeBlock rather than BeginBlock, DeliverTx and EndBlock. The ABCI
+	// version of the app is asked with Info on the first call.
+	SupportsFinalizeBlockSync() (bool, error)
+
+	ExtendVoteSync(types.RequestExtendVote) (*types.ResponseExtendVote, error)
+	VerifyVoteExtensionSync(types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error)
+	PrepareProposalSync(types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error)
+	ProcessProposalSync(types.RequestProcessProposal) (*types.ResponseProcessProposal, error)
 }
 
 type AppConnMempool interface {
@@ -50,6 +62,9 @@ type AppConnSnapshot interface {
 	OfferSnapshotSync(types.RequestOfferSnapshot) (*types.ResponseOfferSnapshot, error)
 	LoadSnapshotChunkSync(types.RequestLoadSnapshotChunk) (*types.ResponseLoadSnapshotChunk, error)
 	ApplySnapshotChunkSync(types.RequestApplySnapshotChunk) (*types.ResponseApplySnapshotChunk, error)
+
+	ExportStateSync(types.RequestExportState) (*types.ResponseExportState, error)
+	ImportStateSync(types.RequestImportState) (*types.ResponseImportState, error)
 }
 
 //-----------------------------------------------------------------------------------------
@@ -57,6 +72,9 @@ type AppConnSnapshot interface {
 
 type appConnConsensus struct {
 	appConn abcicli.Client
+
+	mtx           tmsync.Mutex
+	finalizeBlock *bool // nil until the app is asked
 }
 
 func NewAppConnConsensus(appConn abcicli.Client) AppConnConsensus {
@@ -89,10 +107,48 @@ func (app *appConnConsensus) EndBlockSync(req types.RequestEndBlock) (*types.Res
 	return app.appConn.EndBlockSync(req)
 }
 
+func (app *appConnConsensus) FinalizeBlockSync(req types.RequestFinalizeBlock) (*types.ResponseFinalizeBlock, error) {
+	return app.appConn.FinalizeBlockSync(req)
+}
+
 func (app *appConnConsensus) CommitSync() (*types.ResponseCommit, error) {
 	return app.appConn.CommitSync()
 }
 
+func (app *appConnConsensus) SupportsFinalizeBlockSync() (bool, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	if app.finalizeBlock == nil {
+		res, err := app.appConn.InfoSync(RequestInfo)
+		if err != nil {
+			return false, err
+		}
+		supported := SupportsFinalizeBlock(res.AbciVersion)
+		app.finalizeBlock = &supported
+	}
+	return *app.finalizeBlock, nil
+}
+
+func (app *appConnConsensus) ExtendVoteSync(req types.RequestExtendVote) (*types.ResponseExtendVote, error) {
+	return app.appConn.ExtendVoteSync(req)
+}
+
+func (app *appConnConsensus) VerifyVoteExtensionSync(
+	req types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error) {
+	return app.appConn.VerifyVoteExtensionSync(req)
+}
+
+func (app *appConnConsensus) PrepareProposalSync(
+	req types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error) {
+	return app.appConn.PrepareProposalSync(req)
+}
+
+func (app *appConnConsensus) ProcessProposalSync(
+	req types.RequestProcessProposal) (*types.ResponseProcessProposal, error) {
+	return app.appConn.ProcessProposalSync(req)
+}
+
 //------------------------------------------------
 // Implements AppConnMempool (subset of abcicli.Client)
 
@@ -193,3 +249,11 @@ func (app *appConnSnapshot) ApplySnapshotChunkSync(
 	req types.RequestApplySnapshotChunk) (*types.ResponseApplySnapshotChunk, error) {
 	return app.appConn.ApplySnapshotChunkSync(req)
 }
+
+func (app *appConnSnapshot) ExportStateSync(req types.RequestExportState) (*types.ResponseExportState, error) {
+	return app.appConn.ExportStateSync(req)
+}
+
+func (app *appConnSnapshot) ImportStateSync(req types.RequestImportState) (*types.ResponseImportState, error) {
+	return app.appConn.ImportStateSync(req)
+}
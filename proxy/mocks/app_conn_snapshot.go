@@ -50,6 +50,52 @@ func (_m *AppConnSnapshot) Error() error {
 	return r0
 }
 
+// ExportStateSync provides a mock function with given fields: _a0
+func (_m *AppConnSnapshot) ExportStateSync(_a0 types.RequestExportState) (*types.ResponseExportState, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *types.ResponseExportState
+	if rf, ok := ret.Get(0).(func(types.RequestExportState) *types.ResponseExportState); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ResponseExportState)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.RequestExportState) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportStateSync provides a mock function with given fields: _a0
+func (_m *AppConnSnapshot) ImportStateSync(_a0 types.RequestImportState) (*types.ResponseImportState, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *types.ResponseImportState
+	if rf, ok := ret.Get(0).(func(types.RequestImportState) *types.ResponseImportState); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ResponseImportState)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.RequestImportState) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListSnapshotsSync provides a mock function with given fields: _a0
 func (_m *AppConnSnapshot) ListSnapshotsSync(_a0 types.RequestListSnapshots) (*types.ResponseListSnapshots, error) {
 	ret := _m.Called(_a0)
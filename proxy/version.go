@@ -1,6 +1,9 @@
 package proxy
 
 import (
+	"strconv"
+	"strings"
+
 	abci "github.com/mydexchain/tendermint0/abci/types"
 	"github.com/mydexchain/tendermint0/version"
 )
@@ -13,3 +16,29 @@ var RequestInfo = abci.RequestInfo{
 	BlockVersion: version.BlockProtocol,
 	P2PVersion:   version.P2PProtocol,
 }
+
+// FinalizeBlock was added in version 0.18 of ABCI.
+const finalizeBlockABCIMajor, finalizeBlockABCIMinor = 0, 18
+
+// SupportsFinalizeBlock returns true if an app implementing the given version
+// of ABCI, as reported by Info, executes blocks with FinalizeBlock. Apps which
+// predate it report no version and execute blocks with BeginBlock, DeliverTx
+// and EndBlock.
+func SupportsFinalizeBlock(abciVersion string) bool {
+	parts := strings.SplitN(abciVersion, ".", 3)
+	if len(parts) < 2 {
+		return false
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return false
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return false
+	}
+	if major != finalizeBlockABCIMajor {
+		return major > finalizeBlockABCIMajor
+	}
+	return minor >= finalizeBlockABCIMinor
+}
@@ -0,0 +1,28 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mydexchain/tendermint0/version"
+)
+
+func TestSupportsFinalizeBlock(t *testing.T) {
+	testCases := []struct {
+		abciVersion string
+		supported   bool
+	}{
+		{"", false},
+		{"dev", false},
+		{"0.17.0", false},
+		{"0.18", true},
+		{"0.18.0", true},
+		{"0.19.1-dev", true},
+		{"1.0.0", true},
+		{version.ABCIVersion, true},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.supported, SupportsFinalizeBlock(tc.abciVersion), tc.abciVersion)
+	}
+}
@@ -131,6 +131,19 @@ func TestBroadcastEvidence_DuplicateVoteEvidence(t *testing.T) {
 		err = client.WaitForHeight(c, status.SyncInfo.LatestBlockHeight+2, nil)
 		require.NoError(t, err)
 
+		// the evidence was committed
+		evResult, err := c.Evidence(correct.Hash())
+		require.NoError(t, err)
+		assert.Equal(t, correct.Hash(), evResult.Evidence.Hash())
+		assert.Equal(t, "committed", evResult.Status)
+		committed, err := c.CommittedEvidence(&evResult.Height)
+		require.NoError(t, err)
+		require.Len(t, committed.Evidence, 1)
+		assert.Equal(t, correct.Hash(), committed.Evidence[0].Hash())
+		pending, err := c.PendingEvidence(nil)
+		require.NoError(t, err)
+		assert.Zero(t, pending.Total)
+
 		ed25519pub := pv.Key.PubKey.(ed25519.PubKey)
 		rawpub := ed25519pub.Bytes()
 		result2, err := c.ABCIQuery("/val", rawpub)
@@ -479,6 +479,41 @@ func (c *baseRPCClient) BroadcastEvidence(ev types.Evidence) (*ctypes.ResultBroa
 	return result, nil
 }
 
+func (c *baseRPCClient) PendingEvidence(limit *int) (*ctypes.ResultPendingEvidence, error) {
+	result := new(ctypes.ResultPendingEvidence)
+	params := make(map[string]interface{})
+	if limit != nil {
+		params["limit"] = limit
+	}
+	_, err := c.caller.Call("pending_evidence", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) Evidence(hash []byte) (*ctypes.ResultEvidence, error) {
+	result := new(ctypes.ResultEvidence)
+	_, err := c.caller.Call("evidence", map[string]interface{}{"hash": hash}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) CommittedEvidence(height *int64) (*ctypes.ResultCommittedEvidence, error) {
+	result := new(ctypes.ResultCommittedEvidence)
+	params := make(map[string]interface{})
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call("committed_evidence", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 //-----------------------------------------------------------------------------
 // WSEvents
 
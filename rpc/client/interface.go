@@ -119,9 +119,12 @@ type MempoolClient interface {
 }
 
 // EvidenceClient is used for submitting an evidence of the malicious
-// behaviour.
+// behaviour and inspecting the evidence known to the node.
 type EvidenceClient interface {
 	BroadcastEvidence(ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error)
+	PendingEvidence(limit *int) (*ctypes.ResultPendingEvidence, error)
+	Evidence(hash []byte) (*ctypes.ResultEvidence, error)
+	CommittedEvidence(height *int64) (*ctypes.ResultCommittedEvidence, error)
 }
 
 // RemoteClient is a Client, which can also return the remote network address.
@@ -177,6 +177,18 @@ func (c *Local) BroadcastEvidence(ev types.Evidence) (*ctypes.ResultBroadcastEvi
 	return core.BroadcastEvidence(c.ctx, ev)
 }
 
+func (c *Local) PendingEvidence(limit *int) (*ctypes.ResultPendingEvidence, error) {
+	return core.PendingEvidence(c.ctx, limit)
+}
+
+func (c *Local) Evidence(hash []byte) (*ctypes.ResultEvidence, error) {
+	return core.Evidence(c.ctx, hash)
+}
+
+func (c *Local) CommittedEvidence(height *int64) (*ctypes.ResultCommittedEvidence, error) {
+	return core.CommittedEvidence(c.ctx, height)
+}
+
 func (c *Local) Subscribe(
 	ctx context.Context,
 	subscriber,
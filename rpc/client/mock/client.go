@@ -169,3 +169,15 @@ func (c Client) Validators(height *int64, page, perPage *int) (*ctypes.ResultVal
 func (c Client) BroadcastEvidence(ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return core.BroadcastEvidence(&rpctypes.Context{}, ev)
 }
+
+func (c Client) PendingEvidence(limit *int) (*ctypes.ResultPendingEvidence, error) {
+	return core.PendingEvidence(&rpctypes.Context{}, limit)
+}
+
+func (c Client) Evidence(hash []byte) (*ctypes.ResultEvidence, error) {
+	return core.Evidence(&rpctypes.Context{}, hash)
+}
+
+func (c Client) CommittedEvidence(height *int64) (*ctypes.ResultCommittedEvidence, error) {
+	return core.CommittedEvidence(&rpctypes.Context{}, height)
+}
@@ -9,12 +9,14 @@ import (
 	cfg "github.com/mydexchain/tendermint0/config"
 	"github.com/mydexchain/tendermint0/consensus"
 	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/evidence"
 	"github.com/mydexchain/tendermint0/libs/log"
 	mempl "github.com/mydexchain/tendermint0/mempool"
 	"github.com/mydexchain/tendermint0/p2p"
 	"github.com/mydexchain/tendermint0/proxy"
 	sm "github.com/mydexchain/tendermint0/state"
 	"github.com/mydexchain/tendermint0/state/txindex"
+	"github.com/mydexchain/tendermint0/statesync"
 	"github.com/mydexchain/tendermint0/types"
 )
 
@@ -56,6 +58,12 @@ type transport interface {
 	NodeInfo() p2p.NodeInfo
 }
 
+type evidencePool interface {
+	sm.EvidencePool
+	AllPendingEvidence() []types.Evidence
+	EvidenceByHash(hash []byte) (types.Evidence, evidence.Status, int64, error)
+}
+
 type peers interface {
 	AddPersistentPeers([]string) error
 	DialPeersAsync([]string) error
@@ -73,7 +81,7 @@ type Environment struct {
 	// interfaces defined in types and above
 	StateDB        dbm.DB
 	BlockStore     sm.BlockStore
-	EvidencePool   sm.EvidencePool
+	EvidencePool   evidencePool
 	ConsensusState Consensus
 	P2PPeers       peers
 	P2PTransport   transport
@@ -83,6 +91,7 @@ type Environment struct {
 	GenDoc           *types.GenesisDoc // cache the genesis structure
 	TxIndexer        txindex.TxIndexer
 	ConsensusReactor *consensus.Reactor
+	StateSyncReactor *statesync.Reactor
 	EventBus         *types.EventBus // thread safe
 	Mempool          mempl.Mempool
 
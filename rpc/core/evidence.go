@@ -25,3 +25,52 @@ func BroadcastEvidence(ctx *rpctypes.Context, ev types.Evidence) (*ctypes.Result
 	}
 	return &ctypes.ResultBroadcastEvidence{Hash: ev.Hash()}, nil
 }
+
+// PendingEvidence gets the evidence ready to be proposed (maximum ?limit
+// entries, 30 by default).
+// More: https://docs.tendermint.com/master/rpc/#/Info/pending_evidence
+func PendingEvidence(ctx *rpctypes.Context, limitPtr *int) (*ctypes.ResultPendingEvidence, error) {
+	// reuse per_page validator
+	limit := validatePerPage(limitPtr)
+
+	evList := env.EvidencePool.AllPendingEvidence()
+	total := len(evList)
+	if len(evList) > limit {
+		evList = evList[:limit]
+	}
+	return &ctypes.ResultPendingEvidence{
+		Count:    len(evList),
+		Total:    total,
+		Evidence: evList}, nil
+}
+
+// Evidence gets the evidence with the given hash, whether it is pending,
+// awaiting the end of its trial period or committed.
+// More: https://docs.tendermint.com/master/rpc/#/Info/evidence
+func Evidence(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultEvidence, error) {
+	ev, status, height, err := env.EvidencePool.EvidenceByHash(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get evidence: %w", err)
+	}
+	if ev == nil {
+		return nil, fmt.Errorf("evidence (%X) not found", hash)
+	}
+	return &ctypes.ResultEvidence{Evidence: ev, Status: string(status), Height: height}, nil
+}
+
+// CommittedEvidence gets the evidence committed in the block at the given
+// height. If no height is provided, it will fetch the evidence of the latest
+// block.
+// More: https://docs.tendermint.com/master/rpc/#/Info/committed_evidence
+func CommittedEvidence(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommittedEvidence, error) {
+	height, err := getHeight(env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	block := env.BlockStore.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+	return &ctypes.ResultCommittedEvidence{Height: height, Evidence: block.Evidence.Evidence}, nil
+}
@@ -0,0 +1,26 @@
+package core
+
+import (
+	rpc "github.com/mydexchain/tendermint0/rpc/jsonrpc/server"
+	"github.com/mydexchain/tendermint0/version"
+)
+
+//go:generate go run ../../scripts/openapigen ../swagger/openapi.json
+
+// OpenAPI returns an OpenAPI 3 document describing the given routes, derived
+// from the handlers and their result types rather than maintained by hand.
+func OpenAPI(routes map[string]*rpc.RPCFunc) *rpc.OpenAPI {
+	return rpc.NewOpenAPI(routes, "Tendermint RPC", version.TMCoreSemVer)
+}
+
+// AllRoutes returns Routes together with UnsafeRoutes.
+func AllRoutes() map[string]*rpc.RPCFunc {
+	routes := make(map[string]*rpc.RPCFunc, len(Routes)+len(UnsafeRoutes))
+	for name, rpcFunc := range Routes {
+		routes[name] = rpcFunc
+	}
+	for name, rpcFunc := range UnsafeRoutes {
+		routes[name] = rpcFunc
+	}
+	return routes
+}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+// TestOpenAPIUpToDate ensures the generated OpenAPI document checked into
+// rpc/swagger matches the handlers. Run `make openapi` to regenerate it.
+func TestOpenAPIUpToDate(t *testing.T) {
+	expected, err := json.MarshalIndent(OpenAPI(AllRoutes()), "", "  ")
+	require.NoError(t, err)
+	actual, err := ioutil.ReadFile("../swagger/openapi.json")
+	require.NoError(t, err)
+	assert.Equal(t, string(expected)+"\n", string(actual),
+		"rpc/swagger/openapi.json is out of date, run `make openapi`")
+}
+
+// TestSwaggerMatchesRoutes ensures the hand-written swagger spec documents
+// every route with the parameter names the handlers expect, and nothing else.
+func TestSwaggerMatchesRoutes(t *testing.T) {
+	bz, err := ioutil.ReadFile("../swagger/swagger.yaml")
+	require.NoError(t, err)
+
+	var spec struct {
+		Paths map[string]map[string]struct {
+			Parameters []struct {
+				Name string `yaml:"name"`
+			} `yaml:"parameters"`
+			RequestBody struct {
+				Content map[string]struct {
+					Schema struct {
+						Ref string `yaml:"$ref"`
+					} `yaml:"schema"`
+				} `yaml:"content"`
+			} `yaml:"requestBody"`
+		} `yaml:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]interface{} `yaml:"properties"`
+			} `yaml:"schemas"`
+		} `yaml:"components"`
+	}
+	require.NoError(t, yaml.Unmarshal(bz, &spec))
+
+	routes := AllRoutes()
+	for path := range spec.Paths {
+		_, ok := routes[strings.TrimPrefix(path, "/")]
+		assert.True(t, ok, "swagger documents %s, but there is no such route", path)
+	}
+
+	for name, rpcFunc := range routes {
+		operations, ok := spec.Paths["/"+name]
+		if !assert.True(t, ok, "route %s is not documented in swagger", name) {
+			continue
+		}
+		for method, op := range operations {
+			params := []string{}
+			for _, p := range op.Parameters {
+				params = append(params, p.Name)
+			}
+			for _, content := range op.RequestBody.Content {
+				ref := strings.TrimPrefix(content.Schema.Ref, "#/components/schemas/")
+				for p := range spec.Components.Schemas[ref].Properties {
+					params = append(params, p)
+				}
+			}
+			expected := append([]string{}, rpcFunc.ArgNames()...)
+			sort.Strings(expected)
+			sort.Strings(params)
+			assert.Equal(t, expected, params, "parameters of %s %s", method, name)
+		}
+	}
+}
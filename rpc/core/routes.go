@@ -43,16 +43,26 @@ var Routes = map[string]*rpc.RPCFunc{
 
 	// evidence API
 	"broadcast_evidence": rpc.NewRPCFunc(BroadcastEvidence, "evidence"),
+	"pending_evidence":   rpc.NewRPCFunc(PendingEvidence, "limit"),
+	"evidence":           rpc.NewRPCFunc(Evidence, "hash"),
+	"committed_evidence": rpc.NewRPCFunc(CommittedEvidence, "height"),
 }
 
-func AddUnsafeRoutes() {
+// UnsafeRoutes are added to Routes by AddUnsafeRoutes.
+var UnsafeRoutes = map[string]*rpc.RPCFunc{
 	// control API
-	Routes["dial_seeds"] = rpc.NewRPCFunc(UnsafeDialSeeds, "seeds")
-	Routes["dial_peers"] = rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent")
-	Routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(UnsafeFlushMempool, "")
+	"dial_seeds":           rpc.NewRPCFunc(UnsafeDialSeeds, "seeds"),
+	"dial_peers":           rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent"),
+	"unsafe_flush_mempool": rpc.NewRPCFunc(UnsafeFlushMempool, ""),
 
 	// profiler API
-	Routes["unsafe_start_cpu_profiler"] = rpc.NewRPCFunc(UnsafeStartCPUProfiler, "filename")
-	Routes["unsafe_stop_cpu_profiler"] = rpc.NewRPCFunc(UnsafeStopCPUProfiler, "")
-	Routes["unsafe_write_heap_profile"] = rpc.NewRPCFunc(UnsafeWriteHeapProfile, "filename")
+	"unsafe_start_cpu_profiler": rpc.NewRPCFunc(UnsafeStartCPUProfiler, "filename"),
+	"unsafe_stop_cpu_profiler":  rpc.NewRPCFunc(UnsafeStopCPUProfiler, ""),
+	"unsafe_write_heap_profile": rpc.NewRPCFunc(UnsafeWriteHeapProfile, "filename"),
+}
+
+func AddUnsafeRoutes() {
+	for name, rpcFunc := range UnsafeRoutes {
+		Routes[name] = rpcFunc
+	}
 }
@@ -73,6 +73,16 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 		},
 	}
 
+	if env.StateSyncReactor != nil {
+		if progress, ok := env.StateSyncReactor.Progress(); ok {
+			result.SyncInfo.SnapshotHeight = progress.Height
+			result.SyncInfo.SnapshotChunksApplied = progress.ChunksApplied
+			result.SyncInfo.SnapshotChunksTotal = progress.ChunksTotal
+			result.SyncInfo.SnapshotBytesPerSecond = progress.BytesPerSecond
+			result.SyncInfo.SnapshotETA = progress.ETA
+		}
+	}
+
 	return result, nil
 }
 
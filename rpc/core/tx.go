@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/mydexchain/tendermint0/crypto/merkle"
 	tmmath "github.com/mydexchain/tendermint0/libs/math"
 	tmquery "github.com/mydexchain/tendermint0/libs/pubsub/query"
 	ctypes "github.com/mydexchain/tendermint0/rpc/core/types"
 	rpctypes "github.com/mydexchain/tendermint0/rpc/jsonrpc/types"
+	sm "github.com/mydexchain/tendermint0/state"
 	"github.com/mydexchain/tendermint0/state/txindex/null"
 	"github.com/mydexchain/tendermint0/types"
 )
@@ -35,19 +37,25 @@ func Tx(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error
 	height := r.Height
 	index := r.Index
 
-	var proof types.TxProof
+	var (
+		proof       types.TxProof
+		resultProof merkle.Proof
+	)
 	if prove {
-		block := env.BlockStore.LoadBlock(height)
-		proof = block.Data.Txs.Proof(int(index)) // XXX: overflow on 32-bit machines
+		proof, resultProof, err = txProofs(height, index)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &ctypes.ResultTx{
-		Hash:     hash,
-		Height:   height,
-		Index:    index,
-		TxResult: r.Result,
-		Tx:       r.Tx,
-		Proof:    proof,
+		Hash:        hash,
+		Height:      height,
+		Index:       index,
+		TxResult:    r.Result,
+		Tx:          r.Tx,
+		Proof:       proof,
+		ResultProof: resultProof,
 	}, nil
 }
 
@@ -104,22 +112,103 @@ func TxSearch(ctx *rpctypes.Context, query string, prove bool, pagePtr, perPageP
 	apiResults := make([]*ctypes.ResultTx, 0, pageSize)
 	for i := skipCount; i < skipCount+pageSize; i++ {
 		r := results[i]
-
-		var proof types.TxProof
-		if prove {
-			block := env.BlockStore.LoadBlock(r.Height)
-			proof = block.Data.Txs.Proof(int(r.Index)) // XXX: overflow on 32-bit machines
-		}
-
 		apiResults = append(apiResults, &ctypes.ResultTx{
 			Hash:     types.Tx(r.Tx).Hash(),
 			Height:   r.Height,
 			Index:    r.Index,
 			TxResult: r.Result,
 			Tx:       r.Tx,
-			Proof:    proof,
 		})
 	}
 
-	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
+	var proofs []*ctypes.ResultTxsProof
+	if prove {
+		proofs, err = proveTxs(apiResults)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount, Proofs: proofs}, nil
+}
+
+// proveTxs returns a proof of the txs found at the same height, if there are
+// several, and sets the proofs of the txs found alone at their height. The
+// txs must be grouped by height. Each block and its results are loaded once.
+func proveTxs(txs []*ctypes.ResultTx) ([]*ctypes.ResultTxsProof, error) {
+	var proofs []*ctypes.ResultTxsProof
+	for start := 0; start < len(txs); {
+		height := txs[start].Height
+		end := start + 1
+		for end < len(txs) && txs[end].Height == height {
+			end++
+		}
+		group := txs[start:end]
+		start = end
+
+		block, results, err := loadTxsAndResults(height)
+		if err != nil {
+			return nil, err
+		}
+		indices := make([]int64, 0, len(group))
+		for _, tx := range group {
+			if int(tx.Index) >= len(block.Data.Txs) || int(tx.Index) >= len(results) {
+				return nil, fmt.Errorf("no tx %d at height %d", tx.Index, height)
+			}
+			indices = append(indices, int64(tx.Index))
+		}
+		if len(indices) == 1 {
+			tx := group[0]
+			// XXX: overflow on 32-bit machines
+			tx.Proof = block.Data.Txs.Proof(int(tx.Index))
+			tx.ResultProof = results.ProveResult(int(tx.Index))
+			continue
+		}
+
+		sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+		proof, err := block.Data.Txs.MultiProof(indices)
+		if err != nil {
+			return nil, err
+		}
+		resultProof, err := results.ProveResults(indices)
+		if err != nil {
+			return nil, err
+		}
+		proofs = append(proofs, &ctypes.ResultTxsProof{
+			Height:      height,
+			Proof:       proof,
+			ResultProof: resultProof,
+		})
+	}
+	return proofs, nil
+}
+
+// txProofs returns a proof of the tx at the given index being included in the
+// block (against DataHash of header H) and a proof of its result being
+// included in the block results (against LastResultsHash of header H+1).
+func txProofs(height int64, index uint32) (types.TxProof, merkle.Proof, error) {
+	block, results, err := loadTxsAndResults(height)
+	if err != nil {
+		return types.TxProof{}, merkle.Proof{}, err
+	}
+	if int(index) >= len(results) {
+		return types.TxProof{}, merkle.Proof{}, fmt.Errorf("no result for tx %d at height %d", index, height)
+	}
+
+	// XXX: overflow on 32-bit machines
+	return block.Data.Txs.Proof(int(index)), results.ProveResult(int(index)), nil
+}
+
+// loadTxsAndResults loads the block at the given height and its tx results.
+func loadTxsAndResults(height int64) (*types.Block, types.ABCIResults, error) {
+	block := env.BlockStore.LoadBlock(height)
+	if block == nil {
+		return nil, nil, fmt.Errorf("block at height %d not found", height)
+	}
+
+	abciResponses, err := sm.LoadABCIResponses(env.StateDB, height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return block, types.NewResults(abciResponses.FinalizeBlock.TxResults), nil
 }
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/mydexchain/tm-db"
+
+	abci "github.com/mydexchain/tendermint0/abci/types"
+	tmstate "github.com/mydexchain/tendermint0/proto/tendermint/state"
+	ctypes "github.com/mydexchain/tendermint0/rpc/core/types"
+	sm "github.com/mydexchain/tendermint0/state"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+func TestProveTxs(t *testing.T) {
+	txResults := []*abci.ResponseDeliverTx{
+		{Code: 0, Data: []byte{0x01}, Log: "ok"},
+		{Code: 0, Data: []byte{0x02}, Log: "ok"},
+		{Code: 1, Log: "not ok"},
+		{Code: 0, Data: []byte{0x04}},
+	}
+	txs := types.Txs{types.Tx("a"), types.Tx("b"), types.Tx("c"), types.Tx("d")}
+
+	env = &Environment{}
+	env.StateDB = dbm.NewMemDB()
+	blocks := make(map[int64]*types.Block)
+	for _, height := range []int64{99, 100} {
+		sm.SaveABCIResponses(env.StateDB, height, &tmstate.ABCIResponses{
+			FinalizeBlock: &abci.ResponseFinalizeBlock{TxResults: txResults},
+		})
+		blocks[height] = &types.Block{Header: types.Header{Height: height}, Data: types.Data{Txs: txs}}
+	}
+	env.BlockStore = blocksStore{mockBlockStore: mockBlockStore{height: 100}, blocks: blocks}
+
+	// sorted by descending height and index
+	resultTxs := []*ctypes.ResultTx{
+		{Height: 100, Index: 3, Tx: txs[3], TxResult: *txResults[3]},
+		{Height: 100, Index: 1, Tx: txs[1], TxResult: *txResults[1]},
+		{Height: 99, Index: 2, Tx: txs[2], TxResult: *txResults[2]},
+	}
+	proofs, err := proveTxs(resultTxs)
+	require.NoError(t, err)
+
+	// the tx alone at height 99 has its own proofs
+	results := types.NewResults(txResults)
+	tx := resultTxs[2]
+	assert.NoError(t, tx.Proof.Validate(txs.Hash()))
+	assert.EqualValues(t, tx.Index, tx.Proof.Proof.Index)
+	assert.NoError(t, types.VerifyResult(results.Hash(), &tx.TxResult, tx.ResultProof))
+
+	// the txs at height 100 are only proven together
+	assert.Empty(t, resultTxs[0].Proof.Data)
+	assert.Empty(t, resultTxs[1].Proof.Data)
+	require.Len(t, proofs, 1)
+	proof := proofs[0]
+	assert.EqualValues(t, 100, proof.Height)
+	assert.Equal(t, []int64{1, 3}, proof.Proof.Proof.Indices)
+	assert.Equal(t, types.Txs{txs[1], txs[3]}, proof.Proof.Data)
+	assert.NoError(t, proof.Proof.Validate(txs.Hash()))
+	assert.NoError(t, types.VerifyResults(results.Hash(),
+		[]*abci.ResponseDeliverTx{txResults[1], txResults[3]}, proof.ResultProof))
+}
+
+type blocksStore struct {
+	mockBlockStore
+	blocks map[int64]*types.Block
+}
+
+func (store blocksStore) LoadBlock(height int64) *types.Block { return store.blocks[height] }
@@ -6,6 +6,7 @@ import (
 
 	abci "github.com/mydexchain/tendermint0/abci/types"
 	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/merkle"
 	"github.com/mydexchain/tendermint0/libs/bytes"
 	"github.com/mydexchain/tendermint0/p2p"
 	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
@@ -72,6 +73,13 @@ type SyncInfo struct {
 	EarliestBlockTime   time.Time      `json:"earliest_block_time"`
 
 	CatchingUp bool `json:"catching_up"`
+
+	// Progress of the state sync snapshot restoration, if any.
+	SnapshotHeight         uint64        `json:"snapshot_height,omitempty"`
+	SnapshotChunksApplied  uint32        `json:"snapshot_chunks_applied,omitempty"`
+	SnapshotChunksTotal    uint32        `json:"snapshot_chunks_total,omitempty"`
+	SnapshotBytesPerSecond int64         `json:"snapshot_bytes_per_second,omitempty"`
+	SnapshotETA            time.Duration `json:"snapshot_eta,omitempty"`
 }
 
 // Info about the node's validator
@@ -181,18 +189,30 @@ type ResultCheckTx struct {
 
 // Result of querying for a tx
 type ResultTx struct {
-	Hash     bytes.HexBytes         `json:"hash"`
-	Height   int64                  `json:"height"`
-	Index    uint32                 `json:"index"`
-	TxResult abci.ResponseDeliverTx `json:"tx_result"`
-	Tx       types.Tx               `json:"tx"`
-	Proof    types.TxProof          `json:"proof,omitempty"`
+	Hash        bytes.HexBytes         `json:"hash"`
+	Height      int64                  `json:"height"`
+	Index       uint32                 `json:"index"`
+	TxResult    abci.ResponseDeliverTx `json:"tx_result"`
+	Tx          types.Tx               `json:"tx"`
+	Proof       types.TxProof          `json:"proof,omitempty"`
+	ResultProof merkle.Proof           `json:"result_proof,omitempty"`
 }
 
 // Result of searching for txs
 type ResultTxSearch struct {
 	Txs        []*ResultTx `json:"txs"`
 	TotalCount int         `json:"total_count"`
+	// Proofs of the txs found at the same height, if there are several. The
+	// txs found alone at their height carry their own proofs instead.
+	Proofs []*ResultTxsProof `json:"proofs,omitempty"`
+}
+
+// Proof of several txs found at the same height (against DataHash of header
+// H) and of their results (against LastResultsHash of header H+1)
+type ResultTxsProof struct {
+	Height      int64             `json:"height"`
+	Proof       types.TxsProof    `json:"proof"`
+	ResultProof merkle.MultiProof `json:"result_proof"`
 }
 
 // List of mempool txs
@@ -218,6 +238,28 @@ type ResultBroadcastEvidence struct {
 	Hash []byte `json:"hash"`
 }
 
+// List of pending evidence
+type ResultPendingEvidence struct {
+	Count    int              `json:"n_evidence"`
+	Total    int              `json:"total"`
+	Evidence []types.Evidence `json:"evidence"`
+}
+
+// Evidence and its status in the evidence pool
+type ResultEvidence struct {
+	Evidence types.Evidence `json:"evidence"`
+	Status   string         `json:"status"`
+	// Height of the block the evidence was committed in, 0 if it isn't
+	// committed.
+	Height int64 `json:"height"`
+}
+
+// Evidence committed in a block
+type ResultCommittedEvidence struct {
+	Height   int64            `json:"height"`
+	Evidence []types.Evidence `json:"evidence"`
+}
+
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
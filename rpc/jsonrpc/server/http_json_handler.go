@@ -7,7 +7,9 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"runtime/debug"
 	"sort"
+	"sync"
 
 	tmjson "github.com/mydexchain/tendermint0/libs/json"
 	"github.com/mydexchain/tendermint0/libs/log"
@@ -19,7 +21,7 @@ import (
 ///////////////////////////////////////////////////////////////////////////////
 
 // jsonrpc calls grab the given method's function info and runs reflect.Call
-func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.HandlerFunc {
+func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger, cfg *handlerConfig) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		b, err := ioutil.ReadAll(r.Body)
 		if err != nil {
@@ -42,10 +44,7 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 		}
 
 		// first try to unmarshal the incoming request as an array of RPC requests
-		var (
-			requests  []types.RPCRequest
-			responses []types.RPCResponse
-		)
+		var requests []types.RPCRequest
 		if err := json.Unmarshal(b, &requests); err != nil {
 			// next, try to unmarshal as a single request
 			var request types.RPCRequest
@@ -62,58 +61,120 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 			requests = []types.RPCRequest{request}
 		}
 
-		for _, request := range requests {
-			request := request
-
-			// A Notification is a Request object without an "id" member.
-			// The Server MUST NOT reply to a Notification, including those that are within a batch request.
-			if request.ID == nil {
-				logger.Debug(
-					"HTTPJSONRPC received a notification, skipping... (please send a non-empty ID if you want to call a method)",
-					"req", request,
-				)
-				continue
-			}
-			if len(r.URL.Path) > 1 {
-				responses = append(
-					responses,
-					types.RPCInvalidRequestError(request.ID, fmt.Errorf("path %s is invalid", r.URL.Path)),
-				)
-				continue
-			}
-			rpcFunc, ok := funcMap[request.Method]
-			if !ok || rpcFunc.ws {
-				responses = append(responses, types.RPCMethodNotFoundError(request.ID))
-				continue
+		// Evaluate the requests, concurrently if the batch is large enough and
+		// the handler is configured to do so. Either way, a panic only fails
+		// the request causing it. Responses keep the order of the requests; nil
+		// entries belong to notifications.
+		results := make([]*lazyResponse, len(requests))
+		if cfg.maxBatchConcurrency < 2 || len(requests) < 2 {
+			for i := range requests {
+				results[i] = evalJSONRPCRequestSafe(r, funcMap, &requests[i], logger)
 			}
-			ctx := &types.Context{JSONReq: &request, HTTPReq: r}
-			args := []reflect.Value{reflect.ValueOf(ctx)}
-			if len(request.Params) > 0 {
-				fnArgs, err := jsonParamsToArgs(rpcFunc, request.Params)
-				if err != nil {
-					responses = append(
-						responses,
-						types.RPCInvalidParamsError(request.ID, fmt.Errorf("error converting json params to arguments: %w", err)),
-					)
-					continue
-				}
-				args = append(args, fnArgs...)
+		} else {
+			var (
+				wg  sync.WaitGroup
+				sem = make(chan struct{}, cfg.maxBatchConcurrency)
+			)
+			for i := range requests {
+				wg.Add(1)
+				sem <- struct{}{}
+				go func(i int) {
+					defer func() {
+						<-sem
+						wg.Done()
+					}()
+					results[i] = evalJSONRPCRequestSafe(r, funcMap, &requests[i], logger)
+				}(i)
 			}
-			returns := rpcFunc.f.Call(args)
-			logger.Info("HTTPJSONRPC", "method", request.Method, "args", args, "returns", returns)
-			result, err := unreflectResult(returns)
-			if err != nil {
-				responses = append(responses, types.RPCInternalError(request.ID, err))
-				continue
+			wg.Wait()
+		}
+
+		responses := make([]lazyResponse, 0, len(results))
+		for _, res := range results {
+			if res != nil {
+				responses = append(responses, *res)
 			}
-			responses = append(responses, types.NewRPCSuccessResponse(request.ID, result))
 		}
 		if len(responses) > 0 {
-			WriteRPCResponseHTTP(w, responses...)
+			writeLazyResponsesHTTP(w, logger, responses...)
 		}
 	}
 }
 
+// evalJSONRPCRequest calls the function requested by request and returns the
+// response to send back, or nil if request is a notification.
+func evalJSONRPCRequest(
+	r *http.Request,
+	funcMap map[string]*RPCFunc,
+	request *types.RPCRequest,
+	logger log.Logger,
+) *lazyResponse {
+	// A Notification is a Request object without an "id" member.
+	// The Server MUST NOT reply to a Notification, including those that are within a batch request.
+	if request.ID == nil {
+		logger.Debug(
+			"HTTPJSONRPC received a notification, skipping... (please send a non-empty ID if you want to call a method)",
+			"req", request,
+		)
+		return nil
+	}
+	if len(r.URL.Path) > 1 {
+		return &lazyResponse{
+			RPCResponse: types.RPCInvalidRequestError(request.ID, fmt.Errorf("path %s is invalid", r.URL.Path)),
+		}
+	}
+	rpcFunc, ok := funcMap[request.Method]
+	if !ok || rpcFunc.ws {
+		return &lazyResponse{RPCResponse: types.RPCMethodNotFoundError(request.ID)}
+	}
+	ctx := &types.Context{JSONReq: request, HTTPReq: r}
+	args := []reflect.Value{reflect.ValueOf(ctx)}
+	if len(request.Params) > 0 {
+		fnArgs, err := jsonParamsToArgs(rpcFunc, request.Params)
+		if err != nil {
+			return &lazyResponse{
+				RPCResponse: types.RPCInvalidParamsError(
+					request.ID,
+					fmt.Errorf("error converting json params to arguments: %w", err),
+				),
+			}
+		}
+		args = append(args, fnArgs...)
+	}
+	returns := rpcFunc.f.Call(args)
+	logger.Info("HTTPJSONRPC", "method", request.Method, "args", args, "returns", returns)
+	result, err := unreflectResult(returns)
+	if err != nil {
+		return &lazyResponse{RPCResponse: types.RPCInternalError(request.ID, err)}
+	}
+	return &lazyResponse{
+		RPCResponse: types.NewRPCSuccessResponse(request.ID, nil),
+		result:      result,
+	}
+}
+
+// evalJSONRPCRequestSafe is like evalJSONRPCRequest, but turns a panic in the
+// called function into an internal error response for the request, rather than
+// failing the whole batch as RecoverAndLogHandler would. It must also be used
+// whenever the request is evaluated outside of the goroutine serving the HTTP
+// request, where RecoverAndLogHandler cannot catch the panic.
+func evalJSONRPCRequestSafe(
+	r *http.Request,
+	funcMap map[string]*RPCFunc,
+	request *types.RPCRequest,
+	logger log.Logger,
+) (res *lazyResponse) {
+	defer func() {
+		if e := recover(); e != nil {
+			logger.Error("Panic in RPC HTTP handler", "err", e, "stack", string(debug.Stack()))
+			res = &lazyResponse{
+				RPCResponse: types.RPCInternalError(request.ID, fmt.Errorf("panic: %v", e)),
+			}
+		}
+	}()
+	return evalJSONRPCRequest(r, funcMap, request, logger)
+}
+
 func handleInvalidJSONRPCPaths(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Since the pattern "/" matches all paths not matched by other registered patterns,
@@ -3,11 +3,13 @@ package server
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -216,6 +218,58 @@ func TestRPCNotificationInBatch(t *testing.T) {
 	}
 }
 
+func TestRPCBatchConcurrency(t *testing.T) {
+	// a panic fails the request causing it, whether the batch is evaluated
+	// concurrently or not
+	for _, concurrency := range []int{0, 3} {
+		t.Run(fmt.Sprintf("concurrency=%d", concurrency), func(t *testing.T) {
+			testRPCBatch(t, concurrency)
+		})
+	}
+}
+
+func testRPCBatch(t *testing.T, concurrency int) {
+	funcMap := map[string]*RPCFunc{
+		"echo": NewRPCFunc(func(ctx *types.Context, i int32) (int32, error) {
+			time.Sleep(time.Duration(10-i) * time.Millisecond)
+			return i, nil
+		}, "i"),
+		"panic": NewRPCFunc(func(ctx *types.Context) (int, error) { panic("boom") }, ""),
+	}
+	mux := http.NewServeMux()
+	RegisterRPCFuncs(mux, funcMap, log.TestingLogger(), MaxBatchConcurrency(concurrency))
+
+	payload := `[
+		{"jsonrpc":"2.0","method":"echo","id":0,"params":{"i":0}},
+		{"jsonrpc":"2.0","method":"echo","id":1,"params":{"i":1}},
+		{"jsonrpc":"2.0","method":"panic","id":2},
+		{"jsonrpc":"2.0","method":"echo","params":{"i":3}},
+		{"jsonrpc":"2.0","method":"echo","id":4,"params":{"i":4}}
+	]`
+	req, _ := http.NewRequest("POST", "http://localhost/", strings.NewReader(payload))
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	res := rec.Result()
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var responses []types.RPCResponse
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&responses))
+
+	// responses keep the order of the requests, without the notification
+	require.Len(t, responses, 4)
+	for i, id := range []int{0, 1, 2, 4} {
+		assert.Equal(t, types.JSONRPCIntID(id), responses[i].ID)
+		if id == 2 {
+			require.NotNil(t, responses[i].Error)
+			assert.Contains(t, responses[i].Error.Data, "boom")
+			continue
+		}
+		require.Nil(t, responses[i].Error)
+		assert.Equal(t, fmt.Sprintf("%d", id), string(responses[i].Result))
+	}
+}
+
 func TestUnknownRPCPath(t *testing.T) {
 	mux := testMux()
 	req, _ := http.NewRequest("GET", "http://localhost/unknownrpcpath", nil)
@@ -3,18 +3,24 @@ package server
 
 import (
 	"bufio"
+	"compress/gzip"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"os"
 	"runtime/debug"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/net/netutil"
 
+	tmjson "github.com/mydexchain/tendermint0/libs/json"
 	"github.com/mydexchain/tendermint0/libs/log"
 	types "github.com/mydexchain/tendermint0/rpc/jsonrpc/types"
 )
@@ -32,6 +38,9 @@ type Config struct {
 	MaxBodyBytes int64
 	// mirrors http.Server#MaxHeaderBytes
 	MaxHeaderBytes int
+	// CompressResponses enables gzip compression of the responses sent to
+	// clients which accept it (see the Accept-Encoding request header).
+	CompressResponses bool
 }
 
 // DefaultConfig returns a default configuration.
@@ -42,18 +51,20 @@ func DefaultConfig() *Config {
 		WriteTimeout:       10 * time.Second,
 		MaxBodyBytes:       int64(1000000), // 1MB
 		MaxHeaderBytes:     1 << 20,        // same as the net/http default
+		CompressResponses:  true,
 	}
 }
 
 // Serve creates a http.Server and calls Serve with the given listener. It
 // wraps handler with RecoverAndLogHandler and a handler, which limits the max
-// body size to config.MaxBodyBytes.
+// body size to config.MaxBodyBytes. If config.CompressResponses is set,
+// responses are gzipped for clients which accept it.
 //
 // NOTE: This function blocks - you may want to call it in a go-routine.
 func Serve(listener net.Listener, handler http.Handler, logger log.Logger, config *Config) error {
 	logger.Info(fmt.Sprintf("Starting RPC HTTP server on %s", listener.Addr()))
 	s := &http.Server{
-		Handler:        RecoverAndLogHandler(maxBytesHandler{h: handler, n: config.MaxBodyBytes}, logger),
+		Handler:        RecoverAndLogHandler(wrapHandler(handler, config), logger),
 		ReadTimeout:    config.ReadTimeout,
 		WriteTimeout:   config.WriteTimeout,
 		MaxHeaderBytes: config.MaxHeaderBytes,
@@ -65,7 +76,9 @@ func Serve(listener net.Listener, handler http.Handler, logger log.Logger, confi
 
 // Serve creates a http.Server and calls ServeTLS with the given listener,
 // certFile and keyFile. It wraps handler with RecoverAndLogHandler and a
-// handler, which limits the max body size to config.MaxBodyBytes.
+// handler, which limits the max body size to config.MaxBodyBytes. If
+// config.CompressResponses is set, responses are gzipped for clients which
+// accept it.
 //
 // NOTE: This function blocks - you may want to call it in a go-routine.
 func ServeTLS(
@@ -78,7 +91,7 @@ func ServeTLS(
 	logger.Info(fmt.Sprintf("Starting RPC HTTPS server on %s (cert: %q, key: %q)",
 		listener.Addr(), certFile, keyFile))
 	s := &http.Server{
-		Handler:        RecoverAndLogHandler(maxBytesHandler{h: handler, n: config.MaxBodyBytes}, logger),
+		Handler:        RecoverAndLogHandler(wrapHandler(handler, config), logger),
 		ReadTimeout:    config.ReadTimeout,
 		WriteTimeout:   config.WriteTimeout,
 		MaxHeaderBytes: config.MaxHeaderBytes,
@@ -109,6 +122,98 @@ func WriteRPCResponseHTTPError(
 	}
 }
 
+// writeLazyResponsesHTTP writes res to w, as a JSON array if there is more
+// than one response. The results are encoded twice: first without being kept,
+// to check they can be encoded as a result which can't must be replaced by an
+// internal error before the status is sent, then into the response body
+// through a small buffer, so that the response is never held in memory.
+func writeLazyResponsesHTTP(w http.ResponseWriter, logger log.Logger, res ...lazyResponse) {
+	res = append([]lazyResponse(nil), res...)
+	for i, r := range res {
+		if err := r.writeJSON(ioutil.Discard); err != nil {
+			logger.Error("Failed to encode RPC response", "id", r.ID, "err", err)
+			res[i] = lazyResponse{RPCResponse: types.RPCInternalError(r.ID, err)}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	bw := bufio.NewWriterSize(w, lazyResponseBufferSize)
+	err := writeLazyResponses(bw, res)
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil {
+		logger.Error("Failed to write RPC response", "err", err)
+	}
+}
+
+// lazyResponseBufferSize is the size of the buffer the responses are written
+// to w through.
+const lazyResponseBufferSize = 64 * 1024
+
+func writeLazyResponses(w *bufio.Writer, res []lazyResponse) error {
+	if len(res) != 1 {
+		if err := w.WriteByte('['); err != nil {
+			return err
+		}
+	}
+	for i, r := range res {
+		if i > 0 {
+			if err := w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if err := r.writeJSON(w); err != nil {
+			return err
+		}
+	}
+	if len(res) != 1 {
+		return w.WriteByte(']')
+	}
+	return nil
+}
+
+// lazyResponse is an RPCResponse whose result is only encoded while it is
+// written out. The Result field of the embedded RPCResponse is ignored if
+// result is set.
+type lazyResponse struct {
+	types.RPCResponse
+	result interface{}
+}
+
+func (r lazyResponse) writeJSON(w io.Writer) error {
+	if r.result == nil {
+		bz, err := json.Marshal(r.RPCResponse)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(bz)
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, `{"jsonrpc":%q,`, r.JSONRPC); err != nil {
+		return err
+	}
+	if r.ID != nil {
+		id, err := json.Marshal(r.ID)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, `"id":%s,`, id); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(w, `"result":`); err != nil {
+		return err
+	}
+	if err := tmjson.Encode(w, r.result); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "}")
+	return err
+}
+
 // WriteRPCResponseHTTP marshals res as JSON and writes it to w.
 //
 // Panics if it can't Marshal res or write to w.
@@ -224,6 +329,22 @@ func (w *responseWriterWrapper) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	return w.ResponseWriter.(http.Hijacker).Hijack()
 }
 
+// implements http.Flusher
+func (w *responseWriterWrapper) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// wrapHandler wraps handler with the handlers enabled by config.
+func wrapHandler(handler http.Handler, config *Config) http.Handler {
+	handler = maxBytesHandler{h: handler, n: config.MaxBodyBytes}
+	if config.CompressResponses {
+		handler = gzipHandler{h: handler}
+	}
+	return handler
+}
+
 type maxBytesHandler struct {
 	h http.Handler
 	n int64
@@ -234,6 +355,104 @@ func (h maxBytesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	h.h.ServeHTTP(w, r)
 }
 
+// gzipHandler gzips the responses of h for clients which accept it. Websocket
+// upgrades are passed through untouched.
+type gzipHandler struct {
+	h http.Handler
+}
+
+func (h gzipHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Upgrade") != "" || !acceptsGzip(r.Header.Get("Accept-Encoding")) {
+		h.h.ServeHTTP(w, r)
+		return
+	}
+
+	gw := &gzipResponseWriter{ResponseWriter: w}
+	defer gw.Close()
+	h.h.ServeHTTP(gw, r)
+}
+
+// acceptsGzip reports whether the given Accept-Encoding header value allows a
+// gzip-encoded response.
+func acceptsGzip(acceptEncoding string) bool {
+	for _, coding := range strings.Split(acceptEncoding, ",") {
+		parts := strings.Split(coding, ";")
+		if strings.TrimSpace(parts[0]) != "gzip" {
+			continue
+		}
+		for _, param := range parts[1:] {
+			kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+			if len(kv) == 2 && kv[0] == "q" {
+				if q, err := strconv.ParseFloat(kv[1], 64); err == nil && q == 0 {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	return false
+}
+
+var gzipWriterPool = sync.Pool{
+	New: func() interface{} { return gzip.NewWriter(ioutil.Discard) },
+}
+
+// gzipResponseWriter compresses everything written to it. The gzip stream and
+// the Content-Encoding header are only set up once the response is started,
+// so that a handler which never writes leaves the response untouched.
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	gz          *gzip.Writer
+	wroteHeader bool
+}
+
+func (w *gzipResponseWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		h := w.Header()
+		h.Del("Content-Length")
+		h.Set("Content-Encoding", "gzip")
+		h.Add("Vary", "Accept-Encoding")
+		w.gz = gzipWriterPool.Get().(*gzip.Writer)
+		w.gz.Reset(w.ResponseWriter)
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *gzipResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.gz.Write(b)
+}
+
+// Flush implements http.Flusher by sending the data compressed so far.
+func (w *gzipResponseWriter) Flush() {
+	if w.gz != nil {
+		w.gz.Flush() // nolint: errcheck // the error is returned by the next Write
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Close flushes the remaining compressed data and returns the gzip writer to
+// the pool.
+func (w *gzipResponseWriter) Close() error {
+	if w.gz == nil {
+		return nil
+	}
+	err := w.gz.Close()
+	gzipWriterPool.Put(w.gz)
+	w.gz = nil
+	return err
+}
+
+// implements http.Hijacker
+func (w *gzipResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return w.ResponseWriter.(http.Hijacker).Hijack()
+}
+
 // Listen starts a new net.Listener on the given address.
 // It returns an error if the address is invalid or the call to Listen() fails.
 func Listen(addr string, config *Config) (listener net.Listener, err error) {
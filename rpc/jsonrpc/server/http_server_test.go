@@ -1,7 +1,10 @@
 package server
 
 import (
+	"bytes"
+	"compress/gzip"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -151,6 +154,117 @@ func TestWriteRPCResponseHTTP(t *testing.T) {
 ]`, string(body))
 }
 
+func TestWriteLazyResponsesHTTP(t *testing.T) {
+	id := types.JSONRPCIntID(-1)
+	hello := lazyResponse{RPCResponse: types.NewRPCSuccessResponse(id, nil), result: &sampleResult{"hello"}}
+	fail := lazyResponse{RPCResponse: types.RPCInternalError(id, errors.New("foo"))}
+
+	// one response
+	w := httptest.NewRecorder()
+	writeLazyResponsesHTTP(w, log.TestingLogger(), hello)
+	resp := w.Result()
+	body, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `{"jsonrpc":"2.0","id":-1,"result":{"value":"hello"}}`, string(body))
+
+	// multiple responses
+	w = httptest.NewRecorder()
+	writeLazyResponsesHTTP(w, log.TestingLogger(), hello, fail)
+	resp = w.Result()
+	body, err = ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t,
+		`[{"jsonrpc":"2.0","id":-1,"result":{"value":"hello"}},`+
+			`{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error","data":"foo"}}]`,
+		string(body))
+
+	// a result failing to encode is replaced by an error
+	bad := lazyResponse{RPCResponse: types.NewRPCSuccessResponse(id, nil), result: make(chan int)}
+	w = httptest.NewRecorder()
+	writeLazyResponsesHTTP(w, log.TestingLogger(), hello, bad)
+	resp = w.Result()
+	var responses []types.RPCResponse
+	err = json.NewDecoder(resp.Body).Decode(&responses)
+	_ = resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	require.Len(t, responses, 2)
+	assert.Nil(t, responses[0].Error)
+	require.NotNil(t, responses[1].Error)
+	assert.Equal(t, -32603, responses[1].Error.Code)
+}
+
+func TestCompressResponses(t *testing.T) {
+	config := DefaultConfig()
+	config.CompressResponses = true
+	handler := wrapHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "some body")
+	}), config)
+
+	testCases := []struct {
+		acceptEncoding string
+		gzipped        bool
+	}{
+		{"", false},
+		{"gzip", true},
+		{"deflate, gzip;q=0.8", true},
+		{"gzip;q=0", false},
+		{"br", false},
+	}
+	for _, tc := range testCases {
+		req := httptest.NewRequest("GET", "http://localhost/", nil)
+		if tc.acceptEncoding != "" {
+			req.Header.Set("Accept-Encoding", tc.acceptEncoding)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		resp := w.Result()
+
+		var body io.Reader = resp.Body
+		if tc.gzipped {
+			require.Equal(t, "gzip", resp.Header.Get("Content-Encoding"), tc.acceptEncoding)
+			gr, err := gzip.NewReader(resp.Body)
+			require.NoError(t, err)
+			body = gr
+		} else {
+			require.Empty(t, resp.Header.Get("Content-Encoding"), tc.acceptEncoding)
+		}
+		bz, err := ioutil.ReadAll(body)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, "some body", string(bz), tc.acceptEncoding)
+	}
+}
+
+func TestCompressResponsesFlush(t *testing.T) {
+	flushed := make(chan []byte, 1)
+	handler := wrapHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "some body")
+		w.(http.Flusher).Flush()
+		rec := w.(*gzipResponseWriter).ResponseWriter.(*httptest.ResponseRecorder)
+		flushed <- append([]byte(nil), rec.Body.Bytes()...)
+	}), DefaultConfig())
+
+	req := httptest.NewRequest("GET", "http://localhost/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	assert.True(t, w.Flushed)
+
+	// the data written before the flush can be decompressed
+	gr, err := gzip.NewReader(bytes.NewReader(<-flushed))
+	require.NoError(t, err)
+	bz := make([]byte, len("some body"))
+	_, err = io.ReadFull(gr, bz)
+	require.NoError(t, err)
+	assert.Equal(t, "some body", string(bz))
+}
+
 func TestWriteRPCResponseHTTPError(t *testing.T) {
 	w := httptest.NewRecorder()
 	WriteRPCResponseHTTPError(w,
@@ -172,3 +286,36 @@ func TestWriteRPCResponseHTTPError(t *testing.T) {
   }
 }`, string(body))
 }
+
+// chunkRecorder is a ResponseRecorder recording the size of the largest
+// write.
+type chunkRecorder struct {
+	*httptest.ResponseRecorder
+	writes   int
+	maxWrite int
+}
+
+func (r *chunkRecorder) Write(p []byte) (int, error) {
+	r.writes++
+	if len(p) > r.maxWrite {
+		r.maxWrite = len(p)
+	}
+	return r.ResponseRecorder.Write(p)
+}
+
+func TestWriteLazyResponsesHTTPStreams(t *testing.T) {
+	id := types.JSONRPCIntID(-1)
+	results := make([]sampleResult, lazyResponseBufferSize/4)
+	for i := range results {
+		results[i].Value = "hello"
+	}
+	large := lazyResponse{RPCResponse: types.NewRPCSuccessResponse(id, nil), result: results}
+
+	w := &chunkRecorder{ResponseRecorder: httptest.NewRecorder()}
+	writeLazyResponsesHTTP(w, log.TestingLogger(), large)
+	assert.Greater(t, w.writes, 1)
+	assert.LessOrEqual(t, w.maxWrite, lazyResponseBufferSize)
+	var response types.RPCResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Nil(t, response.Error)
+}
@@ -62,7 +62,10 @@ func makeHTTPHandler(rpcFunc *RPCFunc, logger log.Logger) func(http.ResponseWrit
 				types.RPCInternalError(dummyID, err))
 			return
 		}
-		WriteRPCResponseHTTP(w, types.NewRPCSuccessResponse(dummyID, result))
+		writeLazyResponsesHTTP(w, logger, lazyResponse{
+			RPCResponse: types.NewRPCSuccessResponse(dummyID, nil),
+			result:      result,
+		})
 	}
 }
 
@@ -0,0 +1,283 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// OpenAPI document generation
+///////////////////////////////////////////////////////////////////////////////
+
+// OpenAPI is an OpenAPI 3 document describing the URI/HTTP interface of a set
+// of RPC functions. Only the subset of the specification needed to describe
+// RPC functions is supported.
+type OpenAPI struct {
+	OpenAPI    string                      `json:"openapi"`
+	Info       OpenAPIInfo                 `json:"info"`
+	Paths      map[string]*OpenAPIPathItem `json:"paths"`
+	Components OpenAPIComponents           `json:"components"`
+}
+
+// OpenAPIInfo is the metadata of an OpenAPI document.
+type OpenAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// OpenAPIPathItem describes the operations available on a single path.
+type OpenAPIPathItem struct {
+	Get *OpenAPIOperation `json:"get,omitempty"`
+}
+
+// OpenAPIOperation describes a single RPC function.
+type OpenAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Parameters  []OpenAPIParameter         `json:"parameters,omitempty"`
+	Responses   map[string]OpenAPIResponse `json:"responses"`
+}
+
+// OpenAPIParameter describes a single argument of an RPC function.
+type OpenAPIParameter struct {
+	Name   string         `json:"name"`
+	In     string         `json:"in"`
+	Schema *OpenAPISchema `json:"schema"`
+}
+
+// OpenAPIResponse describes a response of an RPC function.
+type OpenAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]OpenAPIMediaType `json:"content,omitempty"`
+}
+
+// OpenAPIMediaType holds the schema of a response body.
+type OpenAPIMediaType struct {
+	Schema *OpenAPISchema `json:"schema"`
+}
+
+// OpenAPIComponents holds the schemas referenced from the rest of the
+// document.
+type OpenAPIComponents struct {
+	Schemas map[string]*OpenAPISchema `json:"schemas"`
+}
+
+// OpenAPISchema describes a JSON value.
+type OpenAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Items                *OpenAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*OpenAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *OpenAPISchema            `json:"additionalProperties,omitempty"`
+}
+
+// NewOpenAPI generates an OpenAPI document for the functions in funcMap. The
+// schemas of the parameters and results follow the encoding of libs/json,
+// which is what the RPC server uses on the wire. Websocket-only functions are
+// not part of the URI/HTTP interface and are left out.
+func NewOpenAPI(funcMap map[string]*RPCFunc, title, version string) *OpenAPI {
+	g := &openAPIGenerator{
+		schemas: make(map[string]*OpenAPISchema),
+		names:   make(map[reflect.Type]string),
+	}
+	doc := &OpenAPI{
+		OpenAPI:    "3.0.0",
+		Info:       OpenAPIInfo{Title: title, Version: version},
+		Paths:      make(map[string]*OpenAPIPathItem),
+		Components: OpenAPIComponents{Schemas: g.schemas},
+	}
+
+	// Iterate in a fixed order, so schema names are assigned deterministically.
+	funcNames := make([]string, 0, len(funcMap))
+	for name := range funcMap {
+		funcNames = append(funcNames, name)
+	}
+	sort.Strings(funcNames)
+
+	for _, name := range funcNames {
+		rpcFunc := funcMap[name]
+		if rpcFunc.ws {
+			continue
+		}
+		op := &OpenAPIOperation{
+			OperationID: name,
+			Responses: map[string]OpenAPIResponse{
+				"200": {
+					Description: "Success",
+					Content: map[string]OpenAPIMediaType{
+						"application/json": {Schema: g.responseSchema(rpcFunc.returns[0])},
+					},
+				},
+				"500": {
+					Description: "Error",
+					Content: map[string]OpenAPIMediaType{
+						"application/json": {Schema: g.responseSchema(nil)},
+					},
+				},
+			},
+		}
+		// skip types.Context
+		for i, argName := range rpcFunc.argNames {
+			op.Parameters = append(op.Parameters, OpenAPIParameter{
+				Name:   argName,
+				In:     "query",
+				Schema: g.schema(rpcFunc.args[i+1]),
+			})
+		}
+		doc.Paths["/"+name] = &OpenAPIPathItem{Get: op}
+	}
+
+	return doc
+}
+
+// OpenAPIHandler returns a handler serving doc as JSON.
+func OpenAPIHandler(doc *OpenAPI) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bz, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		w.Write(bz) // nolint: errcheck
+	}
+}
+
+type openAPIGenerator struct {
+	schemas map[string]*OpenAPISchema
+	names   map[reflect.Type]string
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf(new(json.Marshaler)).Elem()
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+// responseSchema returns the schema of a JSONRPC response whose result is of
+// type rt, or of an error response if rt is nil.
+func (g *openAPIGenerator) responseSchema(rt reflect.Type) *OpenAPISchema {
+	s := &OpenAPISchema{
+		Type: "object",
+		Properties: map[string]*OpenAPISchema{
+			"jsonrpc": {Type: "string"},
+			"id":      {Type: "integer"},
+		},
+	}
+	if rt == nil {
+		s.Properties["error"] = &OpenAPISchema{
+			Type: "object",
+			Properties: map[string]*OpenAPISchema{
+				"code":    {Type: "integer"},
+				"message": {Type: "string"},
+				"data":    {Type: "string"},
+			},
+		}
+		return s
+	}
+	s.Properties["result"] = g.schema(rt)
+	return s
+}
+
+// schema returns the schema of the libs/json encoding of values of type rt.
+// Named struct types are added to the components of the document and
+// referenced.
+func (g *openAPIGenerator) schema(rt reflect.Type) *OpenAPISchema {
+	for rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	switch {
+	case rt == timeType:
+		return &OpenAPISchema{Type: "string", Format: "date-time"}
+	case rt.Implements(jsonMarshalerType) || reflect.PtrTo(rt).Implements(jsonMarshalerType):
+		// The encoding is up to the type itself. Byte slices (e.g. HexBytes)
+		// are conventionally encoded as strings.
+		if (rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array) && rt.Elem().Kind() == reflect.Uint8 {
+			return &OpenAPISchema{Type: "string"}
+		}
+		return &OpenAPISchema{}
+	}
+
+	switch rt.Kind() {
+	case reflect.Interface:
+		// registered interface types are wrapped in a type/value envelope
+		return &OpenAPISchema{
+			Type: "object",
+			Properties: map[string]*OpenAPISchema{
+				"type":  {Type: "string"},
+				"value": {},
+			},
+		}
+	case reflect.Array, reflect.Slice:
+		if rt.Elem().Kind() == reflect.Uint8 {
+			return &OpenAPISchema{Type: "string", Format: "byte"}
+		}
+		return &OpenAPISchema{Type: "array", Items: g.schema(rt.Elem())}
+	case reflect.Map:
+		return &OpenAPISchema{Type: "object", AdditionalProperties: g.schema(rt.Elem())}
+	case reflect.Struct:
+		return g.structSchema(rt)
+	case reflect.Int64, reflect.Int, reflect.Uint64, reflect.Uint:
+		// 64-bit integers are encoded as strings
+		return &OpenAPISchema{Type: "string", Format: "int64"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &OpenAPISchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &OpenAPISchema{Type: "number"}
+	case reflect.Bool:
+		return &OpenAPISchema{Type: "boolean"}
+	case reflect.String:
+		return &OpenAPISchema{Type: "string"}
+	default:
+		return &OpenAPISchema{}
+	}
+}
+
+func (g *openAPIGenerator) structSchema(rt reflect.Type) *OpenAPISchema {
+	if rt.Name() == "" {
+		return g.structProperties(rt)
+	}
+
+	name, ok := g.names[rt]
+	if !ok {
+		// Different packages may use the same name (e.g. types.Header), so
+		// later types get a numeric suffix.
+		name = rt.String()
+		for i := 2; g.schemas[name] != nil; i++ {
+			name = rt.String() + "_" + strconv.Itoa(i)
+		}
+		g.names[rt] = name
+		// Reserve the name before descending, since types may be recursive.
+		g.schemas[name] = &OpenAPISchema{}
+		*g.schemas[name] = *g.structProperties(rt)
+	}
+	return &OpenAPISchema{Ref: "#/components/schemas/" + name}
+}
+
+// structProperties mirrors the struct encoding of libs/json: exported fields
+// are encoded under the name of their json tag (or field name), embedded
+// structs are not flattened.
+func (g *openAPIGenerator) structProperties(rt reflect.Type) *OpenAPISchema {
+	s := &OpenAPISchema{Type: "object", Properties: make(map[string]*OpenAPISchema)}
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.Name == "" || !unicode.IsUpper(rune(field.Name[0])) {
+			continue
+		}
+		name := field.Name
+		if tag := field.Tag.Get("json"); tag == "-" {
+			continue
+		} else if tagName := strings.Split(tag, ",")[0]; tagName != "" {
+			name = tagName
+		}
+		s.Properties[name] = g.schema(field.Type)
+	}
+	return s
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	types "github.com/mydexchain/tendermint0/rpc/jsonrpc/types"
+)
+
+type openAPIResult struct {
+	Height  int64     `json:"height"`
+	Round   int32     `json:"round"`
+	Data    []byte    `json:"data"`
+	Time    time.Time `json:"time"`
+	Next    *openAPIResult
+	private string
+}
+
+func TestNewOpenAPI(t *testing.T) {
+	funcMap := map[string]*RPCFunc{
+		"c": NewRPCFunc(func(ctx *types.Context, s string, i int64) (*openAPIResult, error) { return nil, nil }, "s,i"),
+		"subscribe": NewWSRPCFunc(func(ctx *types.Context, query string) (*openAPIResult, error) {
+			return nil, nil
+		}, "query"),
+	}
+	doc := NewOpenAPI(funcMap, "test", "0.0.0")
+
+	// websocket functions are not part of the document
+	require.Len(t, doc.Paths, 1)
+	op := doc.Paths["/c"].Get
+	require.NotNil(t, op)
+	assert.Equal(t, "c", op.OperationID)
+	assert.Equal(t, []OpenAPIParameter{
+		{Name: "s", In: "query", Schema: &OpenAPISchema{Type: "string"}},
+		{Name: "i", In: "query", Schema: &OpenAPISchema{Type: "string", Format: "int64"}},
+	}, op.Parameters)
+
+	result := op.Responses["200"].Content["application/json"].Schema.Properties["result"]
+	assert.Equal(t, "#/components/schemas/server.openAPIResult", result.Ref)
+	assert.Equal(t, &OpenAPISchema{
+		Type: "object",
+		Properties: map[string]*OpenAPISchema{
+			"height": {Type: "string", Format: "int64"},
+			"round":  {Type: "integer"},
+			"data":   {Type: "string", Format: "byte"},
+			"time":   {Type: "string", Format: "date-time"},
+			"Next":   {Ref: "#/components/schemas/server.openAPIResult"},
+		},
+	}, doc.Components.Schemas["server.openAPIResult"])
+}
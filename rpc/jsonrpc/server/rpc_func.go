@@ -13,14 +13,38 @@ import (
 // general jsonrpc and websocket handlers for all functions. "result" is the
 // interface on which the result objects are registered, and is popualted with
 // every RPCResponse
-func RegisterRPCFuncs(mux *http.ServeMux, funcMap map[string]*RPCFunc, logger log.Logger) {
+func RegisterRPCFuncs(
+	mux *http.ServeMux,
+	funcMap map[string]*RPCFunc,
+	logger log.Logger,
+	options ...func(*handlerConfig),
+) {
+	cfg := &handlerConfig{}
+	for _, option := range options {
+		option(cfg)
+	}
+
 	// HTTP endpoints
 	for funcName, rpcFunc := range funcMap {
 		mux.HandleFunc("/"+funcName, makeHTTPHandler(rpcFunc, logger))
 	}
 
 	// JSONRPC endpoints
-	mux.HandleFunc("/", handleInvalidJSONRPCPaths(makeJSONRPCHandler(funcMap, logger)))
+	mux.HandleFunc("/", handleInvalidJSONRPCPaths(makeJSONRPCHandler(funcMap, logger, cfg)))
+}
+
+// handlerConfig holds the optional settings of the HTTP and JSONRPC handlers.
+type handlerConfig struct {
+	maxBatchConcurrency int
+}
+
+// MaxBatchConcurrency sets the maximum number of entries of a JSONRPC batch
+// request that are evaluated concurrently. Values below 2 make batches be
+// evaluated sequentially, one entry after another.
+func MaxBatchConcurrency(n int) func(*handlerConfig) {
+	return func(cfg *handlerConfig) {
+		cfg.maxBatchConcurrency = n
+	}
 }
 
 ///////////////////////////////////////////////////////////////////////////////
@@ -47,6 +71,12 @@ func NewWSRPCFunc(f interface{}, args string) *RPCFunc {
 	return newRPCFunc(f, args, true)
 }
 
+// ArgNames returns the names of the function's arguments, as they are passed
+// in requests.
+func (f *RPCFunc) ArgNames() []string {
+	return f.argNames
+}
+
 func newRPCFunc(f interface{}, args string, ws bool) *RPCFunc {
 	var argNames []string
 	if args != "" {
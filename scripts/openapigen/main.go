@@ -0,0 +1,35 @@
+/*
+	openapigen writes the OpenAPI 3 document of the Tendermint RPC, derived
+	from the handlers in rpc/core, to a file.
+
+	Usage:
+			openapigen <path-to-json>
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	rpccore "github.com/mydexchain/tendermint0/rpc/core"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "missing arguments: Usage:openapigen <path-to-json>")
+		os.Exit(1)
+	}
+
+	bz, err := json.MarshalIndent(rpccore.OpenAPI(rpccore.AllRoutes()), "", "  ")
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal OpenAPI document: %v", err))
+	}
+	bz = append(bz, '\n')
+
+	if err := ioutil.WriteFile(os.Args[1], bz, 0644); err != nil {
+		panic(fmt.Errorf("failed to write OpenAPI document: %v", err))
+	}
+}
@@ -42,6 +42,15 @@ type BlockExecutor struct {
 	logger log.Logger
 
 	metrics *Metrics
+
+	// commits verified before the validation of their block
+	verifiedCommits *VerifiedCommits
+
+	// allow the experimental BLS12-381 validator keys
+	experimentalBLS bool
+
+	// takes snapshots of the committed app state, if any
+	snapshotter Snapshotter
 }
 
 type BlockExecutorOption func(executor *BlockExecutor)
@@ -52,6 +61,22 @@ func BlockExecutorWithMetrics(metrics *Metrics) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithExperimentalBLS allows blocks of chains whose consensus
+// params accept BLS12-381 validator keys.
+func BlockExecutorWithExperimentalBLS() BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.experimentalBLS = true
+	}
+}
+
+// BlockExecutorWithSnapshotter notifies the snapshotter of every committed
+// block, before the next block is executed.
+func BlockExecutorWithSnapshotter(snapshotter Snapshotter) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.snapshotter = snapshotter
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -70,6 +95,8 @@ func NewBlockExecutor(
 		evpool:   evpool,
 		logger:   logger,
 		metrics:  NopMetrics(),
+
+		verifiedCommits: NewVerifiedCommits(),
 	}
 
 	for _, option := range options {
@@ -83,6 +110,12 @@ func (blockExec *BlockExecutor) DB() dbm.DB {
 	return blockExec.db
 }
 
+// VerifiedCommits returns the commits verified ahead of the validation of
+// their block, which ValidateBlock doesn't verify again.
+func (blockExec *BlockExecutor) VerifiedCommits() *VerifiedCommits {
+	return blockExec.verifiedCommits
+}
+
 // SetEventBus - sets the event bus for publishing block related events.
 // If not called, it defaults to types.NopEventBus.
 func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher) {
@@ -90,14 +123,20 @@ func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher)
 }
 
 // CreateProposalBlock calls state.MakeBlock with evidence from the evpool
-// and txs from the mempool. The max bytes must be big enough to fit the commit.
-// Up to 1/10th of the block space is allcoated for maximum sized evidence.
-// The rest is given to txs, up to the max gas.
+// and txs chosen by the application. The max bytes must be big enough to fit
+// the commit. Up to 1/10th of the block space is allcoated for maximum sized
+// evidence. The rest is given to txs, up to the max gas.
+//
+// The txs reaped from the mempool are handed to the application via
+// PrepareProposal, along with the vote extensions of the last commit, and the
+// application returns the txs of the block. It may reorder, drop or add txs,
+// but their encoded size must not exceed the space left for them, nor the gas
+// they want the max gas.
 func (blockExec *BlockExecutor) CreateProposalBlock(
 	height int64,
-	state State, commit *types.Commit,
+	state State, commit *types.ExtendedCommit,
 	proposerAddr []byte,
-) (*types.Block, *types.PartSet) {
+) (*types.Block, *types.PartSet, error) {
 
 	maxBytes := state.ConsensusParams.Block.MaxBytes
 	maxGas := state.ConsensusParams.Block.MaxGas
@@ -108,7 +147,98 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 	maxDataBytes := types.MaxDataBytes(maxBytes, state.Validators.Size(), len(evidence))
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxDataBytes, maxGas)
 
-	return state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	// the mempool only counts the size of the txs themselves, without their
+	// encoding overhead
+	var dataBytes int64
+	for i := range txs {
+		dataBytes += types.ComputeProtoSizeForTxs(txs[i : i+1])
+		if dataBytes > maxDataBytes {
+			txs = txs[:i]
+			break
+		}
+	}
+
+	res, err := blockExec.proxyApp.PrepareProposalSync(abci.RequestPrepareProposal{
+		Height:          height,
+		LocalLastCommit: extendedCommitInfo(commit, state.LastValidators),
+		Txs:             txs.ToSliceOfBytes(),
+		MaxTxBytes:      maxDataBytes,
+		MaxGas:          maxGas,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txs = types.ToTxs(res.Txs)
+	if dataBytes := types.ComputeProtoSizeForTxs(txs); dataBytes > maxDataBytes {
+		return nil, nil, fmt.Errorf("txs returned by PrepareProposal exceed the max tx bytes (%d > %d)",
+			dataBytes, maxDataBytes)
+	}
+	if maxGas > -1 && res.GasWanted > maxGas {
+		return nil, nil, fmt.Errorf("txs returned by PrepareProposal exceed the max gas (%d > %d)",
+			res.GasWanted, maxGas)
+	}
+
+	// the signatures of the last commit are aggregated if the validators use
+	// BLS keys
+	lastCommit, err := types.AggregateCommit(commit.ToCommit(), state.LastValidators)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	block, parts := state.MakeBlock(height, txs, lastCommit, evidence, proposerAddr)
+	return block, parts, nil
+}
+
+// ProcessProposal asks the application whether block, proposed for the
+// current height of state, is acceptable. It returns false if the application
+// rejects the block.
+func (blockExec *BlockExecutor) ProcessProposal(block *types.Block, state State) (bool, error) {
+	commitInfo, byzVals := getBeginBlockValidatorInfo(block, blockExec.db, state.InitialHeight)
+
+	res, err := blockExec.proxyApp.ProcessProposalSync(abci.RequestProcessProposal{
+		Hash:                block.Hash(),
+		Header:              *block.Header.ToProto(),
+		Txs:                 block.Txs.ToSliceOfBytes(),
+		ProposedLastCommit:  commitInfo,
+		ByzantineValidators: byzVals,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.Result == abci.ResponseProcessProposal_ACCEPT, nil
+}
+
+// ExtendVote asks the application for the extension of vote, which must be
+// a precommit for a block.
+func (blockExec *BlockExecutor) ExtendVote(vote *types.Vote) ([]byte, error) {
+	res, err := blockExec.proxyApp.ExtendVoteSync(abci.RequestExtendVote{
+		Hash:   vote.BlockID.Hash,
+		Height: vote.Height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.VoteExtension, nil
+}
+
+// VerifyVoteExtension asks the application to verify the extension of vote,
+// a precommit for a block received from another validator. It returns an
+// error if the application rejects the extension.
+func (blockExec *BlockExecutor) VerifyVoteExtension(vote *types.Vote) error {
+	res, err := blockExec.proxyApp.VerifyVoteExtensionSync(abci.RequestVerifyVoteExtension{
+		Hash:             vote.BlockID.Hash,
+		ValidatorAddress: vote.ValidatorAddress,
+		Height:           vote.Height,
+		VoteExtension:    vote.Extension,
+	})
+	if err != nil {
+		return err
+	}
+	if res.Result != abci.ResponseVerifyVoteExtension_ACCEPT {
+		return fmt.Errorf("vote extension of %X rejected by the application (%v)", vote.ValidatorAddress, res.Result)
+	}
+	return nil
 }
 
 // ValidateBlock validates the given block against the given state.
@@ -116,7 +246,17 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 // Validation does not mutate state, but does require historical information from the stateDB,
 // ie. to verify evidence from a validator at an old height.
 func (blockExec *BlockExecutor) ValidateBlock(state State, block *types.Block) error {
-	return validateBlock(blockExec.evpool, blockExec.db, state, block)
+	return validateBlock(blockExec.evpool, blockExec.db, state, block, blockExec.verifiedCommits)
+}
+
+// checkExperimentalBLS returns an error if the params accept BLS12-381
+// validator keys but the executor doesn't allow them.
+func (blockExec *BlockExecutor) checkExperimentalBLS(params tmproto.ConsensusParams) error {
+	if !blockExec.experimentalBLS && types.IsValidPubkeyType(params.Validator, types.ABCIPubKeyTypeBls12381) {
+		return errors.New("BLS12-381 validator keys are experimental and must be enabled with " +
+			"consensus.experimental_bls")
+	}
+	return nil
 }
 
 // ApplyBlock validates the block against the state, executes it against the app,
@@ -129,6 +269,10 @@ func (blockExec *BlockExecutor) ApplyBlock(
 	state State, blockID types.BlockID, block *types.Block,
 ) (State, int64, error) {
 
+	if err := blockExec.checkExperimentalBLS(state.ConsensusParams); err != nil {
+		return state, 0, err
+	}
+
 	if err := blockExec.ValidateBlock(state, block); err != nil {
 		return state, 0, ErrInvalidBlock(err)
 	}
@@ -150,7 +294,7 @@ func (blockExec *BlockExecutor) ApplyBlock(
 	fail.Fail() // XXX
 
 	// validate the validator updates and convert to tendermint types
-	abciValUpdates := abciResponses.EndBlock.ValidatorUpdates
+	abciValUpdates := abciResponses.FinalizeBlock.ValidatorUpdates
 	err = validateValidatorUpdates(abciValUpdates, state.ConsensusParams.Validator)
 	if err != nil {
 		return state, 0, fmt.Errorf("error in validator updates: %v", err)
@@ -168,9 +312,12 @@ func (blockExec *BlockExecutor) ApplyBlock(
 	if err != nil {
 		return state, 0, fmt.Errorf("commit failed for application: %v", err)
 	}
+	if err := blockExec.checkExperimentalBLS(state.ConsensusParams); err != nil {
+		return state, 0, err
+	}
 
 	// Lock mempool, commit app state, update mempoool.
-	appHash, retainHeight, err := blockExec.Commit(state, block, abciResponses.DeliverTxs)
+	appHash, retainHeight, err := blockExec.Commit(state, block, abciResponses.FinalizeBlock.TxResults)
 	if err != nil {
 		return state, 0, fmt.Errorf("commit failed for application: %v", err)
 	}
@@ -186,6 +333,11 @@ func (blockExec *BlockExecutor) ApplyBlock(
 
 	fail.Fail() // XXX
 
+	// Snapshot the committed app state, if due, before the next block changes it.
+	if blockExec.snapshotter != nil {
+		blockExec.snapshotter.OnCommit(block.Height)
+	}
+
 	// Events are fired after everything else.
 	// NOTE: if we crash between Commit and Save, events wont be fired during replay
 	fireEvents(blockExec.logger, blockExec.eventBus, block, abciResponses, validatorUpdates)
@@ -248,7 +400,8 @@ func (blockExec *BlockExecutor) Commit(
 //---------------------------------------------------------
 // Helper functions for executing blocks and updating state
 
-// Executes block's transactions on proxyAppConn.
+// Executes block on proxyAppConn with a single FinalizeBlock call, or with
+// BeginBlock, DeliverTx and EndBlock if the app doesn't support FinalizeBlock.
 // Returns a list of transaction results and updates to the validator set
 func execBlockOnProxyApp(
 	logger log.Logger,
@@ -257,69 +410,108 @@ func execBlockOnProxyApp(
 	stateDB dbm.DB,
 	initialHeight int64,
 ) (*tmstate.ABCIResponses, error) {
-	var validTxs, invalidTxs = 0, 0
-
-	txIndex := 0
-	abciResponses := new(tmstate.ABCIResponses)
-	dtxs := make([]*abci.ResponseDeliverTx, len(block.Txs))
-	abciResponses.DeliverTxs = dtxs
-
-	// Execute transactions and get hash.
-	proxyCb := func(req *abci.Request, res *abci.Response) {
-		if r, ok := res.Value.(*abci.Response_DeliverTx); ok {
-			// TODO: make use of res.Log
-			// TODO: make use of this info
-			// Blocks may include invalid txs.
-			txRes := r.DeliverTx
-			if txRes.Code == abci.CodeTypeOK {
-				validTxs++
-			} else {
-				logger.Debug("Invalid tx", "code", txRes.Code, "log", txRes.Log)
-				invalidTxs++
-			}
-			abciResponses.DeliverTxs[txIndex] = txRes
-			txIndex++
-		}
-	}
-	proxyAppConn.SetResponseCallback(proxyCb)
-
 	commitInfo, byzVals := getBeginBlockValidatorInfo(block, stateDB, initialHeight)
 
-	// Begin block
-	var err error
 	pbh := block.Header.ToProto()
 	if pbh == nil {
 		return nil, errors.New("nil header")
 	}
-	abciResponses.BeginBlock, err = proxyAppConn.BeginBlockSync(abci.RequestBeginBlock{
+	req := abci.RequestFinalizeBlock{
 		Hash:                block.Hash(),
 		Header:              *pbh,
 		LastCommitInfo:      commitInfo,
 		ByzantineValidators: byzVals,
+		Txs:                 block.Txs.ToSliceOfBytes(),
+	}
+
+	finalizeBlock, err := proxyAppConn.SupportsFinalizeBlockSync()
+	if err != nil {
+		logger.Error("Error in proxyAppConn.Info", "err", err)
+		return nil, err
+	}
+	var res *abci.ResponseFinalizeBlock
+	if finalizeBlock {
+		res, err = proxyAppConn.FinalizeBlockSync(req)
+		if err != nil {
+			logger.Error("Error in proxyAppConn.FinalizeBlock", "err", err)
+			return nil, err
+		}
+	} else {
+		res, err = execBlockWithDeliverTxs(logger, proxyAppConn, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(res.TxResults) != len(block.Txs) {
+		return nil, fmt.Errorf("expected %d tx results from FinalizeBlock, got %d", len(block.Txs), len(res.TxResults))
+	}
+
+	// Blocks may include invalid txs.
+	var validTxs, invalidTxs = 0, 0
+	for i, txRes := range res.TxResults {
+		if txRes == nil {
+			return nil, fmt.Errorf("nil result from FinalizeBlock for tx #%d", i)
+		}
+		if txRes.Code == abci.CodeTypeOK {
+			validTxs++
+		} else {
+			logger.Debug("Invalid tx", "code", txRes.Code, "log", txRes.Log)
+			invalidTxs++
+		}
+	}
+
+	logger.Info("Executed block", "height", block.Height, "validTxs", validTxs, "invalidTxs", invalidTxs)
+
+	return &tmstate.ABCIResponses{FinalizeBlock: res}, nil
+}
+
+// execBlockWithDeliverTxs executes the block of req on an app which doesn't
+// support FinalizeBlock, with BeginBlock, DeliverTx for each tx and EndBlock,
+// and combines their responses as FinalizeBlock would return them.
+func execBlockWithDeliverTxs(
+	logger log.Logger,
+	proxyAppConn proxy.AppConnConsensus,
+	req abci.RequestFinalizeBlock,
+) (*abci.ResponseFinalizeBlock, error) {
+	txResults := make([]*abci.ResponseDeliverTx, 0, len(req.Txs))
+	proxyAppConn.SetResponseCallback(func(req *abci.Request, res *abci.Response) {
+		if r, ok := res.Value.(*abci.Response_DeliverTx); ok {
+			txResults = append(txResults, r.DeliverTx)
+		}
+	})
+
+	resBeginBlock, err := proxyAppConn.BeginBlockSync(abci.RequestBeginBlock{
+		Hash:                req.Hash,
+		Header:              req.Header,
+		LastCommitInfo:      req.LastCommitInfo,
+		ByzantineValidators: req.ByzantineValidators,
 	})
 	if err != nil {
 		logger.Error("Error in proxyAppConn.BeginBlock", "err", err)
 		return nil, err
 	}
 
-	// Run txs of block.
-	for _, tx := range block.Txs {
+	for _, tx := range req.Txs {
 		proxyAppConn.DeliverTxAsync(abci.RequestDeliverTx{Tx: tx})
 		if err := proxyAppConn.Error(); err != nil {
 			return nil, err
 		}
 	}
 
-	// End block.
-	abciResponses.EndBlock, err = proxyAppConn.EndBlockSync(abci.RequestEndBlock{Height: block.Height})
+	// EndBlock is answered after the txs, so all their results are in.
+	resEndBlock, err := proxyAppConn.EndBlockSync(abci.RequestEndBlock{Height: req.Header.Height})
 	if err != nil {
 		logger.Error("Error in proxyAppConn.EndBlock", "err", err)
 		return nil, err
 	}
 
-	logger.Info("Executed block", "height", block.Height, "validTxs", validTxs, "invalidTxs", invalidTxs)
-
-	return abciResponses, nil
+	return &abci.ResponseFinalizeBlock{
+		BeginBlockEvents:      resBeginBlock.Events,
+		TxResults:             txResults,
+		ValidatorUpdates:      resEndBlock.ValidatorUpdates,
+		ConsensusParamUpdates: resEndBlock.ConsensusParamUpdates,
+		EndBlockEvents:        resEndBlock.Events,
+	}, nil
 }
 
 func getBeginBlockValidatorInfo(block *types.Block, stateDB dbm.DB,
@@ -372,9 +564,41 @@ func getBeginBlockValidatorInfo(block *types.Block, stateDB dbm.DB,
 	}, byzVals
 }
 
+// extendedCommitInfo returns the ABCI representation of the extended commit
+// ec, signed by valSet. The commit for the initial height is empty, so are
+// its votes.
+func extendedCommitInfo(ec *types.ExtendedCommit, valSet *types.ValidatorSet) abci.ExtendedCommitInfo {
+	if len(ec.ExtendedSignatures) == 0 {
+		return abci.ExtendedCommitInfo{Round: ec.Round}
+	}
+
+	if len(ec.ExtendedSignatures) != valSet.Size() {
+		panic(fmt.Sprintf("extended commit size (%d) doesn't match valset length (%d) at height %d",
+			len(ec.ExtendedSignatures), valSet.Size(), ec.Height))
+	}
+
+	votes := make([]abci.ExtendedVoteInfo, len(ec.ExtendedSignatures))
+	for i, val := range valSet.Validators {
+		sig := ec.ExtendedSignatures[i]
+		votes[i] = abci.ExtendedVoteInfo{
+			Validator:       types.TM2PB.Validator(val),
+			SignedLastBlock: !sig.Absent(),
+			VoteExtension:   sig.Extension,
+		}
+	}
+
+	return abci.ExtendedCommitInfo{
+		Round: ec.Round,
+		Votes: votes,
+	}
+}
+
 func validateValidatorUpdates(abciUpdates []abci.ValidatorUpdate,
 	params tmproto.ValidatorParams) error {
 	for _, valUpdate := range abciUpdates {
+		if len(valUpdate.OperatorAddress) > types.MaxOperatorAddressSize {
+			return fmt.Errorf("operator address of %v is too big, max %d bytes", valUpdate, types.MaxOperatorAddressSize)
+		}
 		if valUpdate.GetPower() < 0 {
 			return fmt.Errorf("voting power can't be negative %v", valUpdate)
 		} else if valUpdate.GetPower() == 0 {
@@ -427,9 +651,9 @@ func updateState(
 	// Update the params with the latest abciResponses.
 	nextParams := state.ConsensusParams
 	lastHeightParamsChanged := state.LastHeightConsensusParamsChanged
-	if abciResponses.EndBlock.ConsensusParamUpdates != nil {
+	if abciResponses.FinalizeBlock.ConsensusParamUpdates != nil {
 		// NOTE: must not mutate s.ConsensusParams
-		nextParams = types.UpdateConsensusParams(state.ConsensusParams, abciResponses.EndBlock.ConsensusParamUpdates)
+		nextParams = types.UpdateConsensusParams(state.ConsensusParams, abciResponses.FinalizeBlock.ConsensusParamUpdates)
 		err := types.ValidateConsensusParams(nextParams)
 		if err != nil {
 			return state, fmt.Errorf("error updating consensus params: %v", err)
@@ -473,16 +697,24 @@ func fireEvents(
 	abciResponses *tmstate.ABCIResponses,
 	validatorUpdates []*types.Validator,
 ) {
+	resBeginBlock := abci.ResponseBeginBlock{
+		Events: abciResponses.FinalizeBlock.BeginBlockEvents,
+	}
+	resEndBlock := abci.ResponseEndBlock{
+		ValidatorUpdates:      abciResponses.FinalizeBlock.ValidatorUpdates,
+		ConsensusParamUpdates: abciResponses.FinalizeBlock.ConsensusParamUpdates,
+		Events:                abciResponses.FinalizeBlock.EndBlockEvents,
+	}
 	eventBus.PublishEventNewBlock(types.EventDataNewBlock{
 		Block:            block,
-		ResultBeginBlock: *abciResponses.BeginBlock,
-		ResultEndBlock:   *abciResponses.EndBlock,
+		ResultBeginBlock: resBeginBlock,
+		ResultEndBlock:   resEndBlock,
 	})
 	eventBus.PublishEventNewBlockHeader(types.EventDataNewBlockHeader{
 		Header:           block.Header,
 		NumTxs:           int64(len(block.Txs)),
-		ResultBeginBlock: *abciResponses.BeginBlock,
-		ResultEndBlock:   *abciResponses.EndBlock,
+		ResultBeginBlock: resBeginBlock,
+		ResultEndBlock:   resEndBlock,
 	})
 
 	if len(block.Evidence.Evidence) != 0 {
@@ -499,7 +731,7 @@ func fireEvents(
 			Height: block.Height,
 			Index:  uint32(i),
 			Tx:     tx,
-			Result: *(abciResponses.DeliverTxs[i]),
+			Result: *(abciResponses.FinalizeBlock.TxResults[i]),
 		}})
 	}
 
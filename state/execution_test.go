@@ -2,13 +2,17 @@ package state_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	dbm "github.com/mydexchain/tm-db"
+
 	abci "github.com/mydexchain/tendermint0/abci/types"
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
 	"github.com/mydexchain/tendermint0/crypto/ed25519"
 	cryptoenc "github.com/mydexchain/tendermint0/crypto/encoding"
 	"github.com/mydexchain/tendermint0/libs/log"
@@ -18,6 +22,7 @@ import (
 	sm "github.com/mydexchain/tendermint0/state"
 	"github.com/mydexchain/tendermint0/types"
 	tmtime "github.com/mydexchain/tendermint0/types/time"
+	"github.com/mydexchain/tendermint0/version"
 )
 
 var (
@@ -50,6 +55,79 @@ func TestApplyBlock(t *testing.T) {
 	assert.EqualValues(t, 1, state.Version.Consensus.App, "App version wasn't updated")
 }
 
+// snapshotter records the heights it is notified of.
+type snapshotter struct {
+	heights []int64
+}
+
+func (s *snapshotter) OnCommit(height int64) {
+	s.heights = append(s.heights, height)
+}
+
+// TestApplyBlockSnapshotter ensures the snapshotter is notified of committed blocks.
+func TestApplyBlockSnapshotter(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(&testApp{})
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, _ := makeState(1, 1)
+	snapshotter := &snapshotter{}
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{}, sm.BlockExecutorWithSnapshotter(snapshotter))
+
+	block := makeBlock(state, 1)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: block.MakePartSet(testPartSize).Header()}
+	_, _, err = blockExec.ApplyBlock(state, blockID, block)
+	require.Nil(t, err)
+	assert.Equal(t, []int64{1}, snapshotter.heights)
+}
+
+// TestApplyBlockFinalizeBlock ensures blocks are executed with FinalizeBlock
+// only on apps reporting an ABCI version which has it.
+func TestApplyBlockFinalizeBlock(t *testing.T) {
+	testCases := []struct {
+		abciVersion   string
+		finalizeBlock bool
+	}{
+		{"", false},
+		{"0.17.0", false},
+		{version.ABCIVersion, true},
+	}
+	for _, tc := range testCases {
+		app := &finalizeBlockApp{abciVersion: tc.abciVersion}
+		cc := proxy.NewLocalClientCreator(app)
+		proxyApp := proxy.NewAppConns(cc)
+		err := proxyApp.Start()
+		require.Nil(t, err)
+		defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+		state, stateDB, _ := makeState(1, 1)
+		blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+			mock.Mempool{}, sm.MockEvidencePool{})
+
+		block := makeBlock(state, 1)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: block.MakePartSet(testPartSize).Header()}
+		_, _, err = blockExec.ApplyBlock(state, blockID, block)
+		require.NoError(t, err, tc.abciVersion)
+
+		abciResponses, err := sm.LoadABCIResponses(stateDB, 1)
+		require.NoError(t, err)
+		require.Len(t, abciResponses.FinalizeBlock.TxResults, len(block.Txs))
+		for i, res := range abciResponses.FinalizeBlock.TxResults {
+			assert.EqualValues(t, i, res.Code, tc.abciVersion)
+		}
+		if tc.finalizeBlock {
+			assert.Equal(t, 1, app.finalized, tc.abciVersion)
+			assert.Zero(t, app.delivered, tc.abciVersion)
+		} else {
+			assert.Zero(t, app.finalized, tc.abciVersion)
+			assert.Equal(t, len(block.Txs), app.delivered, tc.abciVersion)
+		}
+	}
+}
+
 // TestBeginBlockValidators ensures we send absent validators list.
 func TestBeginBlockValidators(t *testing.T) {
 	app := &testApp{}
@@ -213,6 +291,12 @@ func TestValidateValidatorUpdates(t *testing.T) {
 			defaultValidatorParams,
 			true,
 		},
+		{
+			"adding a validator with a too big operator address results in error",
+			[]abci.ValidatorUpdate{{PubKey: pk2, Power: 20, OperatorAddress: make([]byte, types.MaxOperatorAddressSize+1)}},
+			defaultValidatorParams,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -233,6 +317,8 @@ func TestUpdateValidators(t *testing.T) {
 	val1 := types.NewValidator(pubkey1, 10)
 	pubkey2 := ed25519.GenPrivKey().PubKey()
 	val2 := types.NewValidator(pubkey2, 20)
+	operatedVal1 := types.NewValidator(pubkey1, 10)
+	operatedVal1.OperatorAddress = []byte("operator")
 
 	pk, err := cryptoenc.PubKeyToProto(pubkey1)
 	require.NoError(t, err)
@@ -276,6 +362,13 @@ func TestUpdateValidators(t *testing.T) {
 			types.NewValidatorSet([]*types.Validator{val1}),
 			true,
 		},
+		{
+			"rotating the key of a validator is OK",
+			types.NewValidatorSet([]*types.Validator{operatedVal1}),
+			[]abci.ValidatorUpdate{{PubKey: pk2, Power: 10, OperatorAddress: operatedVal1.OperatorAddress}},
+			types.NewValidatorSet([]*types.Validator{types.NewValidator(pubkey2, 10)}),
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -403,3 +496,139 @@ func TestEndBlockValidatorUpdatesResultingInEmptySet(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.NotEmpty(t, state.NextValidators.Validators)
 }
+
+func TestCreateProposalBlockPrepareProposal(t *testing.T) {
+	app := &proposalApp{
+		prepare: func(req abci.RequestPrepareProposal) [][]byte {
+			// reorder, drop and add txs
+			return [][]byte{req.Txs[2], req.Txs[0], []byte("injected")}
+		},
+	}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, _ := makeState(1, 1)
+	mempool := txsMempool{txs: types.Txs{types.Tx("a"), types.Tx("b"), types.Tx("c")}}
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mempool, sm.MockEvidencePool{})
+
+	commit := types.NewExtendedCommit(0, 0, types.BlockID{}, nil)
+	proposerAddr := state.Validators.GetProposer().Address
+	block, _, err := blockExec.CreateProposalBlock(1, state, commit, proposerAddr)
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{types.Tx("c"), types.Tx("a"), types.Tx("injected")}, block.Txs)
+	assert.Equal(t, mempool.txs, types.ToTxs(app.lastPrepare.Txs))
+	assert.Equal(t, int64(1), app.lastPrepare.Height)
+	assert.True(t, app.lastPrepare.MaxTxBytes > 0)
+
+	// the app may not exceed the max tx bytes
+	assert.Equal(t, state.ConsensusParams.Block.MaxGas, app.lastPrepare.MaxGas)
+
+	// the app may not exceed the max tx bytes, encoding included
+	maxTxBytes := app.lastPrepare.MaxTxBytes
+	app.prepare = func(req abci.RequestPrepareProposal) [][]byte {
+		return [][]byte{make([]byte, req.MaxTxBytes-1)}
+	}
+	_, _, err = blockExec.CreateProposalBlock(1, state, commit, proposerAddr)
+	assert.Error(t, err)
+
+	// nor the max gas
+	state.ConsensusParams.Block.MaxGas = 10
+	app.prepare = func(req abci.RequestPrepareProposal) [][]byte { return req.Txs }
+	app.gasWanted = 11
+	_, _, err = blockExec.CreateProposalBlock(1, state, commit, proposerAddr)
+	assert.Error(t, err)
+	app.gasWanted = 10
+	_, _, err = blockExec.CreateProposalBlock(1, state, commit, proposerAddr)
+	assert.NoError(t, err)
+
+	// the reaped txs which don't fit once encoded aren't passed to the app
+	blockExec = sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		txsMempool{txs: types.Txs{types.Tx("a"), make(types.Tx, maxTxBytes-3)}}, sm.MockEvidencePool{})
+	block, _, err = blockExec.CreateProposalBlock(1, state, commit, proposerAddr)
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{types.Tx("a")}, block.Txs)
+}
+
+func TestCreateProposalBlockAggregatesLastCommit(t *testing.T) {
+	proxyApp := newTestApp()
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	// a validator set of BLS keys
+	vals := make([]types.GenesisValidator, 3)
+	privVals := make([]types.PrivValidator, 3)
+	for i := range vals {
+		pk := bls12381.GenPrivKeyFromSecret([]byte(fmt.Sprintf("test%d", i)))
+		vals[i] = types.GenesisValidator{Address: pk.PubKey().Address(), PubKey: pk.PubKey(), Power: 1000}
+		privVals[i] = types.NewMockPVWithParams(pk, false, false)
+	}
+	params := types.DefaultConsensusParams()
+	params.Validator.PubKeyTypes = []string{types.ABCIPubKeyTypeBls12381}
+	state, err := sm.MakeGenesisState(&types.GenesisDoc{
+		ChainID:         chainID,
+		Validators:      vals,
+		ConsensusParams: params,
+	})
+	require.NoError(t, err)
+	stateDB := dbm.NewMemDB()
+	sm.SaveState(stateDB, state)
+	proposerAddr := state.Validators.GetProposer().Address
+
+	// BLS keys are rejected unless they are enabled
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{})
+	_, _, err = makeAndApplyGoodBlock(state, 1, new(types.Commit), proposerAddr, blockExec, nil)
+	require.Error(t, err)
+
+	blockExec = sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{}, sm.BlockExecutorWithExperimentalBLS())
+	state, blockID, err := makeAndApplyGoodBlock(state, 1, new(types.Commit), proposerAddr, blockExec, nil)
+	require.NoError(t, err)
+
+	voteSet := types.NewVoteSet(chainID, 1, 0, tmproto.PrecommitType, state.LastValidators)
+	for _, privVal := range privVals {
+		vote, err := types.MakeVote(1, blockID, state.LastValidators, privVal, chainID, tmtime.Now())
+		require.NoError(t, err)
+		added, err := voteSet.AddVote(vote)
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+
+	block, _, err := blockExec.CreateProposalBlock(2, state, voteSet.MakeExtendedCommit(), proposerAddr)
+	require.NoError(t, err)
+	require.True(t, block.LastCommit.Aggregated())
+	for _, sig := range block.LastCommit.Signatures {
+		assert.Empty(t, sig.Signature)
+	}
+	assert.NoError(t, blockExec.ValidateBlock(state, block))
+}
+
+func TestProcessProposal(t *testing.T) {
+	app := &proposalApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, _ := makeState(1, 1)
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{})
+
+	block := makeBlock(state, 1)
+	accepted, err := blockExec.ProcessProposal(block, state)
+	require.NoError(t, err)
+	assert.True(t, accepted)
+	assert.Equal(t, block.Hash().Bytes(), app.lastProcess.Hash)
+	assert.Equal(t, block.Txs, types.ToTxs(app.lastProcess.Txs))
+
+	app.reject = true
+	accepted, err = blockExec.ProcessProposal(block, state)
+	require.NoError(t, err)
+	assert.False(t, accepted)
+}
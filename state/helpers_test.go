@@ -11,6 +11,7 @@ import (
 	"github.com/mydexchain/tendermint0/crypto"
 	"github.com/mydexchain/tendermint0/crypto/ed25519"
 	tmrand "github.com/mydexchain/tendermint0/libs/rand"
+	"github.com/mydexchain/tendermint0/mempool/mock"
 	tmstate "github.com/mydexchain/tendermint0/proto/tendermint/state"
 	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
 	"github.com/mydexchain/tendermint0/proxy"
@@ -152,13 +153,12 @@ func makeHeaderPartsResponsesValPubKeyChange(
 
 	block := makeBlock(state, state.LastBlockHeight+1)
 	abciResponses := &tmstate.ABCIResponses{
-		BeginBlock: &abci.ResponseBeginBlock{},
-		EndBlock:   &abci.ResponseEndBlock{ValidatorUpdates: nil},
+		FinalizeBlock: &abci.ResponseFinalizeBlock{ValidatorUpdates: nil},
 	}
 	// If the pubkey is new, remove the old and add the new.
 	_, val := state.NextValidators.GetByIndex(0)
 	if !bytes.Equal(pubkey.Bytes(), val.PubKey.Bytes()) {
-		abciResponses.EndBlock = &abci.ResponseEndBlock{
+		abciResponses.FinalizeBlock = &abci.ResponseFinalizeBlock{
 			ValidatorUpdates: []abci.ValidatorUpdate{
 				types.TM2PB.NewValidatorUpdate(val.PubKey, 0),
 				types.TM2PB.NewValidatorUpdate(pubkey, 10),
@@ -176,14 +176,13 @@ func makeHeaderPartsResponsesValPowerChange(
 
 	block := makeBlock(state, state.LastBlockHeight+1)
 	abciResponses := &tmstate.ABCIResponses{
-		BeginBlock: &abci.ResponseBeginBlock{},
-		EndBlock:   &abci.ResponseEndBlock{ValidatorUpdates: nil},
+		FinalizeBlock: &abci.ResponseFinalizeBlock{ValidatorUpdates: nil},
 	}
 
 	// If the pubkey is new, remove the old and add the new.
 	_, val := state.NextValidators.GetByIndex(0)
 	if val.VotingPower != power {
-		abciResponses.EndBlock = &abci.ResponseEndBlock{
+		abciResponses.FinalizeBlock = &abci.ResponseFinalizeBlock{
 			ValidatorUpdates: []abci.ValidatorUpdate{
 				types.TM2PB.NewValidatorUpdate(val.PubKey, power),
 			},
@@ -200,8 +199,7 @@ func makeHeaderPartsResponsesParams(
 
 	block := makeBlock(state, state.LastBlockHeight+1)
 	abciResponses := &tmstate.ABCIResponses{
-		BeginBlock: &abci.ResponseBeginBlock{},
-		EndBlock:   &abci.ResponseEndBlock{ConsensusParamUpdates: types.TM2PB.ConsensusParams(&params)},
+		FinalizeBlock: &abci.ResponseFinalizeBlock{ConsensusParamUpdates: types.TM2PB.ConsensusParams(&params)},
 	}
 	return block.Header, types.BlockID{Hash: block.Hash(), PartSetHeader: types.PartSetHeader{}}, abciResponses
 }
@@ -268,3 +266,74 @@ func (app *testApp) Commit() abci.ResponseCommit {
 func (app *testApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuery) {
 	return
 }
+
+//----------------------------------------------------------------------------
+
+// finalizeBlockApp reports the given ABCI version and counts the blocks and
+// txs it executes. The result code of each tx is its index in the block.
+type finalizeBlockApp struct {
+	abci.BaseApplication
+
+	abciVersion string
+	finalized   int
+	delivered   int
+}
+
+func (app *finalizeBlockApp) Info(req abci.RequestInfo) abci.ResponseInfo {
+	return abci.ResponseInfo{AbciVersion: app.abciVersion}
+}
+
+func (app *finalizeBlockApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	app.delivered++
+	return abci.ResponseDeliverTx{Code: uint32(app.delivered - 1)}
+}
+
+func (app *finalizeBlockApp) FinalizeBlock(req abci.RequestFinalizeBlock) abci.ResponseFinalizeBlock {
+	app.finalized++
+	txResults := make([]*abci.ResponseDeliverTx, len(req.Txs))
+	for i := range req.Txs {
+		txResults[i] = &abci.ResponseDeliverTx{Code: uint32(i)}
+	}
+	return abci.ResponseFinalizeBlock{TxResults: txResults}
+}
+
+//----------------------------------------------------------------------------
+
+// proposalApp records the proposal requests, and lets tests pick the txs of
+// the block to propose and whether to reject proposals.
+type proposalApp struct {
+	abci.BaseApplication
+
+	prepare   func(abci.RequestPrepareProposal) [][]byte
+	gasWanted int64
+	reject    bool
+
+	lastPrepare abci.RequestPrepareProposal
+	lastProcess abci.RequestProcessProposal
+}
+
+var _ abci.Application = (*proposalApp)(nil)
+
+func (app *proposalApp) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	app.lastPrepare = req
+	if app.prepare == nil {
+		return app.BaseApplication.PrepareProposal(req)
+	}
+	return abci.ResponsePrepareProposal{Txs: app.prepare(req), GasWanted: app.gasWanted}
+}
+
+func (app *proposalApp) ProcessProposal(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	app.lastProcess = req
+	if app.reject {
+		return abci.ResponseProcessProposal{Result: abci.ResponseProcessProposal_REJECT}
+	}
+	return abci.ResponseProcessProposal{Result: abci.ResponseProcessProposal_ACCEPT}
+}
+
+// txsMempool is a mock mempool which reaps the given txs.
+type txsMempool struct {
+	mock.Mempool
+	txs types.Txs
+}
+
+func (mem txsMempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs { return mem.txs }
@@ -22,6 +22,7 @@ type BlockStore interface {
 	LoadBlock(height int64) *types.Block
 
 	SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
+	SaveBlockWithExtendedCommit(block *types.Block, blockParts *types.PartSet, seenExtCommit *types.ExtendedCommit)
 
 	PruneBlocks(height int64) (uint64, error)
 
@@ -30,6 +31,7 @@ type BlockStore interface {
 
 	LoadBlockCommit(height int64) *types.Commit
 	LoadSeenCommit(height int64) *types.Commit
+	LoadBlockExtendedCommit(height int64) *types.ExtendedCommit
 }
 
 //-----------------------------------------------------------------------------
@@ -57,3 +59,13 @@ func (me MockEvidencePool) Update(*types.Block, State)              {}
 func (me MockEvidencePool) IsCommitted(types.Evidence) bool         { return false }
 func (me MockEvidencePool) IsPending(types.Evidence) bool           { return false }
 func (me MockEvidencePool) Header(int64) *types.Header              { return nil }
+
+//-----------------------------------------------------------------------------
+// snapshots
+
+// Snapshotter takes snapshots of the application state. OnCommit is called
+// right after the app committed the block at the given height, before the next
+// block is executed, so that the state doesn't change while being exported.
+type Snapshotter interface {
+	OnCommit(height int64)
+}
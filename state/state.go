@@ -245,9 +245,12 @@ func (state State) MakeBlock(
 
 	// Set time.
 	var timestamp time.Time
-	if height == state.InitialHeight {
+	switch {
+	case height == state.InitialHeight:
 		timestamp = state.LastBlockTime // genesis time
-	} else {
+	case state.ConsensusParams.Timestamp.ProposerBased:
+		timestamp = ProposerTime(state.LastBlockTime, state.ConsensusParams.Block.TimeIotaMs)
+	default:
 		timestamp = MedianTime(commit, state.LastValidators)
 	}
 
@@ -286,6 +289,18 @@ func MedianTime(commit *types.Commit, validators *types.ValidatorSet) time.Time
 	return tmtime.WeightedMedian(weightedTimes, totalVotingPower)
 }
 
+// ProposerTime returns the time of a new block if timestamps are proposer
+// based: the local time of the proposer, but at least timeIotaMs after the
+// time of the last block, so that block times are strictly increasing.
+func ProposerTime(lastBlockTime time.Time, timeIotaMs int64) time.Time {
+	now := tmtime.Now()
+	minTime := lastBlockTime.Add(time.Duration(timeIotaMs) * time.Millisecond)
+	if now.Before(minTime) {
+		return minTime
+	}
+	return now
+}
+
 //------------------------------------------------------------------------
 // Genesis
 
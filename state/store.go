@@ -274,7 +274,7 @@ func PruneStates(db dbm.DB, from int64, to int64) error {
 //
 // See merkle.SimpleHashFromByteSlices
 func ABCIResponsesResultsHash(ar *tmstate.ABCIResponses) []byte {
-	return types.NewResults(ar.DeliverTxs).Hash()
+	return types.NewResults(ar.FinalizeBlock.TxResults).Hash()
 }
 
 // LoadABCIResponses loads the ABCIResponses for the given height from the
@@ -302,9 +302,30 @@ func LoadABCIResponses(db dbm.DB, height int64) (*tmstate.ABCIResponses, error)
 	}
 	// TODO: ensure that buf is completely read.
 
+	if abciResponses.FinalizeBlock == nil {
+		abciResponses.FinalizeBlock = legacyFinalizeBlockResponse(abciResponses)
+	}
+
 	return abciResponses, nil
 }
 
+// legacyFinalizeBlockResponse combines the BeginBlock, DeliverTx and EndBlock
+// responses of a height executed before FinalizeBlock.
+func legacyFinalizeBlockResponse(abciResponses *tmstate.ABCIResponses) *abci.ResponseFinalizeBlock {
+	res := &abci.ResponseFinalizeBlock{
+		TxResults: abciResponses.DeliverTxs,
+	}
+	if abciResponses.BeginBlock != nil {
+		res.BeginBlockEvents = abciResponses.BeginBlock.Events
+	}
+	if abciResponses.EndBlock != nil {
+		res.ValidatorUpdates = abciResponses.EndBlock.ValidatorUpdates
+		res.ConsensusParamUpdates = abciResponses.EndBlock.ConsensusParamUpdates
+		res.EndBlockEvents = abciResponses.EndBlock.Events
+	}
+	return res
+}
+
 // SaveABCIResponses persists the ABCIResponses to the database.
 // This is useful in case we crash after app.Commit and before s.Save().
 // Responses are indexed by height so they can also be loaded later to produce
@@ -312,14 +333,16 @@ func LoadABCIResponses(db dbm.DB, height int64) (*tmstate.ABCIResponses, error)
 //
 // Exposed for testing.
 func SaveABCIResponses(db dbm.DB, height int64, abciResponses *tmstate.ABCIResponses) {
-	var dtxs []*abci.ResponseDeliverTx
-	//strip nil values,
-	for _, tx := range abciResponses.DeliverTxs {
-		if tx != nil {
-			dtxs = append(dtxs, tx)
+	if abciResponses.FinalizeBlock != nil {
+		var txResults []*abci.ResponseDeliverTx
+		//strip nil values,
+		for _, tx := range abciResponses.FinalizeBlock.TxResults {
+			if tx != nil {
+				txResults = append(txResults, tx)
+			}
 		}
+		abciResponses.FinalizeBlock.TxResults = txResults
 	}
-	abciResponses.DeliverTxs = dtxs
 
 	bz, err := abciResponses.Marshal()
 	if err != nil {
@@ -8,13 +8,15 @@ import (
 	dbm "github.com/mydexchain/tm-db"
 
 	"github.com/mydexchain/tendermint0/crypto"
+	tmsync "github.com/mydexchain/tendermint0/libs/sync"
 	"github.com/mydexchain/tendermint0/types"
 )
 
 //-----------------------------------------------------
 // Validate block
 
-func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block *types.Block) error {
+func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block *types.Block,
+	verifiedCommits *VerifiedCommits) error {
 	// Validate internal consistency.
 	if err := block.ValidateBasic(); err != nil {
 		return err
@@ -92,9 +94,11 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 		}
 	} else {
 		// LastCommit.Signatures length is checked in VerifyCommit.
-		if err := state.LastValidators.VerifyCommit(
-			state.ChainID, state.LastBlockID, block.Height-1, block.LastCommit); err != nil {
-			return err
+		if !verifiedCommits.Remove(state.LastValidators, state.LastBlockID, block.Height-1, block.LastCommit) {
+			if err := state.LastValidators.VerifyCommit(
+				state.ChainID, state.LastBlockID, block.Height-1, block.LastCommit); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -122,12 +126,18 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 				state.LastBlockTime,
 			)
 		}
-		medianTime := MedianTime(block.LastCommit, state.LastValidators)
-		if !block.Time.Equal(medianTime) {
-			return fmt.Errorf("invalid block time. Expected %v, got %v",
-				medianTime,
-				block.Time,
-			)
+		// With proposer based timestamps, the time of the block is checked to
+		// be timely by the validators before prevoting for it (see
+		// consensus.State.defaultDoPrevote), as it can't be derived from the
+		// block itself.
+		if !state.ConsensusParams.Timestamp.ProposerBased {
+			medianTime := MedianTime(block.LastCommit, state.LastValidators)
+			if !block.Time.Equal(medianTime) {
+				return fmt.Errorf("invalid block time. Expected %v, got %v",
+					medianTime,
+					block.Time,
+				)
+			}
 		}
 
 	case block.Height == state.InitialHeight:
@@ -265,3 +275,66 @@ func VerifyEvidence(stateDB dbm.DB, state State, evidence types.Evidence, commit
 
 	return nil
 }
+
+//-----------------------------------------------------
+// Verified commits
+
+// VerifiedCommits records the commits verified ahead of the validation of the
+// block carrying them, such as while fast syncing, so that they aren't verified
+// twice. It is safe for concurrent use.
+type VerifiedCommits struct {
+	mtx     tmsync.Mutex
+	commits map[verifiedCommitKey]struct{}
+}
+
+type verifiedCommitKey struct {
+	height     int64
+	blockID    string
+	valsHash   string
+	commitHash string
+}
+
+// NewVerifiedCommits returns an empty VerifiedCommits.
+func NewVerifiedCommits() *VerifiedCommits {
+	return &VerifiedCommits{commits: make(map[verifiedCommitKey]struct{})}
+}
+
+func newVerifiedCommitKey(vals *types.ValidatorSet, blockID types.BlockID, height int64,
+	commit *types.Commit) verifiedCommitKey {
+	return verifiedCommitKey{
+		height:     height,
+		blockID:    blockID.Key(),
+		valsHash:   string(vals.Hash()),
+		commitHash: string(commit.Hash()),
+	}
+}
+
+// Add records that vals.VerifyCommit(chainID, blockID, height, commit)
+// succeeded, chainID being the one of the state.
+func (vc *VerifiedCommits) Add(vals *types.ValidatorSet, blockID types.BlockID, height int64, commit *types.Commit) {
+	key := newVerifiedCommitKey(vals, blockID, height, commit)
+	vc.mtx.Lock()
+	defer vc.mtx.Unlock()
+	vc.commits[key] = struct{}{}
+}
+
+// Remove returns true if the commit was verified, removing it along with the
+// commits of lower heights. It returns false for a nil VerifiedCommits.
+func (vc *VerifiedCommits) Remove(vals *types.ValidatorSet, blockID types.BlockID, height int64,
+	commit *types.Commit) bool {
+	if vc == nil {
+		return false
+	}
+	vc.mtx.Lock()
+	defer vc.mtx.Unlock()
+	if len(vc.commits) == 0 {
+		return false
+	}
+	_, ok := vc.commits[newVerifiedCommitKey(vals, blockID, height, commit)]
+	for k := range vc.commits {
+		if k.height <= height {
+			delete(vc.commits, k)
+		}
+	}
+	return ok
+}
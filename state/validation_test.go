@@ -98,6 +98,41 @@ func TestValidateBlockHeader(t *testing.T) {
 	}
 }
 
+func TestValidateBlockTimeProposerBased(t *testing.T) {
+	proxyApp := newTestApp()
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, privVals := makeState(3, 1)
+	state.ConsensusParams.Timestamp.ProposerBased = true
+	blockExec := sm.NewBlockExecutor(
+		stateDB,
+		log.TestingLogger(),
+		proxyApp.Consensus(),
+		memmock.Mempool{},
+		sm.MockEvidencePool{},
+	)
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+
+	for height := int64(1); height < validationTestsStopHeight; height++ {
+		proposerAddr := state.Validators.GetProposer().Address
+
+		if height > state.InitialHeight {
+			// the time of the proposer is used instead of the median time
+			block, _ := state.MakeBlock(height, makeTxs(height), lastCommit, nil, proposerAddr)
+			require.NoError(t, blockExec.ValidateBlock(state, block))
+
+			// but it must still be after the last block time
+			block.Time = state.LastBlockTime
+			require.Error(t, blockExec.ValidateBlock(state, block))
+		}
+
+		var err error
+		state, _, lastCommit, err = makeAndCommitGoodBlock(state, height, lastCommit, proposerAddr, blockExec, privVals, nil)
+		require.NoError(t, err, "height %d", height)
+	}
+}
+
 func TestValidateBlockCommit(t *testing.T) {
 	proxyApp := newTestApp()
 	require.NoError(t, proxyApp.Start())
@@ -211,6 +246,43 @@ func TestValidateBlockCommit(t *testing.T) {
 	}
 }
 
+func TestValidateBlockVerifiedCommit(t *testing.T) {
+	proxyApp := newTestApp()
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, privVals := makeState(1, 1)
+	blockExec := sm.NewBlockExecutor(
+		stateDB,
+		log.TestingLogger(),
+		proxyApp.Consensus(),
+		memmock.Mempool{},
+		sm.MockEvidencePool{},
+	)
+	proposerAddr := state.Validators.GetProposer().Address
+	state, _, lastCommit, err := makeAndCommitGoodBlock(
+		state, 1, types.NewCommit(0, 0, types.BlockID{}, nil), proposerAddr, blockExec, privVals, nil)
+	require.NoError(t, err)
+
+	// a commit with a bad signature only passes if it was recorded as verified
+	badCommit := types.NewCommit(lastCommit.Height, lastCommit.Round, lastCommit.BlockID,
+		[]types.CommitSig{lastCommit.Signatures[0]})
+	badCommit.Signatures[0].Signature = tmrand.Bytes(64)
+	block, _ := state.MakeBlock(2, makeTxs(2), badCommit, nil, proposerAddr)
+	assert.Error(t, blockExec.ValidateBlock(state, block))
+
+	blockExec.VerifiedCommits().Add(state.LastValidators, state.LastBlockID, 1, badCommit)
+	assert.NoError(t, blockExec.ValidateBlock(state, block))
+
+	// but only once
+	assert.Error(t, blockExec.ValidateBlock(state, block))
+
+	// and not for other validators
+	otherVals, _ := types.RandValidatorSet(1, 10)
+	blockExec.VerifiedCommits().Add(otherVals, state.LastBlockID, 1, badCommit)
+	assert.Error(t, blockExec.ValidateBlock(state, block))
+}
+
 func TestValidateBlockEvidence(t *testing.T) {
 	proxyApp := newTestApp()
 	require.NoError(t, proxyApp.Start())
@@ -41,15 +41,30 @@ type chunkQueue struct {
 
 // newChunkQueue creates a new chunk queue for a snapshot, using a temp dir for storage.
 // Callers must call Close() when done.
+//
+// If tempDir is given, chunks are stored in a directory under it named after the snapshot, and
+// any chunks left there by a previous, interrupted, restoration of the snapshot are added to the
+// queue so they don't have to be fetched again. Otherwise, a new directory is created in the
+// system temp dir.
 func newChunkQueue(snapshot *snapshot, tempDir string) (*chunkQueue, error) {
-	dir, err := ioutil.TempDir(tempDir, "tm-statesync")
-	if err != nil {
-		return nil, fmt.Errorf("unable to create temp dir for state sync chunks: %w", err)
-	}
 	if snapshot.Chunks == 0 {
 		return nil, errors.New("snapshot has no chunks")
 	}
-	return &chunkQueue{
+	var (
+		dir string
+		err error
+	)
+	if tempDir == "" {
+		dir, err = ioutil.TempDir("", "tm-statesync")
+	} else {
+		dir = filepath.Join(tempDir, fmt.Sprintf("tm-statesync-%v-%v-%X",
+			snapshot.Height, snapshot.Format, snapshot.Hash))
+		err = os.MkdirAll(dir, 0700)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to create temp dir for state sync chunks: %w", err)
+	}
+	q := &chunkQueue{
 		snapshot:       snapshot,
 		dir:            dir,
 		chunkFiles:     make(map[uint32]string, snapshot.Chunks),
@@ -57,7 +72,29 @@ func newChunkQueue(snapshot *snapshot, tempDir string) (*chunkQueue, error) {
 		chunkAllocated: make(map[uint32]bool, snapshot.Chunks),
 		chunkReturned:  make(map[uint32]bool, snapshot.Chunks),
 		waiters:        make(map[uint32][]chan<- uint32),
-	}, nil
+	}
+	if err = q.resume(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// resume adds any chunks already stored in the queue directory to the queue. Their senders are
+// unknown.
+func (q *chunkQueue) resume() error {
+	files, err := ioutil.ReadDir(q.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read state sync chunk dir %v: %w", q.dir, err)
+	}
+	for _, file := range files {
+		index, err := strconv.ParseUint(file.Name(), 10, 32)
+		if err != nil || file.IsDir() || uint32(index) >= q.snapshot.Chunks {
+			continue // not a chunk, e.g. an incomplete write
+		}
+		q.chunkFiles[uint32(index)] = filepath.Join(q.dir, file.Name())
+		q.chunkAllocated[uint32(index)] = true
+	}
+	return nil
 }
 
 // Add adds a chunk to the queue. It ignores chunks that already exist, returning false.
@@ -83,8 +120,12 @@ func (q *chunkQueue) Add(chunk *chunk) (bool, error) {
 		return false, nil
 	}
 
+	// Write the chunk to a temporary file first, so an interrupted write isn't resumed as a chunk.
 	path := filepath.Join(q.dir, strconv.FormatUint(uint64(chunk.Index), 10))
-	err := ioutil.WriteFile(path, chunk.Chunk, 0600)
+	err := ioutil.WriteFile(path+".tmp", chunk.Chunk, 0600)
+	if err == nil {
+		err = os.Rename(path+".tmp", path)
+	}
 	if err != nil {
 		return false, fmt.Errorf("failed to save chunk %v to file %v: %w", chunk.Index, path, err)
 	}
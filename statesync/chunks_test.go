@@ -54,6 +54,57 @@ func TestNewChunkQueue_TempDir(t *testing.T) {
 	assert.Len(t, files, 0)
 }
 
+func TestNewChunkQueue_Resume(t *testing.T) {
+	s := &snapshot{
+		Height:   3,
+		Format:   1,
+		Chunks:   5,
+		Hash:     []byte{7},
+		Metadata: nil,
+	}
+	dir, err := ioutil.TempDir("", "newchunkqueue")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	queue, err := newChunkQueue(s, dir)
+	require.NoError(t, err)
+	_, err = queue.Add(&chunk{Height: 3, Format: 1, Index: 1, Chunk: []byte{3, 1, 1}, Sender: "a"})
+	require.NoError(t, err)
+
+	// A new queue for the same snapshot, e.g. after a restart, picks up the stored chunk but
+	// not its sender, and does not allocate it again.
+	queue, err = newChunkQueue(s, dir)
+	require.NoError(t, err)
+	assert.True(t, queue.Has(1))
+	assert.False(t, queue.Has(0))
+	assert.EqualValues(t, "", queue.GetSender(1))
+	for _, expect := range []uint32{0, 2, 3, 4} {
+		index, err := queue.Allocate()
+		require.NoError(t, err)
+		assert.Equal(t, expect, index)
+	}
+
+	_, err = queue.Add(&chunk{Height: 3, Format: 1, Index: 0, Chunk: []byte{3, 1, 0}})
+	require.NoError(t, err)
+	c, err := queue.Next()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{3, 1, 0}, c.Chunk)
+	c, err = queue.Next()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{3, 1, 1}, c.Chunk)
+
+	// A queue for a different snapshot does not.
+	other, err := newChunkQueue(&snapshot{Height: 3, Format: 2, Chunks: 5, Hash: []byte{7}}, dir)
+	require.NoError(t, err)
+	assert.False(t, other.Has(1))
+	require.NoError(t, other.Close())
+
+	require.NoError(t, queue.Close())
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 0)
+}
+
 func TestChunkQueue(t *testing.T) {
 	queue, teardown := setupChunkQueue(t)
 	defer teardown()
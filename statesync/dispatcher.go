@@ -0,0 +1,168 @@
+package statesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	tmsync "github.com/mydexchain/tendermint0/libs/sync"
+	lightprovider "github.com/mydexchain/tendermint0/light/provider"
+	"github.com/mydexchain/tendermint0/p2p"
+	ssproto "github.com/mydexchain/tendermint0/proto/tendermint/statesync"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+// dispatcher sends light block and consensus params requests to peers, and routes the peers'
+// responses back to the callers. Only one request can be in flight per peer.
+type dispatcher struct {
+	timeout time.Duration
+
+	mtx   tmsync.Mutex
+	calls map[p2p.ID]chan proto.Message
+}
+
+// newDispatcher creates a new dispatcher, which waits for responses for the given timeout.
+func newDispatcher(timeout time.Duration) *dispatcher {
+	return &dispatcher{
+		timeout: timeout,
+		calls:   make(map[p2p.ID]chan proto.Message),
+	}
+}
+
+// call sends a request to a peer on the given channel, and waits for the response, or until the
+// context is done.
+func (d *dispatcher) call(ctx context.Context, peer p2p.Peer, chID byte, req proto.Message) (proto.Message, error) {
+	d.mtx.Lock()
+	if _, ok := d.calls[peer.ID()]; ok {
+		d.mtx.Unlock()
+		return nil, fmt.Errorf("a request to peer %v is already in progress", peer.ID())
+	}
+	ch := make(chan proto.Message, 1)
+	d.calls[peer.ID()] = ch
+	d.mtx.Unlock()
+
+	defer func() {
+		d.mtx.Lock()
+		if d.calls[peer.ID()] == ch {
+			delete(d.calls, peer.ID())
+		}
+		d.mtx.Unlock()
+	}()
+
+	if !peer.Send(chID, mustEncodeMsg(req)) {
+		return nil, fmt.Errorf("failed to send request to peer %v", peer.ID())
+	}
+
+	timer := time.NewTimer(d.timeout)
+	defer timer.Stop()
+	select {
+	case resp, ok := <-ch:
+		if !ok {
+			return nil, fmt.Errorf("peer %v disconnected", peer.ID())
+		}
+		return resp, nil
+	case <-timer.C:
+		return nil, fmt.Errorf("timed out waiting for response from peer %v", peer.ID())
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// respond passes a response from a peer to the caller waiting for it. It errors if there is no
+// request in flight to the peer.
+func (d *dispatcher) respond(peerID p2p.ID, resp proto.Message) error {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	ch, ok := d.calls[peerID]
+	if !ok {
+		return fmt.Errorf("unsolicited response from peer %v", peerID)
+	}
+	delete(d.calls, peerID)
+	ch <- resp
+	return nil
+}
+
+// removePeer fails any request in flight to a peer.
+func (d *dispatcher) removePeer(peerID p2p.ID) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	if ch, ok := d.calls[peerID]; ok {
+		close(ch)
+		delete(d.calls, peerID)
+	}
+}
+
+// blockProvider is a light client provider which fetches signed headers and validator sets from a
+// peer via the dispatcher.
+type blockProvider struct {
+	chainID    string
+	peer       p2p.Peer
+	dispatcher *dispatcher
+}
+
+var _ lightprovider.Provider = (*blockProvider)(nil)
+
+// newBlockProvider creates a new light client provider for a peer.
+func newBlockProvider(chainID string, peer p2p.Peer, dispatcher *dispatcher) *blockProvider {
+	return &blockProvider{
+		chainID:    chainID,
+		peer:       peer,
+		dispatcher: dispatcher,
+	}
+}
+
+func (p *blockProvider) String() string {
+	return fmt.Sprintf("p2p{%v}", p.peer.ID())
+}
+
+// ChainID implements lightprovider.Provider.
+func (p *blockProvider) ChainID() string {
+	return p.chainID
+}
+
+// LightBlock implements lightprovider.Provider. It fetches the signed header and validator set at
+// a height from the peer, checking that they match the height and chain ID.
+func (p *blockProvider) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	if height < 0 {
+		return nil, fmt.Errorf("expected height >= 0, got height %d", height)
+	}
+	resp, err := p.dispatcher.call(ctx, p.peer, LightBlockChannel, &ssproto.LightBlockRequest{Height: uint64(height)})
+	if err != nil {
+		return nil, err
+	}
+	msg, ok := resp.(*ssproto.LightBlockResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response %T from peer %v", resp, p.peer.ID())
+	}
+	if msg.SignedHeader == nil || msg.ValidatorSet == nil {
+		return nil, lightprovider.ErrLightBlockNotFound
+	}
+
+	sh, err := types.SignedHeaderFromProto(msg.SignedHeader)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signed header from peer %v: %w", p.peer.ID(), err)
+	}
+	if sh.Header == nil {
+		return nil, errors.New("header is nil")
+	}
+	if height != 0 && sh.Height != height {
+		return nil, fmt.Errorf("expected header at height %v, got %v", height, sh.Height)
+	}
+	// Verify we're still on the same chain.
+	if sh.ChainID != p.chainID {
+		return nil, fmt.Errorf("expected chainID %s, got %s", p.chainID, sh.ChainID)
+	}
+	vals, err := types.ValidatorSetFromProto(msg.ValidatorSet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator set from peer %v: %w", p.peer.ID(), err)
+	}
+	return &types.LightBlock{SignedHeader: sh, ValidatorSet: vals}, nil
+}
+
+// ReportEvidence implements lightprovider.Provider.
+func (p *blockProvider) ReportEvidence(ev types.Evidence) error {
+	return errors.New("reporting evidence to peers is not supported")
+}
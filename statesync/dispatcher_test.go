@@ -0,0 +1,132 @@
+package statesync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	lightprovider "github.com/mydexchain/tendermint0/light/provider"
+	"github.com/mydexchain/tendermint0/p2p"
+	p2pmocks "github.com/mydexchain/tendermint0/p2p/mocks"
+	ssproto "github.com/mydexchain/tendermint0/proto/tendermint/statesync"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+// respondingPeer returns a peer which responds to light block requests with the response returned
+// by respond.
+func respondingPeer(id p2p.ID, d *dispatcher,
+	respond func(req *ssproto.LightBlockRequest) *ssproto.LightBlockResponse) *p2pmocks.Peer {
+	peer := &p2pmocks.Peer{}
+	peer.On("ID").Return(id)
+	peer.On("Send", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		req, err := decodeMsg(args[1].([]byte))
+		if err != nil {
+			panic(err)
+		}
+		resp := respond(req.(*ssproto.LightBlockRequest))
+		go func() {
+			_ = d.respond(id, resp)
+		}()
+	}).Return(true)
+	return peer
+}
+
+func TestDispatcher_call(t *testing.T) {
+	d := newDispatcher(time.Second)
+	resp := &ssproto.ParamsResponse{Height: 1}
+
+	peer := &p2pmocks.Peer{}
+	peer.On("ID").Return(p2p.ID("a"))
+	peer.On("Send", ParamsChannel, mustEncodeMsg(&ssproto.ParamsRequest{Height: 1})).Run(func(args mock.Arguments) {
+		// A second request to the peer while one is in flight fails.
+		_, err := d.call(context.Background(), peer, ParamsChannel, &ssproto.ParamsRequest{Height: 1})
+		assert.Error(t, err)
+		go func() {
+			assert.NoError(t, d.respond("a", resp))
+		}()
+	}).Return(true)
+
+	msg, err := d.call(context.Background(), peer, ParamsChannel, &ssproto.ParamsRequest{Height: 1})
+	require.NoError(t, err)
+	assert.Equal(t, resp, msg)
+
+	// Responses without a request are rejected.
+	assert.Error(t, d.respond("a", resp))
+}
+
+func TestDispatcher_call_timeout(t *testing.T) {
+	d := newDispatcher(10 * time.Millisecond)
+	peer := simplePeer("a")
+	peer.On("Send", ParamsChannel, mock.Anything).Return(true)
+
+	_, err := d.call(context.Background(), peer, ParamsChannel, &ssproto.ParamsRequest{Height: 1})
+	require.Error(t, err)
+
+	// The peer is available again after a timeout.
+	_, err = d.call(context.Background(), peer, ParamsChannel, &ssproto.ParamsRequest{Height: 1})
+	require.Error(t, err)
+}
+
+func TestDispatcher_removePeer(t *testing.T) {
+	d := newDispatcher(time.Minute)
+	peer := simplePeer("a")
+	peer.On("Send", ParamsChannel, mock.Anything).Run(func(args mock.Arguments) {
+		go d.removePeer("a")
+	}).Return(true)
+
+	_, err := d.call(context.Background(), peer, ParamsChannel, &ssproto.ParamsRequest{Height: 1})
+	require.Error(t, err)
+}
+
+func TestBlockProvider(t *testing.T) {
+	vals, _ := types.RandValidatorSet(2, 10)
+	pbVals, err := vals.ToProto()
+	require.NoError(t, err)
+	sh := &types.SignedHeader{
+		Header: &types.Header{ChainID: "test", Height: 3, ProposerAddress: vals.Proposer.Address},
+		Commit: types.NewCommit(3, 0, types.BlockID{
+			Hash:          make([]byte, 32),
+			PartSetHeader: types.PartSetHeader{Total: 1, Hash: make([]byte, 32)},
+		}, []types.CommitSig{types.NewCommitSigAbsent()}),
+	}
+
+	d := newDispatcher(time.Second)
+	peer := respondingPeer("a", d, func(req *ssproto.LightBlockRequest) *ssproto.LightBlockResponse {
+		if req.Height == 4 {
+			return &ssproto.LightBlockResponse{}
+		}
+		return &ssproto.LightBlockResponse{SignedHeader: sh.ToProto(), ValidatorSet: pbVals}
+	})
+	p := newBlockProvider("test", peer, d)
+	ctx := context.Background()
+
+	lb, err := p.LightBlock(ctx, 3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, lb.Height)
+	assert.Equal(t, vals.Hash(), lb.ValidatorSet.Hash())
+
+	lb, err = p.LightBlock(ctx, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, lb.Height)
+
+	_, err = p.LightBlock(ctx, 4)
+	assert.Equal(t, lightprovider.ErrLightBlockNotFound, err)
+
+	// A header for the wrong height or chain is rejected.
+	_, err = p.LightBlock(ctx, 5)
+	assert.Error(t, err)
+	_, err = newBlockProvider("other", peer, d).LightBlock(ctx, 3)
+	assert.Error(t, err)
+
+	// A cancelled context aborts the request.
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	silent := simplePeer("b")
+	silent.On("Send", LightBlockChannel, mock.Anything).Return(true)
+	_, err = newBlockProvider("test", silent, d).LightBlock(cancelled, 3)
+	assert.Equal(t, context.Canceled, err)
+}
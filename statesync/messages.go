@@ -14,6 +14,10 @@ const (
 	snapshotMsgSize = int(4e6)
 	// chunkMsgSize is the maximum size of a chunkResponseMessage
 	chunkMsgSize = int(16e6)
+	// lightBlockMsgSize is the maximum size of a lightBlockResponseMessage
+	lightBlockMsgSize = int(1e7)
+	// paramsMsgSize is the maximum size of a paramsResponseMessage
+	paramsMsgSize = int(1e5)
 )
 
 // mustEncodeMsg encodes a Protobuf message, panicing on error.
@@ -28,6 +32,14 @@ func mustEncodeMsg(pb proto.Message) []byte {
 		msg.Sum = &ssproto.Message_SnapshotsRequest{SnapshotsRequest: pb}
 	case *ssproto.SnapshotsResponse:
 		msg.Sum = &ssproto.Message_SnapshotsResponse{SnapshotsResponse: pb}
+	case *ssproto.LightBlockRequest:
+		msg.Sum = &ssproto.Message_LightBlockRequest{LightBlockRequest: pb}
+	case *ssproto.LightBlockResponse:
+		msg.Sum = &ssproto.Message_LightBlockResponse{LightBlockResponse: pb}
+	case *ssproto.ParamsRequest:
+		msg.Sum = &ssproto.Message_ParamsRequest{ParamsRequest: pb}
+	case *ssproto.ParamsResponse:
+		msg.Sum = &ssproto.Message_ParamsResponse{ParamsResponse: pb}
 	default:
 		panic(fmt.Errorf("unknown message type %T", pb))
 	}
@@ -54,6 +66,14 @@ func decodeMsg(bz []byte) (proto.Message, error) {
 		return msg.SnapshotsRequest, nil
 	case *ssproto.Message_SnapshotsResponse:
 		return msg.SnapshotsResponse, nil
+	case *ssproto.Message_LightBlockRequest:
+		return msg.LightBlockRequest, nil
+	case *ssproto.Message_LightBlockResponse:
+		return msg.LightBlockResponse, nil
+	case *ssproto.Message_ParamsRequest:
+		return msg.ParamsRequest, nil
+	case *ssproto.Message_ParamsResponse:
+		return msg.ParamsResponse, nil
 	default:
 		return nil, fmt.Errorf("unknown message type %T", msg)
 	}
@@ -90,6 +110,19 @@ func validateMsg(pb proto.Message) error {
 		if msg.Chunks == 0 {
 			return errors.New("snapshot has no chunks")
 		}
+	case *ssproto.LightBlockRequest:
+	case *ssproto.LightBlockResponse:
+		if (msg.SignedHeader == nil) != (msg.ValidatorSet == nil) {
+			return errors.New("light block must have both a signed header and a validator set, or neither")
+		}
+	case *ssproto.ParamsRequest:
+		if msg.Height == 0 {
+			return errors.New("height cannot be 0")
+		}
+	case *ssproto.ParamsResponse:
+		if msg.Height == 0 {
+			return errors.New("height cannot be 0")
+		}
 	default:
 		return fmt.Errorf("unknown message type %T", msg)
 	}
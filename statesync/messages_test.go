@@ -69,6 +69,23 @@ func TestValidateMsg(t *testing.T) {
 		"SnapshotsResponse no hash": {
 			&ssproto.SnapshotsResponse{Height: 1, Format: 1, Chunks: 2, Hash: []byte{}},
 			false},
+
+		"LightBlockRequest valid":  {&ssproto.LightBlockRequest{Height: 1}, true},
+		"LightBlockRequest latest": {&ssproto.LightBlockRequest{Height: 0}, true},
+
+		"LightBlockResponse valid": {
+			&ssproto.LightBlockResponse{SignedHeader: &tmproto.SignedHeader{}, ValidatorSet: &tmproto.ValidatorSet{}},
+			true},
+		"LightBlockResponse empty": {&ssproto.LightBlockResponse{}, true},
+		"LightBlockResponse no validator set": {
+			&ssproto.LightBlockResponse{SignedHeader: &tmproto.SignedHeader{}},
+			false},
+
+		"ParamsRequest valid":    {&ssproto.ParamsRequest{Height: 1}, true},
+		"ParamsRequest 0 height": {&ssproto.ParamsRequest{Height: 0}, false},
+
+		"ParamsResponse valid":    {&ssproto.ParamsResponse{Height: 1}, true},
+		"ParamsResponse 0 height": {&ssproto.ParamsResponse{Height: 0}, false},
 	}
 	for name, tc := range testcases {
 		tc := tc
@@ -95,6 +112,8 @@ func TestStateSyncVectors(t *testing.T) {
 		{"SnapshotsResponse", &ssproto.SnapshotsResponse{Height: 1, Format: 2, Chunks: 3, Hash: []byte("chuck hash"), Metadata: []byte("snapshot metadata")}, "1225080110021803220a636875636b20686173682a11736e617073686f74206d65746164617461"},
 		{"ChunkRequest", &ssproto.ChunkRequest{Height: 1, Format: 2, Index: 3}, "1a06080110021803"},
 		{"ChunkResponse", &ssproto.ChunkResponse{Height: 1, Format: 2, Index: 3, Chunk: []byte("it's a chunk")}, "2214080110021803220c697427732061206368756e6b"},
+		{"LightBlockRequest", &ssproto.LightBlockRequest{Height: 1}, "2a020801"},
+		{"ParamsRequest", &ssproto.ParamsRequest{Height: 1}, "3a020801"},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,90 @@
+package statesync
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "statesync"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Height of the snapshot being restored.
+	SnapshotHeight metrics.Gauge
+	// Number of chunks in the snapshot being restored.
+	SnapshotChunksTotal metrics.Gauge
+	// Number of chunks applied to the app.
+	SnapshotChunksApplied metrics.Gauge
+	// Rate at which chunk bytes are applied to the app.
+	SnapshotBytesPerSecond metrics.Gauge
+	// Estimated time until the snapshot is restored, in seconds.
+	SnapshotETASeconds metrics.Gauge
+	// Number of chunk requests that timed out.
+	ChunkRequestTimeouts metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		SnapshotHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "snapshot_height",
+			Help:      "Height of the snapshot being restored.",
+		}, labels).With(labelsAndValues...),
+		SnapshotChunksTotal: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "snapshot_chunks_total",
+			Help:      "Number of chunks in the snapshot being restored.",
+		}, labels).With(labelsAndValues...),
+		SnapshotChunksApplied: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "snapshot_chunks_applied",
+			Help:      "Number of snapshot chunks applied to the app.",
+		}, labels).With(labelsAndValues...),
+		SnapshotBytesPerSecond: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "snapshot_bytes_per_second",
+			Help:      "Rate at which snapshot chunk bytes are applied to the app.",
+		}, labels).With(labelsAndValues...),
+		SnapshotETASeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "snapshot_eta_seconds",
+			Help:      "Estimated time until the snapshot is restored, in seconds.",
+		}, labels).With(labelsAndValues...),
+		ChunkRequestTimeouts: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "chunk_request_timeouts",
+			Help:      "Number of snapshot chunk requests that timed out.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		SnapshotHeight:         discard.NewGauge(),
+		SnapshotChunksTotal:    discard.NewGauge(),
+		SnapshotChunksApplied:  discard.NewGauge(),
+		SnapshotBytesPerSecond: discard.NewGauge(),
+		SnapshotETASeconds:     discard.NewGauge(),
+		ChunkRequestTimeouts:   discard.NewCounter(),
+	}
+}
@@ -0,0 +1,161 @@
+package statesync
+
+import (
+	"math/rand"
+	"time"
+
+	tmsync "github.com/mydexchain/tendermint0/libs/sync"
+	"github.com/mydexchain/tendermint0/p2p"
+)
+
+const (
+	// initialChunkRequestsPerPeer is the number of concurrent chunk requests a new peer is given.
+	initialChunkRequestsPerPeer = 2
+	// maxChunkRequestsPerPeer is the maximum number of concurrent chunk requests sent to a peer.
+	maxChunkRequestsPerPeer = 8
+	// latencyWeight is the weight of a new sample in the moving average of a peer's chunk latency.
+	latencyWeight = 0.2
+)
+
+// peerStats tracks chunk requests to a single peer.
+type peerStats struct {
+	latency  time.Duration // moving average of chunk response latency
+	failures int           // number of failed chunk requests
+	inFlight int           // number of outstanding chunk requests
+	limit    int           // maximum number of outstanding chunk requests
+}
+
+// score returns the peer's score, lower is better. Peers we haven't heard from yet have no
+// latency, so they are tried early.
+func (s *peerStats) score() time.Duration {
+	return s.latency * time.Duration(1+s.failures)
+}
+
+// peerScores scores peers by chunk latency and failures, and limits the number of concurrent chunk
+// requests sent to each of them. The limit is increased by one for every chunk received and halved
+// on every failure, so fast and reliable peers are sent more requests.
+type peerScores struct {
+	tmsync.Mutex
+	peers map[p2p.ID]*peerStats
+}
+
+// newPeerScores creates a new peerScores.
+func newPeerScores() *peerScores {
+	return &peerScores{
+		peers: make(map[p2p.ID]*peerStats),
+	}
+}
+
+// get returns the stats for a peer, creating them if necessary. The caller must hold the mutex
+// lock.
+func (p *peerScores) get(peerID p2p.ID) *peerStats {
+	stats, ok := p.peers[peerID]
+	if !ok {
+		stats = &peerStats{limit: initialChunkRequestsPerPeer}
+		p.peers[peerID] = stats
+	}
+	return stats
+}
+
+// Acquire picks the best scoring of the given peers which can take another chunk request, and
+// counts the request against its limit. Peers other than exclude are preferred, but exclude is
+// returned if it is the only peer available. Returns nil if all peers are busy. The caller must
+// call Success, Failure or Release for the peer once the request completes.
+func (p *peerScores) Acquire(peers []p2p.Peer, exclude p2p.ID) p2p.Peer {
+	p.Lock()
+	defer p.Unlock()
+
+	// Shuffle the peers, so that equally scored peers share the load.
+	shuffled := make([]p2p.Peer, len(peers))
+	for i, j := range rand.Perm(len(peers)) { // nolint:gosec // G404: Use of weak random number generator
+		shuffled[i] = peers[j]
+	}
+
+	var best p2p.Peer
+	var bestStats *peerStats
+	for _, peer := range shuffled {
+		stats := p.get(peer.ID())
+		if stats.inFlight >= stats.limit {
+			continue
+		}
+		switch {
+		case best == nil:
+		case best.ID() == exclude && peer.ID() != exclude:
+		case peer.ID() == exclude:
+			continue
+		case stats.score() >= bestStats.score():
+			continue
+		}
+		best, bestStats = peer, stats
+	}
+	if best != nil {
+		bestStats.inFlight++
+	}
+	return best
+}
+
+// Success records a chunk received from a peer after the given latency.
+func (p *peerScores) Success(peerID p2p.ID, latency time.Duration) {
+	p.Lock()
+	defer p.Unlock()
+	stats := p.get(peerID)
+	if stats.inFlight > 0 {
+		stats.inFlight--
+	}
+	if stats.latency == 0 {
+		stats.latency = latency
+	} else {
+		stats.latency = time.Duration((1-latencyWeight)*float64(stats.latency) + latencyWeight*float64(latency))
+	}
+	if stats.limit < maxChunkRequestsPerPeer {
+		stats.limit++
+	}
+}
+
+// Failure records a failed chunk request to a peer, e.g. a timeout.
+func (p *peerScores) Failure(peerID p2p.ID) {
+	p.Lock()
+	defer p.Unlock()
+	stats := p.get(peerID)
+	if stats.inFlight > 0 {
+		stats.inFlight--
+	}
+	p.penalize(stats)
+}
+
+// Penalize records a bad chunk from a peer, without completing a request.
+func (p *peerScores) Penalize(peerID p2p.ID) {
+	if peerID == "" {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	p.penalize(p.get(peerID))
+}
+
+// penalize counts a failure against a peer. The caller must hold the mutex lock.
+func (p *peerScores) penalize(stats *peerStats) {
+	stats.failures++
+	stats.limit /= 2
+	if stats.limit < 1 {
+		stats.limit = 1
+	}
+}
+
+// Release completes a chunk request to a peer without scoring it, e.g. when the chunk was
+// received from a different peer.
+func (p *peerScores) Release(peerID p2p.ID) {
+	p.Lock()
+	defer p.Unlock()
+	stats := p.get(peerID)
+	if stats.inFlight > 0 {
+		stats.inFlight--
+	}
+}
+
+// Remove removes a peer.
+func (p *peerScores) Remove(peerID p2p.ID) {
+	p.Lock()
+	defer p.Unlock()
+	delete(p.peers, peerID)
+}
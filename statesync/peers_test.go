@@ -0,0 +1,89 @@
+package statesync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mydexchain/tendermint0/p2p"
+)
+
+func TestPeerScores_Acquire(t *testing.T) {
+	scores := newPeerScores()
+	peerA, peerB := simplePeer("a"), simplePeer("b")
+	peers := []p2p.Peer{peerA, peerB}
+
+	// Each peer starts out with the initial limit.
+	for i := 0; i < 2*initialChunkRequestsPerPeer; i++ {
+		require.NotNil(t, scores.Acquire(peers, ""))
+	}
+	assert.Nil(t, scores.Acquire(peers, ""))
+
+	// Releasing a request frees up capacity.
+	scores.Release("a")
+	assert.Equal(t, peerA, scores.Acquire(peers, ""))
+}
+
+func TestPeerScores_Acquire_exclude(t *testing.T) {
+	scores := newPeerScores()
+	peerA, peerB := simplePeer("a"), simplePeer("b")
+
+	for i := 0; i < initialChunkRequestsPerPeer; i++ {
+		assert.Equal(t, peerB, scores.Acquire([]p2p.Peer{peerA, peerB}, "a"))
+	}
+	// Once the other peers are busy, the excluded peer is used.
+	assert.Equal(t, peerA, scores.Acquire([]p2p.Peer{peerA, peerB}, "a"))
+}
+
+func TestPeerScores_Score(t *testing.T) {
+	scores := newPeerScores()
+	peerA, peerB := simplePeer("a"), simplePeer("b")
+	peers := []p2p.Peer{peerA, peerB}
+
+	require.NotNil(t, scores.Acquire(peers, ""))
+	require.NotNil(t, scores.Acquire(peers, ""))
+	scores.Success("a", time.Second)
+	scores.Success("b", 2*time.Second)
+
+	// The faster peer is preferred, until it fails.
+	peer := scores.Acquire(peers, "")
+	assert.Equal(t, peerA, peer)
+	scores.Failure(peer.ID())
+	scores.Failure(peer.ID())
+	assert.Equal(t, peerB, scores.Acquire(peers, ""))
+}
+
+func TestPeerScores_Limit(t *testing.T) {
+	scores := newPeerScores()
+	peers := []p2p.Peer{simplePeer("a")}
+
+	// Successes increase the limit up to the maximum.
+	for i := 0; i < 2*maxChunkRequestsPerPeer; i++ {
+		require.NotNil(t, scores.Acquire(peers, ""))
+		scores.Success("a", time.Second)
+	}
+	for i := 0; i < maxChunkRequestsPerPeer; i++ {
+		require.NotNil(t, scores.Acquire(peers, ""))
+	}
+	assert.Nil(t, scores.Acquire(peers, ""))
+
+	// Failures halve the limit.
+	scores.Failure("a")
+	scores.Failure("a")
+	for i := 0; i < maxChunkRequestsPerPeer-2; i++ {
+		scores.Release("a")
+	}
+	for i := 0; i < maxChunkRequestsPerPeer/4; i++ {
+		require.NotNil(t, scores.Acquire(peers, ""))
+	}
+	assert.Nil(t, scores.Acquire(peers, ""))
+
+	// A removed peer starts over.
+	scores.Remove("a")
+	for i := 0; i < initialChunkRequestsPerPeer; i++ {
+		require.NotNil(t, scores.Acquire(peers, ""))
+	}
+	assert.Nil(t, scores.Acquire(peers, ""))
+}
@@ -3,6 +3,9 @@ package statesync
 import (
 	"errors"
 	"sort"
+	"time"
+
+	dbm "github.com/mydexchain/tm-db"
 
 	abci "github.com/mydexchain/tendermint0/abci/types"
 	tmsync "github.com/mydexchain/tendermint0/libs/sync"
@@ -18,8 +21,18 @@ const (
 	SnapshotChannel = byte(0x60)
 	// ChunkChannel exchanges chunk contents
 	ChunkChannel = byte(0x61)
+	// LightBlockChannel exchanges signed headers and validator sets for light client verification
+	LightBlockChannel = byte(0x62)
+	// ParamsChannel exchanges consensus params
+	ParamsChannel = byte(0x63)
 	// recentSnapshots is the number of recent snapshots to send and receive per peer.
 	recentSnapshots = 10
+	// lightBlockResponseTimeout is the time to wait for a peer to respond to a light block or
+	// consensus params request.
+	lightBlockResponseTimeout = 10 * time.Second
+	// snapshotQueueSize is the number of received snapshots waiting to be added to the syncer,
+	// beyond which further snapshots are dropped.
+	snapshotQueueSize = 100
 )
 
 // Reactor handles state sync, both restoring snapshots for the local node and serving snapshots
@@ -27,9 +40,18 @@ const (
 type Reactor struct {
 	p2p.BaseReactor
 
-	conn      proxy.AppConnSnapshot
-	connQuery proxy.AppConnQuery
-	tempDir   string
+	conn       proxy.AppConnSnapshot
+	connQuery  proxy.AppConnQuery
+	stateDB    dbm.DB
+	blockStore sm.BlockStore
+	tempDir    string
+	metrics    *Metrics
+
+	// dispatcher sends light block and consensus params requests for the p2p state provider.
+	dispatcher *dispatcher
+
+	// snapshotCh queues the received snapshots for snapshotRoutine.
+	snapshotCh chan receivedSnapshot
 
 	// This will only be set when a state sync is in progress. It is used to feed received
 	// snapshots and chunks into the sync.
@@ -37,16 +59,43 @@ type Reactor struct {
 	syncer *syncer
 }
 
-// NewReactor creates a new state sync reactor.
-func NewReactor(conn proxy.AppConnSnapshot, connQuery proxy.AppConnQuery, tempDir string) *Reactor {
+// receivedSnapshot is a snapshot received from a peer.
+type receivedSnapshot struct {
+	peer     p2p.Peer
+	snapshot *snapshot
+}
+
+// ReactorOption sets an optional parameter on the Reactor.
+type ReactorOption func(*Reactor)
+
+// NewReactor creates a new state sync reactor. The state database and block store are used to
+// serve light blocks and consensus params to peers. If tempDir is set, snapshot chunks are kept in
+// a directory under it until the snapshot is restored, so that a restoration interrupted by a
+// restart can resume without refetching them.
+func NewReactor(conn proxy.AppConnSnapshot, connQuery proxy.AppConnQuery, stateDB dbm.DB,
+	blockStore sm.BlockStore, tempDir string, options ...ReactorOption) *Reactor {
 	r := &Reactor{
-		conn:      conn,
-		connQuery: connQuery,
+		conn:       conn,
+		connQuery:  connQuery,
+		stateDB:    stateDB,
+		blockStore: blockStore,
+		tempDir:    tempDir,
+		metrics:    NopMetrics(),
+		dispatcher: newDispatcher(lightBlockResponseTimeout),
+		snapshotCh: make(chan receivedSnapshot, snapshotQueueSize),
 	}
 	r.BaseReactor = *p2p.NewBaseReactor("StateSync", r)
+	for _, option := range options {
+		option(r)
+	}
 	return r
 }
 
+// ReactorMetrics sets the metrics.
+func ReactorMetrics(metrics *Metrics) ReactorOption {
+	return func(r *Reactor) { r.metrics = metrics }
+}
+
 // GetChannels implements p2p.Reactor.
 func (r *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 	return []*p2p.ChannelDescriptor{
@@ -62,11 +111,24 @@ func (r *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			SendQueueCapacity:   4,
 			RecvMessageCapacity: chunkMsgSize,
 		},
+		{
+			ID:                  LightBlockChannel,
+			Priority:            2,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: lightBlockMsgSize,
+		},
+		{
+			ID:                  ParamsChannel,
+			Priority:            2,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: paramsMsgSize,
+		},
 	}
 }
 
 // OnStart implements p2p.Reactor.
 func (r *Reactor) OnStart() error {
+	go r.snapshotRoutine()
 	return nil
 }
 
@@ -81,6 +143,7 @@ func (r *Reactor) AddPeer(peer p2p.Peer) {
 
 // RemovePeer implements p2p.Reactor.
 func (r *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
+	r.dispatcher.removePeer(peer.ID())
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
 	if r.syncer != nil {
@@ -130,23 +193,26 @@ func (r *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 
 		case *ssproto.SnapshotsResponse:
 			r.mtx.RLock()
-			defer r.mtx.RUnlock()
-			if r.syncer == nil {
+			syncer := r.syncer
+			r.mtx.RUnlock()
+			if syncer == nil {
 				r.Logger.Debug("Received unexpected snapshot, no state sync in progress")
 				return
 			}
 			r.Logger.Debug("Received snapshot", "height", msg.Height, "format", msg.Format, "peer", src.ID())
-			_, err := r.syncer.AddSnapshot(src, &snapshot{
+			// Adding the snapshot verifies its app hash via the state provider, which may wait
+			// for responses from peers (including src), so we mustn't block the receive routine.
+			select {
+			case r.snapshotCh <- receivedSnapshot{peer: src, snapshot: &snapshot{
 				Height:   msg.Height,
 				Format:   msg.Format,
 				Chunks:   msg.Chunks,
 				Hash:     msg.Hash,
 				Metadata: msg.Metadata,
-			})
-			if err != nil {
-				r.Logger.Error("Failed to add snapshot", "height", msg.Height, "format", msg.Format,
-					"peer", src.ID(), "err", err)
-				return
+			}}:
+			default:
+				r.Logger.Info("Dropping snapshot, too many snapshots waiting to be added",
+					"height", msg.Height, "format", msg.Format, "peer", src.ID())
 			}
 
 		default:
@@ -204,11 +270,125 @@ func (r *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 			r.Logger.Error("Received unknown message %T", msg)
 		}
 
+	case LightBlockChannel:
+		switch msg := msg.(type) {
+		case *ssproto.LightBlockRequest:
+			r.Logger.Debug("Received light block request", "height", msg.Height, "peer", src.ID())
+			resp, err := r.fetchLightBlock(msg.Height)
+			if err != nil {
+				r.Logger.Error("Failed to fetch light block", "height", msg.Height, "err", err)
+				return
+			}
+			src.Send(LightBlockChannel, mustEncodeMsg(resp))
+
+		case *ssproto.LightBlockResponse:
+			if err := r.dispatcher.respond(src.ID(), msg); err != nil {
+				r.Logger.Debug("Failed to handle light block response", "peer", src.ID(), "err", err)
+			}
+
+		default:
+			r.Logger.Error("Received unknown message %T", msg)
+		}
+
+	case ParamsChannel:
+		switch msg := msg.(type) {
+		case *ssproto.ParamsRequest:
+			r.Logger.Debug("Received consensus params request", "height", msg.Height, "peer", src.ID())
+			if r.stateDB == nil {
+				return
+			}
+			params, err := sm.LoadConsensusParams(r.stateDB, int64(msg.Height))
+			if err != nil {
+				r.Logger.Error("Failed to load consensus params", "height", msg.Height, "err", err)
+				return
+			}
+			src.Send(ParamsChannel, mustEncodeMsg(&ssproto.ParamsResponse{
+				Height:          msg.Height,
+				ConsensusParams: params,
+			}))
+
+		case *ssproto.ParamsResponse:
+			if err := r.dispatcher.respond(src.ID(), msg); err != nil {
+				r.Logger.Debug("Failed to handle consensus params response", "peer", src.ID(), "err", err)
+			}
+
+		default:
+			r.Logger.Error("Received unknown message %T", msg)
+		}
+
 	default:
 		r.Logger.Error("Received message on invalid channel %x", chID)
 	}
 }
 
+// fetchLightBlock loads the signed header and validator set at a height, or at the latest height
+// if 0. The response is empty if they're not available.
+func (r *Reactor) fetchLightBlock(height uint64) (*ssproto.LightBlockResponse, error) {
+	if r.blockStore == nil || r.stateDB == nil {
+		return &ssproto.LightBlockResponse{}, nil
+	}
+	h := int64(height)
+	if h == 0 {
+		h = r.blockStore.Height()
+	}
+	meta := r.blockStore.LoadBlockMeta(h)
+	if meta == nil {
+		return &ssproto.LightBlockResponse{}, nil
+	}
+	// The commit for the latest block is only available as the seen commit.
+	commit := r.blockStore.LoadBlockCommit(h)
+	if commit == nil {
+		commit = r.blockStore.LoadSeenCommit(h)
+	}
+	if commit == nil {
+		return &ssproto.LightBlockResponse{}, nil
+	}
+	vals, err := sm.LoadValidators(r.stateDB, h)
+	if err != nil {
+		// The validator set may have been pruned.
+		return &ssproto.LightBlockResponse{}, nil
+	}
+	pbVals, err := vals.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	sh := &types.SignedHeader{Header: &meta.Header, Commit: commit}
+	return &ssproto.LightBlockResponse{
+		SignedHeader: sh.ToProto(),
+		ValidatorSet: pbVals,
+	}, nil
+}
+
+// snapshotRoutine adds the received snapshots to the syncer, one at a time.
+func (r *Reactor) snapshotRoutine() {
+	for {
+		select {
+		case <-r.Quit():
+			return
+		case rs := <-r.snapshotCh:
+			r.mtx.RLock()
+			syncer := r.syncer
+			r.mtx.RUnlock()
+			if syncer == nil {
+				continue
+			}
+			_, err := syncer.AddSnapshot(rs.peer, rs.snapshot)
+			if err != nil {
+				r.Logger.Error("Failed to add snapshot", "height", rs.snapshot.Height,
+					"format", rs.snapshot.Format, "peer", rs.peer.ID(), "err", err)
+			}
+		}
+	}
+}
+
+// peers returns the connected peers.
+func (r *Reactor) peers() []p2p.Peer {
+	if r.Switch == nil {
+		return nil
+	}
+	return r.Switch.Peers().List()
+}
+
 // recentSnapshots fetches the n most recent snapshots from the app
 func (r *Reactor) recentSnapshots(n uint32) ([]*snapshot, error) {
 	resp, err := r.conn.ListSnapshotsSync(abci.RequestListSnapshots{})
@@ -251,7 +431,7 @@ func (r *Reactor) Sync(stateProvider StateProvider) (sm.State, *types.Commit, er
 		r.mtx.Unlock()
 		return sm.State{}, nil, errors.New("a state sync is already in progress")
 	}
-	r.syncer = newSyncer(r.Logger, r.conn, r.connQuery, stateProvider, r.tempDir)
+	r.syncer = newSyncer(r.Logger, r.conn, r.connQuery, stateProvider, r.tempDir, r.metrics)
 	r.mtx.Unlock()
 
 	state, commit, err := r.syncer.SyncAny(defaultDiscoveryTime)
@@ -260,3 +440,14 @@ func (r *Reactor) Sync(stateProvider StateProvider) (sm.State, *types.Commit, er
 	r.mtx.Unlock()
 	return state, commit, err
 }
+
+// Progress returns the progress of the ongoing snapshot restoration, or false if none is in
+// progress.
+func (r *Reactor) Progress() (Progress, bool) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	if r.syncer == nil {
+		return Progress{}, false
+	}
+	return r.syncer.Progress()
+}
@@ -8,11 +8,17 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	dbm "github.com/mydexchain/tm-db"
+
 	abci "github.com/mydexchain/tendermint0/abci/types"
+	"github.com/mydexchain/tendermint0/libs/log"
 	"github.com/mydexchain/tendermint0/p2p"
 	p2pmocks "github.com/mydexchain/tendermint0/p2p/mocks"
 	ssproto "github.com/mydexchain/tendermint0/proto/tendermint/statesync"
 	proxymocks "github.com/mydexchain/tendermint0/proxy/mocks"
+	sm "github.com/mydexchain/tendermint0/state"
+	"github.com/mydexchain/tendermint0/statesync/mocks"
+	"github.com/mydexchain/tendermint0/types"
 )
 
 func TestReactor_Receive_ChunkRequest(t *testing.T) {
@@ -60,7 +66,7 @@ func TestReactor_Receive_ChunkRequest(t *testing.T) {
 			}
 
 			// Start a reactor and send a ssproto.ChunkRequest, then wait for and check response
-			r := NewReactor(conn, nil, "")
+			r := NewReactor(conn, nil, nil, nil, "")
 			err := r.Start()
 			require.NoError(t, err)
 			t.Cleanup(func() {
@@ -137,7 +143,7 @@ func TestReactor_Receive_SnapshotsRequest(t *testing.T) {
 			}
 
 			// Start a reactor and send a SnapshotsRequestMessage, then wait for and check responses
-			r := NewReactor(conn, nil, "")
+			r := NewReactor(conn, nil, nil, nil, "")
 			err := r.Start()
 			require.NoError(t, err)
 			t.Cleanup(func() {
@@ -155,3 +161,164 @@ func TestReactor_Receive_SnapshotsRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestReactor_Receive_SnapshotsResponse(t *testing.T) {
+	// The state provider blocks verifying the app hash of snapshots until released.
+	release := make(chan struct{})
+	verifying := make(chan uint64, snapshotQueueSize)
+	stateProvider := &mocks.StateProvider{}
+	stateProvider.On("AppHash", mock.Anything).Run(func(args mock.Arguments) {
+		verifying <- args[0].(uint64)
+		<-release
+	}).Return([]byte("app_hash"), nil)
+
+	r := NewReactor(&proxymocks.AppConnSnapshot{}, nil, nil, nil, "")
+	err := r.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		close(release)
+		if err := r.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	r.mtx.Lock()
+	r.syncer = newSyncer(log.NewNopLogger(), nil, nil, stateProvider, "", NopMetrics())
+	r.mtx.Unlock()
+
+	peer := simplePeer("id")
+	receive := func(height uint64) {
+		r.Receive(SnapshotChannel, peer, mustEncodeMsg(&ssproto.SnapshotsResponse{
+			Height: height, Format: 1, Chunks: 1, Hash: []byte{byte(height)},
+		}))
+	}
+
+	// Snapshots are verified one at a time, and those which don't fit in the queue meanwhile are
+	// dropped, without blocking the receive routine.
+	receive(1)
+	assert.EqualValues(t, 1, <-verifying)
+	for height := uint64(2); height <= snapshotQueueSize+2; height++ {
+		receive(height)
+	}
+	assert.Len(t, r.snapshotCh, snapshotQueueSize)
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, verifying)
+}
+
+// makeGenesisState creates a genesis state for a validator set, and saves it to a new state
+// database.
+func makeGenesisState(t *testing.T, vals *types.ValidatorSet) (sm.State, dbm.DB) {
+	genVals := make([]types.GenesisValidator, 0, vals.Size())
+	for _, val := range vals.Validators {
+		genVals = append(genVals, types.GenesisValidator{
+			Address: val.Address,
+			PubKey:  val.PubKey,
+			Power:   val.VotingPower,
+		})
+	}
+	state, err := sm.MakeGenesisState(&types.GenesisDoc{ChainID: "test", Validators: genVals})
+	require.NoError(t, err)
+	stateDB := dbm.NewMemDB()
+	sm.SaveState(stateDB, state)
+	return state, stateDB
+}
+
+// lightBlockStore is a block store with a single block, at height 1.
+type lightBlockStore struct {
+	sm.BlockStore
+	header types.Header
+	commit *types.Commit
+}
+
+func (s *lightBlockStore) Height() int64 { return 1 }
+
+func (s *lightBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
+	if height != 1 {
+		return nil
+	}
+	return &types.BlockMeta{Header: s.header}
+}
+
+func (s *lightBlockStore) LoadBlockCommit(height int64) *types.Commit { return nil }
+
+func (s *lightBlockStore) LoadSeenCommit(height int64) *types.Commit {
+	if height != 1 {
+		return nil
+	}
+	return s.commit
+}
+
+func TestReactor_Receive_LightBlockRequest(t *testing.T) {
+	vals, _ := types.RandValidatorSet(2, 10)
+	state, stateDB := makeGenesisState(t, vals)
+
+	blockStore := &lightBlockStore{
+		header: types.Header{ChainID: "test", Height: 1},
+		commit: types.NewCommit(1, 0, types.BlockID{}, []types.CommitSig{types.NewCommitSigAbsent()}),
+	}
+	pbVals, err := state.Validators.ToProto()
+	require.NoError(t, err)
+	sh := &types.SignedHeader{Header: &blockStore.header, Commit: blockStore.commit}
+
+	testcases := map[string]struct {
+		height         uint64
+		expectResponse *ssproto.LightBlockResponse
+	}{
+		"block":   {1, &ssproto.LightBlockResponse{SignedHeader: sh.ToProto(), ValidatorSet: pbVals}},
+		"latest":  {0, &ssproto.LightBlockResponse{SignedHeader: sh.ToProto(), ValidatorSet: pbVals}},
+		"missing": {2, &ssproto.LightBlockResponse{}},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var response *ssproto.LightBlockResponse
+			peer := &p2pmocks.Peer{}
+			peer.On("ID").Return(p2p.ID("id"))
+			peer.On("Send", LightBlockChannel, mock.Anything).Run(func(args mock.Arguments) {
+				msg, err := decodeMsg(args[1].([]byte))
+				require.NoError(t, err)
+				response = msg.(*ssproto.LightBlockResponse)
+			}).Return(true)
+
+			r := NewReactor(&proxymocks.AppConnSnapshot{}, nil, stateDB, blockStore, "")
+			err := r.Start()
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				if err := r.Stop(); err != nil {
+					t.Error(err)
+				}
+			})
+
+			r.Receive(LightBlockChannel, peer, mustEncodeMsg(&ssproto.LightBlockRequest{Height: tc.height}))
+			assert.Equal(t, tc.expectResponse, response)
+			peer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReactor_Receive_ParamsRequest(t *testing.T) {
+	vals, _ := types.RandValidatorSet(1, 10)
+	state, stateDB := makeGenesisState(t, vals)
+
+	var response *ssproto.ParamsResponse
+	peer := &p2pmocks.Peer{}
+	peer.On("ID").Return(p2p.ID("id"))
+	peer.On("Send", ParamsChannel, mock.Anything).Run(func(args mock.Arguments) {
+		msg, err := decodeMsg(args[1].([]byte))
+		require.NoError(t, err)
+		response = msg.(*ssproto.ParamsResponse)
+	}).Return(true)
+
+	r := NewReactor(&proxymocks.AppConnSnapshot{}, nil, stateDB, nil, "")
+	err := r.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := r.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	r.Receive(ParamsChannel, peer, mustEncodeMsg(&ssproto.ParamsRequest{Height: 1}))
+	require.NotNil(t, response)
+	assert.EqualValues(t, 1, response.Height)
+	assert.Equal(t, state.ConsensusParams, response.ConsensusParams)
+}
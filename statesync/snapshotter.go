@@ -0,0 +1,391 @@
+package statesync
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+
+	abci "github.com/mydexchain/tendermint0/abci/types"
+	"github.com/mydexchain/tendermint0/crypto/tmhash"
+	"github.com/mydexchain/tendermint0/libs/protoio"
+	"github.com/mydexchain/tendermint0/libs/service"
+	tmsync "github.com/mydexchain/tendermint0/libs/sync"
+	"github.com/mydexchain/tendermint0/proxy"
+	sm "github.com/mydexchain/tendermint0/state"
+)
+
+const (
+	// SnapshotFormat is the format of the snapshots taken by SnapshotManager.
+	SnapshotFormat uint32 = 1
+	// snapshotChunkSize is the size at which snapshot chunks are cut. Chunks can be slightly
+	// larger, since items are not split across chunks.
+	snapshotChunkSize = 4 * 1024 * 1024
+	// exportPageSize is the maximum size of the items exported by a single ExportState call,
+	// well below the maximum ABCI message size.
+	exportPageSize = 16 * 1024 * 1024
+	// snapshotFile is the name of the file holding a snapshot's description, next to its chunks.
+	snapshotFile = "snapshot"
+)
+
+var (
+	_ proxy.AppConnSnapshot = (*SnapshotManager)(nil)
+	_ sm.Snapshotter        = (*SnapshotManager)(nil)
+)
+
+// SnapshotManager takes snapshots of applications which don't implement snapshots themselves, by
+// exporting their state via the ExportState ABCI call every interval blocks. Snapshots are taken
+// right after committing a block, before the next one is executed, so the exported state is
+// consistent. They are stored on disk in dir, keeping the keepRecent most recent ones.
+//
+// The manager implements proxy.AppConnSnapshot, wrapping the app's snapshot connection, so that
+// the state sync reactor serves its snapshots to peers, and restores them into the app via the
+// ImportState ABCI call.
+//
+// Snapshot chunks hold the app's state items, sorted by key and length-delimited. The snapshot
+// metadata is the concatenation of the chunk hashes, and the snapshot hash is the hash of the
+// metadata, so nodes snapshotting the same state produce the same snapshot.
+type SnapshotManager struct {
+	service.BaseService
+
+	conn       proxy.AppConnSnapshot
+	dir        string
+	interval   int64
+	keepRecent int
+
+	mtx     tmsync.Mutex
+	restore *abci.Snapshot // snapshot being restored, if any
+}
+
+// NewSnapshotManager creates a new snapshot manager. Snapshots are taken after committing every
+// block whose height is a multiple of interval, once the manager is passed to the block executor
+// via state.BlockExecutorWithSnapshotter. If keepRecent is 0, all snapshots are kept.
+func NewSnapshotManager(conn proxy.AppConnSnapshot, dir string, interval int64, keepRecent int) *SnapshotManager {
+	m := &SnapshotManager{
+		conn:       conn,
+		dir:        dir,
+		interval:   interval,
+		keepRecent: keepRecent,
+	}
+	m.BaseService = *service.NewBaseService(nil, "SnapshotManager", m)
+	return m
+}
+
+// OnStart implements service.Service by checking the interval and creating the snapshot dir.
+func (m *SnapshotManager) OnStart() error {
+	if m.interval <= 0 {
+		return fmt.Errorf("invalid snapshot interval %v", m.interval)
+	}
+	if err := os.MkdirAll(m.dir, 0755); err != nil {
+		return fmt.Errorf("unable to create snapshot dir: %w", err)
+	}
+	return nil
+}
+
+// OnCommit implements state.Snapshotter, taking a snapshot of the app state committed at every
+// interval blocks. The next block is only executed once the snapshot has been taken.
+func (m *SnapshotManager) OnCommit(height int64) {
+	if !m.IsRunning() || height%m.interval != 0 {
+		return
+	}
+	snapshot, err := m.Snapshot(height)
+	if err != nil {
+		m.Logger.Error("Failed to take snapshot", "height", height, "err", err)
+		return
+	}
+	m.Logger.Info("Took snapshot", "height", snapshot.Height, "format", snapshot.Format,
+		"chunks", snapshot.Chunks, "hash", fmt.Sprintf("%X", snapshot.Hash))
+}
+
+// Snapshot exports the app state committed at the given height, which must be the last committed
+// one, and stores it as a snapshot, pruning old snapshots. The state is exported page by page,
+// and written to disk chunk by chunk.
+func (m *SnapshotManager) Snapshot(height int64) (*abci.Snapshot, error) {
+	dir := m.snapshotDir(uint64(height))
+	if _, err := os.Stat(dir); err == nil {
+		return nil, fmt.Errorf("snapshot at height %v already exists", height)
+	}
+	tmpDir := dir + ".tmp"
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(tmpDir, 0755); err != nil {
+		return nil, fmt.Errorf("unable to create snapshot dir: %w", err)
+	}
+	defer os.RemoveAll(tmpDir) // noop once the snapshot is saved
+
+	w := &chunkWriter{dir: tmpDir}
+	req := abci.RequestExportState{Height: height, MaxBytes: exportPageSize}
+	for {
+		resp, err := m.conn.ExportStateSync(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export app state: %w", err)
+		}
+		switch {
+		case resp.Height <= 0:
+			return nil, errors.New("app did not export its state")
+		case resp.Height != height:
+			return nil, fmt.Errorf("app exported its state at height %v, expected %v", resp.Height, height)
+		}
+		for _, item := range resp.Items {
+			if err := w.write(item); err != nil {
+				return nil, err
+			}
+		}
+		if len(resp.NextKey) == 0 {
+			break
+		}
+		if len(resp.Items) == 0 || bytes.Compare(resp.NextKey, w.lastKey) <= 0 {
+			return nil, fmt.Errorf("app exported an invalid page before key %X", resp.NextKey)
+		}
+		req.StartKey = resp.NextKey
+	}
+	if err := w.close(); err != nil {
+		return nil, err
+	}
+
+	snapshot := &abci.Snapshot{
+		Height:   uint64(height),
+		Format:   SnapshotFormat,
+		Chunks:   w.chunks,
+		Hash:     tmhash.Sum(w.metadata),
+		Metadata: w.metadata,
+	}
+	bz, err := proto.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, snapshotFile), bz, 0644); err != nil {
+		return nil, fmt.Errorf("failed to save snapshot: %w", err)
+	}
+	if err := os.Rename(tmpDir, dir); err != nil {
+		return nil, err
+	}
+	if err := m.prune(); err != nil {
+		return nil, fmt.Errorf("failed to prune snapshots: %w", err)
+	}
+	return snapshot, nil
+}
+
+// chunkWriter cuts state items into chunks, saved as files named after their index in dir. The
+// items must be written sorted by key.
+type chunkWriter struct {
+	dir      string
+	buf      bytes.Buffer
+	chunks   uint32
+	metadata []byte // chunk hashes
+	lastKey  []byte
+	written  bool // whether any item was written
+}
+
+// write appends an item to the current chunk, saving the chunk once full.
+func (w *chunkWriter) write(item *abci.StateItem) error {
+	if w.written && bytes.Compare(item.Key, w.lastKey) <= 0 {
+		return fmt.Errorf("app exported key %X after %X, keys must be sorted", item.Key, w.lastKey)
+	}
+	w.lastKey, w.written = item.Key, true
+	if _, err := protoio.NewDelimitedWriter(&w.buf).WriteMsg(item); err != nil {
+		return err
+	}
+	if w.buf.Len() >= snapshotChunkSize {
+		return w.save()
+	}
+	return nil
+}
+
+// close saves the last chunk. There is always at least one chunk, which is empty if there are no
+// items.
+func (w *chunkWriter) close() error {
+	if w.buf.Len() > 0 || w.chunks == 0 {
+		return w.save()
+	}
+	return nil
+}
+
+// save writes the current chunk to disk.
+func (w *chunkWriter) save() error {
+	chunk := w.buf.Bytes()
+	err := ioutil.WriteFile(filepath.Join(w.dir, strconv.FormatUint(uint64(w.chunks), 10)), chunk, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to save chunk %v: %w", w.chunks, err)
+	}
+	w.metadata = append(w.metadata, tmhash.Sum(chunk)...)
+	w.chunks++
+	w.buf.Reset()
+	return nil
+}
+
+// prune removes all but the keepRecent most recent snapshots.
+func (m *SnapshotManager) prune() error {
+	if m.keepRecent <= 0 {
+		return nil
+	}
+	heights, err := m.heights()
+	if err != nil {
+		return err
+	}
+	for i := m.keepRecent; i < len(heights); i++ {
+		if err := os.RemoveAll(m.snapshotDir(heights[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// heights returns the heights of the stored snapshots, most recent first.
+func (m *SnapshotManager) heights() ([]uint64, error) {
+	files, err := ioutil.ReadDir(m.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	heights := make([]uint64, 0, len(files))
+	for _, file := range files {
+		height, err := strconv.ParseUint(file.Name(), 10, 64)
+		if err != nil || !file.IsDir() {
+			continue
+		}
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] > heights[j] })
+	return heights, nil
+}
+
+// snapshotDir returns the directory of a snapshot.
+func (m *SnapshotManager) snapshotDir(height uint64) string {
+	return filepath.Join(m.dir, strconv.FormatUint(height, 10))
+}
+
+// Error implements proxy.AppConnSnapshot.
+func (m *SnapshotManager) Error() error {
+	return m.conn.Error()
+}
+
+// ListSnapshotsSync implements proxy.AppConnSnapshot, listing the stored snapshots.
+func (m *SnapshotManager) ListSnapshotsSync(req abci.RequestListSnapshots) (*abci.ResponseListSnapshots, error) {
+	heights, err := m.heights()
+	if err != nil {
+		return nil, err
+	}
+	resp := &abci.ResponseListSnapshots{}
+	for _, height := range heights {
+		bz, err := ioutil.ReadFile(filepath.Join(m.snapshotDir(height), snapshotFile))
+		if os.IsNotExist(err) {
+			continue // pruned since listed
+		} else if err != nil {
+			return nil, err
+		}
+		snapshot := &abci.Snapshot{}
+		if err := proto.Unmarshal(bz, snapshot); err != nil {
+			return nil, fmt.Errorf("invalid snapshot at height %v: %w", height, err)
+		}
+		resp.Snapshots = append(resp.Snapshots, snapshot)
+	}
+	return resp, nil
+}
+
+// LoadSnapshotChunkSync implements proxy.AppConnSnapshot, loading a stored chunk. The response
+// has no chunk if the snapshot or chunk doesn't exist.
+func (m *SnapshotManager) LoadSnapshotChunkSync(
+	req abci.RequestLoadSnapshotChunk) (*abci.ResponseLoadSnapshotChunk, error) {
+	if req.Format != SnapshotFormat {
+		return &abci.ResponseLoadSnapshotChunk{}, nil
+	}
+	chunk, err := ioutil.ReadFile(filepath.Join(m.snapshotDir(req.Height), strconv.FormatUint(uint64(req.Chunk), 10)))
+	if os.IsNotExist(err) {
+		return &abci.ResponseLoadSnapshotChunk{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &abci.ResponseLoadSnapshotChunk{Chunk: chunk}, nil
+}
+
+// OfferSnapshotSync implements proxy.AppConnSnapshot, accepting well-formed snapshots in the
+// manager's format for restoration.
+func (m *SnapshotManager) OfferSnapshotSync(req abci.RequestOfferSnapshot) (*abci.ResponseOfferSnapshot, error) {
+	snapshot := req.Snapshot
+	switch {
+	case snapshot == nil:
+		return &abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT}, nil
+	case snapshot.Format != SnapshotFormat:
+		return &abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT_FORMAT}, nil
+	case snapshot.Chunks == 0,
+		len(snapshot.Metadata) != int(snapshot.Chunks)*tmhash.Size,
+		!bytes.Equal(snapshot.Hash, tmhash.Sum(snapshot.Metadata)):
+		return &abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT}, nil
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.restore = snapshot
+	return &abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}, nil
+}
+
+// ApplySnapshotChunkSync implements proxy.AppConnSnapshot, verifying a chunk against the
+// snapshot metadata and importing its items into the app. Chunks must be applied in order.
+func (m *SnapshotManager) ApplySnapshotChunkSync(
+	req abci.RequestApplySnapshotChunk) (*abci.ResponseApplySnapshotChunk, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	snapshot := m.restore
+	if snapshot == nil || req.Index >= snapshot.Chunks {
+		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}, nil
+	}
+
+	hash := snapshot.Metadata[req.Index*tmhash.Size : (req.Index+1)*tmhash.Size]
+	if !bytes.Equal(hash, tmhash.Sum(req.Chunk)) {
+		return &abci.ResponseApplySnapshotChunk{
+			Result:        abci.ResponseApplySnapshotChunk_RETRY,
+			RefetchChunks: []uint32{req.Index},
+			RejectSenders: []string{req.Sender},
+		}, nil
+	}
+
+	items := []*abci.StateItem{}
+	r := protoio.NewDelimitedReader(bytes.NewReader(req.Chunk), len(req.Chunk))
+	for {
+		item := &abci.StateItem{}
+		err := r.ReadMsg(item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_REJECT_SNAPSHOT}, nil
+		}
+		items = append(items, item)
+	}
+
+	done := req.Index == snapshot.Chunks-1
+	resp, err := m.conn.ImportStateSync(abci.RequestImportState{
+		Height: int64(snapshot.Height),
+		Items:  items,
+		Done:   done,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to import app state: %w", err)
+	}
+	if resp.Result != abci.ResponseImportState_ACCEPT {
+		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}, nil
+	}
+	if done {
+		m.restore = nil
+	}
+	return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}, nil
+}
+
+// ExportStateSync implements proxy.AppConnSnapshot.
+func (m *SnapshotManager) ExportStateSync(req abci.RequestExportState) (*abci.ResponseExportState, error) {
+	return m.conn.ExportStateSync(req)
+}
+
+// ImportStateSync implements proxy.AppConnSnapshot.
+func (m *SnapshotManager) ImportStateSync(req abci.RequestImportState) (*abci.ResponseImportState, error) {
+	return m.conn.ImportStateSync(req)
+}
@@ -0,0 +1,185 @@
+package statesync
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/mydexchain/tendermint0/abci/client"
+	"github.com/mydexchain/tendermint0/abci/example/kvstore"
+	abci "github.com/mydexchain/tendermint0/abci/types"
+	"github.com/mydexchain/tendermint0/proxy"
+	proxymocks "github.com/mydexchain/tendermint0/proxy/mocks"
+)
+
+// setupSnapshotManager sets up a snapshot manager for a kvstore app, with the given txs committed
+// in a block each.
+func setupSnapshotManager(t *testing.T, keepRecent int, txs ...string) (*SnapshotManager, *kvstore.Application) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	app := kvstore.NewApplication()
+	for _, tx := range txs {
+		app.DeliverTx(abci.RequestDeliverTx{Tx: []byte(tx)})
+		app.Commit()
+	}
+	conn := proxy.NewAppConnSnapshot(abcicli.NewLocalClient(nil, app))
+	return NewSnapshotManager(conn, dir, 1, keepRecent), app
+}
+
+func TestSnapshotManager_Snapshot(t *testing.T) {
+	m, app := setupSnapshotManager(t, 1, "a=1", "b=2")
+
+	snapshot, err := m.Snapshot(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, snapshot.Height)
+	assert.Equal(t, SnapshotFormat, snapshot.Format)
+	assert.EqualValues(t, 1, snapshot.Chunks)
+
+	// A snapshot can only be taken once per height, and of the last committed height.
+	_, err = m.Snapshot(2)
+	require.Error(t, err)
+	_, err = m.Snapshot(1)
+	require.Error(t, err)
+
+	// Snapshots of the same state are identical.
+	other, _ := setupSnapshotManager(t, 1, "a=1", "b=2")
+	otherSnapshot, err := other.Snapshot(2)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, otherSnapshot)
+
+	// Older snapshots are pruned.
+	app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("c=3")})
+	app.Commit()
+	snapshot, err = m.Snapshot(3)
+	require.NoError(t, err)
+	resp, err := m.ListSnapshotsSync(abci.RequestListSnapshots{})
+	require.NoError(t, err)
+	assert.Equal(t, []*abci.Snapshot{snapshot}, resp.Snapshots)
+
+	chunk, err := m.LoadSnapshotChunkSync(abci.RequestLoadSnapshotChunk{Height: 3, Format: SnapshotFormat})
+	require.NoError(t, err)
+	assert.NotEmpty(t, chunk.Chunk)
+	chunk, err = m.LoadSnapshotChunkSync(abci.RequestLoadSnapshotChunk{Height: 2, Format: SnapshotFormat})
+	require.NoError(t, err)
+	assert.Nil(t, chunk.Chunk)
+	chunk, err = m.LoadSnapshotChunkSync(abci.RequestLoadSnapshotChunk{Height: 3, Format: 2})
+	require.NoError(t, err)
+	assert.Nil(t, chunk.Chunk)
+}
+
+func TestSnapshotManager_Restore(t *testing.T) {
+	source, sourceApp := setupSnapshotManager(t, 0, "a=1", "b=2")
+	snapshot, err := source.Snapshot(2)
+	require.NoError(t, err)
+	chunk, err := source.LoadSnapshotChunkSync(abci.RequestLoadSnapshotChunk{
+		Height: snapshot.Height, Format: snapshot.Format, Chunk: 0})
+	require.NoError(t, err)
+
+	target, targetApp := setupSnapshotManager(t, 0)
+
+	badSnapshot := *snapshot
+	badSnapshot.Format = 2
+	offer, err := target.OfferSnapshotSync(abci.RequestOfferSnapshot{Snapshot: &badSnapshot})
+	require.NoError(t, err)
+	assert.Equal(t, abci.ResponseOfferSnapshot_REJECT_FORMAT, offer.Result)
+	badSnapshot = *snapshot
+	badSnapshot.Hash = []byte{1, 2, 3}
+	offer, err = target.OfferSnapshotSync(abci.RequestOfferSnapshot{Snapshot: &badSnapshot})
+	require.NoError(t, err)
+	assert.Equal(t, abci.ResponseOfferSnapshot_REJECT, offer.Result)
+
+	offer, err = target.OfferSnapshotSync(abci.RequestOfferSnapshot{Snapshot: snapshot})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseOfferSnapshot_ACCEPT, offer.Result)
+
+	// A chunk not matching the snapshot metadata is refetched from another peer.
+	apply, err := target.ApplySnapshotChunkSync(abci.RequestApplySnapshotChunk{
+		Index: 0, Chunk: []byte{1, 2, 3}, Sender: "bad"})
+	require.NoError(t, err)
+	assert.Equal(t, &abci.ResponseApplySnapshotChunk{
+		Result:        abci.ResponseApplySnapshotChunk_RETRY,
+		RefetchChunks: []uint32{0},
+		RejectSenders: []string{"bad"},
+	}, apply)
+
+	apply, err = target.ApplySnapshotChunkSync(abci.RequestApplySnapshotChunk{
+		Index: 0, Chunk: chunk.Chunk, Sender: "good"})
+	require.NoError(t, err)
+	assert.Equal(t, abci.ResponseApplySnapshotChunk_ACCEPT, apply.Result)
+
+	assert.Equal(t, sourceApp.Info(abci.RequestInfo{}), targetApp.Info(abci.RequestInfo{}))
+	query := targetApp.Query(abci.RequestQuery{Data: []byte("b")})
+	assert.Equal(t, []byte("2"), query.Value)
+}
+
+func TestSnapshotManager_Snapshot_Pages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	// The app state is exported in pages, and cut into chunks independently of the pages.
+	value := make([]byte, snapshotChunkSize/2)
+	conn := &proxymocks.AppConnSnapshot{}
+	conn.On("ExportStateSync", abci.RequestExportState{Height: 5, MaxBytes: exportPageSize}).Return(
+		&abci.ResponseExportState{Height: 5, Items: []*abci.StateItem{
+			{Key: []byte("a"), Value: value}, {Key: []byte("b"), Value: value},
+		}, NextKey: []byte("c")}, nil)
+	conn.On("ExportStateSync", abci.RequestExportState{Height: 5, StartKey: []byte("c"), MaxBytes: exportPageSize}).
+		Return(&abci.ResponseExportState{Height: 5, Items: []*abci.StateItem{
+			{Key: []byte("c"), Value: value},
+		}}, nil)
+	m := NewSnapshotManager(conn, dir, 1, 0)
+	snapshot, err := m.Snapshot(5)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, snapshot.Chunks)
+	conn.AssertExpectations(t)
+
+	testcases := map[string]*abci.ResponseExportState{
+		"no state":     {},
+		"wrong height": {Height: 7},
+		"unsorted":     {Height: 6, Items: []*abci.StateItem{{Key: []byte("b")}, {Key: []byte("a")}}},
+		"empty page":   {Height: 6, NextKey: []byte("a")},
+		"bad next key": {Height: 6, Items: []*abci.StateItem{{Key: []byte("b")}}, NextKey: []byte("a")},
+	}
+	for name, resp := range testcases {
+		resp := resp
+		t.Run(name, func(t *testing.T) {
+			conn := &proxymocks.AppConnSnapshot{}
+			conn.On("ExportStateSync", abci.RequestExportState{Height: 6, MaxBytes: exportPageSize}).Return(resp, nil)
+			m := NewSnapshotManager(conn, dir, 1, 0)
+			_, err := m.Snapshot(6)
+			require.Error(t, err)
+			resp, err := m.ListSnapshotsSync(abci.RequestListSnapshots{})
+			require.NoError(t, err)
+			assert.Len(t, resp.Snapshots, 1)
+		})
+	}
+}
+
+func TestSnapshotManager_OnCommit(t *testing.T) {
+	m, app := setupSnapshotManager(t, 0, "a=1", "b=2")
+	m.interval = 2
+
+	// Commits are ignored until the manager is started.
+	m.OnCommit(2)
+	resp, err := m.ListSnapshotsSync(abci.RequestListSnapshots{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Snapshots)
+
+	require.NoError(t, m.Start())
+	t.Cleanup(func() { _ = m.Stop() })
+	m.OnCommit(2)
+	app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("c=3")})
+	app.Commit()
+	m.OnCommit(3)
+
+	resp, err = m.ListSnapshotsSync(abci.RequestListSnapshots{})
+	require.NoError(t, err)
+	require.Len(t, resp.Snapshots, 1)
+	assert.EqualValues(t, 2, resp.Snapshots[0].Height)
+}
@@ -1,6 +1,8 @@
 package statesync
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -13,8 +15,11 @@ import (
 	lightprovider "github.com/mydexchain/tendermint0/light/provider"
 	lighthttp "github.com/mydexchain/tendermint0/light/provider/http"
 	lightrpc "github.com/mydexchain/tendermint0/light/rpc"
+	lightstore "github.com/mydexchain/tendermint0/light/store"
 	lightdb "github.com/mydexchain/tendermint0/light/store/db"
+	"github.com/mydexchain/tendermint0/p2p"
 	tmstate "github.com/mydexchain/tendermint0/proto/tendermint/state"
+	ssproto "github.com/mydexchain/tendermint0/proto/tendermint/statesync"
 	rpchttp "github.com/mydexchain/tendermint0/rpc/client/http"
 	sm "github.com/mydexchain/tendermint0/state"
 	"github.com/mydexchain/tendermint0/types"
@@ -86,35 +91,286 @@ func NewLightClientStateProvider(
 func (s *lightClientStateProvider) AppHash(height uint64) ([]byte, error) {
 	s.Lock()
 	defer s.Unlock()
+	return verifiedAppHash(s.lc, height)
+}
 
-	// We have to fetch the next height, which contains the app hash for the previous height.
-	header, err := s.lc.VerifyHeaderAtHeight(int64(height+1), time.Now())
+// Commit implements StateProvider.
+func (s *lightClientStateProvider) Commit(height uint64) (*types.Commit, error) {
+	s.Lock()
+	defer s.Unlock()
+	return verifiedCommit(s.lc, height)
+}
+
+// State implements StateProvider.
+func (s *lightClientStateProvider) State(height uint64) (sm.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	state, nextHeader, err := verifiedState(s.lc, s.version, s.initialHeight, height)
 	if err != nil {
+		return sm.State{}, err
+	}
+
+	// We'll also need to fetch consensus params via RPC, using light client verification.
+	primaryURL, ok := s.providers[s.lc.Primary()]
+	if !ok || primaryURL == "" {
+		return sm.State{}, fmt.Errorf("could not find address for primary light client provider")
+	}
+	primaryRPC, err := rpcClient(primaryURL)
+	if err != nil {
+		return sm.State{}, fmt.Errorf("unable to create RPC client: %w", err)
+	}
+	rpcclient := lightrpc.NewClient(primaryRPC, s.lc)
+	result, err := rpcclient.ConsensusParams(&nextHeader.Height)
+	if err != nil {
+		return sm.State{}, fmt.Errorf("unable to fetch consensus parameters for height %v: %w",
+			nextHeader.Height, err)
+	}
+	state.ConsensusParams = result.ConsensusParams
+
+	return state, nil
+}
+
+const (
+	// lightClientPeersTimeout is the time the p2p state provider waits for the 2 peers required
+	// by the light client.
+	lightClientPeersTimeout = 10 * time.Second
+	// lightClientPeersInterval is the interval at which the p2p state provider checks for peers.
+	lightClientPeersInterval = 100 * time.Millisecond
+)
+
+// p2pStateProvider is a state provider using the light client, fetching light blocks and consensus
+// params from peers over p2p instead of from RPC servers.
+type p2pStateProvider struct {
+	tmsync.Mutex                                    // light.Client is not concurrency-safe
+	lc            *light.Client                     // set up once there are enough peers
+	lcPeers       []p2p.ID                          // peers of the light client's providers
+	providers     map[p2p.ID]lightprovider.Provider // built on first use, by peer
+	store         lightstore.Store                  // kept when the light client is set up again
+	peersTimeout  time.Duration
+	chainID       string
+	version       tmstate.Version
+	initialHeight int64
+	trustOptions  light.TrustOptions
+	logger        log.Logger
+	peers         func() []p2p.Peer
+	dispatcher    *dispatcher
+}
+
+// NewP2PStateProvider creates a new StateProvider using a light client, which fetches light blocks
+// and consensus params from the peers of the given reactor. The light client is set up on first
+// use, and requires at least 2 peers: one primary and one witness. It is set up again with the
+// current peers when one of its peers disconnects.
+func NewP2PStateProvider(
+	r *Reactor,
+	chainID string,
+	version tmstate.Version,
+	initialHeight int64,
+	trustOptions light.TrustOptions,
+	logger log.Logger,
+) (StateProvider, error) {
+	if err := trustOptions.ValidateBasic(); err != nil {
 		return nil, err
 	}
-	return header.AppHash, nil
+	return newP2PStateProvider(chainID, version, initialHeight, trustOptions, logger,
+		r.peers, r.dispatcher), nil
+}
+
+// newP2PStateProvider creates a new p2pStateProvider, with peers to fetch data from provided by
+// the peers function.
+func newP2PStateProvider(
+	chainID string,
+	version tmstate.Version,
+	initialHeight int64,
+	trustOptions light.TrustOptions,
+	logger log.Logger,
+	peers func() []p2p.Peer,
+	dispatcher *dispatcher,
+) *p2pStateProvider {
+	return &p2pStateProvider{
+		providers:     make(map[p2p.ID]lightprovider.Provider),
+		store:         lightdb.New(dbm.NewMemDB(), ""),
+		peersTimeout:  lightClientPeersTimeout,
+		chainID:       chainID,
+		version:       version,
+		initialHeight: initialHeight,
+		trustOptions:  trustOptions,
+		logger:        logger,
+		peers:         peers,
+		dispatcher:    dispatcher,
+	}
+}
+
+// client returns the light client, setting it up with the current peers if it hasn't been yet,
+// if one of its peers has disconnected, or if it has removed all its witnesses. The caller must
+// hold the mutex lock.
+func (s *p2pStateProvider) client() (*light.Client, error) {
+	peers, err := s.waitForPeers()
+	if err != nil {
+		return nil, err
+	}
+	if s.lc != nil && len(s.lc.Witnesses()) > 0 && s.connected(peers, s.lcPeers) {
+		return s.lc, nil
+	}
+
+	// Build the providers of new peers, and forget those of disconnected ones.
+	providers := make(map[p2p.ID]lightprovider.Provider, len(peers))
+	lcPeers := make([]p2p.ID, 0, len(peers))
+	lcProviders := make([]lightprovider.Provider, 0, len(peers))
+	for _, peer := range peers {
+		provider, ok := s.providers[peer.ID()]
+		if !ok {
+			provider = newBlockProvider(s.chainID, peer, s.dispatcher)
+		}
+		providers[peer.ID()] = provider
+		lcPeers = append(lcPeers, peer.ID())
+		lcProviders = append(lcProviders, provider)
+	}
+	s.providers = providers
+
+	var lc *light.Client
+	if s.lc == nil {
+		lc, err = light.NewClient(s.chainID, s.trustOptions, lcProviders[0], lcProviders[1:],
+			s.store, light.Logger(s.logger), light.MaxRetryAttempts(5))
+	} else {
+		// The trusted store was initialized by the previous light client.
+		s.logger.Info("Setting up light client with new peers", "peers", len(peers))
+		lc, err = light.NewClientFromTrustedStore(s.chainID, s.trustOptions.Period, lcProviders[0],
+			lcProviders[1:], s.store, light.Logger(s.logger), light.MaxRetryAttempts(5))
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.lc = lc
+	s.lcPeers = lcPeers
+	return lc, nil
+}
+
+// waitForPeers returns the current peers, waiting for the 2 peers required by the light client
+// until peersTimeout.
+func (s *p2pStateProvider) waitForPeers() ([]p2p.Peer, error) {
+	timeout := time.NewTimer(s.peersTimeout)
+	defer timeout.Stop()
+	ticker := time.NewTicker(lightClientPeersInterval)
+	defer ticker.Stop()
+	for {
+		peers := s.peers()
+		if len(peers) >= 2 {
+			return peers, nil
+		}
+		select {
+		case <-ticker.C:
+		case <-timeout.C:
+			return nil, fmt.Errorf("at least 2 peers are required for light client verification, got %v",
+				len(peers))
+		}
+	}
+}
+
+// connected returns true if all the given peer IDs are among the peers.
+func (s *p2pStateProvider) connected(peers []p2p.Peer, peerIDs []p2p.ID) bool {
+	connected := make(map[p2p.ID]bool, len(peers))
+	for _, peer := range peers {
+		connected[peer.ID()] = true
+	}
+	for _, id := range peerIDs {
+		if !connected[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// AppHash implements StateProvider.
+func (s *p2pStateProvider) AppHash(height uint64) ([]byte, error) {
+	s.Lock()
+	defer s.Unlock()
+	lc, err := s.client()
+	if err != nil {
+		return nil, err
+	}
+	return verifiedAppHash(lc, height)
 }
 
 // Commit implements StateProvider.
-func (s *lightClientStateProvider) Commit(height uint64) (*types.Commit, error) {
+func (s *p2pStateProvider) Commit(height uint64) (*types.Commit, error) {
 	s.Lock()
 	defer s.Unlock()
-	header, err := s.lc.VerifyHeaderAtHeight(int64(height), time.Now())
+	lc, err := s.client()
 	if err != nil {
 		return nil, err
 	}
-	return header.Commit, nil
+	return verifiedCommit(lc, height)
 }
 
 // State implements StateProvider.
-func (s *lightClientStateProvider) State(height uint64) (sm.State, error) {
+func (s *p2pStateProvider) State(height uint64) (sm.State, error) {
 	s.Lock()
 	defer s.Unlock()
+	lc, err := s.client()
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	state, nextHeader, err := verifiedState(lc, s.version, s.initialHeight, height)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	// We'll also need to fetch consensus params from peers, and verify them against the consensus
+	// hash of the verified header.
+	for _, peer := range s.peers() {
+		resp, err := s.dispatcher.call(context.Background(), peer, ParamsChannel,
+			&ssproto.ParamsRequest{Height: uint64(nextHeader.Height)})
+		if err != nil {
+			s.logger.Debug("Failed to fetch consensus params", "peer", peer.ID(), "err", err)
+			continue
+		}
+		msg, ok := resp.(*ssproto.ParamsResponse)
+		if !ok || msg.Height != uint64(nextHeader.Height) {
+			s.logger.Debug("Received unexpected response to consensus params request", "peer", peer.ID())
+			continue
+		}
+		if !bytes.Equal(types.HashConsensusParams(msg.ConsensusParams), nextHeader.ConsensusHash) {
+			s.logger.Info("Received invalid consensus params", "peer", peer.ID(), "height", msg.Height)
+			continue
+		}
+		state.ConsensusParams = msg.ConsensusParams
+		return state, nil
+	}
+	return sm.State{}, fmt.Errorf("unable to fetch consensus parameters for height %v from any peer",
+		nextHeader.Height)
+}
+
+// verifiedAppHash returns the app hash after the given height has been committed, verified by the
+// light client.
+func verifiedAppHash(lc *light.Client, height uint64) ([]byte, error) {
+	// We have to fetch the next height, which contains the app hash for the previous height.
+	header, err := lc.VerifyHeaderAtHeight(int64(height+1), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return header.AppHash, nil
+}
+
+// verifiedCommit returns the commit at the given height, verified by the light client.
+func verifiedCommit(lc *light.Client, height uint64) (*types.Commit, error) {
+	header, err := lc.VerifyHeaderAtHeight(int64(height), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return header.Commit, nil
+}
 
+// verifiedState builds a state object at the given height from headers and validator sets verified
+// by the light client, except for the consensus params. It also returns the header at the next
+// height, whose consensus hash can be used to verify the consensus params.
+func verifiedState(lc *light.Client, version tmstate.Version, initialHeight int64,
+	height uint64) (sm.State, *types.SignedHeader, error) {
 	state := sm.State{
-		ChainID:       s.lc.ChainID(),
-		Version:       s.version,
-		InitialHeight: s.initialHeight,
+		ChainID:       lc.ChainID(),
+		Version:       version,
+		InitialHeight: initialHeight,
 	}
 	if state.InitialHeight == 0 {
 		state.InitialHeight = 1
@@ -122,17 +378,17 @@ func (s *lightClientStateProvider) State(height uint64) (sm.State, error) {
 
 	// We need to verify up until h+2, to get the validator set. This also prefetches the headers
 	// for h and h+1 in the typical case where the trusted header is after the snapshot height.
-	_, err := s.lc.VerifyHeaderAtHeight(int64(height+2), time.Now())
+	_, err := lc.VerifyHeaderAtHeight(int64(height+2), time.Now())
 	if err != nil {
-		return sm.State{}, err
+		return sm.State{}, nil, err
 	}
-	header, err := s.lc.VerifyHeaderAtHeight(int64(height), time.Now())
+	header, err := lc.VerifyHeaderAtHeight(int64(height), time.Now())
 	if err != nil {
-		return sm.State{}, err
+		return sm.State{}, nil, err
 	}
-	nextHeader, err := s.lc.VerifyHeaderAtHeight(int64(height+1), time.Now())
+	nextHeader, err := lc.VerifyHeaderAtHeight(int64(height+1), time.Now())
 	if err != nil {
-		return sm.State{}, err
+		return sm.State{}, nil, err
 	}
 	state.LastBlockHeight = header.Height
 	state.LastBlockTime = header.Time
@@ -140,38 +396,21 @@ func (s *lightClientStateProvider) State(height uint64) (sm.State, error) {
 	state.AppHash = nextHeader.AppHash
 	state.LastResultsHash = nextHeader.LastResultsHash
 
-	state.LastValidators, _, err = s.lc.TrustedValidatorSet(int64(height))
+	state.LastValidators, _, err = lc.TrustedValidatorSet(int64(height))
 	if err != nil {
-		return sm.State{}, err
+		return sm.State{}, nil, err
 	}
-	state.Validators, _, err = s.lc.TrustedValidatorSet(int64(height + 1))
+	state.Validators, _, err = lc.TrustedValidatorSet(int64(height + 1))
 	if err != nil {
-		return sm.State{}, err
+		return sm.State{}, nil, err
 	}
-	state.NextValidators, _, err = s.lc.TrustedValidatorSet(int64(height + 2))
+	state.NextValidators, _, err = lc.TrustedValidatorSet(int64(height + 2))
 	if err != nil {
-		return sm.State{}, err
+		return sm.State{}, nil, err
 	}
 	state.LastHeightValidatorsChanged = int64(height)
 
-	// We'll also need to fetch consensus params via RPC, using light client verification.
-	primaryURL, ok := s.providers[s.lc.Primary()]
-	if !ok || primaryURL == "" {
-		return sm.State{}, fmt.Errorf("could not find address for primary light client provider")
-	}
-	primaryRPC, err := rpcClient(primaryURL)
-	if err != nil {
-		return sm.State{}, fmt.Errorf("unable to create RPC client: %w", err)
-	}
-	rpcclient := lightrpc.NewClient(primaryRPC, s.lc)
-	result, err := rpcclient.ConsensusParams(&nextHeader.Height)
-	if err != nil {
-		return sm.State{}, fmt.Errorf("unable to fetch consensus parameters for height %v: %w",
-			nextHeader.Height, err)
-	}
-	state.ConsensusParams = result.ConsensusParams
-
-	return state, nil
+	return state, nextHeader, nil
 }
 
 // rpcClient sets up a new RPC client
@@ -0,0 +1,138 @@
+package statesync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mydexchain/tendermint0/crypto/ed25519"
+	"github.com/mydexchain/tendermint0/libs/log"
+	tmsync "github.com/mydexchain/tendermint0/libs/sync"
+	"github.com/mydexchain/tendermint0/light"
+	"github.com/mydexchain/tendermint0/p2p"
+	tmstate "github.com/mydexchain/tendermint0/proto/tendermint/state"
+	ssproto "github.com/mydexchain/tendermint0/proto/tendermint/statesync"
+	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
+	"github.com/mydexchain/tendermint0/types"
+)
+
+// makeLightBlocks returns a chain of light blocks at heights 1 to n, signed by a single validator.
+func makeLightBlocks(t *testing.T, chainID string, n int64) map[int64]*types.LightBlock {
+	privKey := ed25519.GenPrivKey()
+	vals := types.NewValidatorSet([]*types.Validator{types.NewValidator(privKey.PubKey(), 10)})
+	start := time.Now().Add(-time.Minute)
+
+	blocks := make(map[int64]*types.LightBlock, n)
+	for height := int64(1); height <= n; height++ {
+		header := &types.Header{
+			ChainID:            chainID,
+			Height:             height,
+			Time:               start.Add(time.Duration(height) * time.Second),
+			ValidatorsHash:     vals.Hash(),
+			NextValidatorsHash: vals.Hash(),
+			ProposerAddress:    vals.Validators[0].Address,
+		}
+		blockID := types.BlockID{
+			Hash:          header.Hash(),
+			PartSetHeader: types.PartSetHeader{Total: 1, Hash: make([]byte, 32)},
+		}
+		vote := &types.Vote{
+			Type:             tmproto.PrecommitType,
+			Height:           height,
+			BlockID:          blockID,
+			Timestamp:        header.Time,
+			ValidatorAddress: vals.Validators[0].Address,
+		}
+		sig, err := privKey.Sign(types.VoteSignBytes(chainID, vote.ToProto()))
+		require.NoError(t, err)
+		vote.Signature = sig
+		blocks[height] = &types.LightBlock{
+			SignedHeader: &types.SignedHeader{
+				Header: header,
+				Commit: types.NewCommit(height, 0, blockID, []types.CommitSig{vote.CommitSig()}),
+			},
+			ValidatorSet: vals,
+		}
+	}
+	return blocks
+}
+
+// testPeers is a set of peers which can change during a test.
+type testPeers struct {
+	tmsync.Mutex
+	peers []p2p.Peer
+}
+
+func (p *testPeers) set(peers ...p2p.Peer) {
+	p.Lock()
+	defer p.Unlock()
+	p.peers = peers
+}
+
+func (p *testPeers) get() []p2p.Peer {
+	p.Lock()
+	defer p.Unlock()
+	return p.peers
+}
+
+func TestP2PStateProvider_client(t *testing.T) {
+	blocks := makeLightBlocks(t, "test", 3)
+	d := newDispatcher(time.Second)
+	lightBlockPeer := func(id p2p.ID) p2p.Peer {
+		return respondingPeer(id, d, func(req *ssproto.LightBlockRequest) *ssproto.LightBlockResponse {
+			block, ok := blocks[int64(req.Height)]
+			if req.Height == 0 {
+				block, ok = blocks[3], true
+			}
+			if !ok {
+				return &ssproto.LightBlockResponse{}
+			}
+			pbVals, err := block.ValidatorSet.ToProto()
+			require.NoError(t, err)
+			return &ssproto.LightBlockResponse{SignedHeader: block.SignedHeader.ToProto(), ValidatorSet: pbVals}
+		})
+	}
+	a, b, c := lightBlockPeer("a"), lightBlockPeer("b"), lightBlockPeer("c")
+
+	peers := &testPeers{}
+	trustOptions := light.TrustOptions{Period: time.Hour, Height: 1, Hash: blocks[1].Hash()}
+	s := newP2PStateProvider("test", tmstate.Version{}, 1, trustOptions, log.TestingLogger(), peers.get, d)
+	s.peersTimeout = 200 * time.Millisecond
+	s.Lock()
+	defer s.Unlock()
+
+	// The light client needs 2 peers.
+	peers.set(a)
+	_, err := s.client()
+	require.Error(t, err)
+
+	// It waits for them to connect.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		peers.set(a, b)
+	}()
+	lc, err := s.client()
+	require.NoError(t, err)
+	assert.Equal(t, []p2p.ID{"a", "b"}, s.lcPeers)
+	providerB := s.providers["b"]
+
+	// The light client is kept while its peers are connected.
+	peers.set(a, b, c)
+	lc2, err := s.client()
+	require.NoError(t, err)
+	assert.True(t, lc == lc2)
+
+	// It is set up again when one of them disconnects, keeping the trusted light blocks.
+	peers.set(b, c)
+	lc3, err := s.client()
+	require.NoError(t, err)
+	assert.False(t, lc == lc3)
+	assert.Equal(t, []p2p.ID{"b", "c"}, s.lcPeers)
+	assert.True(t, providerB == s.providers["b"])
+	assert.NotContains(t, s.providers, p2p.ID("a"))
+	header, err := lc3.TrustedHeader(1)
+	require.NoError(t, err)
+	assert.Equal(t, blocks[1].Hash(), header.Hash())
+}
@@ -20,12 +20,15 @@ import (
 const (
 	// defaultDiscoveryTime is the time to spend discovering snapshots.
 	defaultDiscoveryTime = 20 * time.Second
-	// chunkFetchers is the number of concurrent chunk fetchers to run.
-	chunkFetchers = 4
+	// chunkFetchers is the maximum number of concurrent chunk fetchers to run. The number of
+	// requests sent to each peer is further limited by peerScores.
+	chunkFetchers = 16
 	// chunkTimeout is the timeout while waiting for the next chunk from the chunk queue.
 	chunkTimeout = 2 * time.Minute
 	// requestTimeout is the timeout before rerequesting a chunk, possibly from a different peer.
 	chunkRequestTimeout = 10 * time.Second
+	// peerWaitInterval is the time to wait before retrying when no peer can take a chunk request.
+	peerWaitInterval = 100 * time.Millisecond
 )
 
 var (
@@ -47,6 +50,16 @@ var (
 	errNoSnapshots = errors.New("no suitable snapshots found")
 )
 
+// Progress describes the progress of a snapshot restoration.
+type Progress struct {
+	Height         uint64        // snapshot height
+	Format         uint32        // snapshot format
+	ChunksTotal    uint32        // number of chunks in the snapshot
+	ChunksApplied  uint32        // number of chunks applied to the app
+	BytesPerSecond int64         // rate at which chunk bytes are applied to the app
+	ETA            time.Duration // estimated time until the snapshot is restored
+}
+
 // syncer runs a state sync against an ABCI app. Use either SyncAny() to automatically attempt to
 // sync all snapshots in the pool (pausing to discover new ones), or Sync() to sync a specific
 // snapshot. Snapshots and chunks are fed via AddSnapshot() and AddChunk() as appropriate.
@@ -56,22 +69,32 @@ type syncer struct {
 	conn          proxy.AppConnSnapshot
 	connQuery     proxy.AppConnQuery
 	snapshots     *snapshotPool
+	peers         *peerScores
 	tempDir       string
+	metrics       *Metrics
 
 	mtx    tmsync.RWMutex
 	chunks *chunkQueue
+
+	// progress of the snapshot restoration, protected by mtx
+	restoring     *snapshot
+	restoreStart  time.Time
+	chunksApplied uint32
+	bytesApplied  int64
 }
 
 // newSyncer creates a new syncer.
 func newSyncer(logger log.Logger, conn proxy.AppConnSnapshot, connQuery proxy.AppConnQuery,
-	stateProvider StateProvider, tempDir string) *syncer {
+	stateProvider StateProvider, tempDir string, metrics *Metrics) *syncer {
 	return &syncer{
 		logger:        logger,
 		stateProvider: stateProvider,
 		conn:          conn,
 		connQuery:     connQuery,
 		snapshots:     newSnapshotPool(stateProvider),
+		peers:         newPeerScores(),
 		tempDir:       tempDir,
+		metrics:       metrics,
 	}
 }
 
@@ -122,6 +145,66 @@ func (s *syncer) AddPeer(peer p2p.Peer) {
 func (s *syncer) RemovePeer(peer p2p.Peer) {
 	s.logger.Debug("Removing peer from sync", "peer", peer.ID())
 	s.snapshots.RemovePeer(peer.ID())
+	s.peers.Remove(peer.ID())
+}
+
+// Progress returns the progress of the ongoing snapshot restoration, or false if no snapshot is
+// being restored.
+func (s *syncer) Progress() (Progress, bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	if s.restoring == nil {
+		return Progress{}, false
+	}
+	return s.progress(), true
+}
+
+// progress computes the restoration progress. The caller must hold the mutex lock, and a snapshot
+// must be restoring.
+func (s *syncer) progress() Progress {
+	p := Progress{
+		Height:        s.restoring.Height,
+		Format:        s.restoring.Format,
+		ChunksTotal:   s.restoring.Chunks,
+		ChunksApplied: s.chunksApplied,
+	}
+	elapsed := time.Since(s.restoreStart)
+	if elapsed > 0 {
+		p.BytesPerSecond = int64(float64(s.bytesApplied) / elapsed.Seconds())
+	}
+	if s.chunksApplied > 0 {
+		p.ETA = elapsed / time.Duration(s.chunksApplied) * time.Duration(p.ChunksTotal-s.chunksApplied)
+	}
+	return p
+}
+
+// startProgress resets the restoration progress for a snapshot.
+func (s *syncer) startProgress(snapshot *snapshot) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.restoring = snapshot
+	s.restoreStart = time.Now()
+	s.chunksApplied = 0
+	s.bytesApplied = 0
+	s.metrics.SnapshotHeight.Set(float64(snapshot.Height))
+	s.metrics.SnapshotChunksTotal.Set(float64(snapshot.Chunks))
+	s.metrics.SnapshotChunksApplied.Set(0)
+}
+
+// recordChunk records a chunk applied to the app, updating the restoration progress.
+func (s *syncer) recordChunk(chunk *chunk) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.restoring == nil {
+		return
+	}
+	// Chunks are applied in order, so this also accounts for chunks applied again after a retry.
+	s.chunksApplied = chunk.Index + 1
+	s.bytesApplied += int64(len(chunk.Chunk))
+	p := s.progress()
+	s.metrics.SnapshotChunksApplied.Set(float64(p.ChunksApplied))
+	s.metrics.SnapshotBytesPerSecond.Set(float64(p.BytesPerSecond))
+	s.metrics.SnapshotETASeconds.Set(p.ETA.Seconds())
 }
 
 // SyncAny tries to sync any of the snapshots in the snapshot pool, waiting to discover further
@@ -225,6 +308,7 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.
 	defer func() {
 		s.mtx.Lock()
 		s.chunks = nil
+		s.restoring = nil
 		s.mtx.Unlock()
 	}()
 
@@ -233,6 +317,7 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.
 	if err != nil {
 		return sm.State{}, nil, err
 	}
+	s.startProgress(snapshot)
 
 	// Spawn chunk fetchers. They will terminate when the chunk queue is closed or context cancelled.
 	ctx, cancel := context.WithCancel(context.Background())
@@ -329,8 +414,9 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 		s.logger.Info("Applied snapshot chunk to ABCI app", "height", chunk.Height,
 			"format", chunk.Format, "chunk", chunk.Index, "total", chunks.Size())
 
-		// Discard and refetch any chunks as requested by the app
+		// Discard and refetch any chunks as requested by the app, penalizing their senders
 		for _, index := range resp.RefetchChunks {
+			s.peers.Penalize(chunks.GetSender(index))
 			err := chunks.Discard(index)
 			if err != nil {
 				return fmt.Errorf("failed to discard chunk %v: %w", index, err)
@@ -350,6 +436,7 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 
 		switch resp.Result {
 		case abci.ResponseApplySnapshotChunk_ACCEPT:
+			s.recordChunk(chunk)
 		case abci.ResponseApplySnapshotChunk_ABORT:
 			return errAbort
 		case abci.ResponseApplySnapshotChunk_RETRY:
@@ -368,47 +455,91 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 // will be received from the reactor via syncer.AddChunks() to chunkQueue.Add().
 func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *chunkQueue) {
 	for {
+		// Wait for a peer to take the request before allocating a chunk, so that chunks aren't
+		// held up by fetchers waiting for peers.
+		peer := s.acquirePeer(ctx, snapshot, "")
+		if peer == nil {
+			return
+		}
 		index, err := chunks.Allocate()
 		if err == errDone {
+			s.peers.Release(peer.ID())
 			// Keep checking until the context is cancelled (restore is done), in case any
 			// chunks need to be refetched.
 			select {
 			case <-ctx.Done():
 				return
-			default:
+			case <-time.After(2 * time.Second):
 			}
-			time.Sleep(2 * time.Second)
 			continue
 		}
 		if err != nil {
+			s.peers.Release(peer.ID())
 			s.logger.Error("Failed to allocate chunk from queue", "err", err)
 			return
 		}
 		s.logger.Info("Fetching snapshot chunk", "height", snapshot.Height,
 			"format", snapshot.Format, "chunk", index, "total", chunks.Size())
+		s.fetchChunk(ctx, snapshot, chunks, index, peer)
+	}
+}
 
-		ticker := time.NewTicker(chunkRequestTimeout)
-		defer ticker.Stop()
-		s.requestChunk(snapshot, index)
+// fetchChunk requests a chunk from a peer, which must have been acquired via acquirePeer(). If the
+// request times out, the chunk is requested again, preferably from a different peer, until it
+// arrives or the context is cancelled.
+func (s *syncer) fetchChunk(ctx context.Context, snapshot *snapshot, chunks *chunkQueue,
+	index uint32, peer p2p.Peer) {
+	for {
+		start := time.Now()
+		s.requestChunk(snapshot, index, peer)
+		timer := time.NewTimer(chunkRequestTimeout)
 		select {
-		case <-chunks.WaitFor(index):
-		case <-ticker.C:
-			s.requestChunk(snapshot, index)
+		case _, ok := <-chunks.WaitFor(index):
+			timer.Stop()
+			if ok && chunks.GetSender(index) == peer.ID() {
+				s.peers.Success(peer.ID(), time.Since(start))
+			} else {
+				s.peers.Release(peer.ID())
+			}
+			return
+		case <-timer.C:
+			s.logger.Debug("Timed out waiting for snapshot chunk, requesting it again", "height",
+				snapshot.Height, "format", snapshot.Format, "chunk", index, "peer", peer.ID())
+			s.metrics.ChunkRequestTimeouts.Add(1)
+			s.peers.Failure(peer.ID())
 		case <-ctx.Done():
+			timer.Stop()
+			s.peers.Release(peer.ID())
+			return
+		}
+		peer = s.acquirePeer(ctx, snapshot, peer.ID())
+		if peer == nil {
 			return
 		}
-		ticker.Stop()
 	}
 }
 
-// requestChunk requests a chunk from a peer.
-func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
-	peer := s.snapshots.GetPeer(snapshot)
-	if peer == nil {
-		s.logger.Error("No valid peers found for snapshot", "height", snapshot.Height,
-			"format", snapshot.Format, "hash", snapshot.Hash)
-		return
+// acquirePeer waits for a peer with the snapshot to be able to take a chunk request, preferring
+// peers other than exclude. It returns nil if the context is cancelled.
+func (s *syncer) acquirePeer(ctx context.Context, snapshot *snapshot, exclude p2p.ID) p2p.Peer {
+	for {
+		peers := s.snapshots.GetPeers(snapshot)
+		if len(peers) == 0 {
+			s.logger.Debug("No valid peers found for snapshot", "height", snapshot.Height,
+				"format", snapshot.Format, "hash", snapshot.Hash)
+		} else if peer := s.peers.Acquire(peers, exclude); peer != nil {
+			return peer
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(peerWaitInterval):
+		}
 	}
+}
+
+// requestChunk requests a chunk from a peer.
+func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32, peer p2p.Peer) {
 	s.logger.Debug("Requesting snapshot chunk", "height", snapshot.Height,
 		"format", snapshot.Format, "chunk", chunk, "peer", peer.ID())
 	peer.Send(ChunkChannel, mustEncodeMsg(&ssproto.ChunkRequest{
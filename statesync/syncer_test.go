@@ -31,7 +31,7 @@ func setupOfferSyncer(t *testing.T) (*syncer, *proxymocks.AppConnSnapshot) {
 	connSnapshot := &proxymocks.AppConnSnapshot{}
 	stateProvider := &mocks.StateProvider{}
 	stateProvider.On("AppHash", mock.Anything).Return([]byte("app_hash"), nil)
-	syncer := newSyncer(log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+	syncer := newSyncer(log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "", NopMetrics())
 	return syncer, connSnapshot
 }
 
@@ -84,7 +84,7 @@ func TestSyncer_SyncAny(t *testing.T) {
 	connSnapshot := &proxymocks.AppConnSnapshot{}
 	connQuery := &proxymocks.AppConnQuery{}
 
-	syncer := newSyncer(log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+	syncer := newSyncer(log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "", NopMetrics())
 
 	// Adding a chunk should error when no sync is in progress
 	_, err := syncer.AddChunk(&chunk{Height: 1, Format: 1, Index: 0, Chunk: []byte{1}})
@@ -407,7 +407,7 @@ func TestSyncer_applyChunks_Results(t *testing.T) {
 			connSnapshot := &proxymocks.AppConnSnapshot{}
 			stateProvider := &mocks.StateProvider{}
 			stateProvider.On("AppHash", mock.Anything).Return([]byte("app_hash"), nil)
-			syncer := newSyncer(log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "", NopMetrics())
 
 			body := []byte{1, 2, 3}
 			chunks, err := newChunkQueue(&snapshot{Height: 1, Format: 1, Chunks: 1}, "")
@@ -458,7 +458,7 @@ func TestSyncer_applyChunks_RefetchChunks(t *testing.T) {
 			connSnapshot := &proxymocks.AppConnSnapshot{}
 			stateProvider := &mocks.StateProvider{}
 			stateProvider.On("AppHash", mock.Anything).Return([]byte("app_hash"), nil)
-			syncer := newSyncer(log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "", NopMetrics())
 
 			chunks, err := newChunkQueue(&snapshot{Height: 1, Format: 1, Chunks: 3}, "")
 			require.NoError(t, err)
@@ -503,6 +503,47 @@ func TestSyncer_applyChunks_RefetchChunks(t *testing.T) {
 	}
 }
 
+func TestSyncer_applyChunks_Progress(t *testing.T) {
+	stateProvider := &mocks.StateProvider{}
+	stateProvider.On("AppHash", mock.Anything).Return([]byte("app_hash"), nil)
+
+	connQuery := &proxymocks.AppConnQuery{}
+	connSnapshot := &proxymocks.AppConnSnapshot{}
+	syncer := newSyncer(log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "", NopMetrics())
+
+	s := &snapshot{Height: 1, Format: 1, Chunks: 3}
+	chunks, err := newChunkQueue(s, "")
+	require.NoError(t, err)
+	defer chunks.Close()
+
+	_, ok := syncer.Progress()
+	assert.False(t, ok)
+	syncer.startProgress(s)
+
+	for i := uint32(0); i < s.Chunks; i++ {
+		_, err = chunks.Add(&chunk{Height: 1, Format: 1, Index: i, Chunk: []byte{1, 1, byte(i)}})
+		require.NoError(t, err)
+	}
+	connSnapshot.On("ApplySnapshotChunkSync", mock.Anything).Times(3).Run(func(args mock.Arguments) {
+		req := args[0].(abci.RequestApplySnapshotChunk)
+		progress, ok := syncer.Progress()
+		require.True(t, ok)
+		assert.Equal(t, req.Index, progress.ChunksApplied)
+		assert.EqualValues(t, 3, progress.ChunksTotal)
+	}).Return(&abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}, nil)
+
+	err = syncer.applyChunks(chunks)
+	require.NoError(t, err)
+
+	progress, ok := syncer.Progress()
+	require.True(t, ok)
+	assert.EqualValues(t, 1, progress.Height)
+	assert.EqualValues(t, 3, progress.ChunksApplied)
+	assert.True(t, progress.BytesPerSecond > 0)
+	assert.Zero(t, progress.ETA)
+	connSnapshot.AssertExpectations(t)
+}
+
 func TestSyncer_applyChunks_RejectSenders(t *testing.T) {
 	// Banning chunks senders via ban_chunk_senders should work the same for all results
 	testcases := map[string]struct {
@@ -521,7 +562,7 @@ func TestSyncer_applyChunks_RejectSenders(t *testing.T) {
 			connSnapshot := &proxymocks.AppConnSnapshot{}
 			stateProvider := &mocks.StateProvider{}
 			stateProvider.On("AppHash", mock.Anything).Return([]byte("app_hash"), nil)
-			syncer := newSyncer(log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "", NopMetrics())
 
 			// Set up three peers across two snapshots, and ask for one of them to be banned.
 			// It should be banned from all snapshots.
@@ -634,7 +675,7 @@ func TestSyncer_verifyApp(t *testing.T) {
 			connQuery := &proxymocks.AppConnQuery{}
 			connSnapshot := &proxymocks.AppConnSnapshot{}
 			stateProvider := &mocks.StateProvider{}
-			syncer := newSyncer(log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "", NopMetrics())
 
 			connQuery.On("InfoSync", proxy.RequestInfo).Return(tc.response, tc.err)
 			version, err := syncer.verifyApp(s)
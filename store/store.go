@@ -224,6 +224,30 @@ func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 	return commit
 }
 
+// LoadBlockExtendedCommit returns the locally seen ExtendedCommit for the given
+// height, i.e. the seen commit along with the vote extensions of its
+// precommits, or nil if it wasn't saved.
+func (bs *BlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommit {
+	var pbec = new(tmproto.ExtendedCommit)
+	bz, err := bs.db.Get(calcExtCommitKey(height))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nil
+	}
+	err = proto.Unmarshal(bz, pbec)
+	if err != nil {
+		panic(fmt.Sprintf("error reading block extended commit: %v", err))
+	}
+
+	extCommit, err := types.ExtendedCommitFromProto(pbec)
+	if err != nil {
+		panic(fmt.Errorf("error from proto extended commit: %w", err))
+	}
+	return extCommit
+}
+
 // PruneBlocks removes block up to (but not including) a height. It returns number of blocks pruned.
 func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 	if height <= 0 {
@@ -277,6 +301,9 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 		if err := batch.Delete(calcSeenCommitKey(h)); err != nil {
 			return 0, err
 		}
+		if err := batch.Delete(calcExtCommitKey(h)); err != nil {
+			return 0, err
+		}
 		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
 			if err := batch.Delete(calcBlockPartKey(h, p)); err != nil {
 				return 0, err
@@ -309,6 +336,32 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 //             we need this to reload the precommits to catch-up nodes to the
 //             most recent height.  Otherwise they'd stall at H-1.
 func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
+	bs.saveBlock(block, blockParts, seenCommit)
+
+	// Save new BlockStoreState descriptor. This also flushes the database.
+	bs.saveState()
+}
+
+// SaveBlockWithExtendedCommit persists the given block and blockParts, along
+// with the seen commit and the vote extensions of its precommits, which the
+// proposer of the next height passes to the application.
+func (bs *BlockStore) SaveBlockWithExtendedCommit(
+	block *types.Block,
+	blockParts *types.PartSet,
+	seenExtCommit *types.ExtendedCommit,
+) {
+	bs.saveBlock(block, blockParts, seenExtCommit.ToCommit())
+
+	extCommitBytes := mustEncode(seenExtCommit.ToProto())
+	if err := bs.db.Set(calcExtCommitKey(block.Height), extCommitBytes); err != nil {
+		panic(err)
+	}
+
+	// Save new BlockStoreState descriptor. This also flushes the database.
+	bs.saveState()
+}
+
+func (bs *BlockStore) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
 	if block == nil {
 		panic("BlockStore can only save a non-nil block")
 	}
@@ -365,9 +418,6 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 		bs.base = height
 	}
 	bs.mtx.Unlock()
-
-	// Save new BlockStoreState descriptor. This also flushes the database.
-	bs.saveState()
 }
 
 func (bs *BlockStore) saveBlockPart(height int64, index int, part *types.Part) {
@@ -419,6 +469,10 @@ func calcSeenCommitKey(height int64) []byte {
 	return []byte(fmt.Sprintf("SC:%v", height))
 }
 
+func calcExtCommitKey(height int64) []byte {
+	return []byte(fmt.Sprintf("EC:%v", height))
+}
+
 func calcBlockHashKey(hash []byte) []byte {
 	return []byte(fmt.Sprintf("BH:%x", hash))
 }
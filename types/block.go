@@ -11,6 +11,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
 	"github.com/mydexchain/tendermint0/crypto/merkle"
 	"github.com/mydexchain/tendermint0/crypto/tmhash"
 	"github.com/mydexchain/tendermint0/libs/bits"
@@ -49,6 +50,11 @@ type Block struct {
 // ValidateBasic performs basic validation that doesn't involve state data.
 // It checks the internal consistency of the block.
 // Further validation is done using state#ValidateBlock.
+//
+// The block time is not checked here, since, depending on the TimestampParams
+// of the chain, it is either derived from the LastCommit (see
+// state#MedianTime) or chosen by the proposer and checked to be timely by the
+// validators before prevoting.
 func (b *Block) ValidateBasic() error {
 	if b == nil {
 		return errors.New("nil block")
@@ -92,6 +98,9 @@ func (b *Block) ValidateBasic() error {
 			// ConflictingHeadersEvidence must be broken up in pieces and never
 			// committed as a single piece.
 			return fmt.Errorf("found ConflictingHeadersEvidence (#%d)", i)
+		case *LightClientAttackEvidence:
+			// LightClientAttackEvidence must be broken up in pieces as well.
+			return fmt.Errorf("found LightClientAttackEvidence (#%d)", i)
 		case *PotentialAmnesiaEvidence:
 			// PotentialAmnesiaEvidence does not contribute to anything on its own, so
 			// reject it as well.
@@ -657,6 +666,12 @@ func (cs CommitSig) BlockID(commitBlockID BlockID) BlockID {
 
 // ValidateBasic performs basic validation.
 func (cs CommitSig) ValidateBasic() error {
+	return cs.validateBasic(false)
+}
+
+// validateBasic performs basic validation. The signature must be omitted if
+// it's part of the aggregated signature of the commit.
+func (cs CommitSig) validateBasic(aggregated bool) error {
 	switch cs.BlockIDFlag {
 	case BlockIDFlagAbsent:
 	case BlockIDFlagCommit:
@@ -684,6 +699,12 @@ func (cs CommitSig) ValidateBasic() error {
 			)
 		}
 		// NOTE: Timestamp validation is subtle and handled elsewhere.
+		if aggregated {
+			if len(cs.Signature) != 0 {
+				return errors.New("signature is present in an aggregated commit")
+			}
+			break
+		}
 		if len(cs.Signature) == 0 {
 			return errors.New("signature is missing")
 		}
@@ -712,13 +733,15 @@ func (cs *CommitSig) ToProto() *tmproto.CommitSig {
 // FromProto sets a protobuf CommitSig to the given pointer.
 // It returns an error if the CommitSig is invalid.
 func (cs *CommitSig) FromProto(csp tmproto.CommitSig) error {
+	cs.fromProto(csp)
+	return cs.ValidateBasic()
+}
 
+func (cs *CommitSig) fromProto(csp tmproto.CommitSig) {
 	cs.BlockIDFlag = BlockIDFlag(csp.BlockIdFlag)
 	cs.ValidatorAddress = csp.ValidatorAddress
 	cs.Timestamp = csp.Timestamp
 	cs.Signature = csp.Signature
-
-	return cs.ValidateBasic()
 }
 
 //-------------------------------------
@@ -734,6 +757,11 @@ type Commit struct {
 	Round      int32       `json:"round"`
 	BlockID    BlockID     `json:"block_id"`
 	Signatures []CommitSig `json:"signatures"`
+	// AggregatedSignature is the BLS aggregate of the signatures of all the
+	// precommits (for the block or nil), which are then omitted from the
+	// CommitSigs. It's only used when all the validators have BLS keys (see
+	// AggregateCommit).
+	AggregatedSignature []byte `json:"aggregated_signature,omitempty"`
 
 	// Memoized in first call to corresponding method.
 	// NOTE: can't memoize in constructor because constructor isn't used for
@@ -755,18 +783,97 @@ func NewCommit(height int64, round int32, blockID BlockID, commitSigs []CommitSi
 // CommitToVoteSet constructs a VoteSet from the Commit and validator set.
 // Panics if signatures from the commit can't be added to the voteset.
 // Inverse of VoteSet.MakeCommit().
+//
+// The votes of an aggregated commit have no signatures. They are added once
+// the aggregated signature is verified, which the VoteSet then keeps.
 func CommitToVoteSet(chainID string, commit *Commit, vals *ValidatorSet) *VoteSet {
-	voteSet := NewVoteSet(chainID, commit.Height, commit.Round, tmproto.PrecommitType, vals)
-	for idx, commitSig := range commit.Signatures {
-		if commitSig.Absent() {
+	voteSet, err := ExtendedCommitToVoteSet(chainID, commit.WrappedExtendedCommit(), vals)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to reconstruct LastCommit: %v", err))
+	}
+	return voteSet
+}
+
+// ExtendedCommitToVoteSet constructs a VoteSet from the ExtendedCommit and
+// validator set, verifying the signatures of the precommits and of their vote
+// extensions. Inverse of VoteSet.MakeExtendedCommit().
+func ExtendedCommitToVoteSet(chainID string, ec *ExtendedCommit, vals *ValidatorSet) (*VoteSet, error) {
+	voteSet := NewVoteSet(chainID, ec.Height, ec.Round, tmproto.PrecommitType, vals)
+	if len(ec.AggregatedSignature) > 0 {
+		if err := voteSet.addAggregatedCommit(ec); err != nil {
+			return nil, err
+		}
+		return voteSet, nil
+	}
+	for idx, sig := range ec.ExtendedSignatures {
+		if sig.Absent() {
 			continue // OK, some precommits can be missing.
 		}
-		added, err := voteSet.AddVote(commit.GetVote(int32(idx)))
-		if !added || err != nil {
-			panic(fmt.Sprintf("Failed to reconstruct LastCommit: %v", err))
+		added, err := voteSet.AddVote(ec.GetExtendedVote(int32(idx)))
+		if err != nil {
+			return nil, err
+		}
+		if !added {
+			return nil, fmt.Errorf("duplicate precommit #%d", idx)
 		}
 	}
-	return voteSet
+	return voteSet, nil
+}
+
+// WrappedExtendedCommit returns the commit as an ExtendedCommit without vote
+// extensions.
+func (commit *Commit) WrappedExtendedCommit() *ExtendedCommit {
+	sigs := make([]ExtendedCommitSig, len(commit.Signatures))
+	for i, sig := range commit.Signatures {
+		sigs[i] = ExtendedCommitSig{CommitSig: sig}
+	}
+	ec := NewExtendedCommit(commit.Height, commit.Round, commit.BlockID, sigs)
+	ec.AggregatedSignature = commit.AggregatedSignature
+	return ec
+}
+
+// AggregateCommit returns the commit with the signatures of the precommits
+// replaced by their BLS aggregate, if all the validators have BLS keys, or the
+// commit itself otherwise. The signatures of the commit are not verified.
+//
+// If the commit is already aggregated, the signatures of its precommits are
+// added to the aggregate.
+func AggregateCommit(commit *Commit, vals *ValidatorSet) (*Commit, error) {
+	for _, val := range vals.Validators {
+		if _, ok := val.PubKey.(bls12381.PubKey); !ok {
+			return commit, nil
+		}
+	}
+
+	var toAggregate [][]byte
+	if commit.Aggregated() {
+		toAggregate = append(toAggregate, commit.AggregatedSignature)
+	}
+	sigs := make([]CommitSig, len(commit.Signatures))
+	for i, commitSig := range commit.Signatures {
+		if len(commitSig.Signature) > 0 {
+			toAggregate = append(toAggregate, commitSig.Signature)
+			commitSig.Signature = nil
+		}
+		sigs[i] = commitSig
+	}
+	if len(toAggregate) == 0 {
+		return commit, nil
+	}
+
+	aggregated, err := bls12381.AggregateSignatures(toAggregate)
+	if err != nil {
+		return nil, fmt.Errorf("can't aggregate the commit signatures: %w", err)
+	}
+	aggCommit := NewCommit(commit.Height, commit.Round, commit.BlockID, sigs)
+	aggCommit.AggregatedSignature = aggregated
+	return aggCommit, nil
+}
+
+// Aggregated returns true if the signatures of the commit are aggregated (see
+// AggregateCommit).
+func (commit *Commit) Aggregated() bool {
+	return len(commit.AggregatedSignature) > 0
 }
 
 // GetVote converts the CommitSig for the given valIdx to a Vote.
@@ -873,10 +980,13 @@ func (commit *Commit) ValidateBasic() error {
 			return errors.New("no signatures in commit")
 		}
 		for i, commitSig := range commit.Signatures {
-			if err := commitSig.ValidateBasic(); err != nil {
+			if err := commitSig.validateBasic(commit.Aggregated()); err != nil {
 				return fmt.Errorf("wrong CommitSig #%d: %v", i, err)
 			}
 		}
+		if len(commit.AggregatedSignature) > MaxSignatureSize {
+			return fmt.Errorf("aggregated signature is too big (max: %d)", MaxSignatureSize)
+		}
 	}
 	return nil
 }
@@ -897,6 +1007,9 @@ func (commit *Commit) Hash() tmbytes.HexBytes {
 
 			bs[i] = bz
 		}
+		if commit.Aggregated() {
+			bs = append(bs, commit.AggregatedSignature)
+		}
 		commit.hash = merkle.HashFromByteSlices(bs)
 	}
 	return commit.hash
@@ -946,6 +1059,7 @@ func (commit *Commit) ToProto() *tmproto.Commit {
 		c.Hash = commit.hash
 	}
 	c.BitArray = commit.bitArray.ToProto()
+	c.AggregatedSignature = commit.AggregatedSignature
 	return c
 }
 
@@ -968,13 +1082,13 @@ func CommitFromProto(cp *tmproto.Commit) (*Commit, error) {
 
 	bitArray.FromProto(cp.BitArray)
 
+	// the signatures are validated by ValidateBasic below
 	sigs := make([]CommitSig, len(cp.Signatures))
 	for i := range cp.Signatures {
-		if err := sigs[i].FromProto(cp.Signatures[i]); err != nil {
-			return nil, err
-		}
+		sigs[i].fromProto(cp.Signatures[i])
 	}
 	commit.Signatures = sigs
+	commit.AggregatedSignature = cp.AggregatedSignature
 
 	commit.Height = cp.Height
 	commit.Round = cp.Round
@@ -987,6 +1101,153 @@ func CommitFromProto(cp *tmproto.Commit) (*Commit, error) {
 
 //-----------------------------------------------------------------------------
 
+// ExtendedCommitSig is a CommitSig along with the vote extension of the
+// precommit it was made from.
+type ExtendedCommitSig struct {
+	CommitSig
+	Extension          []byte `json:"extension"`
+	ExtensionSignature []byte `json:"extension_signature"`
+}
+
+// ExtendedCommit is a Commit whose signatures include the vote extensions of
+// the precommits. Vote extensions are not part of blocks, so an extended
+// commit only exists on the nodes which received the precommits, and is used
+// by the proposer of the next height to pass the extensions to the
+// application.
+type ExtendedCommit struct {
+	Height             int64               `json:"height"`
+	Round              int32               `json:"round"`
+	BlockID            BlockID             `json:"block_id"`
+	ExtendedSignatures []ExtendedCommitSig `json:"signatures"`
+	// See Commit.AggregatedSignature.
+	AggregatedSignature []byte `json:"aggregated_signature,omitempty"`
+}
+
+// NewExtendedCommit returns a new ExtendedCommit.
+func NewExtendedCommit(height int64, round int32, blockID BlockID, sigs []ExtendedCommitSig) *ExtendedCommit {
+	return &ExtendedCommit{
+		Height:             height,
+		Round:              round,
+		BlockID:            blockID,
+		ExtendedSignatures: sigs,
+	}
+}
+
+// ToCommit returns the Commit of the extended commit, i.e. with the vote
+// extensions stripped.
+func (ec *ExtendedCommit) ToCommit() *Commit {
+	sigs := make([]CommitSig, len(ec.ExtendedSignatures))
+	for i, sig := range ec.ExtendedSignatures {
+		sigs[i] = sig.CommitSig
+	}
+	commit := NewCommit(ec.Height, ec.Round, ec.BlockID, sigs)
+	commit.AggregatedSignature = ec.AggregatedSignature
+	return commit
+}
+
+// GetExtendedVote returns the precommit of the validator at valIdx, along
+// with its vote extension.
+//
+// Panics if valIdx >= len(ec.ExtendedSignatures).
+func (ec *ExtendedCommit) GetExtendedVote(valIdx int32) *Vote {
+	sig := ec.ExtendedSignatures[valIdx]
+	return &Vote{
+		Type:               tmproto.PrecommitType,
+		Height:             ec.Height,
+		Round:              ec.Round,
+		BlockID:            sig.BlockID(ec.BlockID),
+		Timestamp:          sig.Timestamp,
+		ValidatorAddress:   sig.ValidatorAddress,
+		ValidatorIndex:     valIdx,
+		Signature:          sig.Signature,
+		Extension:          sig.Extension,
+		ExtensionSignature: sig.ExtensionSignature,
+	}
+}
+
+// ValidateBasic performs basic validation that doesn't involve state data.
+// Does not actually check the cryptographic signatures.
+func (ec *ExtendedCommit) ValidateBasic() error {
+	if err := ec.ToCommit().ValidateBasic(); err != nil {
+		return err
+	}
+	for i, sig := range ec.ExtendedSignatures {
+		if err := sig.validateExtension(); err != nil {
+			return fmt.Errorf("wrong ExtendedCommitSig #%d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (sig ExtendedCommitSig) validateExtension() error {
+	if !sig.ForBlock() {
+		if len(sig.Extension) > 0 || len(sig.ExtensionSignature) > 0 {
+			return errors.New("extension is present for a vote not for a block")
+		}
+		return nil
+	}
+	if len(sig.Extension) > MaxVoteExtensionSize {
+		return fmt.Errorf("vote extension is too big (max: %d)", MaxVoteExtensionSize)
+	}
+	if len(sig.ExtensionSignature) > MaxSignatureSize {
+		return fmt.Errorf("vote extension signature is too big (max: %d)", MaxSignatureSize)
+	}
+	if len(sig.Extension) > 0 && len(sig.ExtensionSignature) == 0 {
+		return errors.New("vote extension signature is missing")
+	}
+	return nil
+}
+
+// ToProto converts ExtendedCommit to protobuf
+func (ec *ExtendedCommit) ToProto() *tmproto.ExtendedCommit {
+	if ec == nil {
+		return nil
+	}
+
+	sigs := make([]tmproto.ExtendedCommitSig, len(ec.ExtendedSignatures))
+	for i, sig := range ec.ExtendedSignatures {
+		sigs[i] = tmproto.ExtendedCommitSig{
+			CommitSig:          *sig.CommitSig.ToProto(),
+			Extension:          sig.Extension,
+			ExtensionSignature: sig.ExtensionSignature,
+		}
+	}
+	return &tmproto.ExtendedCommit{
+		Height:              ec.Height,
+		Round:               ec.Round,
+		BlockID:             ec.BlockID.ToProto(),
+		ExtendedSignatures:  sigs,
+		AggregatedSignature: ec.AggregatedSignature,
+	}
+}
+
+// ExtendedCommitFromProto converts a protobuf ExtendedCommit to an
+// ExtendedCommit. It returns an error if the extended commit is invalid.
+func ExtendedCommitFromProto(ecp *tmproto.ExtendedCommit) (*ExtendedCommit, error) {
+	if ecp == nil {
+		return nil, errors.New("nil ExtendedCommit")
+	}
+
+	bi, err := BlockIDFromProto(&ecp.BlockID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the signatures are validated by ValidateBasic below
+	sigs := make([]ExtendedCommitSig, len(ecp.ExtendedSignatures))
+	for i, sig := range ecp.ExtendedSignatures {
+		sigs[i].fromProto(sig.CommitSig)
+		sigs[i].Extension = sig.Extension
+		sigs[i].ExtensionSignature = sig.ExtensionSignature
+	}
+	ec := NewExtendedCommit(ecp.Height, ecp.Round, *bi, sigs)
+	ec.AggregatedSignature = ecp.AggregatedSignature
+
+	return ec, ec.ValidateBasic()
+}
+
+//-----------------------------------------------------------------------------
+
 // SignedHeader is a header along with the commits that prove it.
 // It is the basis of the light client.
 type SignedHeader struct {
@@ -8,6 +8,7 @@ import (
 	"math"
 	"os"
 	"reflect"
+	"sort"
 	"testing"
 	"time"
 
@@ -16,6 +17,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
+	"github.com/mydexchain/tendermint0/crypto/ed25519"
 	"github.com/mydexchain/tendermint0/crypto/merkle"
 	"github.com/mydexchain/tendermint0/crypto/tmhash"
 	"github.com/mydexchain/tendermint0/libs/bits"
@@ -543,6 +546,133 @@ func TestCommitToVoteSetWithVotesForNilBlock(t *testing.T) {
 	}
 }
 
+// makeAggregatedCommit returns a commit of 7 validators with BLS keys, the
+// first 5 of which vote for blockID, the sixth for nil and the last one is
+// absent, and its aggregated version.
+func makeAggregatedCommit(t *testing.T, height int64, blockID BlockID) (
+	commit, aggCommit *Commit, valSet *ValidatorSet, privVals []PrivValidator) {
+
+	vals := make([]*Validator, 7)
+	privVals = make([]PrivValidator, 7)
+	for i := range vals {
+		privKey := bls12381.GenPrivKey()
+		vals[i] = NewValidator(privKey.PubKey(), 10)
+		privVals[i] = NewMockPVWithParams(privKey, false, false)
+	}
+	sort.Sort(PrivValidatorsByAddress(privVals))
+	valSet = NewValidatorSet(vals)
+
+	voteSet := NewVoteSet("test_chain_id", height, 0, tmproto.PrecommitType, valSet)
+	for i, privVal := range privVals[:6] {
+		pubKey, err := privVal.GetPubKey()
+		require.NoError(t, err)
+		vote := &Vote{
+			ValidatorAddress: pubKey.Address(),
+			ValidatorIndex:   int32(i),
+			Height:           height,
+			Round:            0,
+			Type:             tmproto.PrecommitType,
+			BlockID:          blockID,
+			Timestamp:        tmtime.Now(),
+		}
+		if i == 5 {
+			vote.BlockID = BlockID{}
+		}
+		_, err = signAddVote(privVal, vote, voteSet)
+		require.NoError(t, err)
+	}
+
+	commit = voteSet.MakeCommit()
+	aggCommit, err := AggregateCommit(commit, valSet)
+	require.NoError(t, err)
+	return commit, aggCommit, valSet, privVals
+}
+
+func TestAggregateCommit(t *testing.T) {
+	blockID := makeBlockIDRandom()
+	commit, aggCommit, valSet, _ := makeAggregatedCommit(t, 3, blockID)
+
+	require.True(t, aggCommit.Aggregated())
+	require.NoError(t, aggCommit.ValidateBasic())
+	for i, commitSig := range aggCommit.Signatures {
+		assert.Empty(t, commitSig.Signature)
+		assert.Equal(t, commit.Signatures[i].BlockIDFlag, commitSig.BlockIDFlag)
+		assert.Equal(t, commit.Signatures[i].ValidatorAddress, commitSig.ValidatorAddress)
+	}
+	assert.NotEqual(t, commit.Hash(), aggCommit.Hash())
+	assert.True(t, len(aggCommit.ToProto().String()) < len(commit.ToProto().String()))
+
+	c, err := CommitFromProto(aggCommit.ToProto())
+	require.NoError(t, err)
+	assert.Equal(t, aggCommit.AggregatedSignature, c.AggregatedSignature)
+	assert.Equal(t, aggCommit.Hash(), c.Hash())
+
+	// aggregating again is a no-op
+	c, err = AggregateCommit(aggCommit, valSet)
+	require.NoError(t, err)
+	assert.Equal(t, aggCommit.AggregatedSignature, c.AggregatedSignature)
+
+	// signatures can't be both aggregated and not
+	c = NewCommit(aggCommit.Height, aggCommit.Round, aggCommit.BlockID, commit.Signatures)
+	c.AggregatedSignature = aggCommit.AggregatedSignature
+	assert.Error(t, c.ValidateBasic())
+	_, err = CommitFromProto(c.ToProto())
+	assert.Error(t, err)
+
+	// only validators with BLS keys
+	vals := append(valSet.Copy().Validators, NewValidator(ed25519.GenPrivKey().PubKey(), 10))
+	c, err = AggregateCommit(commit, NewValidatorSet(vals))
+	require.NoError(t, err)
+	assert.False(t, c.Aggregated())
+}
+
+func TestCommitToVoteSetAggregated(t *testing.T) {
+	const height = int64(3)
+	blockID := makeBlockIDRandom()
+	_, aggCommit, valSet, privVals := makeAggregatedCommit(t, height, blockID)
+
+	voteSet := CommitToVoteSet("test_chain_id", aggCommit, valSet)
+	maj23, ok := voteSet.TwoThirdsMajority()
+	require.True(t, ok)
+	assert.Equal(t, blockID, maj23)
+	assert.Equal(t, aggCommit.AggregatedSignature, voteSet.MakeCommit().AggregatedSignature)
+
+	// a vote of the aggregated commit is ignored
+	pubKey, err := privVals[0].GetPubKey()
+	require.NoError(t, err)
+	vote := &Vote{
+		ValidatorAddress: pubKey.Address(),
+		ValidatorIndex:   0,
+		Height:           height,
+		Round:            0,
+		Type:             tmproto.PrecommitType,
+		BlockID:          blockID,
+		Timestamp:        tmtime.Now(),
+	}
+	added, err := signAddVote(privVals[0], vote, voteSet)
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	// the vote of the absent validator is added to the aggregated signature
+	pubKey, err = privVals[6].GetPubKey()
+	require.NoError(t, err)
+	vote.ValidatorAddress, vote.ValidatorIndex = pubKey.Address(), 6
+	added, err = signAddVote(privVals[6], vote, voteSet)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	commit := voteSet.MakeCommit()
+	require.NoError(t, commit.ValidateBasic())
+	assert.True(t, commit.Aggregated())
+	assert.NotEqual(t, aggCommit.AggregatedSignature, commit.AggregatedSignature)
+	assert.True(t, commit.Signatures[6].ForBlock())
+	assert.NoError(t, valSet.VerifyCommit("test_chain_id", blockID, height, commit))
+
+	// the aggregated commit must be valid
+	commit.AggregatedSignature = aggCommit.AggregatedSignature
+	assert.Panics(t, func() { CommitToVoteSet("test_chain_id", commit, valSet) })
+}
+
 func TestSignedHeaderValidateBasic(t *testing.T) {
 	commit := randCommit(time.Now())
 	chainID := "𠜎"
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"strings"
@@ -139,6 +140,17 @@ func EvidenceToProto(evidence Evidence) (*tmproto.Evidence, error) {
 			},
 		}
 
+		return tp, nil
+
+	case *LightClientAttackEvidence:
+		pbevi := evi.ToProto()
+
+		tp := &tmproto.Evidence{
+			Sum: &tmproto.Evidence_LightClientAttackEvidence{
+				LightClientAttackEvidence: pbevi,
+			},
+		}
+
 		return tp, nil
 	default:
 		return nil, fmt.Errorf("toproto: evidence is not recognized: %T", evi)
@@ -161,6 +173,8 @@ func EvidenceFromProto(evidence *tmproto.Evidence) (Evidence, error) {
 		return PotentialAmnesiaEvidenceFromProto(evi.PotentialAmnesiaEvidence)
 	case *tmproto.Evidence_AmnesiaEvidence:
 		return AmnesiaEvidenceFromProto(evi.AmnesiaEvidence)
+	case *tmproto.Evidence_LightClientAttackEvidence:
+		return LightClientAttackEvidenceFromProto(evi.LightClientAttackEvidence)
 	default:
 		return nil, errors.New("evidence is not recognized")
 	}
@@ -172,6 +186,7 @@ func init() {
 	tmjson.RegisterType(&LunaticValidatorEvidence{}, "tendermint/LunaticValidatorEvidence")
 	tmjson.RegisterType(&PotentialAmnesiaEvidence{}, "tendermint/PotentialAmnesiaEvidence")
 	tmjson.RegisterType(&AmnesiaEvidence{}, "tendermint/AmnesiaEvidence")
+	tmjson.RegisterType(&LightClientAttackEvidence{}, "tendermint/LightClientAttackEvidence")
 }
 
 //-------------------------------------------
@@ -188,12 +203,14 @@ type DuplicateVoteEvidence struct {
 var _ Evidence = &DuplicateVoteEvidence{}
 
 // NewDuplicateVoteEvidence creates DuplicateVoteEvidence with right ordering given
-// two conflicting votes. If one of the votes is nil, evidence returned is nil as well
+// two conflicting votes. If one of the votes is nil, evidence returned is nil as well.
+// The vote extensions, which the vote signatures don't cover, are left out.
 func NewDuplicateVoteEvidence(vote1, vote2 *Vote, time time.Time) *DuplicateVoteEvidence {
 	var voteA, voteB *Vote
 	if vote1 == nil || vote2 == nil {
 		return nil
 	}
+	vote1, vote2 = voteWithoutExtension(vote1), voteWithoutExtension(vote2)
 	if strings.Compare(vote1.BlockID.Key(), vote2.BlockID.Key()) == -1 {
 		voteA = vote1
 		voteB = vote2
@@ -209,6 +226,18 @@ func NewDuplicateVoteEvidence(vote1, vote2 *Vote, time time.Time) *DuplicateVote
 	}
 }
 
+// voteWithoutExtension returns vote, or a copy of it without its extension if
+// it has one. Extensions can be up to MaxVoteExtensionSize bytes, and would
+// otherwise make the evidence exceed MaxEvidenceBytes.
+func voteWithoutExtension(vote *Vote) *Vote {
+	if len(vote.Extension) == 0 && len(vote.ExtensionSignature) == 0 {
+		return vote
+	}
+	v := *vote
+	v.Extension, v.ExtensionSignature = nil, nil
+	return &v
+}
+
 // String returns a string representation of the evidence.
 func (dve *DuplicateVoteEvidence) String() string {
 	return fmt.Sprintf("DuplicateVoteEvidence{VoteA: %v, VoteB: %v, Time: %v}", dve.VoteA, dve.VoteB, dve.Timestamp)
@@ -341,6 +370,10 @@ func (dve *DuplicateVoteEvidence) ValidateBasic() error {
 	if err := dve.VoteB.ValidateBasic(); err != nil {
 		return fmt.Errorf("invalid VoteB: %w", err)
 	}
+	if len(dve.VoteA.Extension) > 0 || len(dve.VoteA.ExtensionSignature) > 0 ||
+		len(dve.VoteB.Extension) > 0 || len(dve.VoteB.ExtensionSignature) > 0 {
+		return errors.New("votes must not have vote extensions")
+	}
 	// Enforce Votes are lexicographically sorted on blockID
 	if strings.Compare(dve.VoteA.BlockID.Key(), dve.VoteB.BlockID.Key()) >= 0 {
 		return errors.New("duplicate votes in invalid order")
@@ -374,6 +407,12 @@ func DuplicateVoteEvidenceFromProto(pb *tmproto.DuplicateVoteEvidence) (*Duplica
 		return nil, err
 	}
 
+	// NewDuplicateVoteEvidence would drop the extensions
+	if len(pb.VoteA.Extension) > 0 || len(pb.VoteA.ExtensionSignature) > 0 ||
+		len(pb.VoteB.Extension) > 0 || len(pb.VoteB.ExtensionSignature) > 0 {
+		return nil, errors.New("votes must not have vote extensions")
+	}
+
 	dve := NewDuplicateVoteEvidence(vA, vB, pb.Timestamp)
 
 	return dve, dve.ValidateBasic()
@@ -661,6 +700,151 @@ func ConflictingHeadersEvidenceFromProto(pb *tmproto.ConflictingHeadersEvidence)
 
 //-------------------------------------------
 
+// LightClientAttackEvidence is submitted by a light client when its primary
+// and a witness diverge after a common trusted height. H1 is the header from
+// the primary and H2 the header from the witness, at the first height where
+// the light client saw them diverge, and CommonHeight is the last height at
+// which they agreed.
+//
+// Like ConflictingHeadersEvidence, it is broken up into the misbehavior of
+// individual validators by full nodes, which compare the headers with the one
+// they committed.
+type LightClientAttackEvidence struct {
+	H1           *SignedHeader `json:"h_1"`
+	H2           *SignedHeader `json:"h_2"`
+	CommonHeight int64         `json:"common_height"`
+}
+
+var _ Evidence = &LightClientAttackEvidence{}
+var _ CompositeEvidence = &LightClientAttackEvidence{}
+
+// NewLightClientAttackEvidence creates a new instance of the respective evidence
+func NewLightClientAttackEvidence(h1, h2 *SignedHeader, commonHeight int64) *LightClientAttackEvidence {
+	return &LightClientAttackEvidence{H1: h1, H2: h2, CommonHeight: commonHeight}
+}
+
+// conflictingHeaders returns the conflicting headers of the evidence.
+func (ev *LightClientAttackEvidence) conflictingHeaders() *ConflictingHeadersEvidence {
+	return NewConflictingHeadersEvidence(ev.H1, ev.H2)
+}
+
+// Split breaks up evidence into smaller chunks of evidence, as
+// ConflictingHeadersEvidence#Split does.
+func (ev *LightClientAttackEvidence) Split(committedHeader *Header, valSet *ValidatorSet) []Evidence {
+	return ev.conflictingHeaders().Split(committedHeader, valSet)
+}
+
+func (ev *LightClientAttackEvidence) Height() int64 { return ev.H1.Height }
+
+// Time returns time of the latest header.
+func (ev *LightClientAttackEvidence) Time() time.Time {
+	return maxTime(ev.H1.Time, ev.H2.Time)
+}
+
+func (ev *LightClientAttackEvidence) Address() []byte {
+	panic("use LightClientAttackEvidence#Split to split evidence into individual pieces")
+}
+
+func (ev *LightClientAttackEvidence) Bytes() []byte {
+	pbe := ev.ToProto()
+
+	bz, err := pbe.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (ev *LightClientAttackEvidence) Hash() []byte {
+	bz := make([]byte, tmhash.Size*2+8)
+	copy(bz[:tmhash.Size], ev.H1.Hash().Bytes())
+	copy(bz[tmhash.Size:], ev.H2.Hash().Bytes())
+	binary.BigEndian.PutUint64(bz[tmhash.Size*2:], uint64(ev.CommonHeight))
+	return tmhash.Sum(bz)
+}
+
+func (ev *LightClientAttackEvidence) Verify(chainID string, _ crypto.PubKey) error {
+	panic("use LightClientAttackEvidence#VerifyComposite to verify composite evidence")
+}
+
+// VerifyComposite verifies the conflicting headers, as
+// ConflictingHeadersEvidence#VerifyComposite does.
+func (ev *LightClientAttackEvidence) VerifyComposite(committedHeader *Header, valSet *ValidatorSet) error {
+	return ev.conflictingHeaders().VerifyComposite(committedHeader, valSet)
+}
+
+func (ev *LightClientAttackEvidence) Equal(ev2 Evidence) bool {
+	if e2, ok := ev2.(*LightClientAttackEvidence); ok {
+		return bytes.Equal(ev.H1.Hash(), e2.H1.Hash()) && bytes.Equal(ev.H2.Hash(), e2.H2.Hash()) &&
+			ev.CommonHeight == e2.CommonHeight
+	}
+
+	return false
+}
+
+func (ev *LightClientAttackEvidence) ValidateBasic() error {
+	if ev == nil {
+		return errors.New("empty light client attack evidence")
+	}
+	if err := ev.conflictingHeaders().ValidateBasic(); err != nil {
+		return err
+	}
+	if ev.H1.ChainID != ev.H2.ChainID {
+		return errors.New("headers are from different chains")
+	}
+	if ev.H1.Height != ev.H2.Height {
+		return fmt.Errorf("headers are from different heights: %d and %d", ev.H1.Height, ev.H2.Height)
+	}
+	if bytes.Equal(ev.H1.Hash(), ev.H2.Hash()) {
+		return errors.New("headers are identical")
+	}
+	if ev.CommonHeight <= 0 || ev.CommonHeight >= ev.H1.Height {
+		return fmt.Errorf("common height %d must be positive and below the headers' height %d",
+			ev.CommonHeight, ev.H1.Height)
+	}
+	return nil
+}
+
+func (ev *LightClientAttackEvidence) String() string {
+	return fmt.Sprintf("LightClientAttackEvidence{H1: %d#%X, H2: %d#%X, CommonHeight: %d}",
+		ev.H1.Height, ev.H1.Hash(),
+		ev.H2.Height, ev.H2.Hash(),
+		ev.CommonHeight)
+}
+
+func (ev *LightClientAttackEvidence) ToProto() *tmproto.LightClientAttackEvidence {
+	return &tmproto.LightClientAttackEvidence{
+		H1:           ev.H1.ToProto(),
+		H2:           ev.H2.ToProto(),
+		CommonHeight: ev.CommonHeight,
+	}
+}
+
+func LightClientAttackEvidenceFromProto(pb *tmproto.LightClientAttackEvidence) (*LightClientAttackEvidence, error) {
+	if pb == nil {
+		return &LightClientAttackEvidence{}, errors.New("nil LightClientAttackEvidence")
+	}
+	h1, err := SignedHeaderFromProto(pb.H1)
+	if err != nil {
+		return &LightClientAttackEvidence{}, fmt.Errorf("from proto err: %w", err)
+	}
+	h2, err := SignedHeaderFromProto(pb.H2)
+	if err != nil {
+		return &LightClientAttackEvidence{}, fmt.Errorf("from proto err: %w", err)
+	}
+
+	tp := &LightClientAttackEvidence{
+		H1:           h1,
+		H2:           h2,
+		CommonHeight: pb.CommonHeight,
+	}
+
+	return tp, tp.ValidateBasic()
+}
+
+//-------------------------------------------
+
 type LunaticValidatorEvidence struct {
 	Header             *Header `json:"header"`
 	Vote               *Vote   `json:"vote"`
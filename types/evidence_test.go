@@ -138,6 +138,43 @@ func TestMaxEvidenceBytes(t *testing.T) {
 
 }
 
+func TestDuplicateVoteEvidenceWithoutExtensions(t *testing.T) {
+	val := NewMockPV()
+	blockID := makeBlockID(tmhash.Sum([]byte("blockhash")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
+	blockID2 := makeBlockID(tmhash.Sum([]byte("blockhash2")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
+	maxTime := time.Date(9999, 0, 0, 0, 0, 0, 0, time.UTC)
+	const chainID = "mychain"
+	vote1 := makeVote(t, val, chainID, math.MaxInt32, math.MaxInt64, math.MaxInt32, 0x02, blockID, maxTime)
+	vote2 := makeVote(t, val, chainID, math.MaxInt32, math.MaxInt64, math.MaxInt32, 0x02, blockID2, maxTime)
+	plain := NewDuplicateVoteEvidence(vote1, vote2, maxTime)
+
+	// the extensions of precommits are left out of the evidence
+	extended1, extended2 := vote1.Copy(), vote2.Copy()
+	for _, vote := range []*Vote{extended1, extended2} {
+		vote.Extension = tmrand.Bytes(MaxVoteExtensionSize)
+		vote.ExtensionSignature = tmrand.Bytes(MaxSignatureSize)
+	}
+	ev := NewDuplicateVoteEvidence(extended1, extended2, maxTime)
+	require.NoError(t, ev.ValidateBasic())
+	assert.Equal(t, plain.Hash(), ev.Hash())
+	assert.LessOrEqual(t, int64(len(ev.Bytes())), MaxEvidenceBytes)
+	pb, err := EvidenceToProto(ev)
+	require.NoError(t, err)
+	bz, err := pb.Marshal()
+	require.NoError(t, err)
+	assert.LessOrEqual(t, int64(len(bz)), MaxEvidenceBytes)
+
+	// the votes themselves are left untouched
+	assert.Len(t, extended1.Extension, MaxVoteExtensionSize)
+
+	// and evidence carrying extensions is rejected
+	ev.VoteA = extended1
+	ev.VoteB = extended2
+	assert.Error(t, ev.ValidateBasic())
+	_, err = DuplicateVoteEvidenceFromProto(ev.ToProto())
+	assert.Error(t, err)
+}
+
 func randomDuplicatedVoteEvidence(t *testing.T) *DuplicateVoteEvidence {
 	val := NewMockPV()
 	blockID := makeBlockID([]byte("blockhash"), 1000, []byte("partshash"))
@@ -326,6 +363,81 @@ func TestConflictingHeadersEvidence(t *testing.T) {
 	assert.NotEmpty(t, ev.String())
 }
 
+func TestLightClientAttackEvidence(t *testing.T) {
+	const (
+		chainID       = "TestLightClientAttackEvidence"
+		height  int64 = 37
+	)
+
+	var (
+		blockID = makeBlockIDRandom()
+		header1 = makeHeaderRandom()
+		header2 = makeHeaderRandom()
+	)
+
+	header1.Height = height
+	header1.LastBlockID = blockID
+	header1.ChainID = chainID
+
+	header2.Height = height
+	header2.LastBlockID = blockID
+	header2.ChainID = chainID
+
+	voteSet1, valSet, vals := randVoteSet(height, 1, tmproto.PrecommitType, 10, 1)
+	voteSet2 := NewVoteSet(chainID, height, 1, tmproto.PrecommitType, valSet)
+
+	commit1, err := MakeCommit(BlockID{
+		Hash: header1.Hash(),
+		PartSetHeader: PartSetHeader{
+			Total: 100,
+			Hash:  crypto.CRandBytes(tmhash.Size),
+		},
+	}, height, 1, voteSet1, vals, time.Now())
+	require.NoError(t, err)
+	commit2, err := MakeCommit(BlockID{
+		Hash: header2.Hash(),
+		PartSetHeader: PartSetHeader{
+			Total: 100,
+			Hash:  crypto.CRandBytes(tmhash.Size),
+		},
+	}, height, 1, voteSet2, vals, time.Now())
+	require.NoError(t, err)
+
+	h1 := &SignedHeader{
+		Header: header1,
+		Commit: commit1,
+	}
+	h2 := &SignedHeader{
+		Header: header2,
+		Commit: commit2,
+	}
+
+	ev := NewLightClientAttackEvidence(h1, h2, height-3)
+
+	assert.Panics(t, func() {
+		ev.Address()
+	})
+
+	assert.Panics(t, func() {
+		pubKey, _ := vals[0].GetPubKey()
+		ev.Verify(chainID, pubKey)
+	})
+
+	assert.Equal(t, height, ev.Height())
+	assert.Equal(t, ev.H2.Time, ev.Time())
+	assert.NotEmpty(t, ev.Hash())
+	assert.NotEqual(t, ev.Hash(), NewLightClientAttackEvidence(h1, h2, height-2).Hash())
+	assert.NotEmpty(t, ev.Bytes())
+	assert.NoError(t, ev.VerifyComposite(header1, valSet))
+	assert.True(t, ev.Equal(ev))
+	assert.False(t, ev.Equal(NewConflictingHeadersEvidence(h1, h2)))
+	assert.NoError(t, ev.ValidateBasic())
+	assert.NotEmpty(t, ev.String())
+
+	assert.Error(t, NewLightClientAttackEvidence(h1, h1, height-3).ValidateBasic())
+	assert.Error(t, NewLightClientAttackEvidence(h1, h2, height).ValidateBasic())
+}
+
 func TestPotentialAmnesiaEvidence(t *testing.T) {
 	const (
 		chainID       = "TestPotentialAmnesiaEvidence"
@@ -687,6 +799,9 @@ func TestEvidenceProto(t *testing.T) {
 		{"ConflictingHeadersEvidence nil H2", &ConflictingHeadersEvidence{H1: h1, H2: nil}, false, true},
 		{"ConflictingHeadersEvidence nil H1", &ConflictingHeadersEvidence{H1: nil, H2: h2}, false, true},
 		{"ConflictingHeadersEvidence success", &ConflictingHeadersEvidence{H1: h1, H2: h2}, false, false},
+		{"LightClientAttackEvidence empty fail", &LightClientAttackEvidence{}, false, true},
+		{"LightClientAttackEvidence no common height", &LightClientAttackEvidence{H1: h1, H2: h2}, false, true},
+		{"LightClientAttackEvidence success", &LightClientAttackEvidence{H1: h1, H2: h2, CommonHeight: 30}, false, false},
 		{"LunaticValidatorEvidence success", &LunaticValidatorEvidence{Header: header1,
 			Vote: v, InvalidHeaderField: "ValidatorsHash"}, false, true},
 		{"&LunaticValidatorEvidence empty fail", &LunaticValidatorEvidence{}, false, true},
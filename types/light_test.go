@@ -0,0 +1,109 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mydexchain/tendermint0/version"
+)
+
+func TestLightBlockValidateBasic(t *testing.T) {
+	header := makeRandHeader()
+	commit := randCommit(time.Now())
+	vals, _ := RandValidatorSet(5, 1)
+	header.Height = commit.Height
+	header.LastBlockID = commit.BlockID
+	header.ValidatorsHash = vals.Hash()
+	header.Version.Block = version.BlockProtocol
+	vals2, _ := RandValidatorSet(3, 1)
+	vals3 := vals.Copy()
+	vals3.Proposer = &Validator{}
+	commit.BlockID.Hash = header.Hash()
+
+	sh := &SignedHeader{
+		Header: &header,
+		Commit: commit,
+	}
+
+	testCases := []struct {
+		name      string
+		sh        *SignedHeader
+		vals      *ValidatorSet
+		expectErr bool
+	}{
+		{"valid light block", sh, vals, false},
+		{"hashes don't match", sh, vals2, true},
+		{"invalid validator set", sh, vals3, true},
+		{"invalid signed header", &SignedHeader{Header: &header, Commit: randCommit(time.Now())}, vals, true},
+	}
+
+	for _, tc := range testCases {
+		lightBlock := LightBlock{
+			SignedHeader: tc.sh,
+			ValidatorSet: tc.vals,
+		}
+		err := lightBlock.ValidateBasic(header.ChainID)
+		if tc.expectErr {
+			assert.Error(t, err, tc.name)
+		} else {
+			assert.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestLightBlockProtobuf(t *testing.T) {
+	header := makeRandHeader()
+	commit := randCommit(time.Now())
+	vals, _ := RandValidatorSet(5, 1)
+	header.Height = commit.Height
+	header.LastBlockID = commit.BlockID
+	header.Version.Block = version.BlockProtocol
+	header.ValidatorsHash = vals.Hash()
+	vals3 := vals.Copy()
+	vals3.Proposer = &Validator{}
+	commit.BlockID.Hash = header.Hash()
+
+	sh := &SignedHeader{
+		Header: &header,
+		Commit: commit,
+	}
+
+	testCases := []struct {
+		name       string
+		sh         *SignedHeader
+		vals       *ValidatorSet
+		toProtoErr bool
+		toBlockErr bool
+	}{
+		{"valid light block", sh, vals, false, false},
+		{"empty signed header", &SignedHeader{}, vals, false, false},
+		{"empty validator set", sh, &ValidatorSet{}, false, true},
+		{"empty light block", &SignedHeader{}, &ValidatorSet{}, false, true},
+	}
+
+	for _, tc := range testCases {
+		lightBlock := &LightBlock{
+			SignedHeader: tc.sh,
+			ValidatorSet: tc.vals,
+		}
+		lbp, err := lightBlock.ToProto()
+		if tc.toProtoErr {
+			assert.Error(t, err, tc.name)
+		} else {
+			assert.NoError(t, err, tc.name)
+		}
+
+		lb, err := LightBlockFromProto(lbp)
+		if tc.toBlockErr {
+			assert.Error(t, err, tc.name)
+		} else {
+			assert.NoError(t, err, tc.name)
+			assert.Equal(t, lightBlock, lb)
+		}
+	}
+
+	_, err := LightBlockFromProto(nil)
+	assert.Error(t, err)
+}
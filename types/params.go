@@ -31,6 +31,7 @@ func DefaultConsensusParams() *tmproto.ConsensusParams {
 		Evidence:  DefaultEvidenceParams(),
 		Validator: DefaultValidatorParams(),
 		Version:   DefaultVersionParams(),
+		Timestamp: DefaultTimestampParams(),
 	}
 }
 
@@ -67,6 +68,16 @@ func DefaultVersionParams() tmproto.VersionParams {
 	}
 }
 
+// DefaultTimestampParams returns a default TimestampParams, which keep the
+// block time the median time of the LastCommit.
+func DefaultTimestampParams() tmproto.TimestampParams {
+	return tmproto.TimestampParams{
+		ProposerBased: false,
+		Precision:     505 * time.Millisecond,
+		MessageDelay:  12 * time.Second,
+	}
+}
+
 func IsValidPubkeyType(params tmproto.ValidatorParams, pubkeyType string) bool {
 	for i := 0; i < len(params.PubKeyTypes); i++ {
 		if params.PubKeyTypes[i] == pubkeyType {
@@ -141,11 +152,23 @@ func ValidateConsensusParams(params tmproto.ConsensusParams) error {
 		}
 	}
 
+	if params.Timestamp.ProposerBased {
+		if params.Timestamp.Precision <= 0 {
+			return fmt.Errorf("timestamp.Precision must be greater than 0 if timestamps are proposer based. Got %v",
+				params.Timestamp.Precision)
+		}
+		if params.Timestamp.MessageDelay <= 0 {
+			return fmt.Errorf("timestamp.MessageDelay must be greater than 0 if timestamps are proposer based. Got %v",
+				params.Timestamp.MessageDelay)
+		}
+	}
+
 	return nil
 }
 
 // Hash returns a hash of a subset of the parameters to store in the block header.
-// Only the Block.MaxBytes and Block.MaxGas are included in the hash.
+// Only the Block.MaxBytes, Block.MaxGas and Timestamp.ProposerBased are
+// included in the hash.
 // This allows the ConsensusParams to evolve more without breaking the block
 // protocol. No need for a Merkle tree here, just a small struct to hash.
 func HashConsensusParams(params tmproto.ConsensusParams) []byte {
@@ -154,6 +177,8 @@ func HashConsensusParams(params tmproto.ConsensusParams) []byte {
 	hp := tmproto.HashedParams{
 		BlockMaxBytes: params.Block.MaxBytes,
 		BlockMaxGas:   params.Block.MaxGas,
+
+		TimestampProposerBased: params.Timestamp.ProposerBased,
 	}
 
 	bz, err := hp.Marshal()
@@ -192,5 +217,10 @@ func UpdateConsensusParams(params tmproto.ConsensusParams, params2 *abci.Consens
 	if params2.Version != nil {
 		res.Version.AppVersion = params2.Version.AppVersion
 	}
+	if params2.Timestamp != nil {
+		res.Timestamp.ProposerBased = params2.Timestamp.ProposerBased
+		res.Timestamp.Precision = params2.Timestamp.Precision
+		res.Timestamp.MessageDelay = params2.Timestamp.MessageDelay
+	}
 	return res
 }
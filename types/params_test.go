@@ -40,6 +40,9 @@ func TestConsensusParamsValidation(t *testing.T) {
 		13: {makeParams(1, 0, 10, 2, 0, []string{}), false},
 		// test invalid pubkey type provided
 		14: {makeParams(1, 0, 10, 2, 0, []string{"potatoes make good pubkeys"}), false},
+		// test other supported pubkey types
+		15: {makeParams(1, 0, 10, 2, 0, []string{ABCIPubKeyTypeSecp256k1, ABCIPubKeyTypeSr25519}), true},
+		16: {makeParams(1, 0, 10, 2, 0, []string{ABCIPubKeyTypeBls12381}), true},
 	}
 	for i, tc := range testCases {
 		if tc.valid {
@@ -149,3 +152,54 @@ func TestConsensusParamsUpdate_AppVersion(t *testing.T) {
 
 	assert.EqualValues(t, 1, updated.Version.AppVersion)
 }
+
+func TestConsensusParamsValidation_Timestamp(t *testing.T) {
+	testCases := []struct {
+		timestamp tmproto.TimestampParams
+		valid     bool
+	}{
+		0: {tmproto.TimestampParams{}, true},
+		1: {DefaultTimestampParams(), true},
+		2: {tmproto.TimestampParams{ProposerBased: true, Precision: time.Second, MessageDelay: time.Second}, true},
+		3: {tmproto.TimestampParams{ProposerBased: true, Precision: 0, MessageDelay: time.Second}, false},
+		4: {tmproto.TimestampParams{ProposerBased: true, Precision: time.Second, MessageDelay: -1}, false},
+	}
+	for i, tc := range testCases {
+		params := makeParams(1, 0, 10, 2, 0, valEd25519)
+		params.Timestamp = tc.timestamp
+		if tc.valid {
+			assert.NoErrorf(t, ValidateConsensusParams(params), "expected no error for valid params (#%d)", i)
+		} else {
+			assert.Errorf(t, ValidateConsensusParams(params), "expected error for non valid params (#%d)", i)
+		}
+	}
+}
+
+func TestConsensusParamsHash_Timestamp(t *testing.T) {
+	params := makeParams(1, 2, 10, 3, 1, valEd25519)
+	hash := HashConsensusParams(params)
+
+	// precision and message delay are not part of the hash
+	params.Timestamp.Precision = time.Second
+	params.Timestamp.MessageDelay = time.Second
+	assert.Equal(t, hash, HashConsensusParams(params))
+
+	params.Timestamp.ProposerBased = true
+	assert.NotEqual(t, hash, HashConsensusParams(params))
+}
+
+func TestConsensusParamsUpdate_Timestamp(t *testing.T) {
+	params := makeParams(1, 2, 10, 3, 0, valEd25519)
+
+	assert.False(t, params.Timestamp.ProposerBased)
+
+	updated := UpdateConsensusParams(params, &abci.ConsensusParams{Timestamp: &tmproto.TimestampParams{
+		ProposerBased: true,
+		Precision:     time.Second,
+		MessageDelay:  2 * time.Second,
+	}})
+
+	assert.True(t, updated.Timestamp.ProposerBased)
+	assert.Equal(t, time.Second, updated.Timestamp.Precision)
+	assert.Equal(t, 2*time.Second, updated.Timestamp.MessageDelay)
+}
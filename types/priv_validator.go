@@ -19,6 +19,22 @@ type PrivValidator interface {
 	SignProposal(chainID string, proposal *tmproto.Proposal) error
 }
 
+// RotatingPrivValidator is a PrivValidator whose key can be rotated without
+// changing the identity of the validator (see
+// ValidatorSet.UpdateWithChangeSet). The consensus switches it to its next key
+// at the first height whose validator set contains that key, i.e. H+2 for a
+// rotation returned by the application at height H.
+type RotatingPrivValidator interface {
+	PrivValidator
+
+	// GetNextPubKey returns the key the validator rotates to, or nil if there
+	// is none.
+	GetNextPubKey() (crypto.PubKey, error)
+	// RotateKey makes the next key the key of the validator, from the given
+	// height on.
+	RotateKey(height int64) error
+}
+
 type PrivValidatorsByAddress []PrivValidator
 
 func (pvs PrivValidatorsByAddress) Len() int {
@@ -84,6 +100,14 @@ func (pv MockPV) SignVote(chainID string, vote *tmproto.Vote) error {
 		return err
 	}
 	vote.Signature = sig
+
+	if IsVoteExtendable(vote) {
+		extSig, err := pv.PrivKey.Sign(VoteExtensionSignBytes(useChainID, vote))
+		if err != nil {
+			return err
+		}
+		vote.ExtensionSignature = extSig
+	}
 	return nil
 }
 
@@ -6,8 +6,11 @@ import (
 
 	abci "github.com/mydexchain/tendermint0/abci/types"
 	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
 	"github.com/mydexchain/tendermint0/crypto/ed25519"
 	cryptoenc "github.com/mydexchain/tendermint0/crypto/encoding"
+	"github.com/mydexchain/tendermint0/crypto/secp256k1"
+	"github.com/mydexchain/tendermint0/crypto/sr25519"
 	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
 )
 
@@ -21,13 +24,19 @@ const (
 )
 
 const (
-	ABCIPubKeyTypeEd25519 = "ed25519"
+	ABCIPubKeyTypeEd25519   = "ed25519"
+	ABCIPubKeyTypeSecp256k1 = "secp256k1"
+	ABCIPubKeyTypeSr25519   = "sr25519"
+	ABCIPubKeyTypeBls12381  = "bls12381"
 )
 
 // TODO: Make non-global by allowing for registration of more pubkey types
 
 var ABCIPubKeyTypesToNames = map[string]string{
-	ABCIPubKeyTypeEd25519: ed25519.PubKeyName,
+	ABCIPubKeyTypeEd25519:   ed25519.PubKeyName,
+	ABCIPubKeyTypeSecp256k1: secp256k1.PubKeyName,
+	ABCIPubKeyTypeSr25519:   sr25519.PubKeyName,
+	ABCIPubKeyTypeBls12381:  bls12381.PubKeyName,
 }
 
 //-------------------------------------------------------
@@ -89,8 +98,9 @@ func (tm2pb) ValidatorUpdate(val *Validator) abci.ValidatorUpdate {
 		panic(err)
 	}
 	return abci.ValidatorUpdate{
-		PubKey: pk,
-		Power:  val.VotingPower,
+		PubKey:          pk,
+		Power:           val.VotingPower,
+		OperatorAddress: val.OperatorAddress,
 	}
 }
 
@@ -111,6 +121,7 @@ func (tm2pb) ConsensusParams(params *tmproto.ConsensusParams) *abci.ConsensusPar
 		},
 		Evidence:  &params.Evidence,
 		Validator: &params.Validator,
+		Timestamp: &params.Timestamp,
 	}
 }
 
@@ -175,6 +186,7 @@ func (pb2tm) ValidatorUpdates(vals []abci.ValidatorUpdate) ([]*Validator, error)
 			return nil, err
 		}
 		tmVals[i] = NewValidator(pub, v.Power)
+		tmVals[i].OperatorAddress = v.OperatorAddress
 	}
 	return tmVals, nil
 }
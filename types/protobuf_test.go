@@ -50,6 +50,15 @@ func TestABCIValidators(t *testing.T) {
 	tmVals, err = PB2TM.ValidatorUpdates([]abci.ValidatorUpdate{abciVal})
 	assert.Nil(t, err)
 	assert.Equal(t, tmValExpected, tmVals[0])
+
+	// val with operator address
+	tmVal.OperatorAddress = []byte("operator")
+	tmValExpected.OperatorAddress = tmVal.OperatorAddress
+
+	abciVal = TM2PB.ValidatorUpdate(tmVal)
+	tmVals, err = PB2TM.ValidatorUpdates([]abci.ValidatorUpdate{abciVal})
+	assert.Nil(t, err)
+	assert.Equal(t, tmValExpected, tmVals[0])
 }
 
 func TestABCIConsensusParams(t *testing.T) {
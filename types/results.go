@@ -29,6 +29,41 @@ func (a ABCIResults) ProveResult(i int) merkle.Proof {
 	return *proofs[i]
 }
 
+// VerifyResult verifies the proof of the deterministic part of result being
+// included in the results with the given root hash (LastResultsHash).
+func VerifyResult(rootHash []byte, result *abci.ResponseDeliverTx, proof merkle.Proof) error {
+	bz, err := deterministicResponseDeliverTx(result).Marshal()
+	if err != nil {
+		return err
+	}
+	return proof.Verify(rootHash, bz)
+}
+
+// ProveResults returns a merkle proof of the results at the given indexes,
+// which must be in increasing order.
+func (a ABCIResults) ProveResults(indices []int64) (merkle.MultiProof, error) {
+	_, proof, err := merkle.MultiProofFromByteSlices(a.toByteSlices(), indices)
+	if err != nil {
+		return merkle.MultiProof{}, err
+	}
+	return *proof, nil
+}
+
+// VerifyResults verifies the proof of the deterministic part of results being
+// included in the results with the given root hash (LastResultsHash), in the
+// order of the proof's indexes.
+func VerifyResults(rootHash []byte, results []*abci.ResponseDeliverTx, proof merkle.MultiProof) error {
+	bzs := make([][]byte, len(results))
+	for i, result := range results {
+		bz, err := deterministicResponseDeliverTx(result).Marshal()
+		if err != nil {
+			return err
+		}
+		bzs[i] = bz
+	}
+	return proof.Verify(rootHash, bzs)
+}
+
 func (a ABCIResults) toByteSlices() [][]byte {
 	l := len(a)
 	bzs := make([][]byte, l)
@@ -52,3 +52,54 @@ func TestABCIResults(t *testing.T) {
 		assert.NoError(t, valid, "%d", i)
 	}
 }
+
+func TestVerifyResult(t *testing.T) {
+	responses := []*abci.ResponseDeliverTx{
+		{Code: 0, Data: []byte("one"), Log: "ok", GasUsed: 10},
+		{Code: 14, Data: []byte("two"), Info: "info"},
+	}
+	results := NewResults(responses)
+	root := results.Hash()
+
+	// Non-deterministic fields don't affect the proof.
+	assert.NoError(t, VerifyResult(root, responses[0], results.ProveResult(0)))
+	assert.NoError(t, VerifyResult(root, responses[1], results.ProveResult(1)))
+
+	// Proof of a different result.
+	assert.Error(t, VerifyResult(root, responses[0], results.ProveResult(1)))
+
+	// Tampered result.
+	tampered := *responses[0]
+	tampered.Code = 1
+	assert.Error(t, VerifyResult(root, &tampered, results.ProveResult(0)))
+
+	// Wrong root hash.
+	assert.Error(t, VerifyResult([]byte("foo"), responses[0], results.ProveResult(0)))
+}
+
+func TestVerifyResults(t *testing.T) {
+	responses := []*abci.ResponseDeliverTx{
+		{Code: 0, Data: []byte("one"), Log: "ok", GasUsed: 10},
+		{Code: 14, Data: []byte("two"), Info: "info"},
+		{Code: 0, Data: []byte("three")},
+	}
+	results := NewResults(responses)
+	root := results.Hash()
+
+	proof, err := results.ProveResults([]int64{0, 2})
+	require.NoError(t, err)
+
+	// Non-deterministic fields don't affect the proof.
+	assert.NoError(t, VerifyResults(root, []*abci.ResponseDeliverTx{responses[0], responses[2]}, proof))
+
+	// Results in a different order or of different txs.
+	assert.Error(t, VerifyResults(root, []*abci.ResponseDeliverTx{responses[2], responses[0]}, proof))
+	assert.Error(t, VerifyResults(root, []*abci.ResponseDeliverTx{responses[0], responses[1]}, proof))
+	assert.Error(t, VerifyResults(root, []*abci.ResponseDeliverTx{responses[0]}, proof))
+
+	// Wrong root hash.
+	assert.Error(t, VerifyResults([]byte("foo"), []*abci.ResponseDeliverTx{responses[0], responses[2]}, proof))
+
+	_, err = results.ProveResults([]int64{3})
+	assert.Error(t, err)
+}
@@ -61,6 +61,32 @@ func (txs Txs) IndexByHash(hash []byte) int {
 	return -1
 }
 
+// ToSliceOfBytes returns the txs as a slice of byte slices, as used by ABCI.
+func (txs Txs) ToSliceOfBytes() [][]byte {
+	txBzs := make([][]byte, len(txs))
+	for i := range txs {
+		txBzs[i] = txs[i]
+	}
+	return txBzs
+}
+
+// ComputeProtoSizeForTxs returns the size of txs once encoded in the Data of a
+// block, which is what MaxDataBytes bounds.
+func ComputeProtoSizeForTxs(txs []Tx) int64 {
+	data := Data{Txs: txs}
+	pdData := data.ToProto()
+	return int64(pdData.Size())
+}
+
+// ToTxs converts a slice of byte slices, as used by ABCI, to Txs.
+func ToTxs(txBzs [][]byte) Txs {
+	txs := make(Txs, len(txBzs))
+	for i := range txBzs {
+		txs[i] = txBzs[i]
+	}
+	return txs
+}
+
 // Proof returns a simple merkle proof for this node.
 // Panics if i < 0 or i >= len(txs)
 // TODO: optimize this!
@@ -79,6 +105,29 @@ func (txs Txs) Proof(i int) TxProof {
 	}
 }
 
+// MultiProof returns a merkle proof of the txs at the given indexes, which
+// must be in increasing order.
+func (txs Txs) MultiProof(indices []int64) (TxsProof, error) {
+	bzs := make([][]byte, len(txs))
+	for i := range txs {
+		bzs[i] = txs[i].Hash()
+	}
+	root, proof, err := merkle.MultiProofFromByteSlices(bzs, indices)
+	if err != nil {
+		return TxsProof{}, err
+	}
+
+	data := make(Txs, len(indices))
+	for i, index := range indices {
+		data[i] = txs[index]
+	}
+	return TxsProof{
+		RootHash: root,
+		Data:     data,
+		Proof:    *proof,
+	}, nil
+}
+
 // TxProof represents a Merkle proof of the presence of a transaction in the Merkle tree.
 type TxProof struct {
 	RootHash tmbytes.HexBytes `json:"root_hash"`
@@ -137,3 +186,36 @@ func TxProofFromProto(pb tmproto.TxProof) (TxProof, error) {
 
 	return pbtp, nil
 }
+
+// TxsProof represents a Merkle proof of the presence of several transactions
+// in the Merkle tree.
+type TxsProof struct {
+	RootHash tmbytes.HexBytes  `json:"root_hash"`
+	Data     Txs               `json:"data"`
+	Proof    merkle.MultiProof `json:"proof"`
+}
+
+// Leaves returns the hashes of the txs, which are the leaves in the merkle
+// tree which this proof refers to.
+func (tp TxsProof) Leaves() [][]byte {
+	leaves := make([][]byte, len(tp.Data))
+	for i, tx := range tp.Data {
+		leaves[i] = tx.Hash()
+	}
+	return leaves
+}
+
+// Validate verifies the proof. It returns nil if the RootHash matches the dataHash argument,
+// and if the proof is internally consistent. Otherwise, it returns a sensible error.
+func (tp TxsProof) Validate(dataHash []byte) error {
+	if !bytes.Equal(dataHash, tp.RootHash) {
+		return errors.New("proof matches different data hash")
+	}
+	if err := tp.Proof.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid proof: %w", err)
+	}
+	if err := tp.Proof.Verify(tp.RootHash, tp.Leaves()); err != nil {
+		return errors.New("proof is not internally consistent")
+	}
+	return nil
+}
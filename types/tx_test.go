@@ -98,6 +98,30 @@ func TestValidTxProof(t *testing.T) {
 	}
 }
 
+func TestValidTxsProof(t *testing.T) {
+	txs := makeTxs(61, 15)
+	root := txs.Hash()
+
+	indices := []int64{0, 7, 8, 9, 42, 60}
+	proof, err := txs.MultiProof(indices)
+	require.NoError(t, err)
+	assert.EqualValues(t, root, proof.RootHash)
+	assert.EqualValues(t, indices, proof.Proof.Indices)
+	for i, index := range indices {
+		assert.EqualValues(t, txs[index], proof.Data[i])
+		assert.EqualValues(t, txs[index].Hash(), proof.Leaves()[i])
+	}
+	assert.NoError(t, proof.Validate(root))
+	assert.Error(t, proof.Validate([]byte("foobar")))
+
+	// a different tx
+	proof.Data[1] = txs[1]
+	assert.Error(t, proof.Validate(root))
+
+	_, err = txs.MultiProof([]int64{8, 7})
+	assert.Error(t, err)
+}
+
 func TestTxProofUnchangable(t *testing.T) {
 	// run the other test a bunch...
 	for i := 0; i < 40; i++ {
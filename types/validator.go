@@ -21,8 +21,16 @@ type Validator struct {
 	VotingPower int64         `json:"voting_power"`
 
 	ProposerPriority int64 `json:"proposer_priority"`
+
+	// OperatorAddress is the stable identity of the validator's operator,
+	// which doesn't change when the key of the validator is rotated. It is
+	// optional.
+	OperatorAddress Address `json:"operator_address,omitempty"`
 }
 
+// MaxOperatorAddressSize is the maximum size of an operator address.
+const MaxOperatorAddressSize = 64
+
 // NewValidator returns a new validator with the given pubkey and voting power.
 func NewValidator(pubKey crypto.PubKey, votingPower int64) *Validator {
 	return &Validator{
@@ -50,6 +58,11 @@ func (v *Validator) ValidateBasic() error {
 		return fmt.Errorf("validator address is the wrong size: %v", v.Address)
 	}
 
+	if len(v.OperatorAddress) > MaxOperatorAddressSize {
+		return fmt.Errorf("validator operator address is too big: %d bytes, max %d",
+			len(v.OperatorAddress), MaxOperatorAddressSize)
+	}
+
 	return nil
 }
 
@@ -113,7 +126,8 @@ func ValidatorListString(vals []*Validator) string {
 // Bytes computes the unique encoding of a validator with a given voting power.
 // These are the bytes that gets hashed in consensus. It excludes address
 // as its redundant with the pubkey. This also excludes ProposerPriority
-// which changes every round.
+// which changes every round. The operator address, when there is one, is
+// included.
 func (v *Validator) Bytes() []byte {
 	pk, err := ce.PubKeyToProto(v.PubKey)
 	if err != nil {
@@ -121,8 +135,9 @@ func (v *Validator) Bytes() []byte {
 	}
 
 	pbv := tmproto.SimpleValidator{
-		PubKey:      &pk,
-		VotingPower: v.VotingPower,
+		PubKey:          &pk,
+		VotingPower:     v.VotingPower,
+		OperatorAddress: v.OperatorAddress,
 	}
 
 	bz, err := pbv.Marshal()
@@ -148,6 +163,7 @@ func (v *Validator) ToProto() (*tmproto.Validator, error) {
 		PubKey:           pk,
 		VotingPower:      v.VotingPower,
 		ProposerPriority: v.ProposerPriority,
+		OperatorAddress:  v.OperatorAddress,
 	}
 
 	return &vp, nil
@@ -169,6 +185,7 @@ func ValidatorFromProto(vp *tmproto.Validator) (*Validator, error) {
 	v.PubKey = pk
 	v.VotingPower = vp.GetVotingPower()
 	v.ProposerPriority = vp.GetProposerPriority()
+	v.OperatorAddress = vp.GetOperatorAddress()
 
 	return v, nil
 }
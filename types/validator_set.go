@@ -9,7 +9,11 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/mydexchain/tendermint0/crypto"
+	"github.com/mydexchain/tendermint0/crypto/batch"
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
 	"github.com/mydexchain/tendermint0/crypto/merkle"
+	"github.com/mydexchain/tendermint0/libs/bits"
 	tmmath "github.com/mydexchain/tendermint0/libs/math"
 	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
 )
@@ -276,6 +280,21 @@ func (vals *ValidatorSet) GetByAddress(address []byte) (index int32, val *Valida
 	return -1, nil
 }
 
+// GetByOperatorAddress returns an index of the validator with the given
+// operator address and a copy of the validator. It returns -1 and nil if the
+// operator address is empty or if no validator has it.
+func (vals *ValidatorSet) GetByOperatorAddress(operatorAddress []byte) (index int32, val *Validator) {
+	if len(operatorAddress) == 0 {
+		return -1, nil
+	}
+	for idx, val := range vals.Validators {
+		if bytes.Equal(val.OperatorAddress, operatorAddress) {
+			return int32(idx), val.Copy()
+		}
+	}
+	return -1, nil
+}
+
 // GetByIndex returns the validator's address and validator itself (copy) by
 // index.
 // It returns nil values if index is less than 0 or greater or equal to
@@ -406,6 +425,67 @@ func processChanges(origChanges []*Validator) (updates, removals []*Validator, e
 	return updates, removals, err
 }
 
+// processRotations finds the key rotations among the updates: the updates with
+// the operator address of a validator of the set but another key. The
+// validators whose key is rotated are removed from the set, the validators
+// with their new key being added by the updates.
+//
+// Inputs:
+// updates, removals - the lists returned by processChanges.
+// vals - the original validator set. Note that vals is NOT modified by this function.
+//
+// Returns:
+// removals - the removals, including the validators whose key is rotated, sorted by address
+// rotated - the validators whose key is rotated, by the address of their new key
+// err - non-nil if operator addresses are duplicated or if a validator whose key is rotated
+//   is also updated or removed
+func processRotations(
+	updates, removals []*Validator,
+	vals *ValidatorSet,
+) (_ []*Validator, rotated map[string]*Validator, err error) {
+
+	changed := make(map[string]bool, len(updates)+len(removals))
+	for _, valUpdate := range updates {
+		changed[string(valUpdate.Address)] = true
+	}
+	for _, valUpdate := range removals {
+		changed[string(valUpdate.Address)] = true
+	}
+
+	rotated = make(map[string]*Validator)
+	operators := make(map[string]bool)
+	for _, valUpdate := range updates {
+		operator := valUpdate.OperatorAddress
+		if len(operator) == 0 {
+			continue
+		}
+		if operators[string(operator)] {
+			return nil, nil, fmt.Errorf("duplicate operator address %v in %v", operator, updates)
+		}
+		operators[string(operator)] = true
+
+		_, val := vals.GetByOperatorAddress(operator)
+		if val == nil || bytes.Equal(val.Address, valUpdate.Address) {
+			continue
+		}
+		switch {
+		case changed[string(val.Address)]:
+			return nil, nil, fmt.Errorf("validator %v can't be both updated and rotated to %v",
+				val.Address, valUpdate.Address)
+		case vals.HasAddress(valUpdate.Address):
+			return nil, nil, fmt.Errorf("can't rotate validator %v to the key of validator %v",
+				val.Address, valUpdate.Address)
+		}
+		rotated[string(valUpdate.Address)] = val
+		removals = append(removals, val)
+	}
+
+	if len(rotated) > 0 {
+		sort.Sort(ValidatorsByAddress(removals))
+	}
+	return removals, rotated, nil
+}
+
 // verifyUpdates verifies a list of updates against a validator set, making sure the allowed
 // total voting power would not be exceeded if these updates would be applied to the set.
 //
@@ -493,7 +573,8 @@ func computeNewPriorities(updates []*Validator, vals *ValidatorSet, updatedTotal
 }
 
 // Merges the vals' validator list with the updates list.
-// When two elements with same address are seen, the one from updates is selected.
+// When two elements with same address are seen, the one from updates is selected,
+// keeping the operator address of the existing one if the update has none.
 // Expects updates to be a list of updates sorted by address with no duplicates or errors,
 // must have been validated with verifyUpdates() and priorities computed with computeNewPriorities().
 func (vals *ValidatorSet) applyUpdates(updates []*Validator) {
@@ -511,6 +592,9 @@ func (vals *ValidatorSet) applyUpdates(updates []*Validator) {
 			// Apply add or update.
 			merged[i] = updates[0]
 			if bytes.Equal(existing[0].Address, updates[0].Address) {
+				if len(updates[0].OperatorAddress) == 0 {
+					updates[0].OperatorAddress = existing[0].OperatorAddress
+				}
 				// Validator is present in both, advance existing.
 				existing = existing[1:]
 			}
@@ -599,6 +683,12 @@ func (vals *ValidatorSet) updateWithChangeSet(changes []*Validator, allowDeletes
 		return fmt.Errorf("cannot process validators with voting power 0: %v", deletes)
 	}
 
+	// Find the key rotations, removing the validators whose key is rotated.
+	deletes, rotated, err := processRotations(updates, deletes, vals)
+	if err != nil {
+		return err
+	}
+
 	// Check that the resulting set will not be empty.
 	if numNewValidators(updates, vals) == 0 && len(vals.Validators) == len(deletes) {
 		return errors.New("applying the validator changes would result in empty set")
@@ -621,6 +711,13 @@ func (vals *ValidatorSet) updateWithChangeSet(changes []*Validator, allowDeletes
 	// Compute the priorities for updates.
 	computeNewPriorities(updates, vals, tvpAfterUpdatesBeforeRemovals)
 
+	// The validators whose key is rotated keep their priority.
+	for _, valUpdate := range updates {
+		if val, ok := rotated[string(valUpdate.Address)]; ok {
+			valUpdate.ProposerPriority = val.ProposerPriority
+		}
+	}
+
 	// Apply updates and removals.
 	vals.applyUpdates(updates)
 	vals.applyRemovals(deletes)
@@ -639,6 +736,8 @@ func (vals *ValidatorSet) updateWithChangeSet(changes []*Validator, allowDeletes
 // UpdateWithChangeSet attempts to update the validator set with 'changes'.
 // It performs the following steps:
 // - validates the changes making sure there are no duplicates and splits them in updates and deletes
+// - finds the key rotations, i.e. the updates with the operator address of a validator but another
+//   key, which delete the validator with the old key and keep its priority for the new key
 // - verifies that applying the changes will not result in errors
 // - computes the total voting power BEFORE removals to ensure that in the next steps the priorities
 //   across old and newly added validators are fair
@@ -675,8 +774,11 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 			blockID, commit.BlockID)
 	}
 
-	talliedVotingPower := int64(0)
-	votingPowerNeeded := vals.TotalVotingPower() * 2 / 3
+	var (
+		talliedVotingPower int64
+		votingPowerNeeded  = vals.TotalVotingPower() * 2 / 3
+		sigs               = make([]commitSigToVerify, 0, len(commit.Signatures))
+	)
 	for idx, commitSig := range commit.Signatures {
 		if commitSig.Absent() {
 			continue // OK, some signatures can be absent.
@@ -685,13 +787,8 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 		// The vals and commit have a 1-to-1 correspondance.
 		// This means we don't need the validator address or to do any lookup.
 		val := vals.Validators[idx]
+		sigs = append(sigs, commitSigToVerify{idx: int32(idx), val: val})
 
-		// Validate signature.
-		voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
-		if !val.PubKey.VerifySignature(voteSignBytes, commitSig.Signature) {
-			return fmt.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature)
-		}
-		// Good!
 		if commitSig.ForBlock() {
 			talliedVotingPower += val.VotingPower
 		}
@@ -701,6 +798,11 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 		// }
 	}
 
+	// Validate signatures.
+	if err := verifyCommitSigs(chainID, commit, sigs, nil); err != nil {
+		return err
+	}
+
 	if got, needed := talliedVotingPower, votingPowerNeeded; got <= needed {
 		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
 	}
@@ -718,47 +820,83 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 // signatures.
 func (vals *ValidatorSet) VerifyCommitLight(chainID string, blockID BlockID,
 	height int64, commit *Commit) error {
+	_, err := vals.VerifyCommitLightWithResult(chainID, blockID, height, commit)
+	return err
+}
+
+// CommitVerification describes what was checked while verifying a commit. If
+// the verification fails, it covers the checks performed up to the failure.
+type CommitVerification struct {
+	// Voting power of the validators of the set, which signed the block, out
+	// of the total voting power of the set. Signatures are only checked until
+	// more than VotingPowerNeeded is reached, unless the commit is aggregated.
+	VotingPower       int64
+	VotingPowerNeeded int64
+	TotalVotingPower  int64
+
+	// Signatures of the commit, which were verified.
+	VerifiedSignatures *bits.BitArray
+}
+
+// VerifyCommitLightWithResult verifies the commit like VerifyCommitLight does
+// and describes what was checked.
+func (vals *ValidatorSet) VerifyCommitLightWithResult(chainID string, blockID BlockID,
+	height int64, commit *Commit) (CommitVerification, error) {
+
+	result := CommitVerification{VerifiedSignatures: bits.NewBitArray(len(commit.Signatures))}
 
 	if vals.Size() != len(commit.Signatures) {
-		return NewErrInvalidCommitSignatures(vals.Size(), len(commit.Signatures))
+		return result, NewErrInvalidCommitSignatures(vals.Size(), len(commit.Signatures))
 	}
 
 	// Validate Height and BlockID.
 	if height != commit.Height {
-		return NewErrInvalidCommitHeight(height, commit.Height)
+		return result, NewErrInvalidCommitHeight(height, commit.Height)
 	}
 	if !blockID.Equals(commit.BlockID) {
-		return fmt.Errorf("invalid commit -- wrong block ID: want %v, got %v",
+		return result, fmt.Errorf("invalid commit -- wrong block ID: want %v, got %v",
 			blockID, commit.BlockID)
 	}
 
-	talliedVotingPower := int64(0)
-	votingPowerNeeded := vals.TotalVotingPower() * 2 / 3
+	result.TotalVotingPower = vals.TotalVotingPower()
+	result.VotingPowerNeeded = result.TotalVotingPower * 2 / 3
+
+	var (
+		talliedVotingPower int64
+		sigs               = make([]commitSigToVerify, 0, len(commit.Signatures))
+	)
 	for idx, commitSig := range commit.Signatures {
-		// No need to verify absent or nil votes.
-		if !commitSig.ForBlock() {
+		// No need to verify absent or nil votes, unless they're part of the
+		// aggregated signature.
+		if commitSig.Absent() || (!commitSig.ForBlock() && !commit.Aggregated()) {
 			continue
 		}
 
 		// The vals and commit have a 1-to-1 correspondance.
 		// This means we don't need the validator address or to do any lookup.
 		val := vals.Validators[idx]
-
-		// Validate signature.
-		voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
-		if !val.PubKey.VerifySignature(voteSignBytes, commitSig.Signature) {
-			return fmt.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature)
+		sigs = append(sigs, commitSigToVerify{idx: int32(idx), val: val})
+		if commitSig.ForBlock() {
+			talliedVotingPower += val.VotingPower
 		}
 
-		talliedVotingPower += val.VotingPower
-
-		// return as soon as +2/3 of the signatures are verified
-		if talliedVotingPower > votingPowerNeeded {
-			return nil
+		// stop as soon as +2/3 of the signatures are picked (the aggregated
+		// signature can only be verified with all of them)
+		if talliedVotingPower > result.VotingPowerNeeded && !commit.Aggregated() {
+			break
 		}
 	}
 
-	return ErrNotEnoughVotingPowerSigned{Got: talliedVotingPower, Needed: votingPowerNeeded}
+	// Validate signatures.
+	if err := verifyCommitSigs(chainID, commit, sigs, result.VerifiedSignatures); err != nil {
+		return result, err
+	}
+	result.VotingPower = talliedVotingPower
+
+	if talliedVotingPower <= result.VotingPowerNeeded {
+		return result, ErrNotEnoughVotingPowerSigned{Got: talliedVotingPower, Needed: result.VotingPowerNeeded}
+	}
+	return result, nil
 }
 
 // VerifyCommitLightTrusting verifies that trustLevel of the validator set signed
@@ -767,29 +905,48 @@ func (vals *ValidatorSet) VerifyCommitLight(chainID string, blockID BlockID,
 // NOTE the given validators do not necessarily correspond to the validator set
 // for this commit, but there may be some intersection.
 //
+// The aggregated signature of an aggregated commit is verified with the keys
+// of the set only, so none of its voting power is counted unless all the
+// signers are in the set.
+//
 // This method is primarily used by the light client and does not check all the
 // signatures.
 func (vals *ValidatorSet) VerifyCommitLightTrusting(chainID string, commit *Commit, trustLevel tmmath.Fraction) error {
+	_, err := vals.VerifyCommitLightTrustingWithResult(chainID, commit, trustLevel)
+	return err
+}
+
+// VerifyCommitLightTrustingWithResult verifies the commit like
+// VerifyCommitLightTrusting does and describes what was checked.
+func (vals *ValidatorSet) VerifyCommitLightTrustingWithResult(chainID string, commit *Commit,
+	trustLevel tmmath.Fraction) (CommitVerification, error) {
+
+	result := CommitVerification{VerifiedSignatures: bits.NewBitArray(len(commit.Signatures))}
+
 	// sanity check
 	if trustLevel.Denominator == 0 {
-		return errors.New("trustLevel has zero Denominator")
+		return result, errors.New("trustLevel has zero Denominator")
 	}
 
 	var (
 		talliedVotingPower int64
 		seenVals           = make(map[int32]int, len(commit.Signatures)) // validator index -> commit index
+		sigs               = make([]commitSigToVerify, 0, len(commit.Signatures))
 	)
 
 	// Safely calculate voting power needed.
-	totalVotingPowerMulByNumerator, overflow := safeMul(vals.TotalVotingPower(), trustLevel.Numerator)
+	result.TotalVotingPower = vals.TotalVotingPower()
+	totalVotingPowerMulByNumerator, overflow := safeMul(result.TotalVotingPower, trustLevel.Numerator)
 	if overflow {
-		return errors.New("int64 overflow while calculating voting power needed. please provide smaller trustLevel numerator")
+		return result, errors.New("int64 overflow while calculating voting power needed. " +
+			"please provide smaller trustLevel numerator")
 	}
-	votingPowerNeeded := totalVotingPowerMulByNumerator / trustLevel.Denominator
+	result.VotingPowerNeeded = totalVotingPowerMulByNumerator / trustLevel.Denominator
 
 	for idx, commitSig := range commit.Signatures {
-		// No need to verify absent or nil votes.
-		if !commitSig.ForBlock() {
+		// No need to verify absent or nil votes, unless they're part of the
+		// aggregated signature.
+		if commitSig.Absent() || (!commitSig.ForBlock() && !commit.Aggregated()) {
 			continue
 		}
 
@@ -801,25 +958,151 @@ func (vals *ValidatorSet) VerifyCommitLightTrusting(chainID string, commit *Comm
 			// check for double vote of validator on the same commit
 			if firstIndex, ok := seenVals[valIdx]; ok {
 				secondIndex := idx
-				return fmt.Errorf("double vote from %v (%d and %d)", val, firstIndex, secondIndex)
+				return result, fmt.Errorf("double vote from %v (%d and %d)", val, firstIndex, secondIndex)
 			}
 			seenVals[valIdx] = idx
 
-			// Validate signature.
-			voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
-			if !val.PubKey.VerifySignature(voteSignBytes, commitSig.Signature) {
-				return fmt.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature)
+			sigs = append(sigs, commitSigToVerify{idx: int32(idx), val: val})
+			if commitSig.ForBlock() {
+				talliedVotingPower += val.VotingPower
 			}
 
-			talliedVotingPower += val.VotingPower
+			if talliedVotingPower > result.VotingPowerNeeded && !commit.Aggregated() {
+				break
+			}
+		} else if commit.Aggregated() {
+			// The aggregated signature can't be verified without the keys of all
+			// the signers.
+			return result, ErrNotEnoughVotingPowerSigned{Got: 0, Needed: result.VotingPowerNeeded}
+		}
+	}
+
+	// Validate signatures.
+	if err := verifyCommitSigs(chainID, commit, sigs, result.VerifiedSignatures); err != nil {
+		return result, err
+	}
+	result.VotingPower = talliedVotingPower
+
+	if talliedVotingPower <= result.VotingPowerNeeded {
+		return result, ErrNotEnoughVotingPowerSigned{Got: talliedVotingPower, Needed: result.VotingPowerNeeded}
+	}
+	return result, nil
+}
+
+// commitSigToVerify is a signature of the commit at idx, which is expected
+// to be made by val.
+type commitSigToVerify struct {
+	idx int32
+	val *Validator
+}
+
+// verifyCommitSigs verifies the given signatures of the commit, marks them as
+// verified and returns an error for the first invalid one.
+//
+// If the keys support it, the signatures are verified in a batch. If the
+// batch is invalid, the per-signature results are used to find the invalid
+// signature.
+//
+// The signatures of an aggregated commit are verified all at once with its
+// aggregated signature, so sigs must contain all of them.
+func verifyCommitSigs(chainID string, commit *Commit, sigs []commitSigToVerify, verified *bits.BitArray) error {
+	if commit.Aggregated() {
+		idxs := make([]int32, len(sigs))
+		pubKeys := make([]crypto.PubKey, len(sigs))
+		for i, sig := range sigs {
+			idxs[i], pubKeys[i] = sig.idx, sig.val.PubKey
+		}
+		if err := VerifyAggregatedCommitSig(chainID, commit, idxs, pubKeys); err != nil {
+			return err
+		}
+		for _, sig := range sigs {
+			verified.SetIndex(int(sig.idx), true)
+		}
+		return nil
+	}
 
-			if talliedVotingPower > votingPowerNeeded {
-				return nil
+	if len(sigs) > 1 {
+		if bv, ok := newCommitSigsBatch(chainID, commit, sigs); ok {
+			_, valid := bv.Verify()
+			for i, sig := range sigs {
+				if !valid[i] {
+					return fmt.Errorf("wrong signature (#%d): %X", sig.idx, commit.Signatures[sig.idx].Signature)
+				}
+				verified.SetIndex(int(sig.idx), true)
 			}
+			return nil
 		}
 	}
 
-	return ErrNotEnoughVotingPowerSigned{Got: talliedVotingPower, Needed: votingPowerNeeded}
+	for _, sig := range sigs {
+		voteSignBytes := commit.VoteSignBytes(chainID, sig.idx)
+		signature := commit.Signatures[sig.idx].Signature
+		if !sig.val.PubKey.VerifySignature(voteSignBytes, signature) {
+			return fmt.Errorf("wrong signature (#%d): %X", sig.idx, signature)
+		}
+		verified.SetIndex(int(sig.idx), true)
+	}
+	return nil
+}
+
+// VerifyAggregatedCommitSig verifies the aggregated signature of the commit,
+// given the keys of the validators who signed the precommits at the given
+// (increasing) indexes. All the precommits, which aren't absent, must be
+// given, and their validator addresses must match the keys.
+func VerifyAggregatedCommitSig(chainID string, commit *Commit, idxs []int32, pubKeys []crypto.PubKey) error {
+	var signed int
+	for _, commitSig := range commit.Signatures {
+		if !commitSig.Absent() {
+			signed++
+		}
+	}
+	if len(idxs) != signed || len(pubKeys) != signed {
+		return fmt.Errorf("can't verify the aggregated signature: expected %d signers, got %d", signed, len(idxs))
+	}
+
+	var (
+		blsKeys = make([]bls12381.PubKey, len(idxs))
+		msgs    = make([][]byte, len(idxs))
+	)
+	for i, idx := range idxs {
+		if idx < 0 || int(idx) >= len(commit.Signatures) || (i > 0 && idx <= idxs[i-1]) ||
+			commit.Signatures[idx].Absent() {
+			return fmt.Errorf("can't verify the aggregated signature: invalid signer #%d", idx)
+		}
+		pubKey, ok := pubKeys[i].(bls12381.PubKey)
+		if !ok {
+			return fmt.Errorf("can't verify the aggregated signature: signer #%d has a %s key", idx, pubKeys[i].Type())
+		}
+		if addr := commit.Signatures[idx].ValidatorAddress; !bytes.Equal(addr, pubKey.Address()) {
+			return fmt.Errorf("can't verify the aggregated signature: signer #%d address %X doesn't match its key",
+				idx, addr)
+		}
+		blsKeys[i] = pubKey
+		msgs[i] = commit.VoteSignBytes(chainID, idx)
+	}
+
+	if !bls12381.VerifyAggregateSignature(blsKeys, msgs, commit.AggregatedSignature) {
+		return fmt.Errorf("wrong aggregated signature: %X", commit.AggregatedSignature)
+	}
+	return nil
+}
+
+// newCommitSigsBatch adds the given signatures of the commit to a new batch
+// verifier. It returns false if any of the keys doesn't support batch
+// verification (e.g. the validators use different key types) or any of the
+// signatures is malformed.
+func newCommitSigsBatch(chainID string, commit *Commit, sigs []commitSigToVerify) (crypto.BatchVerifier, bool) {
+	bv, ok := batch.CreateBatchVerifier(sigs[0].val.PubKey)
+	if !ok {
+		return nil, false
+	}
+	for _, sig := range sigs {
+		voteSignBytes := commit.VoteSignBytes(chainID, sig.idx)
+		if err := bv.Add(sig.val.PubKey, voteSignBytes, commit.Signatures[sig.idx].Signature); err != nil {
+			return nil, false
+		}
+	}
+	return bv, true
 }
 
 //-----------------
@@ -15,6 +15,7 @@ import (
 
 	"github.com/mydexchain/tendermint0/crypto"
 	"github.com/mydexchain/tendermint0/crypto/ed25519"
+	"github.com/mydexchain/tendermint0/crypto/sr25519"
 	tmmath "github.com/mydexchain/tendermint0/libs/math"
 	tmrand "github.com/mydexchain/tendermint0/libs/rand"
 	tmproto "github.com/mydexchain/tendermint0/proto/tendermint/types"
@@ -812,6 +813,114 @@ func TestValidatorSet_VerifyCommitLightTrusting_ReturnsAsSoonAsTrustLevelOfVotin
 	assert.NoError(t, err)
 }
 
+func TestValidatorSet_VerifyCommit_KeyTypes(t *testing.T) {
+	var (
+		chainID = "test_chain_id"
+		h       = int64(3)
+		blockID = makeBlockIDRandom()
+	)
+
+	testCases := []struct {
+		name       string
+		genPrivKey func(i int) crypto.PrivKey
+	}{
+		{"ed25519", func(int) crypto.PrivKey { return ed25519.GenPrivKey() }},
+		{"sr25519", func(int) crypto.PrivKey { return sr25519.GenPrivKey() }},
+		{"mixed", func(i int) crypto.PrivKey {
+			if i%2 == 0 {
+				return ed25519.GenPrivKey()
+			}
+			return sr25519.GenPrivKey()
+		}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				vals     = make([]*Validator, 10)
+				privVals = make([]PrivValidator, 10)
+			)
+			for i := range vals {
+				privKey := tc.genPrivKey(i)
+				vals[i] = NewValidator(privKey.PubKey(), 10)
+				privVals[i] = NewMockPVWithParams(privKey, false, false)
+			}
+			sort.Sort(PrivValidatorsByAddress(privVals))
+			valSet := NewValidatorSet(vals)
+
+			voteSet := NewVoteSet(chainID, h, 0, tmproto.PrecommitType, valSet)
+			commit, err := MakeCommit(blockID, h, 0, voteSet, privVals, time.Now())
+			require.NoError(t, err)
+
+			trustLevel := tmmath.Fraction{Numerator: 1, Denominator: 3}
+			require.NoError(t, valSet.VerifyCommit(chainID, blockID, h, commit))
+			require.NoError(t, valSet.VerifyCommitLight(chainID, blockID, h, commit))
+			require.NoError(t, valSet.VerifyCommitLightTrusting(chainID, commit, trustLevel))
+
+			// malleate 2nd signature
+			vote := voteSet.GetByIndex(1)
+			v := vote.ToProto()
+			err = privVals[1].SignVote("CentaurusA", v)
+			require.NoError(t, err)
+			vote.Signature = v.Signature
+			commit.Signatures[1] = vote.CommitSig()
+
+			for _, err := range []error{
+				valSet.VerifyCommit(chainID, blockID, h, commit),
+				valSet.VerifyCommitLight(chainID, blockID, h, commit),
+				valSet.VerifyCommitLightTrusting(chainID, commit, trustLevel),
+			} {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), "wrong signature (#1)")
+				}
+			}
+		})
+	}
+}
+
+func TestValidatorSet_VerifyCommit_Aggregated(t *testing.T) {
+	var (
+		chainID    = "test_chain_id"
+		h          = int64(3)
+		blockID    = makeBlockIDRandom()
+		trustLevel = tmmath.Fraction{Numerator: 1, Denominator: 3}
+	)
+	commit, aggCommit, valSet, _ := makeAggregatedCommit(t, h, blockID)
+
+	require.NoError(t, valSet.VerifyCommit(chainID, blockID, h, aggCommit))
+	require.NoError(t, valSet.VerifyCommitLight(chainID, blockID, h, aggCommit))
+	require.NoError(t, valSet.VerifyCommitLightTrusting(chainID, aggCommit, trustLevel))
+
+	// the keys of all the signers are needed
+	trustedVals := NewValidatorSet(valSet.Copy().Validators[1:])
+	err := trustedVals.VerifyCommitLightTrusting(chainID, aggCommit, trustLevel)
+	assert.Equal(t, ErrNotEnoughVotingPowerSigned{Got: 0, Needed: trustedVals.TotalVotingPower() / 3}, err)
+
+	// the aggregated signature is missing a signature
+	c, err := AggregateCommit(commit, valSet)
+	require.NoError(t, err)
+	c.AggregatedSignature = commit.Signatures[0].Signature
+	for _, err := range []error{
+		valSet.VerifyCommit(chainID, blockID, h, c),
+		valSet.VerifyCommitLight(chainID, blockID, h, c),
+		valSet.VerifyCommitLightTrusting(chainID, c, trustLevel),
+	} {
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "wrong aggregated signature")
+		}
+	}
+
+	// the signers must match the validators
+	c, err = AggregateCommit(commit, valSet)
+	require.NoError(t, err)
+	c.Signatures[0].ValidatorAddress = c.Signatures[1].ValidatorAddress
+	err = valSet.VerifyCommitLight(chainID, blockID, h, c)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "doesn't match its key")
+	}
+}
+
 func TestEmptySet(t *testing.T) {
 
 	var valList []*Validator
@@ -1147,6 +1256,66 @@ func TestValSetUpdatesBasicTestsExecute(t *testing.T) {
 	}
 }
 
+func TestValSetUpdatesKeyRotation(t *testing.T) {
+	newOperatedValidator := func(address, operator string, power int64) *Validator {
+		val := newValidator([]byte(address), power)
+		val.OperatorAddress = []byte(operator)
+		return val
+	}
+	valSet := NewValidatorSet([]*Validator{
+		newOperatedValidator("v1", "op1", 10),
+		newOperatedValidator("v2", "op2", 20),
+		newValidator([]byte("v3"), 30),
+	})
+	valSet.IncrementProposerPriority(3)
+
+	// the key of the validator of op1 is rotated, the validator keeping the
+	// priority it would have had if only its power had changed
+	valSetNoRotation := valSet.Copy()
+	require.NoError(t, valSetNoRotation.UpdateWithChangeSet([]*Validator{newOperatedValidator("v1", "op1", 15)}))
+	_, v1 := valSetNoRotation.GetByAddress([]byte("v1"))
+
+	rotated := valSet.Copy()
+	require.NoError(t, rotated.UpdateWithChangeSet([]*Validator{newOperatedValidator("v4", "op1", 15)}))
+	assert.False(t, rotated.HasAddress([]byte("v1")))
+	_, v4 := rotated.GetByOperatorAddress([]byte("op1"))
+	require.NotNil(t, v4)
+	assert.Equal(t, []testVal{{"v3", 30}, {"v2", 20}, {"v4", 15}}, toTestValList(rotated.Validators))
+	assert.Equal(t, v1.ProposerPriority, v4.ProposerPriority)
+	verifyValidatorSet(t, rotated)
+
+	// a validator without operator address can be given one
+	require.NoError(t, rotated.UpdateWithChangeSet([]*Validator{newOperatedValidator("v3", "op3", 30)}))
+	_, v3 := rotated.GetByOperatorAddress([]byte("op3"))
+	require.NotNil(t, v3)
+	assert.EqualValues(t, "v3", v3.Address)
+
+	// and keeps it when its power is updated without it
+	require.NoError(t, rotated.UpdateWithChangeSet([]*Validator{newValidator([]byte("v3"), 35)}))
+	_, v3 = rotated.GetByOperatorAddress([]byte("op3"))
+	require.NotNil(t, v3)
+	assert.EqualValues(t, 35, v3.VotingPower)
+
+	testCases := []struct {
+		name    string
+		changes []*Validator
+	}{
+		{"duplicate operator address",
+			[]*Validator{newOperatedValidator("v4", "op1", 10), newOperatedValidator("v5", "op1", 10)}},
+		{"rotated and updated",
+			[]*Validator{newOperatedValidator("v4", "op1", 10), newValidator([]byte("v1"), 20)}},
+		{"rotated and removed",
+			[]*Validator{newOperatedValidator("v4", "op1", 10), newValidator([]byte("v1"), 0)}},
+		{"rotated to the key of another validator",
+			[]*Validator{newOperatedValidator("v3", "op1", 10)}},
+	}
+	for _, tc := range testCases {
+		valSetCopy := valSet.Copy()
+		assert.Error(t, valSetCopy.UpdateWithChangeSet(tc.changes), tc.name)
+		assert.Equal(t, valSet, valSetCopy, tc.name)
+	}
+}
+
 // Test that different permutations of an update give the same result.
 func TestValSetUpdatesOrderIndependenceTestsExecute(t *testing.T) {
 	// startVals - initial validators to create the set with
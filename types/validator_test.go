@@ -9,6 +9,8 @@ import (
 
 func TestValidatorProtoBuf(t *testing.T) {
 	val, _ := RandValidator(true, 100)
+	operatedVal, _ := RandValidator(true, 100)
+	operatedVal.OperatorAddress = []byte("operator")
 	testCases := []struct {
 		msg      string
 		v1       *Validator
@@ -16,6 +18,7 @@ func TestValidatorProtoBuf(t *testing.T) {
 		expPass2 bool
 	}{
 		{"success validator", val, true, true},
+		{"success validator with operator address", operatedVal, true, true},
 		{"failure empty", &Validator{}, false, false},
 		{"failure nil", nil, false, false},
 	}
@@ -84,6 +87,15 @@ func TestValidatorValidateBasic(t *testing.T) {
 			err: true,
 			msg: "validator address is the wrong size: 61",
 		},
+		{
+			val: &Validator{
+				PubKey:          pubKey,
+				Address:         pubKey.Address(),
+				OperatorAddress: make([]byte, MaxOperatorAddressSize+1),
+			},
+			err: true,
+			msg: "validator operator address is too big: 65 bytes, max 64",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -16,6 +16,9 @@ const (
 	// MaxVoteBytes is a maximum vote size (including amino overhead).
 	MaxVoteBytes int64  = 209
 	nilVoteStr   string = "nil-Vote"
+
+	// MaxVoteExtensionSize is the maximum size of a vote extension.
+	MaxVoteExtensionSize int = 64 * 1024 // 64kB
 )
 
 var (
@@ -23,6 +26,7 @@ var (
 	ErrVoteInvalidValidatorIndex     = errors.New("invalid validator index")
 	ErrVoteInvalidValidatorAddress   = errors.New("invalid validator address")
 	ErrVoteInvalidSignature          = errors.New("invalid signature")
+	ErrVoteInvalidExtensionSignature = errors.New("invalid vote extension signature")
 	ErrVoteInvalidBlockHash          = errors.New("invalid block hash")
 	ErrVoteNonDeterministicSignature = errors.New("non-deterministic signature")
 	ErrVoteNil                       = errors.New("nil vote")
@@ -58,6 +62,12 @@ type Vote struct {
 	ValidatorAddress Address               `json:"validator_address"`
 	ValidatorIndex   int32                 `json:"validator_index"`
 	Signature        []byte                `json:"signature"`
+
+	// Vote extension provided by the application, and its signature. Only
+	// precommits for a block are extended. The extension isn't covered by
+	// Signature and never becomes part of a Commit.
+	Extension          []byte `json:"extension"`
+	ExtensionSignature []byte `json:"extension_signature"`
 }
 
 // CommitSig converts the Vote to a CommitSig.
@@ -100,6 +110,28 @@ func VoteSignBytes(chainID string, vote *tmproto.Vote) []byte {
 	return bz
 }
 
+// VoteExtensionSignBytes returns the proto-encoding of the canonicalized vote
+// extension of vote, for signing.
+//
+// Panics if the marshaling fails.
+//
+// See CanonicalizeVoteExtension
+func VoteExtensionSignBytes(chainID string, vote *tmproto.Vote) []byte {
+	pb := CanonicalizeVoteExtension(chainID, vote)
+	bz, err := protoio.MarshalDelimited(&pb)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// IsVoteExtendable returns true if vote is a precommit for a block, i.e. if
+// it should be extended by the application and its extension signed.
+func IsVoteExtendable(vote *tmproto.Vote) bool {
+	return vote.Type == tmproto.PrecommitType && len(vote.BlockID.Hash) > 0
+}
+
 func (vote *Vote) Copy() *Vote {
 	voteCopy := *vote
 	return &voteCopy
@@ -155,6 +187,23 @@ func (vote *Vote) Verify(chainID string, pubKey crypto.PubKey) error {
 	return nil
 }
 
+// VerifyExtension checks the signature of the vote extension. Votes which
+// were not extended by their validator have neither an extension nor an
+// extension signature.
+func (vote *Vote) VerifyExtension(chainID string, pubKey crypto.PubKey) error {
+	if len(vote.ExtensionSignature) == 0 {
+		if len(vote.Extension) > 0 {
+			return ErrVoteInvalidExtensionSignature
+		}
+		return nil
+	}
+	v := vote.ToProto()
+	if !pubKey.VerifySignature(VoteExtensionSignBytes(chainID, v), vote.ExtensionSignature) {
+		return ErrVoteInvalidExtensionSignature
+	}
+	return nil
+}
+
 // ValidateBasic performs basic validation.
 func (vote *Vote) ValidateBasic() error {
 	if !IsVoteTypeValid(vote.Type) {
@@ -198,6 +247,25 @@ func (vote *Vote) ValidateBasic() error {
 		return fmt.Errorf("signature is too big (max: %d)", MaxSignatureSize)
 	}
 
+	if vote.Type != tmproto.PrecommitType || vote.BlockID.IsZero() {
+		if len(vote.Extension) > 0 || len(vote.ExtensionSignature) > 0 {
+			return errors.New("only precommits for a block can have a vote extension")
+		}
+		return nil
+	}
+
+	if len(vote.Extension) > MaxVoteExtensionSize {
+		return fmt.Errorf("vote extension is too big (max: %d)", MaxVoteExtensionSize)
+	}
+
+	if len(vote.ExtensionSignature) > MaxSignatureSize {
+		return fmt.Errorf("vote extension signature is too big (max: %d)", MaxSignatureSize)
+	}
+
+	if len(vote.Extension) > 0 && len(vote.ExtensionSignature) == 0 {
+		return errors.New("vote extension signature is missing")
+	}
+
 	return nil
 }
 
@@ -209,18 +277,20 @@ func (vote *Vote) ToProto() *tmproto.Vote {
 	}
 
 	return &tmproto.Vote{
-		Type:             vote.Type,
-		Height:           vote.Height,
-		Round:            vote.Round,
-		BlockID:          vote.BlockID.ToProto(),
-		Timestamp:        vote.Timestamp,
-		ValidatorAddress: vote.ValidatorAddress,
-		ValidatorIndex:   vote.ValidatorIndex,
-		Signature:        vote.Signature,
+		Type:               vote.Type,
+		Height:             vote.Height,
+		Round:              vote.Round,
+		BlockID:            vote.BlockID.ToProto(),
+		Timestamp:          vote.Timestamp,
+		ValidatorAddress:   vote.ValidatorAddress,
+		ValidatorIndex:     vote.ValidatorIndex,
+		Signature:          vote.Signature,
+		Extension:          vote.Extension,
+		ExtensionSignature: vote.ExtensionSignature,
 	}
 }
 
-//FromProto converts a proto generetad type to a handwritten type
+// FromProto converts a proto generetad type to a handwritten type
 // return type, nil if everything converts safely, otherwise nil, error
 func VoteFromProto(pv *tmproto.Vote) (*Vote, error) {
 	if pv == nil {
@@ -241,6 +311,8 @@ func VoteFromProto(pv *tmproto.Vote) (*Vote, error) {
 	vote.ValidatorAddress = pv.ValidatorAddress
 	vote.ValidatorIndex = pv.ValidatorIndex
 	vote.Signature = pv.Signature
+	vote.Extension = pv.Extension
+	vote.ExtensionSignature = pv.ExtensionSignature
 
 	return vote, vote.ValidateBasic()
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/mydexchain/tendermint0/crypto/bls12381"
 	"github.com/mydexchain/tendermint0/libs/bits"
 	tmjson "github.com/mydexchain/tendermint0/libs/json"
 	tmsync "github.com/mydexchain/tendermint0/libs/sync"
@@ -72,6 +73,12 @@ type VoteSet struct {
 	maj23         *BlockID               // First 2/3 majority seen
 	votesByBlock  map[string]*blockVotes // string(blockHash|blockParts) -> blockVotes
 	peerMaj23s    map[P2PID]BlockID      // Maj23 for each peer
+
+	// Aggregated signature of the votes without signatures (see
+	// CommitToVoteSet)
+	aggregatedSignature []byte
+
+	verifyExtension func(*Vote) error // see SetExtensionVerifier
 }
 
 // Constructs a new VoteSet struct used to accumulate votes for given height/round.
@@ -99,6 +106,15 @@ func (voteSet *VoteSet) ChainID() string {
 	return voteSet.chainID
 }
 
+// SetExtensionVerifier sets the function verifying the vote extensions of the
+// precommits for a block, once their signatures are verified. Votes whose
+// extension is rejected are not added.
+func (voteSet *VoteSet) SetExtensionVerifier(verify func(*Vote) error) {
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+	voteSet.verifyExtension = verify
+}
+
 // Implements VoteSetReader.
 func (voteSet *VoteSet) GetHeight() int64 {
 	if voteSet == nil {
@@ -191,6 +207,12 @@ func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
 			valAddr, lookupAddr, valIndex, ErrVoteInvalidValidatorAddress)
 	}
 
+	// The votes of an aggregated commit can't be replaced, since they're part
+	// of the aggregated signature.
+	if existing := voteSet.votes[valIndex]; existing != nil && len(existing.Signature) == 0 {
+		return false, nil
+	}
+
 	// If we already know of this vote, return false.
 	if existing, ok := voteSet.getVote(valIndex, blockKey); ok {
 		if bytes.Equal(existing.Signature, vote.Signature) {
@@ -203,6 +225,15 @@ func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
 	if err := vote.Verify(voteSet.chainID, val.PubKey); err != nil {
 		return false, fmt.Errorf("failed to verify vote with ChainID %s and PubKey %s: %w", voteSet.chainID, val.PubKey, err)
 	}
+	if err := vote.VerifyExtension(voteSet.chainID, val.PubKey); err != nil {
+		return false, fmt.Errorf("failed to verify vote extension with ChainID %s and PubKey %s: %w",
+			voteSet.chainID, val.PubKey, err)
+	}
+	if voteSet.verifyExtension != nil && IsVoteExtendable(vote.ToProto()) {
+		if err := voteSet.verifyExtension(vote); err != nil {
+			return false, fmt.Errorf("vote extension rejected: %w", err)
+		}
+	}
 
 	// Add vote and get conflicting vote if any.
 	added, conflicting := voteSet.addVerifiedVote(vote, blockKey, val.VotingPower)
@@ -215,6 +246,40 @@ func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
 	return added, nil
 }
 
+// addAggregatedCommit verifies the aggregated commit, along with the vote
+// extensions, and adds its votes, which have no signatures.
+func (voteSet *VoteSet) addAggregatedCommit(ec *ExtendedCommit) error {
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	commit := ec.ToCommit()
+	if err := voteSet.valSet.VerifyCommit(voteSet.chainID, commit.BlockID, commit.Height, commit); err != nil {
+		return err
+	}
+
+	for idx, sig := range ec.ExtendedSignatures {
+		if sig.Absent() {
+			continue
+		}
+		vote := ec.GetExtendedVote(int32(idx))
+		if vote.Round != voteSet.round {
+			return fmt.Errorf("expected round %d, but got %d: %w", voteSet.round, vote.Round, ErrVoteUnexpectedStep)
+		}
+		lookupAddr, val := voteSet.valSet.GetByIndex(vote.ValidatorIndex)
+		if !bytes.Equal(vote.ValidatorAddress, lookupAddr) {
+			return fmt.Errorf("vote.ValidatorAddress (%X) does not match address (%X) for vote.ValidatorIndex (%d): %w",
+				vote.ValidatorAddress, lookupAddr, vote.ValidatorIndex, ErrVoteInvalidValidatorAddress)
+		}
+		if err := vote.VerifyExtension(voteSet.chainID, val.PubKey); err != nil {
+			return fmt.Errorf("failed to verify vote extension with ChainID %s and PubKey %s: %w",
+				voteSet.chainID, val.PubKey, err)
+		}
+		voteSet.addVerifiedVote(vote, vote.BlockID.Key(), val.VotingPower)
+	}
+	voteSet.aggregatedSignature = commit.AggregatedSignature
+	return nil
+}
+
 // Returns (vote, true) if vote exists for valIndex and blockKey.
 func (voteSet *VoteSet) getVote(valIndex int32, blockKey string) (vote *Vote, ok bool) {
 	if existing := voteSet.votes[valIndex]; existing != nil && existing.BlockID.Key() == blockKey {
@@ -576,6 +641,15 @@ func (voteSet *VoteSet) sumTotalFrac() (int64, int64, float64) {
 // Panics if the vote type is not PrecommitType or if there's no +2/3 votes for
 // a single block.
 func (voteSet *VoteSet) MakeCommit() *Commit {
+	return voteSet.MakeExtendedCommit().ToCommit()
+}
+
+// MakeExtendedCommit constructs a Commit from the VoteSet, which includes the
+// vote extensions of the precommits. See MakeCommit.
+//
+// Panics if the vote type is not PrecommitType or if there's no +2/3 votes for
+// a single block.
+func (voteSet *VoteSet) MakeExtendedCommit() *ExtendedCommit {
 	if voteSet.signedMsgType != tmproto.PrecommitType {
 		panic("Cannot MakeCommit() unless VoteSet.Type is PrecommitType")
 	}
@@ -588,17 +662,37 @@ func (voteSet *VoteSet) MakeCommit() *Commit {
 	}
 
 	// For every validator, get the precommit
-	commitSigs := make([]CommitSig, len(voteSet.votes))
+	sigs := make([]ExtendedCommitSig, len(voteSet.votes))
 	for i, v := range voteSet.votes {
 		commitSig := v.CommitSig()
 		// if block ID exists but doesn't match, exclude sig
 		if commitSig.ForBlock() && !v.BlockID.Equals(*voteSet.maj23) {
 			commitSig = NewCommitSigAbsent()
 		}
-		commitSigs[i] = commitSig
+		sigs[i] = ExtendedCommitSig{CommitSig: commitSig}
+		if commitSig.ForBlock() {
+			sigs[i].Extension = v.Extension
+			sigs[i].ExtensionSignature = v.ExtensionSignature
+		}
 	}
 
-	return NewCommit(voteSet.GetHeight(), voteSet.GetRound(), *voteSet.maj23, commitSigs)
+	ec := NewExtendedCommit(voteSet.GetHeight(), voteSet.GetRound(), *voteSet.maj23, sigs)
+	if voteSet.aggregatedSignature != nil {
+		// Some votes have no signatures, so the commit must be aggregated.
+		toAggregate := [][]byte{voteSet.aggregatedSignature}
+		for i := range sigs {
+			if len(sigs[i].Signature) > 0 {
+				toAggregate = append(toAggregate, sigs[i].Signature)
+				sigs[i].Signature = nil
+			}
+		}
+		aggregated, err := bls12381.AggregateSignatures(toAggregate)
+		if err != nil {
+			panic(fmt.Sprintf("Cannot aggregate verified signatures: %v", err))
+		}
+		ec.AggregatedSignature = aggregated
+	}
+	return ec
 }
 
 //--------------------------------------------------------------------------------
@@ -471,6 +471,88 @@ func TestVoteSet_MakeCommit(t *testing.T) {
 	}
 }
 
+func TestVoteSet_MakeExtendedCommit(t *testing.T) {
+	height, round := int64(1), int32(0)
+	voteSet, _, privValidators := randVoteSet(height, round, tmproto.PrecommitType, 4, 1)
+	blockID := BlockID{crypto.CRandBytes(32), PartSetHeader{123, crypto.CRandBytes(32)}}
+
+	voteProto := &Vote{
+		ValidatorAddress: nil,
+		ValidatorIndex:   -1,
+		Height:           height,
+		Round:            round,
+		Timestamp:        tmtime.Now(),
+		Type:             tmproto.PrecommitType,
+		BlockID:          blockID,
+	}
+
+	// 3 out of 4 voted for the block, with an extension.
+	for i := int32(0); i < 3; i++ {
+		pv, err := privValidators[i].GetPubKey()
+		require.NoError(t, err)
+		vote := withValidator(voteProto, pv.Address(), i)
+		vote.Extension = []byte{byte(i)}
+		v := vote.ToProto()
+		require.NoError(t, privValidators[i].SignVote(voteSet.ChainID(), v))
+		vote.Signature = v.Signature
+		vote.ExtensionSignature = v.ExtensionSignature
+		_, err = voteSet.AddVote(vote)
+		require.NoError(t, err)
+	}
+
+	// The 4th voted for nil.
+	{
+		pv, err := privValidators[3].GetPubKey()
+		require.NoError(t, err)
+		vote := withValidator(voteProto, pv.Address(), 3)
+		vote.BlockID = BlockID{}
+		_, err = signAddVote(privValidators[3], vote, voteSet)
+		require.NoError(t, err)
+	}
+
+	extCommit := voteSet.MakeExtendedCommit()
+	require.Len(t, extCommit.ExtendedSignatures, 4)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, []byte{byte(i)}, extCommit.ExtendedSignatures[i].Extension)
+		assert.NotEmpty(t, extCommit.ExtendedSignatures[i].ExtensionSignature)
+	}
+	assert.Empty(t, extCommit.ExtendedSignatures[3].Extension)
+	assert.Equal(t, BlockIDFlagNil, extCommit.ExtendedSignatures[3].BlockIDFlag)
+
+	// The commit doesn't carry the extensions.
+	commit := extCommit.ToCommit()
+	assert.Equal(t, voteSet.MakeCommit(), commit)
+	require.NoError(t, commit.ValidateBasic())
+}
+
+func TestVoteSet_AddVote_BadExtensionSignature(t *testing.T) {
+	height, round := int64(1), int32(0)
+	voteSet, _, privValidators := randVoteSet(height, round, tmproto.PrecommitType, 4, 1)
+
+	pv, err := privValidators[0].GetPubKey()
+	require.NoError(t, err)
+	vote := &Vote{
+		ValidatorAddress: pv.Address(),
+		ValidatorIndex:   0,
+		Height:           height,
+		Round:            round,
+		Timestamp:        tmtime.Now(),
+		Type:             tmproto.PrecommitType,
+		BlockID:          BlockID{crypto.CRandBytes(32), PartSetHeader{123, crypto.CRandBytes(32)}},
+		Extension:        []byte("extension"),
+	}
+	v := vote.ToProto()
+	require.NoError(t, privValidators[0].SignVote(voteSet.ChainID(), v))
+	vote.Signature = v.Signature
+	vote.ExtensionSignature = v.ExtensionSignature
+
+	// the extension was changed after signing
+	vote.Extension = []byte("tampered")
+	added, err := voteSet.AddVote(vote)
+	assert.False(t, added)
+	assert.Error(t, err)
+}
+
 func buildVoteSet(
 	height int64, round int32, nonVotes, nonNilVotes, nilVotes int,
 	voteType tmproto.SignedMsgType) (voteSet *VoteSet, valSet *ValidatorSet,
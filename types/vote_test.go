@@ -297,6 +297,79 @@ func TestVoteValidateBasic(t *testing.T) {
 	}
 }
 
+func TestVoteExtension(t *testing.T) {
+	privVal := NewMockPV()
+	pubkey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	vote := examplePrecommit()
+	vote.ValidatorAddress = pubkey.Address()
+	vote.Extension = []byte("extension")
+	v := vote.ToProto()
+	err = privVal.SignVote("test_chain_id", v)
+	require.NoError(t, err)
+	vote.Signature = v.Signature
+	vote.ExtensionSignature = v.ExtensionSignature
+	require.NotEmpty(t, vote.ExtensionSignature)
+
+	// the extension isn't covered by the vote signature
+	require.NoError(t, vote.Verify("test_chain_id", pubkey))
+	require.NoError(t, vote.VerifyExtension("test_chain_id", pubkey))
+	require.Equal(t, ErrVoteInvalidExtensionSignature,
+		vote.VerifyExtension("other_chain_id", pubkey))
+	require.Equal(t, ErrVoteInvalidExtensionSignature,
+		vote.VerifyExtension("test_chain_id", ed25519.GenPrivKey().PubKey()))
+
+	tampered := vote.Copy()
+	tampered.Extension = []byte("tampered")
+	require.NoError(t, tampered.Verify("test_chain_id", pubkey))
+	require.Equal(t, ErrVoteInvalidExtensionSignature, tampered.VerifyExtension("test_chain_id", pubkey))
+
+	unsigned := vote.Copy()
+	unsigned.ExtensionSignature = nil
+	require.Equal(t, ErrVoteInvalidExtensionSignature, unsigned.VerifyExtension("test_chain_id", pubkey))
+
+	// prevotes are never extended
+	prevote := examplePrevote()
+	pv := prevote.ToProto()
+	require.NoError(t, privVal.SignVote("test_chain_id", pv))
+	require.Empty(t, pv.ExtensionSignature)
+}
+
+func TestVoteValidateBasicExtension(t *testing.T) {
+	privVal := NewMockPV()
+
+	testCases := []struct {
+		testName     string
+		malleateVote func(*Vote)
+		expectErr    bool
+	}{
+		{"Good Extension", func(v *Vote) {}, false},
+		{"No Extension", func(v *Vote) { v.Extension, v.ExtensionSignature = nil, nil }, false},
+		{"Missing Extension Signature", func(v *Vote) { v.ExtensionSignature = nil }, true},
+		{"Too big Extension", func(v *Vote) { v.Extension = make([]byte, MaxVoteExtensionSize+1) }, true},
+		{"Too big Extension Signature", func(v *Vote) {
+			v.ExtensionSignature = make([]byte, MaxSignatureSize+1)
+		}, true},
+		{"Extended Prevote", func(v *Vote) { v.Type = tmproto.PrevoteType }, true},
+		{"Extended nil Precommit", func(v *Vote) { v.BlockID = BlockID{} }, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			vote := examplePrecommit()
+			vote.Extension = []byte("extension")
+			v := vote.ToProto()
+			err := privVal.SignVote("test_chain_id", v)
+			require.NoError(t, err)
+			vote.Signature = v.Signature
+			vote.ExtensionSignature = v.ExtensionSignature
+			tc.malleateVote(vote)
+			assert.Equal(t, tc.expectErr, vote.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
 func TestVoteProtobuf(t *testing.T) {
 	privVal := NewMockPV()
 	vote := examplePrecommit()
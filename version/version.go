@@ -23,7 +23,7 @@ const (
 	TMCoreSemVer = "0.34.0"
 
 	// ABCISemVer is the semantic version of the ABCI library
-	ABCISemVer = "0.17.0"
+	ABCISemVer = "0.18.0"
 
 	ABCIVersion = ABCISemVer
 )